@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	applydefaultspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/applydefaults"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	m := &applydefaultspb.Config{}
+	m.ApplyDefaults()
+	want := &applydefaultspb.Config{
+		Name:    proto.String("default"),
+		Mode:    applydefaultspb.Config_MODE_SAFE.Enum(),
+		Salt:    []byte{1, 2},
+		Retries: proto.Int32(3),
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ApplyDefaults() = %v, want %v", m, want)
+	}
+
+	// The default bytes value must not be shared with the message.
+	m.Salt[0] = 9
+	if applydefaultspb.Default_Config_Salt[0] != 1 {
+		t.Errorf("modifying the salt field modified Default_Config_Salt")
+	}
+}
+
+func TestApplyDefaultsKeepsSetFields(t *testing.T) {
+	m := &applydefaultspb.Config{
+		Name:   proto.String(""),
+		Mode:   applydefaultspb.Config_MODE_FAST.Enum(),
+		Salt:   []byte{},
+		Choice: &applydefaultspb.Config_Label{Label: "set"},
+	}
+	m.ApplyDefaults()
+	want := &applydefaultspb.Config{
+		Name:    proto.String(""),
+		Mode:    applydefaultspb.Config_MODE_FAST.Enum(),
+		Salt:    []byte{},
+		Retries: proto.Int32(3),
+		Choice:  &applydefaultspb.Config_Label{Label: "set"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ApplyDefaults() = %v, want %v", m, want)
+	}
+
+	var nilConfig *applydefaultspb.Config
+	nilConfig.ApplyDefaults() // must not panic
+}
+
+func TestApplyDefaultsEditions(t *testing.T) {
+	m := &applydefaultspb.EditionsConfig{}
+	m.SetMode(applydefaultspb.Config_MODE_FAST)
+	m.ApplyDefaults()
+	if got, want := m.GetName(), "default"; !m.HasName() || got != want {
+		t.Errorf("after ApplyDefaults: name = %q (set %v), want %q", got, m.HasName(), want)
+	}
+	if got, want := m.GetMode(), applydefaultspb.Config_MODE_FAST; got != want {
+		t.Errorf("after ApplyDefaults: mode = %v, want %v", got, want)
+	}
+	if got, want := string(m.GetSalt()), "\x01\x02"; !m.HasSalt() || got != want {
+		t.Errorf("after ApplyDefaults: salt = %q (set %v), want %q", got, m.HasSalt(), want)
+	}
+	if m.HasRetries() {
+		t.Errorf("after ApplyDefaults: retries is set, want unset as it has no declared default")
+	}
+}
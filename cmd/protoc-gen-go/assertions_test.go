@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestOneofAssertions checks that the generated files declare an assertion
+// for each method through which a oneof wrapper type implements the
+// interface of its oneof.
+func TestOneofAssertions(t *testing.T) {
+	for _, path := range []string{
+		"testdata/assertions/oneof/oneof.pb.go",
+		"testdata/assertions/oneof/hybrid.pb.go",
+		"testdata/assertions/oneof/hybrid_protoopaque.pb.go",
+	} {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var methods, assertions []string
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil || !strings.HasPrefix(decl.Name.Name, "is") {
+					continue
+				}
+				recv := decl.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident)
+				methods = append(methods, decl.Name.Name+" "+recv.Name)
+			case *ast.GenDecl:
+				if decl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.ValueSpec)
+					if spec.Names[0].Name != "_" || spec.Type == nil {
+						continue
+					}
+					conv, ok := spec.Values[0].(*ast.CallExpr)
+					if !ok {
+						continue
+					}
+					star := conv.Fun.(*ast.ParenExpr).X.(*ast.StarExpr)
+					assertions = append(assertions, spec.Type.(*ast.Ident).Name+" "+star.X.(*ast.Ident).Name)
+				}
+			}
+		}
+		if len(methods) == 0 {
+			t.Errorf("%v: no oneof wrapper types found", path)
+		}
+		if diff := cmp.Diff(methods, assertions); diff != "" {
+			t.Errorf("%v: assertions do not match oneof wrapper methods (-methods +assertions):\n%s", path, diff)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	atpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/at"
+)
+
+func TestAtInRange(t *testing.T) {
+	child := &atpb.Series{}
+	m := &atpb.Series{
+		Points:   []int64{10, 20},
+		Blobs:    [][]byte{[]byte("blob")},
+		Children: []*atpb.Series{child, nil},
+	}
+	if v, ok := m.PointsAt(1); v != 20 || !ok {
+		t.Errorf("PointsAt(1) = (%v, %v), want (20, true)", v, ok)
+	}
+	if v, ok := m.BlobsAt(0); string(v) != "blob" || !ok {
+		t.Errorf("BlobsAt(0) = (%q, %v), want (%q, true)", v, ok, "blob")
+	}
+	if v, ok := m.ChildrenAt(0); v != child || !ok {
+		t.Errorf("ChildrenAt(0) = (%v, %v), want (%v, true)", v, ok, child)
+	}
+	if v, ok := m.ChildrenAt(1); v != nil || !ok {
+		t.Errorf("ChildrenAt(1) = (%v, %v), want (nil, true)", v, ok)
+	}
+}
+
+func TestAtOutOfRange(t *testing.T) {
+	m := &atpb.Series{
+		Points:   []int64{10, 20},
+		Children: []*atpb.Series{{}},
+	}
+	for _, i := range []int{-1, 2} {
+		if v, ok := m.PointsAt(i); v != 0 || ok {
+			t.Errorf("PointsAt(%d) = (%v, %v), want (0, false)", i, v, ok)
+		}
+	}
+	if v, ok := m.ChildrenAt(1); v != nil || ok {
+		t.Errorf("ChildrenAt(1) = (%v, %v), want (nil, false)", v, ok)
+	}
+}
+
+func TestAtEmpty(t *testing.T) {
+	for _, m := range []*atpb.Series{{}, nil} {
+		if v, ok := m.LabelsAt(0); v != "" || ok {
+			t.Errorf("LabelsAt(0) on %v = (%q, %v), want (\"\", false)", m, v, ok)
+		}
+		if v, ok := m.BlobsAt(0); v != nil || ok {
+			t.Errorf("BlobsAt(0) on %v = (%q, %v), want (nil, false)", m, v, ok)
+		}
+	}
+}
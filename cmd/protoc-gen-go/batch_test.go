@@ -0,0 +1,118 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+
+	batchpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/batch"
+)
+
+func batchRecords(n int) []*batchpb.Record {
+	xs := make([]*batchpb.Record, n)
+	for i := range xs {
+		xs[i] = &batchpb.Record{
+			Id:      int64(i),
+			Payload: "payload",
+			Values:  []int32{int32(i), int32(i * 2)},
+		}
+	}
+	return xs
+}
+
+func TestMarshalSlice(t *testing.T) {
+	xs := batchRecords(3)
+	bs, err := batchpb.MarshalRecordSlice(xs)
+	if err != nil {
+		t.Fatalf("MarshalRecordSlice: %v", err)
+	}
+	if len(bs) != len(xs) {
+		t.Fatalf("MarshalRecordSlice returned %d encodings, want %d", len(bs), len(xs))
+	}
+	for i, b := range bs {
+		got := &batchpb.Record{}
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Fatalf("proto.Unmarshal(encoding %d): %v", i, err)
+		}
+		if !proto.Equal(got, xs[i]) {
+			t.Errorf("encoding %d decodes to %v, want %v", i, got, xs[i])
+		}
+	}
+
+	if _, err := batchpb.MarshalRecordSlice([]*batchpb.Record{xs[0], nil}); err == nil {
+		t.Errorf("MarshalRecordSlice with nil element: got nil error, want error")
+	}
+
+	skipped, err := batchpb.MarshalSkippedRecordSlice([]*batchpb.SkippedRecord{{Id: 1}, nil, {Id: 2}})
+	if err != nil {
+		t.Fatalf("MarshalSkippedRecordSlice: %v", err)
+	}
+	if len(skipped) != 2 {
+		t.Errorf("MarshalSkippedRecordSlice returned %d encodings, want 2", len(skipped))
+	}
+}
+
+func TestMarshalStream(t *testing.T) {
+	xs := batchRecords(3)
+	var buf bytes.Buffer
+	if err := batchpb.MarshalRecordStream(&buf, xs); err != nil {
+		t.Fatalf("MarshalRecordStream: %v", err)
+	}
+	r := bufio.NewReader(&buf)
+	for i, want := range xs {
+		got := &batchpb.Record{}
+		if err := protodelim.UnmarshalFrom(r, got); err != nil {
+			t.Fatalf("protodelim.UnmarshalFrom(message %d): %v", i, err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("message %d = %v, want %v", i, got, want)
+		}
+	}
+	if _, err := r.ReadByte(); err == nil {
+		t.Errorf("MarshalRecordStream wrote trailing data")
+	}
+
+	if err := batchpb.MarshalRecordStream(&buf, []*batchpb.Record{nil}); err == nil {
+		t.Errorf("MarshalRecordStream with nil element: got nil error, want error")
+	}
+	buf.Reset()
+	if err := batchpb.MarshalSkippedRecordStream(&buf, []*batchpb.SkippedRecord{nil, {Id: 1}}); err != nil {
+		t.Fatalf("MarshalSkippedRecordStream: %v", err)
+	}
+	got := &batchpb.SkippedRecord{}
+	if err := protodelim.UnmarshalFrom(bufio.NewReader(&buf), got); err != nil || got.GetId() != 1 {
+		t.Errorf("MarshalSkippedRecordStream wrote %v (err: %v), want id 1", got, err)
+	}
+}
+
+func BenchmarkMarshalSlice(b *testing.B) {
+	xs := batchRecords(100)
+	b.Run("Batch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := batchpb.MarshalRecordSlice(xs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Naive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bs := make([][]byte, 0, len(xs))
+			for _, x := range xs {
+				enc, err := proto.Marshal(x)
+				if err != nil {
+					b.Fatal(err)
+				}
+				bs = append(bs, enc)
+			}
+		}
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	byjsonnamepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/byjsonname"
+)
+
+func TestByJSONName(t *testing.T) {
+	got := &byjsonnamepb.Signup{}
+	for _, set := range []struct {
+		name string
+		v    any
+	}{
+		{"userName", "gopher"},
+		{"limit", int32(10)},
+		{"subscribe", false},
+		{"interests", []string{"go"}},
+		{"referrer", &byjsonnamepb.Signup{UserName: "ref"}},
+		{"emailAddress", "g@example.com"},
+	} {
+		if err := got.SetByJSONName(set.name, set.v); err != nil {
+			t.Errorf("SetByJSONName(%q, %v): %v", set.name, set.v, err)
+		}
+	}
+	want := &byjsonnamepb.Signup{
+		UserName:  "gopher",
+		MaxItems:  10,
+		Subscribe: proto.Bool(false),
+		Interests: []string{"go"},
+		Referrer:  &byjsonnamepb.Signup{UserName: "ref"},
+		Contact:   &byjsonnamepb.Signup_EmailAddress{EmailAddress: "g@example.com"},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("after SetByJSONName:\ngot:  %v\nwant: %v", got, want)
+	}
+
+	for name, want := range map[string]any{
+		"userName":     "gopher",
+		"limit":        int32(10),
+		"emailAddress": "g@example.com",
+		"phone":        "",
+	} {
+		v, err := got.GetByJSONName(name)
+		if err != nil || v != want {
+			t.Errorf("GetByJSONName(%q) = %v, %v, want %v", name, v, err, want)
+		}
+	}
+}
+
+func TestByJSONNameErrors(t *testing.T) {
+	m := &byjsonnamepb.Signup{}
+	for _, name := range []string{"user_name", "maxItems", "max_items", "UserName", ""} {
+		if err := m.SetByJSONName(name, "x"); err == nil || !strings.Contains(err.Error(), "unknown JSON name") {
+			t.Errorf("SetByJSONName(%q): got error %v, want unknown JSON name", name, err)
+		}
+		if _, err := m.GetByJSONName(name); err == nil {
+			t.Errorf("GetByJSONName(%q): got nil error, want error", name)
+		}
+	}
+	if err := m.SetByJSONName("limit", int64(1)); err == nil || !strings.Contains(err.Error(), "invalid type int64") {
+		t.Errorf("SetByJSONName with a mistyped value: got error %v, want invalid type", err)
+	}
+	if !proto.Equal(m, &byjsonnamepb.Signup{}) {
+		t.Errorf("failed SetByJSONName modified the message: %v", m)
+	}
+}
+
+func TestByJSONNameHybrid(t *testing.T) {
+	m := &byjsonnamepb.Profile{}
+	if err := m.SetByJSONName("age", int64(30)); err != nil {
+		t.Errorf("SetByJSONName(age): %v", err)
+	}
+	if err := m.SetByJSONName("avatarPng", []byte{1}); err != nil {
+		t.Errorf("SetByJSONName(avatarPng): %v", err)
+	}
+	want := byjsonnamepb.Profile_builder{AgeYears: proto.Int64(30), AvatarPng: []byte{1}}.Build()
+	if !proto.Equal(m, want) {
+		t.Errorf("after SetByJSONName: got %v, want %v", m, want)
+	}
+	if v, err := m.GetByJSONName("age"); err != nil || v != int64(30) {
+		t.Errorf("GetByJSONName(age) = %v, %v, want 30", v, err)
+	}
+	if _, err := m.GetByJSONName("ageYears"); err == nil {
+		t.Errorf("GetByJSONName(ageYears): got nil error, want error")
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	bytelenpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/bytelen"
+)
+
+func TestTotalStringBytes(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		m    *bytelenpb.Upload
+		want int
+	}{
+		{"nil", nil, 0},
+		{"empty", &bytelenpb.Upload{}, 0},
+		{"non-string fields", &bytelenpb.Upload{Size: 100, Sizes: map[int32]int64{1: 2}}, 0},
+		{"repeated string", &bytelenpb.Upload{Name: "ab", Tags: []string{"cde", "", "f"}}, 6},
+		{"nested bytes", &bytelenpb.Upload{
+			First:  &bytelenpb.Upload_Chunk{Data: []byte("1234"), Offset: 7},
+			Chunks: []*bytelenpb.Upload_Chunk{{Data: []byte("12")}, nil},
+		}, 6},
+		{"maps", &bytelenpb.Upload{
+			Metadata: map[string]string{"key": "value"},
+			ByIndex:  map[int32]*bytelenpb.Upload_Chunk{1: {Data: []byte("123")}, 2: nil},
+		}, 11},
+		{"oneof", &bytelenpb.Upload{Source: &bytelenpb.Upload_Inline{Inline: []byte("12345")}}, 5},
+		{"optional", &bytelenpb.Upload{Title: proto.String("abc"), Checksum: []byte("12")}, 5},
+	} {
+		if got := tt.m.TotalStringBytes(); got != tt.want {
+			t.Errorf("%v: TotalStringBytes() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
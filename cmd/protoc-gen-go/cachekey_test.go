@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	rawpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cachekey/raw"
+	sha256pb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cachekey/sha256"
+)
+
+func TestCacheKey(t *testing.T) {
+	newQuery := func(keys ...string) *rawpb.Query {
+		m := &rawpb.Query{Table: "t", Filters: map[string]string{}, Columns: []string{"a", "b"}}
+		for _, k := range keys {
+			m.Filters[k] = "v" + k
+		}
+		return m
+	}
+	x, y := newQuery("a", "b", "c", "d", "e", "f"), newQuery("f", "e", "d", "c", "b", "a")
+	if got, want := x.CacheKey(), y.CacheKey(); got != want || got == "" {
+		t.Errorf("equal messages have keys %q and %q, want the same non-empty key", got, want)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := x.CacheKey(), base64.RawURLEncoding.EncodeToString(b); got != want {
+		t.Errorf("CacheKey() = %q, want %q", got, want)
+	}
+
+	for _, edit := range []func(*rawpb.Query){
+		func(m *rawpb.Query) { m.Table = "u" },
+		func(m *rawpb.Query) { m.Filters["a"] = "changed" },
+		func(m *rawpb.Query) { m.Columns = []string{"b", "a"} },
+		func(m *rawpb.Query) { m.Limit = 1 },
+	} {
+		z := proto.Clone(x).(*rawpb.Query)
+		edit(z)
+		if x.CacheKey() == z.CacheKey() {
+			t.Errorf("messages %v and %v have the same key %q", x, z, x.CacheKey())
+		}
+	}
+}
+
+func TestCacheKeySHA256(t *testing.T) {
+	x := &sha256pb.Query{Table: "t", Filters: map[string]string{"a": "1", "b": "2"}}
+	y := &sha256pb.Query{Table: "t", Filters: map[string]string{"b": "2", "a": "1"}}
+	if got, want := x.CacheKey(), y.CacheKey(); got != want {
+		t.Errorf("equal messages have keys %q and %q, want the same key", got, want)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(b)
+	if got, want := x.CacheKey(), base64.RawURLEncoding.EncodeToString(sum[:]); got != want {
+		t.Errorf("CacheKey() = %q, want %q", got, want)
+	}
+
+	y.Limit = 1
+	if x.CacheKey() == y.CacheKey() {
+		t.Errorf("messages %v and %v have the same key %q", x, y, x.CacheKey())
+	}
+}
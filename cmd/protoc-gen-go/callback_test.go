@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	callbackpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/tracking/callback"
+)
+
+type fieldChange struct {
+	Field    protoreflect.Name
+	Old, New any
+}
+
+func TestOnFieldChange(t *testing.T) {
+	m := &callbackpb.Account{}
+	m.SetName("before")
+
+	var changes []fieldChange
+	m.OnFieldChange(func(field protoreflect.Name, old, new any) {
+		if got := m.GetBalance(); field == "balance" && got != new {
+			t.Errorf("callback for balance called before the write: GetBalance() = %v, want %v", got, new)
+		}
+		changes = append(changes, fieldChange{field, old, new})
+	})
+	m.SetName("after")
+	m.SetBalance(10)
+	m.SetBalance(20)
+	m.SetEmail("a@example.com")
+	m.SetPhone("555")
+	m.SetTags([]string{"a"})
+	want := []fieldChange{
+		{"name", "before", "after"},
+		{"balance", int64(0), int64(10)},
+		{"balance", int64(10), int64(20)},
+		{"email", "", "a@example.com"},
+		{"phone", "", "555"},
+		{"tags", []string(nil), []string{"a"}},
+	}
+	if diff := cmp.Diff(want, changes); diff != "" {
+		t.Errorf("field changes mismatch (-want +got):\n%s", diff)
+	}
+
+	changes = nil
+	m.ClearName()
+	proto.Merge(m, callbackpb.Account_builder{Balance: proto.Int64(30)}.Build())
+	m.OnFieldChange(nil)
+	m.SetName("removed")
+	if len(changes) > 0 {
+		t.Errorf("callback called for %v, want no calls after clearing, merging and removing it", changes)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	clearkindpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearkind"
+)
+
+func newClearKindProfile() *clearkindpb.Profile {
+	return &clearkindpb.Profile{
+		Name:     "name",
+		Id:       1,
+		Aliases:  []string{"a"},
+		Home:     &clearkindpb.Profile_Address{Street: "home", Number: 1},
+		Previous: []*clearkindpb.Profile_Address{{Street: "old", Number: 2}},
+		Labels:   map[string]string{"k": "v"},
+		ByFloor:  map[int32]*clearkindpb.Profile_Address{3: {Street: "floor", Number: 3}},
+		Contact:  &clearkindpb.Profile_Email{Email: "email"},
+	}
+}
+
+func TestClearKindString(t *testing.T) {
+	m := newClearKindProfile()
+	m.ClearKind(protoreflect.StringKind)
+	want := &clearkindpb.Profile{
+		Id:       1,
+		Home:     &clearkindpb.Profile_Address{Number: 1},
+		Previous: []*clearkindpb.Profile_Address{{Number: 2}},
+		ByFloor:  map[int32]*clearkindpb.Profile_Address{3: {Number: 3}},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ClearKind(StringKind) = %v, want %v", m, want)
+	}
+}
+
+func TestClearKindMessage(t *testing.T) {
+	m := newClearKindProfile()
+	m.Contact = &clearkindpb.Profile_Mailing{Mailing: &clearkindpb.Profile_Address{}}
+	m.ClearKind(protoreflect.MessageKind)
+	want := &clearkindpb.Profile{
+		Name:    "name",
+		Id:      1,
+		Aliases: []string{"a"},
+		Labels:  map[string]string{"k": "v"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ClearKind(MessageKind) = %v, want %v", m, want)
+	}
+
+	var nilProfile *clearkindpb.Profile
+	nilProfile.ClearKind(protoreflect.StringKind) // must not panic
+}
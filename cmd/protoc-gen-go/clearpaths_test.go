@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	clearpathspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearpaths"
+)
+
+func newClearPathsUser() *clearpathspb.User {
+	return &clearpathspb.User{
+		Name:  "name",
+		Email: "user@example.com",
+		Address: &clearpathspb.Address{
+			Street: "street",
+			City:   "city",
+		},
+		Phones: []string{"1", "2"},
+		Labels: map[string]string{"k": "v"},
+		PreviousAddresses: []*clearpathspb.Address{
+			{Street: "old street"},
+		},
+	}
+}
+
+func TestClearPaths(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		paths []string
+		want  func(*clearpathspb.User)
+	}{{
+		desc:  "scalar",
+		paths: []string{"email"},
+		want:  func(u *clearpathspb.User) { u.Email = "" },
+	}, {
+		desc:  "nested",
+		paths: []string{"address.street"},
+		want:  func(u *clearpathspb.User) { u.Address.Street = "" },
+	}, {
+		desc:  "repeated and map",
+		paths: []string{"phones", "labels", "previous_addresses"},
+		want: func(u *clearpathspb.User) {
+			u.Phones, u.Labels, u.PreviousAddresses = nil, nil, nil
+		},
+	}, {
+		desc:  "message",
+		paths: []string{"address", "name"},
+		want:  func(u *clearpathspb.User) { u.Address, u.Name = nil, "" },
+	}} {
+		got := newClearPathsUser()
+		if err := got.ClearPaths(test.paths...); err != nil {
+			t.Errorf("%s: ClearPaths(%q): %v", test.desc, test.paths, err)
+			continue
+		}
+		want := newClearPathsUser()
+		test.want(want)
+		if !proto.Equal(got, want) {
+			t.Errorf("%s: after ClearPaths(%q):\ngot:  %v\nwant: %v", test.desc, test.paths, got, want)
+		}
+	}
+
+	// Clearing a field within an unpopulated message is not an error.
+	u := &clearpathspb.User{Name: "name"}
+	if err := u.ClearPaths("address.city"); err != nil {
+		t.Errorf("ClearPaths(%q) on unpopulated message: %v", "address.city", err)
+	}
+	if u.Address != nil {
+		t.Errorf("ClearPaths(%q) populated the address: %v", "address.city", u.Address)
+	}
+}
+
+func TestClearPathsInvalid(t *testing.T) {
+	for _, paths := range [][]string{
+		{"nosuchfield"},
+		{"address.nosuchfield"},
+		{"name.street"},
+		{"previous_addresses.street"},
+		{""},
+		{"email", "address."},
+	} {
+		got := newClearPathsUser()
+		if err := got.ClearPaths(paths...); err == nil {
+			t.Errorf("ClearPaths(%q) = nil, want error", paths)
+		}
+		if want := newClearPathsUser(); !proto.Equal(got, want) {
+			t.Errorf("ClearPaths(%q) modified the message:\ngot:  %v\nwant: %v", paths, got, want)
+		}
+	}
+}
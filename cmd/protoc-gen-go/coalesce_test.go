@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	coalescepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/coalesce"
+)
+
+func newCoalesceDefaults() *coalescepb.Config {
+	return &coalescepb.Config{
+		Name:    "default",
+		Workers: 4,
+		Verbose: proto.Bool(true),
+		Secret:  []byte("s"),
+		Hosts:   []string{"a", "b"},
+		Env:     map[string]string{"HOME": "/"},
+		Limits:  &coalescepb.Config_Limits{Memory: 1 << 30, Cpu: 2},
+		Timeout: durationpb.New(5),
+		Storage: &coalescepb.Config_Path{Path: "/var"},
+	}
+}
+
+func TestCoalesceWith(t *testing.T) {
+	overrides := &coalescepb.Config{
+		Workers: 16,
+		Verbose: proto.Bool(false),
+		Hosts:   []string{"c"},
+		Limits:  &coalescepb.Config_Limits{Cpu: 8},
+		Timeout: &durationpb.Duration{},
+		Storage: &coalescepb.Config_Quota{Quota: &coalescepb.Config_Limits{}},
+	}
+	defaults := newCoalesceDefaults()
+	overrides.CoalesceWith(defaults)
+	want := &coalescepb.Config{
+		Name:    "default",
+		Workers: 16,
+		Verbose: proto.Bool(false),
+		Secret:  []byte("s"),
+		Hosts:   []string{"c"},
+		Env:     map[string]string{"HOME": "/"},
+		Limits:  &coalescepb.Config_Limits{Memory: 1 << 30, Cpu: 8},
+		Timeout: &durationpb.Duration{},
+		Storage: &coalescepb.Config_Quota{Quota: &coalescepb.Config_Limits{}},
+	}
+	if !proto.Equal(overrides, want) {
+		t.Errorf("CoalesceWith:\ngot:  %v\nwant: %v", overrides, want)
+	}
+
+	// The values taken from the fallback are copies.
+	overrides.Secret[0] = 'x'
+	overrides.Env["HOME"] = "/home"
+	if !proto.Equal(defaults, newCoalesceDefaults()) {
+		t.Errorf("modifying the result of CoalesceWith modified the fallback: %v", defaults)
+	}
+
+	empty := &coalescepb.Config{}
+	empty.CoalesceWith(defaults)
+	if !proto.Equal(empty, defaults) || empty.GetLimits() == defaults.GetLimits() {
+		t.Errorf("CoalesceWith on an empty message = %v, want a deep copy of %v", empty, defaults)
+	}
+	empty.CoalesceWith(nil)
+	(*coalescepb.Config)(nil).CoalesceWith(defaults)
+}
+
+func TestCoalesceWithHybrid(t *testing.T) {
+	fallback := coalescepb.Layer_builder{
+		Name:  proto.String("base"),
+		Level: 3,
+		Base:  coalescepb.Layer_builder{Name: proto.String("root"), Level: 1}.Build(),
+		Ports: []int32{80},
+		Url:   proto.String("https://example.com"),
+	}.Build()
+	m := coalescepb.Layer_builder{
+		Name: proto.String(""),
+		Base: coalescepb.Layer_builder{Level: 2}.Build(),
+	}.Build()
+	m.CoalesceWith(fallback)
+	want := coalescepb.Layer_builder{
+		Name:  proto.String(""),
+		Level: 3,
+		Base:  coalescepb.Layer_builder{Name: proto.String("root"), Level: 2}.Build(),
+		Ports: []int32{80},
+		Url:   proto.String("https://example.com"),
+	}.Build()
+	if !proto.Equal(m, want) {
+		t.Errorf("CoalesceWith:\ngot:  %v\nwant: %v", m, want)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	commonfieldpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/commonfield"
+)
+
+func TestCommonFieldInterface(t *testing.T) {
+	for _, test := range []struct {
+		m    any
+		want string
+	}{
+		{&commonfieldpb.User{Id: "user"}, "user"},
+		{&commonfieldpb.Group{Id: "group"}, "group"},
+		{&commonfieldpb.Event{Subject: &commonfieldpb.Event_Id{Id: "event"}}, "event"},
+	} {
+		m, ok := test.m.(commonfieldpb.HasId)
+		if !ok {
+			t.Errorf("%T does not implement HasId", test.m)
+			continue
+		}
+		if got := m.GetId(); got != test.want {
+			t.Errorf("%T.GetId() = %q, want %q", test.m, got, test.want)
+		}
+	}
+
+	if _, ok := any(&commonfieldpb.Note{}).(commonfieldpb.HasId); ok {
+		t.Errorf("%T implements HasId, but has no id field", &commonfieldpb.Note{})
+	}
+}
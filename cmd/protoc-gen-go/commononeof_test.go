@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	commononeofpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/commononeof"
+)
+
+func TestCommonOneof(t *testing.T) {
+	payloadText := func(m commononeofpb.HasPayload) string {
+		if p, ok := m.GetPayload().(*commononeofpb.Request_Text); ok {
+			return p.Text
+		}
+		return ""
+	}
+	req := &commononeofpb.Request{Payload: &commononeofpb.Request_Text{Text: "req"}}
+	resp := &commononeofpb.Response{Payload: &commononeofpb.Response_Text{Text: "resp"}}
+	if got := payloadText(req); got != "req" {
+		t.Errorf("payload text of %v = %q, want %q", req, got, "req")
+	}
+	if got := payloadText(resp); got != "resp" {
+		t.Errorf("payload text of %v = %q, want %q", resp, got, "resp")
+	}
+
+	// A payload may be moved between messages sharing the oneof.
+	att := &commononeofpb.Response{Payload: &commononeofpb.Request_Attachment{
+		Attachment: &commononeofpb.Attachment{Name: "a"},
+	}}
+	req.Payload = att.GetPayload()
+	if got := req.GetAttachment().GetName(); got != "a" {
+		t.Errorf("GetAttachment().GetName() = %q, want %q", got, "a")
+	}
+
+	if _, ok := any(&commononeofpb.Ping{}).(commononeofpb.HasPayload); ok {
+		t.Errorf("Ping implements HasPayload, want it not to")
+	}
+}
+
+func TestCommonOneofRoundTrip(t *testing.T) {
+	for _, m := range []proto.Message{
+		&commononeofpb.Request{Id: "1", Payload: &commononeofpb.Request_Text{Text: "t"}},
+		&commononeofpb.Response{Status: 2, Payload: &commononeofpb.Response_Attachment{Attachment: &commononeofpb.Attachment{Name: "a"}}},
+		&commononeofpb.Response{Payload: &commononeofpb.Response_Text{}, Trailer: &commononeofpb.Response_Checksum{Checksum: "c"}},
+	} {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", m, err)
+		}
+		got := m.ProtoReflect().New().Interface()
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		if !proto.Equal(got, m) {
+			t.Errorf("round trip of %v = %v", m, got)
+		}
+	}
+}
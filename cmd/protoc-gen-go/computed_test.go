@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	computedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/computed"
+)
+
+func TestComputedSetters(t *testing.T) {
+	typ := reflect.TypeOf(new(computedpb.Order))
+	for _, name := range []string{"Total", "Warnings", "ShippedDay", "Count"} {
+		if _, ok := typ.MethodByName("Set" + name); ok {
+			t.Errorf("Order has method Set%v for a computed field", name)
+		}
+		if _, ok := typ.MethodByName("Get" + name); !ok {
+			t.Errorf("Order has no method Get%v", name)
+		}
+	}
+	for _, name := range []string{"Id", "Items", "Note"} {
+		if _, ok := typ.MethodByName("Set" + name); !ok {
+			t.Errorf("Order has no method Set%v", name)
+		}
+	}
+}
+
+func TestComputedBuilder(t *testing.T) {
+	typ := reflect.TypeOf(computedpb.Order_builder{})
+	for _, name := range []string{"Total", "Warnings", "ShippedDay", "Count"} {
+		if _, ok := typ.FieldByName(name); ok {
+			t.Errorf("Order_builder has field %v for a computed field", name)
+		}
+	}
+	for _, name := range []string{"Id", "Items", "Note"} {
+		if _, ok := typ.FieldByName(name); !ok {
+			t.Errorf("Order_builder has no field %v", name)
+		}
+	}
+}
+
+func TestComputedValidate(t *testing.T) {
+	for _, test := range []struct {
+		m     *computedpb.Order
+		field string // empty if valid
+	}{
+		{nil, ""},
+		{computedpb.Order_builder{}.Build(), ""},
+		{computedpb.Order_builder{Id: proto.String("id"), Items: []string{"a"}, Note: proto.String("note")}.Build(), ""},
+		{unmarshalOrder(t, "total: 0"), "total"},
+		{unmarshalOrder(t, `warnings: "w"`), "warnings"},
+		{unmarshalOrder(t, "shipped_day: 0"), "shipped_day"},
+		{unmarshalOrder(t, "count: 1"), "count"},
+	} {
+		err := test.m.Validate()
+		switch {
+		case test.field == "" && err != nil:
+			t.Errorf("Validate(%v) = %v, want nil", test.m, err)
+		case test.field != "" && (err == nil || !strings.Contains(err.Error(), "Order."+test.field+" ")):
+			t.Errorf("Validate(%v) = %v, want error for field %v", test.m, err, test.field)
+		}
+	}
+}
+
+// unmarshalOrder returns the Order in the text format s, as computed fields
+// are set in messages received from the server.
+func unmarshalOrder(t *testing.T, s string) *computedpb.Order {
+	t.Helper()
+	m := &computedpb.Order{}
+	if err := prototext.Unmarshal([]byte(s), m); err != nil {
+		t.Fatalf("prototext.Unmarshal(%q): %v", s, err)
+	}
+	return m
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	strictpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/strict"
+	v1pb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/v1"
+	v2pb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/v2"
+)
+
+func TestConvertTo(t *testing.T) {
+	m := &v1pb.Person{
+		Name:     "name",
+		Age:      42,
+		Nickname: proto.String(""),
+		Photo:    []byte{1, 2},
+		Kind:     v1pb.Kind_KIND_PERSONAL,
+		Home:     &v1pb.Address{City: "home"},
+		Previous: []*v1pb.Address{{City: "old"}, {}},
+		Offices:  map[string]*v1pb.Address{"hq": {City: "office"}},
+		Scores:   map[string]int64{"a": 1},
+		Contact:  &v1pb.Person_Mailing{Mailing: &v1pb.Address{City: "mail"}},
+		Fax:      "skipped",
+	}
+	got, err := m.ConvertToV2()
+	if err != nil {
+		t.Fatalf("ConvertToV2() error: %v", err)
+	}
+	want := &v2pb.Person{
+		Name:     "name",
+		Years:    42,
+		Nickname: proto.String(""),
+		Photo:    []byte{1, 2},
+		Kind:     v2pb.Kind_KIND_PERSONAL,
+		Home:     &v2pb.Address{City: "home"},
+		Previous: []*v2pb.Address{{City: "old"}, {}},
+		Offices:  map[string]*v2pb.Address{"hq": {City: "office"}},
+		Scores:   map[string]int64{"a": 1},
+		Contact:  &v2pb.Person_Mailing{Mailing: &v2pb.Address{City: "mail"}},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("ConvertToV2() = %v, want %v", got, want)
+	}
+
+	got.Photo[0] = 9
+	if m.Photo[0] != 1 {
+		t.Errorf("modifying the result of ConvertToV2 modified the source message")
+	}
+
+	if got, err := (*v1pb.Person)(nil).ConvertToV2(); got != nil || err != nil {
+		t.Errorf("nil.ConvertToV2() = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestConvertToIncompatible(t *testing.T) {
+	// The value field is a string in v1, but an int64 in v2.
+	_, err := (&v1pb.Incompatible{}).ConvertToV2()
+	if want := "incompatible types"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ConvertToV2() with incompatible field: got error %v, want it to contain %q", err, want)
+	}
+}
+
+func TestConvertToStrict(t *testing.T) {
+	got, err := (&strictpb.Address{City: "city"}).ConvertToV2()
+	if err != nil {
+		t.Fatalf("strict ConvertToV2() of identical messages: %v", err)
+	}
+	if want := (&v2pb.Address{City: "city"}); !proto.Equal(got, want) {
+		t.Errorf("strict ConvertToV2() = %v, want %v", got, want)
+	}
+
+	// The fax field has no counterpart in v2.
+	_, err = (&strictpb.Person{Name: "name"}).ConvertToV2()
+	if want := "field fax has no counterpart"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("strict ConvertToV2() with missing field: got error %v, want it to contain %q", err, want)
+	}
+}
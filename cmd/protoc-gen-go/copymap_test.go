@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"maps"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	copymappb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/getters/copymap"
+)
+
+func TestCopyMapGetters(t *testing.T) {
+	child := &copymappb.Registry{Names: []string{"c"}}
+	m := &copymappb.Registry{
+		Counts:   map[string]int32{"a": 1},
+		Children: map[int64]*copymappb.Registry{1: child},
+	}
+	want := proto.Clone(m)
+
+	counts := m.GetCounts()
+	if len(counts) != 1 || counts["a"] != 1 {
+		t.Fatalf("GetCounts() = %v, want map[a:1]", counts)
+	}
+	counts["a"] = 2
+	counts["b"] = 3
+	delete(m.GetChildren(), 1)
+	m.GetChildren()[2] = &copymappb.Registry{}
+	if !proto.Equal(m, want) {
+		t.Errorf("modifying the maps returned by the getters modified the message:\ngot:  %v\nwant: %v", m, want)
+	}
+
+	// The copy is shallow.
+	if got := m.GetChildren()[1]; got != child {
+		t.Errorf("GetChildren()[1] = %p, want the message held by the field %p", got, child)
+	}
+	if got := m.GetNames(); got != nil {
+		t.Errorf("GetNames() = %v, want nil", got)
+	}
+	if got := (*copymappb.Registry)(nil).GetCounts(); got != nil {
+		t.Errorf("nil.GetCounts() = %v, want nil", got)
+	}
+	if got := new(copymappb.Registry).GetCounts(); got != nil {
+		t.Errorf("GetCounts() of an empty message = %v, want nil", got)
+	}
+}
+
+func TestCopyMapGettersHybrid(t *testing.T) {
+	m := copymappb.Index_builder{Offsets: map[string]int64{"x": 8}}.Build()
+	m.GetOffsets()["x"] = 16
+	if got := m.GetOffsets()["x"]; got != 8 {
+		t.Errorf("after modifying the map returned by GetOffsets, GetOffsets()[x] = %d, want 8", got)
+	}
+}
+
+func TestCopyMapGettersModifyingMethods(t *testing.T) {
+	catalog := func() *copymappb.Catalog {
+		return copymappb.Catalog_builder{
+			Labels: map[string]string{"a": "x", "b": "y"},
+			Counts: map[int32]int64{1: 10},
+		}.Build()
+	}
+
+	m := catalog()
+	m.MapStrings(func(_ protoreflect.Name, s string) string { return s + s })
+	if got, want := m.GetLabels(), map[string]string{"a": "xx", "b": "yy"}; !maps.Equal(got, want) {
+		t.Errorf("after MapStrings, GetLabels() = %v, want %v", got, want)
+	}
+
+	m = catalog()
+	m.LimitCollections(1)
+	if got, want := m.GetLabels(), map[string]string{"a": "x"}; !maps.Equal(got, want) {
+		t.Errorf("after LimitCollections(1), GetLabels() = %v, want %v", got, want)
+	}
+
+	src := copymappb.Catalog_builder{Labels: map[string]string{"c": "z"}, Counts: map[int32]int64{2: 20}}.Build()
+	want := copymappb.Catalog_builder{
+		Labels: map[string]string{"a": "x", "b": "y", "c": "z"},
+		Counts: map[int32]int64{1: 10, 2: 20},
+	}.Build()
+	m = catalog()
+	m.PatchFrom(src)
+	if !proto.Equal(m, want) {
+		t.Errorf("after PatchFrom, got %v, want %v", m, want)
+	}
+	m = catalog()
+	if _, err := m.MergeReport(src); err != nil {
+		t.Fatalf("MergeReport: %v", err)
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("after MergeReport, got %v, want %v", m, want)
+	}
+	m = new(copymappb.Catalog)
+	m.PatchFrom(src)
+	if !proto.Equal(m, src) {
+		t.Errorf("after PatchFrom into an empty message, got %v, want %v", m, src)
+	}
+}
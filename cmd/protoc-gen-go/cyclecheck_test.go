@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	cyclecheckpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cyclecheck"
+)
+
+func TestHasCycle(t *testing.T) {
+	shared := &cyclecheckpb.Node{Name: proto.String("shared")}
+	tree := &cyclecheckpb.Node{
+		Next:     shared,
+		Children: []*cyclecheckpb.Node{shared, {Next: shared}},
+		Links:    map[string]*cyclecheckpb.Node{"a": shared},
+		Data:     &structpb.Struct{Fields: map[string]*structpb.Value{"k": structpb.NewStringValue("v")}},
+		Owner:    &cyclecheckpb.Node_Parent{Parent: &cyclecheckpb.Node{}},
+	}
+	proto.SetExtension(tree, cyclecheckpb.E_Alias, shared)
+	if tree.HasCycle() {
+		t.Errorf("HasCycle() of a tree with shared messages = true, want false")
+	}
+	if _, err := proto.Marshal(tree); err != nil {
+		t.Errorf("proto.Marshal of a tree: %v", err)
+	}
+	if (*cyclecheckpb.Node)(nil).HasCycle() {
+		t.Errorf("nil.HasCycle() = true, want false")
+	}
+
+	for name, makeCycle := range map[string]func() *cyclecheckpb.Node{
+		"self": func() *cyclecheckpb.Node {
+			m := &cyclecheckpb.Node{}
+			m.Next = m
+			return m
+		},
+		"repeated": func() *cyclecheckpb.Node {
+			m := &cyclecheckpb.Node{}
+			m.Children = []*cyclecheckpb.Node{{}, {Next: m}}
+			return m
+		},
+		"map": func() *cyclecheckpb.Node {
+			m := &cyclecheckpb.Node{}
+			m.Links = map[string]*cyclecheckpb.Node{"loop": {Children: []*cyclecheckpb.Node{m}}}
+			return m
+		},
+		"oneof": func() *cyclecheckpb.Node {
+			m := &cyclecheckpb.Node{}
+			m.Owner = &cyclecheckpb.Node_Parent{Parent: m}
+			return m
+		},
+		"extension": func() *cyclecheckpb.Node {
+			m := &cyclecheckpb.Node{}
+			proto.SetExtension(m, cyclecheckpb.E_Alias, &cyclecheckpb.Node{Next: m})
+			return m
+		},
+		"nested": func() *cyclecheckpb.Node {
+			inner := &cyclecheckpb.Node{}
+			inner.Next = inner
+			return &cyclecheckpb.Node{Next: &cyclecheckpb.Node{Next: inner}}
+		},
+		"other file": func() *cyclecheckpb.Node {
+			s := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+			s.Fields["self"] = structpb.NewStructValue(s)
+			return &cyclecheckpb.Node{Data: s}
+		},
+	} {
+		if !makeCycle().HasCycle() {
+			t.Errorf("%s: HasCycle() = false, want true", name)
+		}
+	}
+}
+
+func TestHasCycleHybrid(t *testing.T) {
+	m := cyclecheckpb.Link_builder{}.Build()
+	m.SetMirrors([]*cyclecheckpb.Link{cyclecheckpb.Link_builder{Target: m}.Build()})
+	if !m.HasCycle() {
+		t.Errorf("HasCycle() = false, want true")
+	}
+	m.SetMirrors(nil)
+	if m.HasCycle() {
+		t.Errorf("HasCycle() after removing the cycle = true, want false")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	defaultjsonpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/defaultjson"
+)
+
+func TestDefaultJSON(t *testing.T) {
+	b := (*defaultjsonpb.Form)(nil).DefaultJSON()
+
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("DefaultJSON() = %s, not a JSON object: %v", b, err)
+	}
+	want := map[string]any{
+		"fullName":   "",
+		"age":        "0",
+		"subscribed": false,
+		"plan":       "PLAN_UNSPECIFIED",
+		"interests":  []any{},
+		"extra":      map[string]any{},
+		"address":    nil,
+		"avatar":     "",
+	}
+	if diff := cmp.Diff(want, fields); diff != "" {
+		t.Errorf("DefaultJSON() fields mismatch (-want +got):\n%s", diff)
+	}
+
+	m := new(defaultjsonpb.Form)
+	if err := protojson.Unmarshal(b, m); err != nil {
+		t.Fatalf("protojson.Unmarshal(DefaultJSON()): %v", err)
+	}
+	if !proto.Equal(m, new(defaultjsonpb.Form)) {
+		t.Errorf("protojson.Unmarshal(DefaultJSON()) = %v, want empty message", m)
+	}
+
+	b[0] = 'x'
+	if got := new(defaultjsonpb.Form).DefaultJSON(); got[0] != '{' {
+		t.Errorf("modifying the result of DefaultJSON modified later results: %s", got)
+	}
+}
+
+func TestDefaultJSONRequired(t *testing.T) {
+	b := (*defaultjsonpb.Account)(nil).DefaultJSON()
+
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("DefaultJSON() = %s, not a JSON object: %v", b, err)
+	}
+	want := map[string]any{
+		"id":    nil,
+		"level": nil,
+	}
+	if diff := cmp.Diff(want, fields); diff != "" {
+		t.Errorf("DefaultJSON() fields mismatch (-want +got):\n%s", diff)
+	}
+}
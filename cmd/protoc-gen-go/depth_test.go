@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	depthpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/depth"
+)
+
+func TestMaxDepth(t *testing.T) {
+	tests := []struct {
+		desc string
+		m    *depthpb.Node
+		want int
+	}{
+		{"nil", nil, 0},
+		{"flat", &depthpb.Node{Name: "leaf", Weights: map[string]int32{"a": 1}}, 1},
+		{"singly nested", &depthpb.Node{Child: &depthpb.Node{}}, 2},
+		{
+			desc: "repeated message tree",
+			m: &depthpb.Node{Children: []*depthpb.Node{
+				{},
+				{Children: []*depthpb.Node{{Child: &depthpb.Node{}}}},
+				nil,
+			}},
+			want: 4,
+		},
+		{
+			desc: "message-valued map",
+			m:    &depthpb.Node{Named: map[string]*depthpb.Node{"a": {Child: &depthpb.Node{}}, "nil": nil}},
+			want: 3,
+		},
+		{
+			desc: "oneof and external message",
+			m: &depthpb.Node{
+				Created: &timestamppb.Timestamp{},
+				Choice:  &depthpb.Node_Chosen{Chosen: &depthpb.Node{Child: &depthpb.Node{}}},
+			},
+			want: 3,
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.m.MaxDepth(); got != tt.want {
+			t.Errorf("%s: MaxDepth() = %d, want %d", tt.desc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	descindexpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/descindex"
+)
+
+func TestDescriptorIndexPath(t *testing.T) {
+	type message interface {
+		Descriptor() ([]byte, []int)
+		DescriptorIndexPath() []int
+		ProtoReflect() protoreflect.Message
+	}
+	for _, m := range []message{
+		(*descindexpb.Catalog)(nil),
+		(*descindexpb.Order)(nil),
+		(*descindexpb.Order_Item)(nil),
+		(*descindexpb.Order_Item_Option)(nil),
+	} {
+		md := m.ProtoReflect().Descriptor()
+		_, want := m.Descriptor()
+		if got := m.DescriptorIndexPath(); !slices.Equal(got, want) {
+			t.Errorf("%v: DescriptorIndexPath() = %v, want %v", md.FullName(), got, want)
+		}
+		if got, want := m.DescriptorIndexPath(), descriptorIndexPath(md); !slices.Equal(got, want) {
+			t.Errorf("%v: DescriptorIndexPath() = %v, want %v", md.FullName(), got, want)
+		}
+	}
+
+	type enum interface {
+		EnumDescriptor() ([]byte, []int)
+		DescriptorIndexPath() []int
+		Descriptor() protoreflect.EnumDescriptor
+	}
+	for _, e := range []enum{
+		descindexpb.Color(0),
+		descindexpb.Order_Status(0),
+		descindexpb.Order_Item_Option_Kind(0),
+	} {
+		ed := e.Descriptor()
+		_, want := e.EnumDescriptor()
+		if got := e.DescriptorIndexPath(); !slices.Equal(got, want) {
+			t.Errorf("%v: DescriptorIndexPath() = %v, want %v", ed.FullName(), got, want)
+		}
+		if got, want := e.DescriptorIndexPath(), descriptorIndexPath(ed); !slices.Equal(got, want) {
+			t.Errorf("%v: DescriptorIndexPath() = %v, want %v", ed.FullName(), got, want)
+		}
+	}
+}
+
+// descriptorIndexPath returns the indexes of d and its parent messages,
+// outermost first.
+func descriptorIndexPath(d protoreflect.Descriptor) []int {
+	var path []int
+	for ; d != d.ParentFile(); d = d.Parent() {
+		path = append([]int{d.Index()}, path...)
+	}
+	return path
+}
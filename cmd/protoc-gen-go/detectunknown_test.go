@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	detectunknownpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/detectunknown"
+)
+
+func TestUnknownFieldNumbers(t *testing.T) {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, "name")
+	b = protowire.AppendTag(b, 7, protowire.BytesType)
+	b = protowire.AppendString(b, "unknown")
+	b = protowire.AppendTag(b, 5, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+	b = protowire.AppendTag(b, 7, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 2)
+	// A group, whose nested fields are not reported.
+	b = protowire.AppendTag(b, 9, protowire.StartGroupType)
+	b = protowire.AppendTag(b, 3, protowire.VarintType)
+	b = protowire.AppendVarint(b, 3)
+	b = protowire.AppendTag(b, 9, protowire.EndGroupType)
+
+	m := &detectunknownpb.Event{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	want := []protoreflect.FieldNumber{5, 7, 9}
+	if diff := cmp.Diff(want, m.UnknownFieldNumbers()); diff != "" {
+		t.Errorf("UnknownFieldNumbers() mismatch (-want +got):\n%s", diff)
+	}
+	if got := m.GetName(); got != "name" {
+		t.Errorf("GetName() = %q, want %q", got, "name")
+	}
+}
+
+func TestUnknownFieldNumbersTopLevelOnly(t *testing.T) {
+	var nested []byte
+	nested = protowire.AppendTag(nested, 8, protowire.VarintType)
+	nested = protowire.AppendVarint(nested, 1)
+	var b []byte
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, nested)
+	b = protowire.AppendTag(b, 6, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+
+	m := &detectunknownpb.Event{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]protoreflect.FieldNumber{6}, m.UnknownFieldNumbers()); diff != "" {
+		t.Errorf("UnknownFieldNumbers() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]protoreflect.FieldNumber{8}, m.GetParent().UnknownFieldNumbers()); diff != "" {
+		t.Errorf("GetParent().UnknownFieldNumbers() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnknownFieldNumbersNone(t *testing.T) {
+	if got := (&detectunknownpb.Event{Name: "name"}).UnknownFieldNumbers(); len(got) > 0 {
+		t.Errorf("UnknownFieldNumbers() = %v, want none", got)
+	}
+	if got := (*detectunknownpb.Event)(nil).UnknownFieldNumbers(); got != nil {
+		t.Errorf("nil.UnknownFieldNumbers() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	diffcountpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/diffcount"
+)
+
+func newDiffCountServer() *diffcountpb.Server {
+	return &diffcountpb.Server{
+		Name:   "name",
+		Cores:  proto.Int32(4),
+		Key:    []byte{1},
+		Tags:   []string{"a", "b"},
+		Labels: map[string]string{"env": "prod", "team": "infra"},
+		Root:   &diffcountpb.Server_Disk{Path: "/", Size: 10},
+		Disks:  []*diffcountpb.Server_Disk{{Path: "/data", Size: 100}},
+		Mounts: map[string]*diffcountpb.Server_Disk{"tmp": {Path: "/tmp"}},
+	}
+}
+
+func TestDiffCount(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		modify func(*diffcountpb.Server)
+		want   int
+	}{
+		{"identical", func(*diffcountpb.Server) {}, 0},
+		{"scalar", func(m *diffcountpb.Server) { m.Name = "other" }, 1},
+		{"presence", func(m *diffcountpb.Server) { m.Cores = nil }, 1},
+		{"bytes", func(m *diffcountpb.Server) { m.Key = []byte{2} }, 1},
+		{"two map entries", func(m *diffcountpb.Server) {
+			m.Labels["env"] = "dev"
+			m.Labels["owner"] = "gopher"
+		}, 2},
+		{"removed map entry", func(m *diffcountpb.Server) { delete(m.Labels, "team") }, 1},
+		{"repeated element", func(m *diffcountpb.Server) { m.Tags[1] = "c" }, 1},
+		{"appended elements", func(m *diffcountpb.Server) { m.Tags = append(m.Tags, "c", "d") }, 2},
+		{"nested fields", func(m *diffcountpb.Server) { m.Root = &diffcountpb.Server_Disk{Path: "/root", Size: 20} }, 2},
+		{"cleared message", func(m *diffcountpb.Server) { m.Root = nil }, 2},
+		{"repeated message field", func(m *diffcountpb.Server) { m.Disks[0].Size = 200 }, 1},
+		{"map message field", func(m *diffcountpb.Server) { m.Mounts["tmp"].Size = 1 }, 1},
+	} {
+		x, y := newDiffCountServer(), newDiffCountServer()
+		test.modify(y)
+		if got := x.DiffCount(y); got != test.want {
+			t.Errorf("%v: x.DiffCount(y) = %d, want %d", test.name, got, test.want)
+		}
+		if got := y.DiffCount(x); got != test.want {
+			t.Errorf("%v: y.DiffCount(x) = %d, want %d", test.name, got, test.want)
+		}
+	}
+
+	var empty *diffcountpb.Server
+	if got, want := empty.DiffCount(newDiffCountServer()), 11; got != want {
+		t.Errorf("nil.DiffCount(m) = %d, want %d", got, want)
+	}
+}
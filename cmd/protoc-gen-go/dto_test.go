@@ -0,0 +1,124 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	dtooutpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout"
+	sharedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared"
+)
+
+func TestDTORoundTrip(t *testing.T) {
+	for _, m := range []*dtooutpb.Person{
+		{},
+		{
+			Name:      "name",
+			Age:       42,
+			Nickname:  proto.String(""),
+			Photo:     []byte{1, 2, 3},
+			Role:      dtooutpb.Role_ROLE_ADMIN,
+			Home:      &dtooutpb.Person_Address{City: "home"},
+			Emails:    []string{"a", "b"},
+			Previous:  []*dtooutpb.Person_Address{{City: "old"}, {}},
+			Roles:     []dtooutpb.Role{dtooutpb.Role_ROLE_ADMIN, dtooutpb.Role_ROLE_UNSPECIFIED},
+			Scores:    map[string]int64{"x": 1, "y": 2},
+			Offices:   map[int32]*dtooutpb.Person_Address{1: {City: "office"}},
+			Contact:   &dtooutpb.Person_Mailing{Mailing: &dtooutpb.Person_Address{City: "mail"}},
+			Created:   &sharedpb.Stamp{Seconds: 1, Zone: "UTC"},
+			Thumbnail: []byte{4},
+			Visits:    []*sharedpb.Stamp{{Seconds: 2}, {}},
+			Stamps:    map[string]*sharedpb.Stamp{"a": {Seconds: 3}},
+		},
+		{Contact: &dtooutpb.Person_Phone{Phone: ""}},
+		{Thumbnail: []byte{}},
+	} {
+		got := new(dtooutpb.Person).FromDTO(m.ToDTO())
+		if !proto.Equal(got, m) {
+			t.Errorf("FromDTO(ToDTO(%v)) = %v, want %v", m, got, m)
+		}
+	}
+}
+
+func TestDTOOneofCase(t *testing.T) {
+	m := &dtooutpb.Person{Contact: &dtooutpb.Person_Phone{Phone: "555"}}
+	d := m.ToDTO()
+	if got, want := d.Contact.Case, int32(12); got != want {
+		t.Errorf("ToDTO().Contact.Case = %v, want %v", got, want)
+	}
+	if got, want := d.Contact.Phone, "555"; got != want {
+		t.Errorf("ToDTO().Contact.Phone = %q, want %q", got, want)
+	}
+	if got := new(dtooutpb.Person).ToDTO().Contact.Case; got != 0 {
+		t.Errorf("ToDTO().Contact.Case for unset oneof = %v, want 0", got)
+	}
+}
+
+func TestDTOCopies(t *testing.T) {
+	m := &dtooutpb.Person{Photo: []byte{1}, Emails: []string{"a"}, Created: &sharedpb.Stamp{Seconds: 1}}
+	d := m.ToDTO()
+	d.Photo[0] = 2
+	d.Emails[0] = "b"
+	d.Created.Seconds = 2
+	if m.Photo[0] != 1 || m.Emails[0] != "a" || m.Created.Seconds != 1 {
+		t.Errorf("modifying the result of ToDTO modified the message: %v", m)
+	}
+}
+
+func TestDTONil(t *testing.T) {
+	var m *dtooutpb.Person
+	if d := m.ToDTO(); d != nil {
+		t.Errorf("nil.ToDTO() = %v, want nil", d)
+	}
+	m = &dtooutpb.Person{Name: "name"}
+	if got := m.FromDTO(nil); got != m || got.GetName() != "" {
+		t.Errorf("FromDTO(nil) = %v, want reset receiver", got)
+	}
+}
+
+func TestDTOImports(t *testing.T) {
+	// The data transfer objects of the messages of other files are allowed,
+	// but not the packages of the runtime, directly or through them.
+	const module = "google.golang.org/protobuf/"
+	seen := map[string]bool{}
+	var check func(dir string)
+	check = func(dir string) {
+		if seen[dir] {
+			return
+		}
+		seen[dir] = true
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil || len(files) == 0 {
+			t.Fatalf("no Go files in %v: %v", dir, err)
+		}
+		for _, path := range files {
+			f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, imp := range f.Imports {
+				p, _ := strconv.Unquote(imp.Path.Value)
+				switch {
+				case !strings.HasPrefix(p, module):
+				case strings.HasSuffix(p, "/dto"):
+					check(filepath.Join("..", "..", filepath.FromSlash(strings.TrimPrefix(p, module))))
+				default:
+					t.Errorf("%v imports %q, want no protobuf runtime imports", path, p)
+				}
+			}
+		}
+	}
+	check("testdata/dtoout/dto")
+	if !seen[filepath.Join("..", "..", "cmd/protoc-gen-go/testdata/dtoout/shared/dto")] {
+		t.Errorf("testdata/dtoout/dto does not import the data transfer objects of shared.proto")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	eachextpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/eachext"
+)
+
+func TestEachExtension(t *testing.T) {
+	m := &eachextpb.Extendable{Name: proto.String("name")}
+	m.EachExtension(func(xt protoreflect.ExtensionType, _ protoreflect.Value) bool {
+		t.Errorf("EachExtension with no extensions visited %v", xt.TypeDescriptor().FullName())
+		return true
+	})
+
+	proto.SetExtension(m, eachextpb.E_First, int32(1))
+	proto.SetExtension(m, eachextpb.E_Second, "second")
+	got := make(map[protoreflect.FullName]any)
+	m.EachExtension(func(xt protoreflect.ExtensionType, v protoreflect.Value) bool {
+		got[xt.TypeDescriptor().FullName()] = xt.InterfaceOf(v)
+		return true
+	})
+	want := map[protoreflect.FullName]any{
+		eachextpb.E_First.TypeDescriptor().FullName():  int32(1),
+		eachextpb.E_Second.TypeDescriptor().FullName(): "second",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("EachExtension() mismatch (-want +got):\n%s", diff)
+	}
+
+	n := 0
+	m.EachExtension(func(protoreflect.ExtensionType, protoreflect.Value) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("EachExtension visited %d extensions after f returned false, want 1", n)
+	}
+
+	if _, ok := any(&eachextpb.Plain{}).(interface {
+		EachExtension(func(protoreflect.ExtensionType, protoreflect.Value) bool)
+	}); ok {
+		t.Errorf("Plain has EachExtension, but no extension ranges")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	eachmsgpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/eachmsg"
+)
+
+func TestEachMessage(t *testing.T) {
+	a, b := &eachmsgpb.Entry{Name: "a"}, &eachmsgpb.Entry{Name: "b"}
+	m := &eachmsgpb.Registry{
+		Entries: map[string]*eachmsgpb.Entry{"a": a, "b": b, "nil": nil},
+	}
+
+	got := make(map[string]*eachmsgpb.Entry)
+	m.EachEntries(func(k string, v *eachmsgpb.Entry) bool {
+		if v == nil {
+			t.Errorf("EachEntries: called with nil value for key %q", k)
+		}
+		got[k] = v
+		return true
+	})
+	want := map[string]*eachmsgpb.Entry{"a": a, "b": b}
+	if diff := cmp.Diff(want, got, cmp.Comparer(func(x, y *eachmsgpb.Entry) bool { return x == y })); diff != "" {
+		t.Errorf("EachEntries mismatch (-want +got):\n%s", diff)
+	}
+
+	calls := 0
+	m.EachEntries(func(string, *eachmsgpb.Entry) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("EachEntries: f called %d times after returning false, want 1", calls)
+	}
+
+	(*eachmsgpb.Registry)(nil).EachById(func(int32, *eachmsgpb.Entry) bool {
+		t.Errorf("EachById on nil message: f called, want no calls")
+		return true
+	})
+
+	if _, ok := any(m).(interface {
+		EachLabels(func(string, string) bool)
+	}); ok {
+		t.Errorf("Registry has EachLabels, but labels does not have message values")
+	}
+}
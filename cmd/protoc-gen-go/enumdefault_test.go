@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enumdefaultpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/enumdefault"
+)
+
+func TestEnumDefault(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		got  protoreflect.Enum
+		want protoreflect.EnumNumber
+	}{
+		{"closed enum", enumdefaultpb.Level(0).Default(), 3},
+		{"open enum", enumdefaultpb.Mode(1).Default(), 0},
+	} {
+		if got := test.got.Number(); got != test.want {
+			t.Errorf("%s: Default() = %v, want %v", test.desc, got, test.want)
+		}
+		if got, want := test.got.Number(), test.got.Descriptor().Values().Get(0).Number(); got != want {
+			t.Errorf("%s: Default() = %v, does not match first descriptor value %v", test.desc, got, want)
+		}
+	}
+
+	// Fields without an explicit default use the default of the enum.
+	m := &enumdefaultpb.Settings{}
+	if got, want := m.GetLevel(), enumdefaultpb.Level(0).Default(); got != want {
+		t.Errorf("GetLevel() = %v, want %v", got, want)
+	}
+	if got, want := m.GetStrict(), enumdefaultpb.Level_LEVEL_HIGH; got != want {
+		t.Errorf("GetStrict() = %v, want explicit default %v", got, want)
+	}
+	if got, want := m.GetMode(), enumdefaultpb.Mode(0).Default(); got != want {
+		t.Errorf("GetMode() = %v, want %v", got, want)
+	}
+}
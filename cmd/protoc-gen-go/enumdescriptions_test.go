@@ -0,0 +1,29 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	descriptionspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/descriptions"
+)
+
+func TestEnumDescription(t *testing.T) {
+	for _, test := range []struct {
+		value descriptionspb.Status
+		want  string
+	}{
+		{descriptionspb.Status_STATUS_UNSPECIFIED, "STATUS_UNSPECIFIED"},
+		{descriptionspb.Status_STATUS_PENDING, "The request is waiting to be processed."},
+		{descriptionspb.Status_STATUS_DONE, "The request has been processed."},
+		{descriptionspb.Status_STATUS_FAILED, "Leading comments take precedence."},
+		{descriptionspb.Status_STATUS_ERROR, "Leading comments take precedence."},
+		{descriptionspb.Status(100), "100"},
+	} {
+		if got := test.value.Description(); got != test.want {
+			t.Errorf("Status(%d).Description() = %q, want %q", test.value, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	labelpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/label"
+)
+
+func TestEnumLabel(t *testing.T) {
+	labels := map[protoreflect.FullName]map[protoreflect.EnumNumber]string{
+		"goproto.protoc.enums.label.Color":         {1: "rouge"},
+		"goproto.protoc.enums.label.Palette.Shade": {1: "foncé"},
+	}
+	lookup := func(name protoreflect.FullName, num protoreflect.EnumNumber) string {
+		return labels[name][num]
+	}
+	for _, tt := range []struct {
+		got, want string
+	}{
+		{labelpb.Color_COLOR_RED.Label(lookup), "rouge"},
+		{labelpb.Palette_SHADE_DARK.Label(lookup), "foncé"},
+		// The lookup returns the empty string, so the name of the value is used.
+		{labelpb.Color_COLOR_GREEN.Label(lookup), "COLOR_GREEN"},
+		{labelpb.Palette_SHADE_UNSPECIFIED.Label(lookup), "SHADE_UNSPECIFIED"},
+		{labelpb.Color(5).Label(lookup), "5"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("Label() = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
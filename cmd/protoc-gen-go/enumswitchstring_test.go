@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoimpl"
+
+	switchstringpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/switchstring"
+)
+
+type stringEnum interface {
+	protoreflect.Enum
+	String() string
+}
+
+func TestEnumSwitchString(t *testing.T) {
+	for _, e := range []stringEnum{
+		switchstringpb.Level_LEVEL_UNSPECIFIED,
+		switchstringpb.Level_LEVEL_LOW,
+		switchstringpb.Level_LEVEL_HIGH,
+		switchstringpb.Level_LEVEL_MAX,
+		switchstringpb.Level(2),
+		switchstringpb.Level(-7),
+		switchstringpb.Month_MONTH_APRIL,
+		switchstringpb.Month(12),
+		switchstringpb.Reading_UNIT_CELSIUS,
+		switchstringpb.Reading_Unit(9),
+	} {
+		want := protoimpl.X.EnumStringOf(e.Descriptor(), e.Number())
+		if got := e.String(); got != want {
+			t.Errorf("%T(%d).String() = %q, want %q", e, e.Number(), got, want)
+		}
+	}
+	if got, want := switchstringpb.Level_LEVEL_MAX.String(), "LEVEL_HIGH"; got != want {
+		t.Errorf("Level_LEVEL_MAX.String() = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkEnumString(b *testing.B) {
+	e := switchstringpb.Level_LEVEL_HIGH
+	b.Run("Switch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = e.String()
+		}
+	})
+	b.Run("Descriptor", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = protoimpl.X.EnumStringOf(e.Descriptor(), e.Number())
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = switchstringpb.Level_name[int32(e)]
+		}
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	envoverridepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/envoverride"
+)
+
+func TestApplyEnvOverrides(t *testing.T) {
+	t.Setenv("APP_NAME", "new")
+	t.Setenv("APP_WORKERS", "-8")
+	t.Setenv("APP_MODE", "MODE_SAFE")
+	t.Setenv("APP_BUDGET", "0")
+	t.Setenv("APP_MAX_CONNS", "64")
+	t.Setenv("APP_PORT", "443")
+	t.Setenv("APP_TAGS", "ignored")
+	t.Setenv("OTHER_RATIO", "0.5")
+
+	m := &envoverridepb.Config{Name: "old", Workers: 1, Ratio: 2, Verbose: true}
+	if err := m.ApplyEnvOverrides("APP_"); err != nil {
+		t.Fatalf("ApplyEnvOverrides: %v", err)
+	}
+	want := &envoverridepb.Config{
+		Name:     "new",
+		Workers:  -8,
+		Mode:     envoverridepb.Config_MODE_SAFE,
+		Budget:   proto.Uint64(0),
+		Verbose:  true,
+		Ratio:    2,
+		MaxConns: 64,
+		Target:   &envoverridepb.Config_Port{Port: 443},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ApplyEnvOverrides() = %v, want %v", m, want)
+	}
+}
+
+func TestApplyEnvOverridesEnumNumber(t *testing.T) {
+	t.Setenv("MODE", "1")
+	m := &envoverridepb.Config{}
+	if err := m.ApplyEnvOverrides(""); err != nil {
+		t.Fatalf("ApplyEnvOverrides: %v", err)
+	}
+	if got, want := m.GetMode(), envoverridepb.Config_MODE_FAST; got != want {
+		t.Errorf("ApplyEnvOverrides() set mode %v, want %v", got, want)
+	}
+}
+
+func TestApplyEnvOverridesHybrid(t *testing.T) {
+	t.Setenv("JOB_ID", "j")
+	t.Setenv("JOB_RETRIES", "3")
+	t.Setenv("JOB_PRIORITY", "PRIORITY_HIGH")
+	t.Setenv("JOB_CRON", "@daily")
+	m := &envoverridepb.Job{}
+	if err := m.ApplyEnvOverrides("JOB_"); err != nil {
+		t.Fatalf("ApplyEnvOverrides: %v", err)
+	}
+	want := envoverridepb.Job_builder{
+		Id:       proto.String("j"),
+		Retries:  3,
+		Priority: envoverridepb.Job_PRIORITY_HIGH.Enum(),
+		Cron:     proto.String("@daily"),
+	}.Build()
+	if !proto.Equal(m, want) {
+		t.Errorf("ApplyEnvOverrides() = %v, want %v", m, want)
+	}
+}
+
+func TestApplyEnvOverridesErrors(t *testing.T) {
+	for _, test := range []struct {
+		name, value string
+	}{
+		{"APP_WORKERS", "many"},
+		{"APP_WORKERS", "4294967296"},
+		{"APP_MODE", "MODE_SLOW"},
+		{"APP_VERBOSE", "maybe"},
+		{"APP_TOKEN", "!"},
+	} {
+		t.Run(test.name+"="+test.value, func(t *testing.T) {
+			t.Setenv("APP_NAME", "changed")
+			t.Setenv(test.name, test.value)
+			orig := &envoverridepb.Config{Name: "orig"}
+			m := proto.Clone(orig).(*envoverridepb.Config)
+			err := m.ApplyEnvOverrides("APP_")
+			if err == nil || !strings.Contains(err.Error(), test.name) {
+				t.Errorf("ApplyEnvOverrides: got error %v, want error naming %s", err, test.name)
+			}
+			if !proto.Equal(m, orig) {
+				t.Errorf("ApplyEnvOverrides modified the message: %v", m)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	equalignorepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/equalignore"
+)
+
+func TestEqualIgnoring(t *testing.T) {
+	x := &equalignorepb.Request{
+		Id:         "id",
+		Payload:    "payload",
+		ReceivedAt: &timestamppb.Timestamp{Seconds: 1},
+		Attempt:    1,
+	}
+	y := &equalignorepb.Request{
+		Id:         "id",
+		Payload:    "payload",
+		ReceivedAt: &timestamppb.Timestamp{Seconds: 2},
+		Attempt:    2,
+	}
+	if x.EqualIgnoring(y) {
+		t.Errorf("EqualIgnoring() = true, want false")
+	}
+	if x.EqualIgnoring(y, 3) {
+		t.Errorf("EqualIgnoring(3) = true, want false, as the attempt fields differ")
+	}
+	if !x.EqualIgnoring(y, 3, 4) {
+		t.Errorf("EqualIgnoring(3, 4) = false, want true")
+	}
+	if got := x.GetReceivedAt().GetSeconds(); got != 1 {
+		t.Errorf("EqualIgnoring modified x: received_at.seconds = %v, want 1", got)
+	}
+
+	y.Attempt, y.Payload = 1, "other"
+	if x.EqualIgnoring(y, 3) {
+		t.Errorf("EqualIgnoring(3) with differing payloads = true, want false")
+	}
+}
+
+func TestEqualIgnoringNil(t *testing.T) {
+	var x *equalignorepb.Request
+	if !x.EqualIgnoring(nil, 1) {
+		t.Errorf("nil.EqualIgnoring(nil, 1) = false, want true")
+	}
+	if x.EqualIgnoring(&equalignorepb.Request{Id: "id"}, 2) {
+		t.Errorf("nil.EqualIgnoring(non-nil, 2) = true, want false")
+	}
+}
+
+func TestEqualIgnoringUnknownNumber(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("EqualIgnoring(100): got no panic, want panic")
+		}
+	}()
+	x := &equalignorepb.Request{}
+	x.EqualIgnoring(x, protoreflect.FieldNumber(100))
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	exportmsgtypespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/reflection/exportmsgtypes"
+)
+
+func TestExportedMessageTypes(t *testing.T) {
+	var want []protoreflect.FullName
+	var walk func(protoreflect.MessageDescriptors)
+	walk = func(mds protoreflect.MessageDescriptors) {
+		for i := 0; i < mds.Len(); i++ {
+			if !mds.Get(i).IsMapEntry() {
+				want = append(want, mds.Get(i).FullName())
+			}
+			walk(mds.Get(i).Messages())
+		}
+	}
+	fd := exportmsgtypespb.File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto
+	walk(fd.Messages())
+
+	mis := exportmsgtypespb.File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_MessageTypes()
+	if len(mis) != len(want) {
+		t.Fatalf("MessageTypes() has %d message infos, want %d", len(mis), len(want))
+	}
+	got := make(map[protoreflect.FullName]bool)
+	for _, mi := range mis {
+		got[mi.Desc.FullName()] = true
+	}
+	for _, name := range want {
+		if !got[name] {
+			t.Errorf("MessageTypes() has no message info for %v", name)
+		}
+	}
+
+	m := &exportmsgtypespb.Inventory{}
+	if got, want := m.ProtoReflect().Type(), mis[0].MessageOf(m).Type(); got != want {
+		t.Errorf("MessageTypes()[0] is not the message info of Inventory")
+	}
+	mis[0] = nil
+	if exportmsgtypespb.File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_MessageTypes()[0] == nil {
+		t.Errorf("MessageTypes() returned a slice shared with a previous call")
+	}
+}
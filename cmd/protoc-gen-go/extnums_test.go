@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	extnumspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/extnums"
+)
+
+func TestSetExtensionNumbers(t *testing.T) {
+	m := &extnumspb.Extendable{Name: proto.String("name")}
+	if got := m.SetExtensionNumbers(); len(got) > 0 {
+		t.Errorf("SetExtensionNumbers() with no extensions = %v, want none", got)
+	}
+
+	proto.SetExtension(m, extnumspb.E_Third, []int64{1})
+	proto.SetExtension(m, extnumspb.E_First, int32(1))
+	want := []protoreflect.FieldNumber{100, 199}
+	if diff := cmp.Diff(want, m.SetExtensionNumbers()); diff != "" {
+		t.Errorf("SetExtensionNumbers() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, ok := any(&extnumspb.Plain{}).(interface {
+		SetExtensionNumbers() []protoreflect.FieldNumber
+	}); ok {
+		t.Errorf("Plain has SetExtensionNumbers, but no extension ranges")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fastclonepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fastclone"
+)
+
+func newFastCloneDocument() *fastclonepb.Document {
+	m := &fastclonepb.Document{
+		Title:   proto.String("title"),
+		Version: proto.Int64(0),
+		Digest:  []byte{},
+		Kind:    fastclonepb.Document_KIND_REPORT.Enum(),
+		Tags:    []string{"a", "b"},
+		Blobs:   [][]byte{{1}, {}},
+		Sections: []*fastclonepb.Document_Section{{
+			Title:    proto.String("section"),
+			Children: []*fastclonepb.Document_Section{{Title: proto.String("child")}},
+		}},
+		ByName:  map[string]*fastclonepb.Document_Section{"x": {Title: proto.String("x")}},
+		Chunks:  map[int32][]byte{1: {2, 3}},
+		Summary: &fastclonepb.Document_Section{},
+		Created: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 5},
+		Source:  &fastclonepb.Document_Inline{Inline: &fastclonepb.Document_Section{Title: proto.String("inline")}},
+	}
+	proto.SetExtension(m, fastclonepb.E_Owner, "owner")
+	proto.SetExtension(m, fastclonepb.E_Appendices, []*fastclonepb.Document_Section{{Title: proto.String("appendix")}})
+	b := protowire.AppendTag(nil, 50, protowire.VarintType)
+	m.ProtoReflect().SetUnknown(protowire.AppendVarint(b, 1))
+	return m
+}
+
+func TestCloneVT(t *testing.T) {
+	for _, m := range []proto.Message{
+		&fastclonepb.Document{},
+		newFastCloneDocument(),
+		&fastclonepb.Document{Source: &fastclonepb.Document_Raw{Raw: []byte{}}},
+		&fastclonepb.Document{Source: &fastclonepb.Document_Url{Url: ""}},
+		fastclonepb.Note_builder{
+			Text:       proto.String(""),
+			Priority:   3,
+			Attachment: []byte{},
+			Replies:    []*fastclonepb.Note{fastclonepb.Note_builder{Text: proto.String("reply")}.Build()},
+			Counts:     map[string]int64{"x": 1},
+			Parent:     fastclonepb.Note_builder{Text: proto.String("parent")}.Build(),
+			Thread:     &fastclonepb.Note{},
+		}.Build(),
+	} {
+		var got proto.Message
+		switch m := m.(type) {
+		case *fastclonepb.Document:
+			got = m.CloneVT()
+		case *fastclonepb.Note:
+			got = m.CloneVT()
+		}
+		if !proto.Equal(got, m) {
+			t.Errorf("CloneVT() = %v, want %v", got, m)
+		}
+	}
+	if got := (*fastclonepb.Document)(nil).CloneVT(); got != nil {
+		t.Errorf("nil.CloneVT() = %v, want nil", got)
+	}
+}
+
+func TestCloneVTIsDeep(t *testing.T) {
+	m := newFastCloneDocument()
+	c := m.CloneVT()
+	c.Tags[0] = "changed"
+	c.Blobs[0][0] = 9
+	c.Sections[0].Children[0].Title = proto.String("changed")
+	c.ByName["x"].Title = proto.String("changed")
+	c.Chunks[1][0] = 9
+	c.Summary.Title = proto.String("changed")
+	c.Created.Seconds++
+	c.GetInline().Title = proto.String("changed")
+	proto.GetExtension(c, fastclonepb.E_Appendices).([]*fastclonepb.Document_Section)[0].Title = proto.String("changed")
+	c.ProtoReflect().GetUnknown()[0] = 0
+	if want := newFastCloneDocument(); !proto.Equal(m, want) {
+		t.Errorf("modifying the result of CloneVT modified the original:\ngot  %v\nwant %v", m, want)
+	}
+}
+
+func BenchmarkCloneVT(b *testing.B) {
+	m := newFastCloneDocument()
+	proto.ClearExtension(m, fastclonepb.E_Owner)
+	proto.ClearExtension(m, fastclonepb.E_Appendices)
+	b.Run("CloneVT", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.CloneVT()
+		}
+	})
+	b.Run("proto.Clone", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			proto.Clone(m)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	fdlookuppb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fdlookup"
+)
+
+func TestFieldDescriptorByGoName(t *testing.T) {
+	m := &fdlookuppb.Lookup{}
+	for _, test := range []struct {
+		goName   string
+		wantName protoreflect.Name
+	}{
+		{goName: "UserId", wantName: "user_id"},
+		{goName: "Tags", wantName: "tags"},
+		{goName: "Email", wantName: "email"},
+		{goName: "Nested", wantName: "nested"},
+	} {
+		fd := m.FieldDescriptorByGoName(test.goName)
+		if fd == nil {
+			t.Errorf("FieldDescriptorByGoName(%q) = nil, want %v", test.goName, test.wantName)
+			continue
+		}
+		if fd.Name() != test.wantName {
+			t.Errorf("FieldDescriptorByGoName(%q).Name() = %v, want %v", test.goName, fd.Name(), test.wantName)
+		}
+		if want := m.ProtoReflect().Descriptor().Fields().ByName(test.wantName); fd != want {
+			t.Errorf("FieldDescriptorByGoName(%q) = %v, want %v", test.goName, fd.FullName(), want.FullName())
+		}
+	}
+	if od := m.FieldDescriptorByGoName("Email").ContainingOneof(); od == nil || od.Name() != "target" {
+		t.Errorf("FieldDescriptorByGoName(%q).ContainingOneof() = %v, want target", "Email", od)
+	}
+
+	for _, name := range []string{"", "user_id", "Target", "Unknown"} {
+		if fd := m.FieldDescriptorByGoName(name); fd != nil {
+			t.Errorf("FieldDescriptorByGoName(%q) = %v, want nil", name, fd.FullName())
+		}
+	}
+}
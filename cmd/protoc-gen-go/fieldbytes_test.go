@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	fieldbytespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldbytes"
+)
+
+func TestFieldBytes(t *testing.T) {
+	id := int64(-7)
+	m := &fieldbytespb.Event{
+		Id:     id,
+		Name:   "name",
+		Source: &fieldbytespb.Event_Source{Host: "host", Port: 80},
+		Codes:  []int32{1, 2},
+	}
+
+	var source []byte
+	source = protowire.AppendTag(source, 1, protowire.BytesType)
+	source = protowire.AppendString(source, "host")
+	source = protowire.AppendTag(source, 2, protowire.VarintType)
+	source = protowire.AppendVarint(source, 80)
+
+	for _, test := range []struct {
+		num  protoreflect.FieldNumber
+		want []byte
+	}{{
+		num:  1,
+		want: protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), uint64(id)),
+	}, {
+		num:  2,
+		want: protowire.AppendString(protowire.AppendTag(nil, 2, protowire.BytesType), "name"),
+	}, {
+		num:  3,
+		want: protowire.AppendBytes(protowire.AppendTag(nil, 3, protowire.BytesType), source),
+	}, {
+		num:  4,
+		want: protowire.AppendBytes(protowire.AppendTag(nil, 4, protowire.BytesType), []byte{1, 2}),
+	}, {
+		num:  5,
+		want: nil,
+	}} {
+		got, err := m.FieldBytes(test.num)
+		if err != nil {
+			t.Errorf("FieldBytes(%d) error: %v", test.num, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("FieldBytes(%d) = %x, want %x", test.num, got, test.want)
+		}
+	}
+
+	if b, err := m.FieldBytes(6); err == nil {
+		t.Errorf("FieldBytes(6) = %x, want error for unknown field number", b)
+	}
+}
+
+func TestFieldBytesRequired(t *testing.T) {
+	m := &fieldbytespb.Record{Key: proto.String("k"), Value: proto.Int32(3)}
+	got, err := m.FieldBytes(2)
+	if err != nil {
+		t.Fatalf("FieldBytes(2) error: %v", err)
+	}
+	if want := protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 3); !bytes.Equal(got, want) {
+		t.Errorf("FieldBytes(2) = %x, want %x", got, want)
+	}
+	if got, err := new(fieldbytespb.Record).FieldBytes(2); err != nil || len(got) != 0 {
+		t.Errorf("FieldBytes(2) of a message without its required field = %x, %v, want no bytes", got, err)
+	}
+}
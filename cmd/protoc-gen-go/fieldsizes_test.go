@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	fieldsizespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldsizes"
+)
+
+func TestFieldSizes(t *testing.T) {
+	m := &fieldsizespb.Sample{
+		Id:     proto.Int32(150),
+		Values: []int64{1, 300},
+		Tags:   []string{"a", "bc"},
+		Counts: map[string]int32{"x": 1, "y": 2},
+		Child:  &fieldsizespb.Sample{Label: proto.String("c")},
+		Extra:  &fieldsizespb.Sample_Extra{Flag: proto.Bool(true)},
+		Big:    proto.Uint32(0),
+	}
+	proto.SetExtension(m, fieldsizespb.E_Stamp, uint64(1))
+	m.ProtoReflect().SetUnknown([]byte{0xf8, 0x06, 0x01}) // field 111, varint 1
+
+	got := m.FieldSizes()
+	want := map[protoreflect.FieldNumber]int{
+		1:    3,         // tag, 2-byte varint
+		3:    2 + 3,     // tag, length, packed varints of 1 and 2 bytes
+		4:    3 + 4,     // two length-delimited elements
+		5:    2 * 7,     // two entries: a tag, length, then the key and value, each with a tag
+		6:    2 + 3,     // tag, length, then the label of the child
+		7:    1 + 2 + 1, // start group, flag, end group
+		2000: 2 + 1,     // 2-byte tag, 1-byte varint
+		100:  2 + 8,     // 2-byte tag, fixed64
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FieldSizes() mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := got[2]; ok {
+		t.Errorf("FieldSizes() has the unset field 2")
+	}
+
+	sum := 0
+	for _, n := range got {
+		sum += n
+	}
+	if want := proto.Size(m) - len(m.ProtoReflect().GetUnknown()); sum != want {
+		t.Errorf("sum of FieldSizes() = %d, want proto.Size less unknown fields = %d", sum, want)
+	}
+
+	if got := new(fieldsizespb.Sample).FieldSizes(); len(got) != 0 {
+		t.Errorf("FieldSizes() of an empty message = %v, want empty", got)
+	}
+	if got := (*fieldsizespb.Sample)(nil).FieldSizes(); len(got) != 0 {
+		t.Errorf("nil.FieldSizes() = %v, want empty", got)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	filterpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/filter"
+)
+
+func TestFilterHelpers(t *testing.T) {
+	titles := func(panels []*filterpb.Dashboard_Panel) (s []string) {
+		for _, p := range panels {
+			s = append(s, p.GetTitle())
+		}
+		return s
+	}
+	m := &filterpb.Dashboard{Panels: []*filterpb.Dashboard_Panel{
+		{Title: "a"},
+		{Title: "b", Hidden: true},
+		{Title: "c"},
+		{Title: "d", Hidden: true},
+	}}
+	backing := m.Panels[:cap(m.Panels)]
+
+	m.FilterPanels(func(p *filterpb.Dashboard_Panel) bool { return !p.GetHidden() })
+	if got, want := titles(m.GetPanels()), []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterPanels(visible): got panels %v, want %v", got, want)
+	}
+	if &m.Panels[0] != &backing[0] {
+		t.Errorf("FilterPanels(visible) did not reuse the backing array")
+	}
+	if backing[2] != nil || backing[3] != nil {
+		t.Errorf("FilterPanels(visible) retained removed elements beyond the field: %v", backing[2:])
+	}
+
+	m.FilterPanels(func(*filterpb.Dashboard_Panel) bool { return false })
+	if got := m.GetPanels(); len(got) != 0 {
+		t.Errorf("FilterPanels(none): got panels %v, want none", titles(got))
+	}
+
+	if _, ok := reflect.TypeOf(m).MethodByName("FilterTags"); ok {
+		t.Errorf("Dashboard has a FilterTags method for a scalar field")
+	}
+	if _, ok := reflect.TypeOf(m).MethodByName("FilterNamed"); ok {
+		t.Errorf("Dashboard has a FilterNamed method for a map field")
+	}
+}
+
+func TestFilterHelpersHybrid(t *testing.T) {
+	m := filterpb.Report_builder{Rows: []*filterpb.Report_Row{
+		filterpb.Report_Row_builder{Value: proto.Int32(1)}.Build(),
+		filterpb.Report_Row_builder{Value: proto.Int32(2)}.Build(),
+		filterpb.Report_Row_builder{Value: proto.Int32(3)}.Build(),
+	}}.Build()
+	m.FilterRows(func(r *filterpb.Report_Row) bool { return r.GetValue()%2 == 1 })
+	var got []int32
+	for _, r := range m.GetRows() {
+		got = append(got, r.GetValue())
+	}
+	if want := []int32{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterRows(odd): got rows %v, want %v", got, want)
+	}
+}
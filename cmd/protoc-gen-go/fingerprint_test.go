@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fingerprintpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fingerprint"
+)
+
+func fingerprintResponse() *fingerprintpb.Response {
+	return &fingerprintpb.Response{
+		Query:     "q",
+		ServedAt:  &timestamppb.Timestamp{Seconds: 1},
+		RequestId: "r1",
+		Items: []*fingerprintpb.Response_Item{
+			{Name: "a", FetchedAt: 10},
+			{Name: "b", FetchedAt: 11},
+		},
+		ItemsByName: map[string]*fingerprintpb.Response_Item{
+			"a": {Name: "a", FetchedAt: 10},
+			"b": {Name: "b", FetchedAt: 11},
+		},
+		Best:   &fingerprintpb.Response_Item{Name: "a", FetchedAt: 10},
+		Trace:  []string{"t1"},
+		Source: &fingerprintpb.Response_Cache{Cache: "c1"},
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	want := fingerprintResponse().Fingerprint()
+	for _, test := range []struct {
+		name   string
+		change func(*fingerprintpb.Response)
+		same   bool
+	}{
+		{"served_at", func(m *fingerprintpb.Response) { m.ServedAt.Seconds = 2 }, true},
+		{"unset served_at", func(m *fingerprintpb.Response) { m.ServedAt = nil }, true},
+		{"request_id", func(m *fingerprintpb.Response) { m.RequestId = "r2" }, true},
+		{"trace", func(m *fingerprintpb.Response) { m.Trace = append(m.Trace, "t2") }, true},
+		{"cache", func(m *fingerprintpb.Response) { m.Source = &fingerprintpb.Response_Cache{Cache: "c2"} }, true},
+		{"unset oneof", func(m *fingerprintpb.Response) { m.Source = nil }, true},
+		{"fetched_at of items", func(m *fingerprintpb.Response) { m.Items[1].FetchedAt = 12 }, true},
+		{"fetched_at of map values", func(m *fingerprintpb.Response) { m.ItemsByName["a"].FetchedAt = 12 }, true},
+		{"fetched_at of best", func(m *fingerprintpb.Response) { m.Best.FetchedAt = 12 }, true},
+		{"query", func(m *fingerprintpb.Response) { m.Query = "other" }, false},
+		{"name of items", func(m *fingerprintpb.Response) { m.Items[0].Name = "c" }, false},
+		{"order of items", func(m *fingerprintpb.Response) { m.Items[0], m.Items[1] = m.Items[1], m.Items[0] }, false},
+		{"map keys", func(m *fingerprintpb.Response) { delete(m.ItemsByName, "b") }, false},
+		{"best", func(m *fingerprintpb.Response) { m.Best = nil }, false},
+		{"origin", func(m *fingerprintpb.Response) { m.Source = &fingerprintpb.Response_Origin{Origin: "o"} }, false},
+	} {
+		m := fingerprintResponse()
+		test.change(m)
+		if got := m.Fingerprint(); (got == want) != test.same {
+			if test.same {
+				t.Errorf("changing %s changed the fingerprint", test.name)
+			} else {
+				t.Errorf("changing %s did not change the fingerprint", test.name)
+			}
+		}
+	}
+
+	m := fingerprintResponse()
+	m.Fingerprint()
+	if !proto.Equal(m, fingerprintResponse()) {
+		t.Errorf("Fingerprint modified the message: %v", m)
+	}
+	if got, want := (*fingerprintpb.Response)(nil).Fingerprint(), new(fingerprintpb.Response).Fingerprint(); got != want {
+		t.Errorf("nil.Fingerprint() = %x, want the fingerprint of an empty message %x", got, want)
+	}
+}
+
+func TestClearVolatileFields(t *testing.T) {
+	m := fingerprintResponse()
+	m.Source = &fingerprintpb.Response_Origin{Origin: "o"}
+	m.ClearVolatileFields()
+	want := &fingerprintpb.Response{
+		Query: "q",
+		Items: []*fingerprintpb.Response_Item{{Name: "a"}, {Name: "b"}},
+		ItemsByName: map[string]*fingerprintpb.Response_Item{
+			"a": {Name: "a"},
+			"b": {Name: "b"},
+		},
+		Best:   &fingerprintpb.Response_Item{Name: "a"},
+		Source: &fingerprintpb.Response_Origin{Origin: "o"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("ClearVolatileFields() = %v, want %v", m, want)
+	}
+}
+
+func TestFingerprintHybrid(t *testing.T) {
+	snapshot := func(takenAt, fetchedAt int64) *fingerprintpb.Snapshot {
+		return fingerprintpb.Snapshot_builder{
+			Id:       proto.String("s"),
+			TakenAt:  proto.Int64(takenAt),
+			Response: &fingerprintpb.Response{Query: "q", Best: &fingerprintpb.Response_Item{Name: "a", FetchedAt: fetchedAt}},
+		}.Build()
+	}
+	if a, b := snapshot(1, 10), snapshot(2, 20); a.Fingerprint() != b.Fingerprint() {
+		t.Errorf("snapshots differing only in volatile fields have different fingerprints")
+	}
+	m := snapshot(1, 10)
+	m.SetId("other")
+	if m.Fingerprint() == snapshot(1, 10).Fingerprint() {
+		t.Errorf("snapshots with different ids have the same fingerprint")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	fixturespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/fixtures"
+)
+
+func TestFixtures(t *testing.T) {
+	for _, m := range []proto.Message{
+		fixturespb.Order_Fixture(),
+		fixturespb.Job_Fixture(),
+	} {
+		name := m.ProtoReflect().Descriptor().FullName()
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Errorf("Marshal(%v fixture): %v", name, err)
+			continue
+		}
+		if len(b) == 0 {
+			t.Errorf("%v fixture is empty", name)
+		}
+		got := m.ProtoReflect().New().Interface()
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Errorf("Unmarshal(%v fixture): %v", name, err)
+		} else if !proto.Equal(got, m) {
+			t.Errorf("%v fixture does not round-trip: got %v, want %v", name, got, m)
+		}
+
+		fields := m.ProtoReflect().Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && oneof.Fields().Get(0) != fd {
+				continue
+			}
+			if !m.ProtoReflect().Has(fd) {
+				t.Errorf("%v fixture does not set the field %v", name, fd.Name())
+			}
+		}
+	}
+
+	if a, b := fixturespb.Order_Fixture(), fixturespb.Order_Fixture(); a == b || !proto.Equal(a, b) {
+		t.Errorf("Order_Fixture() does not return a new message with the same values")
+	}
+	if got := fixturespb.Order_Fixture().GetStatus(); got != fixturespb.Order_STATUS_OPEN {
+		t.Errorf("Order_Fixture() has status %v, want the first non-zero value", got)
+	}
+	if got := fixturespb.Empty_Fixture(); got == nil || proto.Size(got) != 0 {
+		t.Errorf("Empty_Fixture() = %v, want an empty message", got)
+	}
+}
+
+func TestFixturesDepth(t *testing.T) {
+	var depth int
+	for m := protoreflect.ProtoMessage(fixturespb.Order_Fixture()); m.ProtoReflect().IsValid(); depth++ {
+		m = m.(*fixturespb.Order).GetParent()
+	}
+	if want := 4; depth != want {
+		t.Errorf("Order_Fixture() has %d levels of parents, want %d", depth, want)
+	}
+}
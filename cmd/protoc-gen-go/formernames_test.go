@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	formernamespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/formernames"
+)
+
+func TestEnumFormerNames(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		got, want any
+	}{
+		{"State_STATE_ENABLED", formernamespb.State_STATE_ENABLED, formernamespb.State_STATE_ACTIVE},
+		{"State_STATE_ON", formernamespb.State_STATE_ON, formernamespb.State_STATE_ACTIVE},
+		{"Job_PHASE_STARTED", formernamespb.Job_PHASE_STARTED, formernamespb.Job_PHASE_RUNNING},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%v = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if got, want := formernamespb.State_STATE_ON.String(), "STATE_ACTIVE"; got != want {
+		t.Errorf("State_STATE_ON.String() = %q, want %q", got, want)
+	}
+}
+
+func TestEnumFormerNamesNotInMaps(t *testing.T) {
+	for _, name := range []string{"STATE_ENABLED", "STATE_ON"} {
+		if _, ok := formernamespb.State_value[name]; ok {
+			t.Errorf("State_value contains former name %q", name)
+		}
+	}
+	if got, want := len(formernamespb.State_name), 3; got != want {
+		t.Errorf("len(State_name) = %v, want %v", got, want)
+	}
+	if _, ok := formernamespb.Job_Phase_value["PHASE_STARTED"]; ok {
+		t.Errorf(`Job_Phase_value contains former name "PHASE_STARTED"`)
+	}
+}
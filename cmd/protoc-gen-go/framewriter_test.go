@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	framewriterpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/framewriter"
+)
+
+func TestWriteFramed(t *testing.T) {
+	records := []*framewriterpb.Record{
+		{Message: "started", Timestamp: 1, Labels: map[string]string{"level": "info"}},
+		{},
+		{Message: "stopped", Timestamp: 2},
+	}
+	var buf bytes.Buffer
+	for _, m := range records {
+		if err := m.WriteFramed(&buf); err != nil {
+			t.Fatalf("WriteFramed(%v): %v", m, err)
+		}
+	}
+
+	for _, want := range records {
+		var prefix [4]byte
+		if _, err := io.ReadFull(&buf, prefix[:]); err != nil {
+			t.Fatalf("reading length prefix: %v", err)
+		}
+		n := binary.BigEndian.Uint32(prefix[:])
+		if got, want := int(n), proto.Size(want); got != want {
+			t.Errorf("length prefix = %d, want %d", got, want)
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(&buf, payload); err != nil {
+			t.Fatalf("reading payload: %v", err)
+		}
+		got := new(framewriterpb.Record)
+		if err := proto.Unmarshal(payload, got); err != nil {
+			t.Fatalf("proto.Unmarshal: %v", err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("read frame %v, want %v", got, want)
+		}
+	}
+	if buf.Len() > 0 {
+		t.Errorf("%d trailing bytes after the last frame", buf.Len())
+	}
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestWriteFramedWriteError(t *testing.T) {
+	wantErr := errors.New("sink closed")
+	m := &framewriterpb.Record{Message: "lost"}
+	if err := m.WriteFramed(errWriter{wantErr}); !errors.Is(err, wantErr) {
+		t.Errorf("WriteFramed to failing writer = %v, want %v", err, wantErr)
+	}
+}
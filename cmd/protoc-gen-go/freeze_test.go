@@ -0,0 +1,177 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	freezepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freeze"
+)
+
+func newFreezeConfig() *freezepb.Config {
+	return freezepb.Config_builder{
+		Name:     proto.String("name"),
+		Tags:     []string{"a"},
+		Primary:  freezepb.Config_Endpoint_builder{Address: proto.String("primary")}.Build(),
+		Replicas: []*freezepb.Config_Endpoint{freezepb.Config_Endpoint_builder{Address: proto.String("replica")}.Build()},
+		Named:    map[string]*freezepb.Config_Endpoint{"x": freezepb.Config_Endpoint_builder{Address: proto.String("named")}.Build()},
+		Issuer:   freezepb.Config_Endpoint_builder{Address: proto.String("issuer")}.Build(),
+	}.Build()
+}
+
+func TestFreezeSetterPanics(t *testing.T) {
+	m := newFreezeConfig()
+	m.Freeze()
+	for _, test := range []struct {
+		method string
+		call   func()
+	}{
+		{"SetName", func() { m.SetName("other") }},
+		{"SetTags", func() { m.SetTags(nil) }},
+		{"SetToken", func() { m.SetToken("token") }},
+		{"ClearName", func() { m.ClearName() }},
+		{"ClearAuth", func() { m.ClearAuth() }},
+		{"Primary.SetAddress", func() { m.GetPrimary().SetAddress("other") }},
+		{"Replicas[0].SetAddress", func() { m.GetReplicas()[0].SetAddress("other") }},
+		{"Named[x].ClearAddress", func() { m.GetNamed()["x"].ClearAddress() }},
+		{"Issuer.SetAddress", func() { m.GetIssuer().SetAddress("other") }},
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if s, _ := r.(string); !strings.Contains(s, "called on frozen message") {
+					t.Errorf("%v on frozen message: recovered %v, want panic", test.method, r)
+				}
+			}()
+			test.call()
+		}()
+	}
+	if !proto.Equal(m, newFreezeConfig()) {
+		t.Errorf("frozen message was modified: %v", m)
+	}
+}
+
+func TestFreezeGetters(t *testing.T) {
+	m := newFreezeConfig()
+	m.Freeze()
+	if got, want := m.GetName(), "name"; got != want {
+		t.Errorf("GetName() = %q, want %q", got, want)
+	}
+	if got, want := m.GetPrimary().GetAddress(), "primary"; got != want {
+		t.Errorf("GetPrimary().GetAddress() = %q, want %q", got, want)
+	}
+	if !m.HasIssuer() {
+		t.Errorf("HasIssuer() = false, want true")
+	}
+	if _, err := proto.Marshal(m); err != nil {
+		t.Errorf("proto.Marshal of frozen message: %v", err)
+	}
+
+	c := proto.Clone(m).(*freezepb.Config)
+	c.SetName("clone")
+	c.GetPrimary().SetAddress("clone")
+	if got, want := m.GetName(), "name"; got != want {
+		t.Errorf("GetName() after modifying clone = %q, want %q", got, want)
+	}
+
+	var nilConfig *freezepb.Config
+	nilConfig.Freeze()
+}
+
+func TestFreezeUnfrozen(t *testing.T) {
+	m := newFreezeConfig()
+	m.SetName("other")
+	m.GetPrimary().SetAddress("other")
+	if got, want := m.GetPrimary().GetAddress(), "other"; got != want {
+		t.Errorf("GetPrimary().GetAddress() = %q, want %q", got, want)
+	}
+}
+
+func TestFreezeReplacePanics(t *testing.T) {
+	m := freezepb.Settings_builder{Name: proto.String("name")}.Build()
+	m.Freeze()
+	for _, test := range []struct {
+		method string
+		call   func()
+	}{
+		{"Reset", func() { m.Reset() }},
+		{"proto.Reset", func() { proto.Reset(m) }},
+		{"proto.Unmarshal", func() { proto.Unmarshal(nil, m) }},
+		{"FromKV", func() { m.FromKV(map[string]string{"name": "other"}) }},
+		{"FromURLValues", func() { m.FromURLValues(url.Values{"name": {"other"}}) }},
+		{"ApplyEnvOverrides", func() { m.ApplyEnvOverrides("FREEZE_TEST_") }},
+		{"ApplyJSONPatch", func() { m.ApplyJSONPatch([]byte(`[{"op":"replace","path":"/name","value":"other"}]`)) }},
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if s, _ := r.(string); !strings.Contains(s, "called on frozen message") {
+					t.Errorf("%v on frozen message: recovered %v, want panic", test.method, r)
+				}
+			}()
+			test.call()
+		}()
+	}
+	if got, want := m.GetName(), "name"; got != want {
+		t.Errorf("GetName() = %q, want %q", got, want)
+	}
+}
+
+func TestReplaceKeepsTracking(t *testing.T) {
+	t.Setenv("REPLACE_TEST_PORT", "8080")
+	for _, test := range []struct {
+		method string
+		call   func(m *freezepb.Settings) error
+	}{
+		{"FromKV", func(m *freezepb.Settings) error { return m.FromKV(map[string]string{"port": "8080"}) }},
+		{"FromURLValues", func(m *freezepb.Settings) error { return m.FromURLValues(url.Values{"port": {"8080"}}) }},
+		{"ApplyEnvOverrides", func(m *freezepb.Settings) error { return m.ApplyEnvOverrides("REPLACE_TEST_") }},
+		{"ApplyJSONPatch", func(m *freezepb.Settings) error {
+			return m.ApplyJSONPatch([]byte(`[{"op":"replace","path":"/port","value":8080}]`))
+		}},
+	} {
+		m := &freezepb.Settings{}
+		var changed []protoreflect.Name
+		m.OnFieldChange(func(field protoreflect.Name, old, new any) {
+			changed = append(changed, field)
+		})
+		m.SetName("name")
+		if err := test.call(m); err != nil {
+			t.Errorf("%v: %v", test.method, err)
+			continue
+		}
+		if got, want := m.GetPort(), int32(8080); got != want {
+			t.Errorf("%v: GetPort() = %v, want %v", test.method, got, want)
+		}
+		if got := m.TouchedFields(); len(got) != 1 || got[0] != "name" {
+			t.Errorf("%v: TouchedFields() = %v, want [name]", test.method, got)
+		}
+		m.SetName("other")
+		if len(changed) != 2 {
+			t.Errorf("%v: OnFieldChange hook called for %v, want it kept", test.method, changed)
+		}
+	}
+}
+
+func TestResetClearsTracking(t *testing.T) {
+	m := &freezepb.Settings{}
+	called := false
+	m.OnFieldChange(func(field protoreflect.Name, old, new any) { called = true })
+	m.SetName("name")
+	called = false
+	proto.Reset(m)
+	m.SetName("other")
+	if called {
+		t.Errorf("OnFieldChange hook called after proto.Reset")
+	}
+	if got := m.TouchedFields(); len(got) != 1 || got[0] != "name" {
+		t.Errorf("TouchedFields() after proto.Reset and SetName = %v, want [name]", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	freezemapspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freezemaps"
+)
+
+func TestSortedMaps(t *testing.T) {
+	shelf := &freezemapspb.Shelf{Slots: map[int32][]byte{3: {3}, -1: {1}, 0: nil}}
+	m := &freezemapspb.Inventory{
+		Counts:  map[string]int32{"pear": 2, "apple": 5, "fig": 1},
+		Shelves: map[uint64]*freezemapspb.Shelf{9: {}, 2: shelf},
+		Flags:   map[bool]string{true: "t", false: "f"},
+		Front:   shelf,
+	}
+
+	type countEntry = struct {
+		Key   string
+		Value int32
+	}
+	wantCounts := []countEntry{{"apple", 5}, {"fig", 1}, {"pear", 2}}
+	if diff := cmp.Diff(wantCounts, m.SortedCounts()); diff != "" {
+		t.Errorf("SortedCounts() mismatch (-want +got):\n%s", diff)
+	}
+
+	type flagEntry = struct {
+		Key   bool
+		Value string
+	}
+	if diff := cmp.Diff([]flagEntry{{false, "f"}, {true, "t"}}, m.SortedFlags()); diff != "" {
+		t.Errorf("SortedFlags() mismatch (-want +got):\n%s", diff)
+	}
+
+	shelves := m.SortedShelves()
+	if len(shelves) != 2 || shelves[0].Key != 2 || shelves[1].Key != 9 || shelves[0].Value != shelf {
+		t.Errorf("SortedShelves() = %v, want keys 2 and 9 with the messages of the map", shelves)
+	}
+
+	// The map fields of nested messages are sorted by their own methods.
+	type slotEntry = struct {
+		Key   int32
+		Value []byte
+	}
+	wantSlots := []slotEntry{{-1, []byte{1}}, {0, nil}, {3, []byte{3}}}
+	if diff := cmp.Diff(wantSlots, m.GetFront().SortedSlots()); diff != "" {
+		t.Errorf("GetFront().SortedSlots() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantSlots, shelves[0].Value.SortedSlots()); diff != "" {
+		t.Errorf("SortedShelves()[0].Value.SortedSlots() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := new(freezemapspb.Inventory).SortedCounts(); got != nil {
+		t.Errorf("SortedCounts() of an empty map = %v, want nil", got)
+	}
+	if got := (*freezemapspb.Inventory)(nil).SortedShelves(); got != nil {
+		t.Errorf("nil.SortedShelves() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	fromkvpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv"
+	fromkvunsupportedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv/unsupported"
+)
+
+func TestFromKV(t *testing.T) {
+	m := &fromkvpb.Config{Name: "old", Workers: 1, Threshold: 2}
+	err := m.FromKV(map[string]string{
+		"name":    "new",
+		"workers": "-8",
+		"budget":  "0",
+		"verbose": "true",
+		"ratio":   "0.5",
+		"mode":    "MODE_SAFE",
+		"token":   "/w==",
+		"offset":  "-3",
+		"port":    "443",
+		"limits":  "ignored",
+		"tags":    "ignored",
+		"labels":  "ignored",
+	})
+	if err != nil {
+		t.Fatalf("FromKV: %v", err)
+	}
+	want := &fromkvpb.Config{
+		Name:      "new",
+		Workers:   -8,
+		Budget:    proto.Uint64(0),
+		Verbose:   true,
+		Ratio:     0.5,
+		Threshold: 2,
+		Mode:      fromkvpb.Config_MODE_SAFE,
+		Token:     []byte{0xff},
+		Offset:    -3,
+		Target:    &fromkvpb.Config_Port{Port: 443},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("FromKV() = %v, want %v", m, want)
+	}
+}
+
+func TestFromKVEnum(t *testing.T) {
+	for _, s := range []string{"MODE_FAST", "1"} {
+		m := &fromkvpb.Config{}
+		if err := m.FromKV(map[string]string{"mode": s}); err != nil {
+			t.Errorf("FromKV with mode %q: %v", s, err)
+			continue
+		}
+		if got, want := m.GetMode(), fromkvpb.Config_MODE_FAST; got != want {
+			t.Errorf("FromKV with mode %q: got %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestFromKVHybrid(t *testing.T) {
+	m := &fromkvpb.Job{}
+	if err := m.FromKV(map[string]string{"id": "j", "retries": "3", "priority": "PRIORITY_HIGH", "cron": "@daily"}); err != nil {
+		t.Fatalf("FromKV: %v", err)
+	}
+	want := fromkvpb.Job_builder{
+		Id:       proto.String("j"),
+		Retries:  3,
+		Priority: fromkvpb.Job_PRIORITY_HIGH.Enum(),
+		Cron:     proto.String("@daily"),
+	}.Build()
+	if !proto.Equal(m, want) {
+		t.Errorf("FromKV() = %v, want %v", m, want)
+	}
+}
+
+func TestFromKVErrors(t *testing.T) {
+	for _, test := range []struct {
+		kv   map[string]string
+		want string
+	}{
+		{map[string]string{"workers": "many"}, `key "workers"`},
+		{map[string]string{"workers": "4294967296"}, `key "workers"`},
+		{map[string]string{"mode": "MODE_SLOW"}, `key "mode"`},
+		{map[string]string{"verbose": "maybe"}, `key "verbose"`},
+		{map[string]string{"token": "!"}, `key "token"`},
+		{map[string]string{"unknown": "x"}, `unknown key "unknown"`},
+		{map[string]string{"Name": "x"}, `unknown key "Name"`},
+	} {
+		orig := &fromkvpb.Config{Name: "orig"}
+		m := proto.Clone(orig).(*fromkvpb.Config)
+		kv := map[string]string{"name": "changed"}
+		for k, v := range test.kv {
+			kv[k] = v
+		}
+		err := m.FromKV(kv)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("FromKV(%v): got error %v, want error containing %s", kv, err, test.want)
+		}
+		if !proto.Equal(m, orig) {
+			t.Errorf("FromKV(%v) modified the message: %v", kv, m)
+		}
+	}
+	if err := new(fromkvpb.Empty).FromKV(map[string]string{"x": ""}); err == nil {
+		t.Errorf("Empty.FromKV with a key: got nil error, want error")
+	}
+}
+
+func TestFromKVUnsupportedError(t *testing.T) {
+	m := &fromkvunsupportedpb.Config{}
+	if err := m.FromKV(map[string]string{"name": "n"}); err != nil {
+		t.Errorf("FromKV with a scalar key: %v", err)
+	}
+	for _, key := range []string{"ports", "limits", "weights"} {
+		if err := m.FromKV(map[string]string{key: "x"}); err == nil || !strings.Contains(err.Error(), "does not name a scalar field") {
+			t.Errorf("FromKV with key %q: got error %v, want error for a non-scalar field", key, err)
+		}
+	}
+	if err := m.FromKV(map[string]string{"unknown": "x"}); err == nil || !strings.Contains(err.Error(), "unknown key") {
+		t.Errorf("FromKV with an unknown key: got error %v, want unknown key", err)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	indexkeypb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/indexkey"
+)
+
+func TestBuildIndex(t *testing.T) {
+	a := &indexkeypb.Product{Sku: "a", Title: "A"}
+	b := &indexkeypb.Product{Sku: "b", Title: "B"}
+	unset := &indexkeypb.Product{Title: "no sku"}
+	index, err := indexkeypb.BuildProductIndex([]*indexkeypb.Product{a, nil, b, unset})
+	if err != nil {
+		t.Fatalf("BuildProductIndex: %v", err)
+	}
+	if len(index) != 3 || index["a"] != a || index["b"] != b || index[""] != unset {
+		t.Errorf("BuildProductIndex() = %v, want a, b and the product without a sku", index)
+	}
+
+	m := &indexkeypb.Catalog{Products: []*indexkeypb.Product{a}, Discontinued: []*indexkeypb.Product{b}}
+	if index, err := m.ProductsIndex(); err != nil || len(index) != 1 || index["a"] != a {
+		t.Errorf("ProductsIndex() = %v, %v, want a", index, err)
+	}
+	if index, err := m.DiscontinuedIndex(); err != nil || len(index) != 1 || index["b"] != b {
+		t.Errorf("DiscontinuedIndex() = %v, %v, want b", index, err)
+	}
+
+	shelf := &indexkeypb.Catalog_Shelf{Bins: []*indexkeypb.Catalog_Shelf_Bin{{Number: 3}, {Number: 7}}}
+	if index, err := shelf.BinsIndex(); err != nil || len(index) != 2 || index[7] != shelf.Bins[1] {
+		t.Errorf("BinsIndex() = %v, %v, want bins 3 and 7", index, err)
+	}
+
+	if index, err := (*indexkeypb.Catalog)(nil).ProductsIndex(); err != nil || len(index) != 0 {
+		t.Errorf("nil.ProductsIndex() = %v, %v, want an empty index", index, err)
+	}
+}
+
+func TestBuildIndexDuplicate(t *testing.T) {
+	items := []*indexkeypb.Product{{Sku: "a"}, {Sku: "b"}, {Sku: "a", Title: "again"}}
+	index, err := indexkeypb.BuildProductIndex(items)
+	if err == nil || !strings.Contains(err.Error(), `duplicate sku "a"`) {
+		t.Errorf("BuildProductIndex with a duplicate sku: got error %v, want duplicate sku", err)
+	}
+	if index != nil {
+		t.Errorf("BuildProductIndex with a duplicate sku = %v, want nil", index)
+	}
+
+	bins := []*indexkeypb.Catalog_Shelf_Bin{{Number: 1}, {Number: 1}}
+	if _, err := indexkeypb.BuildCatalog_Shelf_BinIndex(bins); err == nil || !strings.Contains(err.Error(), "duplicate number 1") {
+		t.Errorf("BuildCatalog_Shelf_BinIndex with a duplicate number: got error %v, want duplicate number", err)
+	}
+}
+
+func TestBuildIndexLastWins(t *testing.T) {
+	first := indexkeypb.Vehicle_builder{Plate: proto.String("x"), Seats: proto.Int32(2)}.Build()
+	last := indexkeypb.Vehicle_builder{Plate: proto.String("x"), Seats: proto.Int32(5)}.Build()
+	other := indexkeypb.Vehicle_builder{Plate: proto.String("y")}.Build()
+	m := indexkeypb.Fleet_builder{Vehicles: []*indexkeypb.Vehicle{first, other, last}}.Build()
+	index, err := m.VehiclesIndex()
+	if err != nil {
+		t.Fatalf("VehiclesIndex: %v", err)
+	}
+	if len(index) != 2 || index["x"] != last || index["y"] != other {
+		t.Errorf("VehiclesIndex() = %v, want the last vehicle with plate x and the vehicle with plate y", index)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	int64stringpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/int64string"
+)
+
+func TestInt64StringRoundTrip(t *testing.T) {
+	const large = "9007199254740993" // 2^53 + 1, which a float64 cannot represent
+	m := new(int64stringpb.Account)
+	for _, set := range []struct {
+		name string
+		fn   func(string) error
+		s    string
+	}{
+		{"Balance", m.SetBalanceString, large},
+		{"Id", m.SetIdString, strconv.FormatUint(math.MaxUint64, 10)},
+		{"Offset", m.SetOffsetString, strconv.FormatInt(math.MinInt64, 10)},
+		{"Checksum", m.SetChecksumString, large},
+		{"Delta", m.SetDeltaString, "-" + large},
+		{"Limit", m.SetLimitString, "0"},
+		{"RefId", m.SetRefIdString, large},
+	} {
+		if err := set.fn(set.s); err != nil {
+			t.Errorf("Set%vString(%q): %v", set.name, set.s, err)
+		}
+	}
+
+	want := &int64stringpb.Account{
+		Balance:  9007199254740993,
+		Id:       math.MaxUint64,
+		Offset:   math.MinInt64,
+		Checksum: 9007199254740993,
+		Delta:    -9007199254740993,
+		Limit:    proto.Int64(0),
+		Ref:      &int64stringpb.Account_RefId{RefId: 9007199254740993},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("after setters: got %v, want %v", m, want)
+	}
+
+	// The string form must match the protojson encoding of the field.
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, get := range []struct {
+		name string
+		got  string
+		json string
+	}{
+		{"balance", m.GetBalanceString(), "balance"},
+		{"id", m.GetIdString(), "id"},
+		{"offset", m.GetOffsetString(), "offset"},
+		{"checksum", m.GetChecksumString(), "checksum"},
+		{"delta", m.GetDeltaString(), "delta"},
+		{"limit", m.GetLimitString(), "limit"},
+		{"ref_id", m.GetRefIdString(), "refId"},
+	} {
+		if want, _ := fields[get.json].(string); get.got != want {
+			t.Errorf("Get%vString() = %q, want protojson encoding %q", get.name, get.got, want)
+		}
+	}
+}
+
+func TestInt64StringErrors(t *testing.T) {
+	m := &int64stringpb.Account{Balance: 1, Id: 2}
+	for _, s := range []string{"", "1.5", "0x10", "9223372036854775808"} {
+		if err := m.SetBalanceString(s); err == nil {
+			t.Errorf("SetBalanceString(%q): got nil error, want error", s)
+		}
+	}
+	if err := m.SetIdString("-1"); err == nil {
+		t.Errorf(`SetIdString("-1"): got nil error, want error`)
+	}
+	if m.GetBalance() != 1 || m.GetId() != 2 {
+		t.Errorf("failed setters modified the message: %v", m)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageApplyDefaults generates the ApplyDefaults method, which sets the
+// unset fields of a message with declared defaults to their default values.
+func genMessageApplyDefaults(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ApplyDefaults sets each unset field of x with a default value declared in")
+	g.P("// the .proto file to that value. Members of oneofs are left unset.")
+	g.P("func (x *", m.GoIdent, ") ApplyDefaults() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	for _, field := range m.Fields {
+		if !field.Desc.HasDefault() || isOneofMember(field) {
+			continue
+		}
+		// The name of the declaration generated by genMessageDefaultDecls.
+		def := "Default_" + m.GoIdent.GoName + "_" + field.GoName
+		v := def
+		if field.Desc.Kind() == protoreflect.BytesKind {
+			v = "append([]byte(nil), " + def + "...)"
+		}
+		if m.isOpen() {
+			g.P("if x.", field.GoName, " == nil {")
+			if field.Desc.Kind() == protoreflect.BytesKind {
+				g.P("x.", field.GoName, " = ", v)
+			} else {
+				g.P("v := ", def)
+				g.P("x.", field.GoName, " = &v")
+			}
+			g.P("}")
+			continue
+		}
+		hasserName, _ := field.MethodName("Has")
+		g.P("if !x.", hasserName, "() {")
+		g.P(fieldAssignStmt(m, "x", field, v))
+		g.P("}")
+	}
+	g.P("}")
+	g.P()
+}
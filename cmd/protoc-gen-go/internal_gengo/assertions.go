@@ -0,0 +1,21 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genOneofWrapperAssertions generates compile-time assertions that each of
+// the wrapper types of a oneof implements the interface of the oneof.
+func genOneofWrapperAssertions(g *protogen.GeneratedFile, ifName string, wrapperTypes []protogen.GoIdent) {
+	if !generateAssertions.enabled["oneof"] {
+		return
+	}
+	for _, t := range wrapperTypes {
+		g.P("var _ ", ifName, " = (*", t, ")(nil)")
+	}
+	g.P()
+}
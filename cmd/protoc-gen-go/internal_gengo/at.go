@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageAtMethods generates a FooAt method for each repeated field foo of
+// a message, which returns the element at an index without panicking when the
+// index is out of range.
+func genMessageAtMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsList() {
+			continue
+		}
+		goType, _ := fieldGoType(g, f, field)
+		elemType := strings.TrimPrefix(goType, "[]")
+		getterName, _ := field.MethodName("Get")
+		g.P("// ", field.GoName, "At returns the element of the ", field.Desc.Name(), " field at index i")
+		g.P("// and true, or the zero value and false if i is out of range.")
+		g.P("func (x *", m.GoIdent, ") ", field.GoName, "At(i int) (v ", elemType, ", ok bool) {")
+		g.P("if l := x.", getterName, "(); i >= 0 && i < len(l) {")
+		g.P("return l[i], true")
+		g.P("}")
+		g.P("return v, false")
+		g.P("}")
+		g.P()
+	}
+}
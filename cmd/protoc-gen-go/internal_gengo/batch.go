@@ -0,0 +1,87 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageBatchMarshal generates package-level functions marshaling a slice
+// of messages, either into separate byte slices or as a stream of
+// length-delimited messages.
+func genMessageBatchMarshal(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	skipNil := batchNil.value == "skip"
+	nilDoc := "It reports an error if any element of xs is nil."
+	if skipNil {
+		nilDoc = "Nil elements of xs are skipped."
+	}
+	loopVars := "i, x"
+	if skipNil {
+		loopVars = "_, x"
+	}
+	genNilCheck := func(ret string) {
+		g.P("if x == nil {")
+		if skipNil {
+			g.P("continue")
+		} else {
+			g.P("return ", ret, fmtPackage.Ident("Errorf"), "(", strconv.Quote("nil "+m.GoIdent.GoName+" at index %d"), ", i)")
+		}
+		g.P("}")
+	}
+
+	sliceName := "Marshal" + m.GoIdent.GoName + "Slice"
+	g.P("// ", sliceName, " returns the wire-format encoding of each message in xs.")
+	g.P("// The encodings share a single buffer and must not be appended to.")
+	g.P("// ", nilDoc)
+	g.P("func ", sliceName, "(xs []*", m.GoIdent, ") ([][]byte, error) {")
+	g.P("n := 0")
+	g.P("for ", loopVars, " := range xs {")
+	genNilCheck("nil, ")
+	g.P("n += ", protoPackage.Ident("Size"), "(x)")
+	g.P("}")
+	g.P("opts := ", protoPackage.Ident("MarshalOptions"), "{UseCachedSize: true}")
+	g.P("buf := make([]byte, 0, n)")
+	g.P("out := make([][]byte, 0, len(xs))")
+	g.P("for _, x := range xs {")
+	if skipNil {
+		g.P("if x == nil {")
+		g.P("continue")
+		g.P("}")
+	}
+	g.P("start := len(buf)")
+	g.P("var err error")
+	g.P("if buf, err = opts.MarshalAppend(buf, x); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("out = append(out, buf[start:len(buf):len(buf)])")
+	g.P("}")
+	g.P("return out, nil")
+	g.P("}")
+	g.P()
+
+	streamName := "Marshal" + m.GoIdent.GoName + "Stream"
+	g.P("// ", streamName, " writes each message in xs to w, prefixed by its size")
+	g.P("// encoded as a varint. The output can be read back with protodelim.UnmarshalFrom.")
+	g.P("// ", nilDoc)
+	g.P("func ", streamName, "(w ", ioPackage.Ident("Writer"), ", xs []*", m.GoIdent, ") error {")
+	g.P("opts := ", protoPackage.Ident("MarshalOptions"), "{UseCachedSize: true}")
+	g.P("var buf []byte")
+	g.P("for ", loopVars, " := range xs {")
+	genNilCheck("")
+	g.P("buf = ", protowirePackage.Ident("AppendVarint"), "(buf[:0], uint64(", protoPackage.Ident("Size"), "(x)))")
+	g.P("var err error")
+	g.P("if buf, err = opts.MarshalAppend(buf, x); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("if _, err := w.Write(buf); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageByJSONName generates the GetByJSONName and SetByJSONName methods,
+// which access a field identified by its JSON name, such as the name of an
+// input of an HTML form.
+func genMessageByJSONName(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	varName := messageVarName(f, m, "fieldsByJSONName")
+	g.P("var ", varName, " = map[string]", protoreflectPackage.Ident("FieldNumber"), "{")
+	for _, field := range m.Fields {
+		g.P(strconv.Quote(field.Desc.JSONName()), ": ", field.Desc.Number(), ",")
+	}
+	g.P("}")
+	g.P()
+
+	unknownErr := strconv.Quote("unknown JSON name %q for message " + string(m.Desc.FullName()))
+
+	g.P("// GetByJSONName returns the value of the field of x with the given JSON")
+	g.P("// name, as returned by its getter. For fields with explicit presence, the")
+	g.P("// value is that of the field, and not a pointer to it.")
+	g.P("func (x *", m.GoIdent, ") GetByJSONName(name string) (any, error) {")
+	g.P("switch ", varName, "[name] {")
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		g.P("case ", field.Desc.Number(), ":")
+		g.P("return x.", getterName, "(), nil")
+	}
+	g.P("}")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(", unknownErr, ", name)")
+	g.P("}")
+	g.P()
+
+	g.P("// SetByJSONName sets the field of x with the given JSON name to v, which must")
+	g.P("// have the Go type of the field. For fields with explicit presence, v is the")
+	g.P("// value of the field, and not a pointer to it. Setting a oneof member clears")
+	g.P("// the other members of the oneof.")
+	g.P("func (x *", m.GoIdent, ") SetByJSONName(name string, v any) error {")
+	g.P("switch ", varName, "[name] {")
+	for _, field := range m.Fields {
+		g.P("case ", field.Desc.Number(), ":")
+		genSetFieldFromAny(g, f, m, field, "v")
+	}
+	g.P("default:")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(", unknownErr, ", name)")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
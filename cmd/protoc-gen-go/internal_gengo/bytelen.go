@@ -0,0 +1,104 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageTotalStringBytes generates the TotalStringBytes method, which sums
+// the lengths of the string and bytes values reachable from a message.
+func genMessageTotalStringBytes(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// TotalStringBytes returns the total length in bytes of the values of the")
+	g.P("// string and bytes fields of x, including the elements of repeated fields,")
+	g.P("// the keys and values of map fields, and the fields of nested messages.")
+	g.P("func (x *", m.GoIdent, ") TotalStringBytes() int {")
+	g.P("if x == nil {")
+	g.P("return 0")
+	g.P("}")
+	g.P("n := 0")
+	for _, field := range m.Fields {
+		// Singular string fields with explicit presence are pointers in the
+		// open API, so they are read by their getter like oneof members.
+		var v string
+		if isOneofMember(field) || (isStringOrBytes(field) && !field.Desc.IsList() && !field.Desc.IsMap()) {
+			getterName, _ := field.MethodName("Get")
+			v = "x." + getterName + "()"
+		} else {
+			v = fieldValueExpr(m, "x", field)
+		}
+		switch {
+		case field.Desc.IsList():
+			switch {
+			case isStringOrBytes(field):
+				g.P("for _, v := range ", v, " {")
+				g.P("n += len(v)")
+				g.P("}")
+			case field.Message != nil:
+				g.P("for _, v := range ", v, " {")
+				genTotalStringBytes(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsMap():
+			keyField, valField := field.Message.Fields[0], field.Message.Fields[1]
+			k, val := "_", "_"
+			if isStringOrBytes(keyField) {
+				k = "k"
+			}
+			if isStringOrBytes(valField) || valField.Message != nil {
+				val = "v"
+			}
+			if k == "_" && val == "_" {
+				continue
+			}
+			if val == "_" {
+				g.P("for k := range ", v, " {")
+			} else {
+				g.P("for ", k, ", v := range ", v, " {")
+			}
+			if k != "_" {
+				g.P("n += len(k)")
+			}
+			switch {
+			case isStringOrBytes(valField):
+				g.P("n += len(v)")
+			case valField.Message != nil:
+				genTotalStringBytes(g, f, valField.Message, "v")
+			}
+			g.P("}")
+		case isStringOrBytes(field):
+			g.P("n += len(", v, ")")
+		case field.Message != nil:
+			genTotalStringBytes(g, f, field.Message, v)
+		}
+	}
+	g.P("return n")
+	g.P("}")
+	g.P()
+}
+
+// isStringOrBytes reports whether the field has string or bytes values.
+func isStringOrBytes(field *protogen.Field) bool {
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return true
+	}
+	return false
+}
+
+// genTotalStringBytes generates code adding the total length of the string and
+// bytes values reachable from the message value v to n. Messages declared in
+// other files are only recursed into if they were also generated with the
+// method.
+func genTotalStringBytes(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P("n += ", v, ".TotalStringBytes()")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface{ TotalStringBytes() int }); ok {")
+	g.P("n += m.TotalStringBytes()")
+	g.P("}")
+}
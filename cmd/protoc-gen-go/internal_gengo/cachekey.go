@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageCacheKey generates the CacheKey method, which returns a string
+// identifying the contents of a message, for use as the key of a cache.
+func genMessageCacheKey(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	hash := cacheKeyEncoding.value == "sha256"
+	if hash {
+		g.P("// CacheKey returns the unpadded URL-safe base64 encoding of the SHA-256 hash")
+		g.P("// of the deterministic wire-format encoding of x.")
+	} else {
+		g.P("// CacheKey returns the unpadded URL-safe base64 encoding of the deterministic")
+		g.P("// wire-format encoding of x.")
+	}
+	g.P("// Equal messages have the same key when generated by the same binary, even")
+	g.P("// if their map fields were populated in different orders. It returns the")
+	g.P("// empty string if x cannot be marshaled.")
+	g.P("func (x *", m.GoIdent, ") CacheKey() string {")
+	g.P("b, err := ", protoPackage.Ident("MarshalOptions"), "{Deterministic: true}.Marshal(x)")
+	g.P("if err != nil {")
+	g.P(`return ""`)
+	g.P("}")
+	if hash {
+		g.P("sum := ", sha256Package.Ident("Sum256"), "(b)")
+		g.P("return ", base64Package.Ident("RawURLEncoding"), ".EncodeToString(sum[:])")
+	} else {
+		g.P("return ", base64Package.Ident("RawURLEncoding"), ".EncodeToString(b)")
+	}
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// changeHookFieldName is the name of the struct field holding the function
+// called by setters after they change a field.
+const changeHookFieldName = "xxx_onFieldChange"
+
+// notifiesChanges reports whether the setters of a message call the function
+// registered with OnFieldChange.
+func notifiesChanges(m *messageInfo) bool {
+	return generateTracking.enabled["callback"] && !m.isOpen()
+}
+
+// genChangeHookStructField generates the struct field holding the function
+// registered with OnFieldChange.
+func genChangeHookStructField(g *protogen.GeneratedFile, sf *structFields) {
+	g.P(changeHookFieldName, " func(field ", protoreflectPackage.Ident("Name"), ", old, new any)")
+	sf.append(changeHookFieldName)
+}
+
+// genNotifyFieldChange generates statements making a setter of field call the
+// registered function, if any, with the value of the field from before and
+// after the setter.
+func genNotifyFieldChange(g *protogen.GeneratedFile, field *protogen.Field) {
+	getterName, _ := field.MethodName("Get")
+	g.P("if h := x.", changeHookFieldName, "; h != nil {")
+	g.P("old := x.", getterName, "()")
+	g.P("defer func() { h(", fmt.Sprintf("%q", field.Desc.Name()), ", old, x.", getterName, "()) }()")
+	g.P("}")
+}
+
+// genMessageOnFieldChange generates the OnFieldChange method, which registers
+// the function called by the setters of a message.
+func genMessageOnFieldChange(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if !notifiesChanges(m) {
+		return
+	}
+	g.P("// OnFieldChange registers f to be called by each setter of x after it has")
+	g.P("// written a field, with the name of the field and the values returned by its")
+	g.P("// getter before and after the write. A later call replaces f, and a nil f")
+	g.P("// removes it. Clearers, builders, reflection and unmarshaling do not call f,")
+	g.P("// nor do the setters of the messages held by x. The function is not copied")
+	g.P("// by proto.Clone or proto.Merge, and it is removed by Reset but kept by the")
+	g.P("// generated methods replacing the contents of x, such as FromKV.")
+	g.P("func (x *", m.GoIdent, ") OnFieldChange(f func(field ", protoreflectPackage.Ident("Name"), ", old, new any)) {")
+	g.P("x.", changeHookFieldName, " = f")
+	g.P("}")
+	g.P()
+}
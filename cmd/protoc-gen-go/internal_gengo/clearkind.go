@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func clearKindFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "clearKind")
+}
+
+// genMessageClearKind generates the ClearKind method, which clears the fields
+// of a message and of its nested messages with a given kind.
+func genMessageClearKind(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ClearKind clears every field of x with values of kind k, recursing into the")
+	g.P("// message values of the remaining fields. A repeated field is cleared if")
+	g.P("// its elements have kind k, and a map field if its values have kind k.")
+	g.P("func (x *", m.GoIdent, ") ClearKind(k ", protoreflectPackage.Ident("Kind"), ") {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P(clearKindFuncName(f), "(x.ProtoReflect(), k)")
+	g.P("}")
+	g.P()
+}
+
+// genFileClearKind generates the function implementing ClearKind for all
+// messages of the file.
+func genFileClearKind(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	g.P("func ", clearKindFuncName(f), "(m ", protoreflectIdent("Message"), ", k ", protoreflectIdent("Kind"), ") {")
+	g.P("m.Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("kind := fd.Kind()")
+	g.P("if fd.IsMap() {")
+	g.P("kind = fd.MapValue().Kind()")
+	g.P("}")
+	g.P("switch {")
+	g.P("case kind == k:")
+	g.P("m.Clear(fd)")
+	g.P("case fd.IsMap():")
+	g.P("if fd.MapValue().Message() != nil {")
+	g.P("v.Map().Range(func(_ ", protoreflectIdent("MapKey"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P(clearKindFuncName(f), "(v.Message(), k)")
+	g.P("return true")
+	g.P("})")
+	g.P("}")
+	g.P("case fd.IsList():")
+	g.P("if fd.Message() != nil {")
+	g.P("for i, l := 0, v.List(); i < l.Len(); i++ {")
+	g.P(clearKindFuncName(f), "(l.Get(i).Message(), k)")
+	g.P("}")
+	g.P("}")
+	g.P("case fd.Message() != nil:")
+	g.P(clearKindFuncName(f), "(v.Message(), k)")
+	g.P("}")
+	g.P("return true")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
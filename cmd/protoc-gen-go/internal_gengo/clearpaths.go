@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func clearPathsFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "clearPaths")
+}
+
+// genMessageClearPaths generates the ClearPaths method, which clears the fields
+// of a message addressed by a list of dotted paths of field names.
+func genMessageClearPaths(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ClearPaths clears the fields of x addressed by each of the paths, which are")
+	g.P("// dot-separated lists of field names. Every component but the last must name a")
+	g.P("// singular message field, and a path ending at a repeated or map field clears")
+	g.P("// the whole field. ClearPaths reports an error and leaves x unmodified if any")
+	g.P("// of the paths is invalid.")
+	g.P("func (x *", m.GoIdent, ") ClearPaths(paths ...string) error {")
+	g.P("return ", clearPathsFuncName(f), "(x.ProtoReflect(), paths)")
+	g.P("}")
+	g.P()
+}
+
+// genFileClearPaths generates the function implementing ClearPaths for all
+// messages of the file, which resolves the paths against the descriptor of
+// the message.
+func genFileClearPaths(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	fieldDescriptor := protoreflectPackage.Ident("FieldDescriptor")
+	g.P("func ", clearPathsFuncName(f), "(m ", protoreflectPackage.Ident("Message"), ", paths []string) error {")
+	g.P("// Resolve all of the paths before clearing any of the fields.")
+	g.P("md := m.Descriptor()")
+	g.P("resolved := make([][]", fieldDescriptor, ", len(paths))")
+	g.P("for i, path := range paths {")
+	g.P("var parent ", fieldDescriptor)
+	g.P("d := md")
+	g.P("for _, name := range ", stringsPackage.Ident("Split"), "(path, \".\") {")
+	g.P("if d == nil {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"invalid path %q for message %v: %v is not a singular message field\", path, md.FullName(), parent.FullName())")
+	g.P("}")
+	g.P("fd := d.Fields().ByName(", protoreflectPackage.Ident("Name"), "(name))")
+	g.P("if fd == nil {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"invalid path %q for message %v: no field %q in %v\", path, md.FullName(), name, d.FullName())")
+	g.P("}")
+	g.P("resolved[i] = append(resolved[i], fd)")
+	g.P("parent, d = fd, nil")
+	g.P("if fd.Message() != nil && fd.Cardinality() != ", protoreflectPackage.Ident("Repeated"), " {")
+	g.P("d = fd.Message()")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("next:")
+	g.P("for _, fds := range resolved {")
+	g.P("m := m")
+	g.P("for _, fd := range fds[:len(fds)-1] {")
+	g.P("if !m.Has(fd) {")
+	g.P("continue next // nothing to clear")
+	g.P("}")
+	g.P("m = m.Get(fd).Message()")
+	g.P("}")
+	g.P("m.Clear(fds[len(fds)-1])")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
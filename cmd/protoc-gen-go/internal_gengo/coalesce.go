@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageCoalesceWith generates the CoalesceWith method, which fills the
+// fields of a message which are not populated from another message.
+func genMessageCoalesceWith(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// CoalesceWith sets each field of x which is not populated to a deep copy of")
+	g.P("// the same field of fallback, so that fallback provides defaults for x. A")
+	g.P("// field without explicit presence is not populated if it has its zero value,")
+	g.P("// and a repeated or map field if it is empty.")
+	g.P("//")
+	g.P("// Singular message fields set in both messages are coalesced recursively")
+	g.P("// if their message is declared in the same file, and are otherwise kept as")
+	g.P("// they are in x. A oneof is taken from fallback only if none of its members")
+	g.P("// is set in x.")
+	g.P("func (x *", m.GoIdent, ") CoalesceWith(fallback *", m.GoIdent, ") {")
+	g.P("if x == nil || fallback == nil || x == fallback {")
+	g.P("return")
+	g.P("}")
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		g.P("if x.", opaqueOneofFieldName(oneof, m.isOpaque()), " == nil {")
+		for _, field := range oneof.Fields {
+			genCloneField(g, f, m, field, "fallback", "x")
+		}
+		g.P("}")
+	}
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			continue
+		}
+		g.P("if ", fieldUnpopulatedCond(g, m, "x", field), " {")
+		genCloneField(g, f, m, field, "fallback", "x")
+		if field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() && isLocalMessage(f, field.Message) {
+			g.P("} else {")
+			g.P(fieldValueExpr(m, "x", field), ".CoalesceWith(", fieldValueExpr(m, "fallback", field), ")")
+		}
+		g.P("}")
+	}
+	g.P("}")
+	g.P()
+}
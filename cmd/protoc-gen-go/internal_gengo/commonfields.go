@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/strs"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// genCommonFieldInterfaces generates an interface for each field named by the
+// common_field option of the file, along with static assertions that every
+// message with the field implements the interface. It reports an error if the
+// getters of the field do not have the same signature in all messages.
+func genCommonFieldInterfaces(g *protogen.GeneratedFile, f *fileInfo) error {
+	for _, name := range optionStrings(f.Desc.Options().(*descriptorpb.FileOptions), commonField_fieldNumber) {
+		var getterName, goType string
+		var messages []*messageInfo
+		for _, m := range f.allMessages {
+			if m.Desc.IsMapEntry() {
+				continue
+			}
+			for _, field := range m.Fields {
+				if field.Desc.Name() != protoreflect.Name(name) {
+					continue
+				}
+				fieldGetterName, _ := field.MethodName("Get")
+				fieldType, _ := fieldGoType(g, f, field)
+				if len(messages) == 0 {
+					getterName, goType = fieldGetterName, fieldType
+				} else if fieldGetterName != getterName || fieldType != goType {
+					return fmt.Errorf("%v: common field %q has getter %s() %s, but %s() %s in %v",
+						field.Desc.FullName(), name, fieldGetterName, fieldType, getterName, goType, messages[0].Desc.FullName())
+				}
+				messages = append(messages, m)
+			}
+		}
+		if len(messages) == 0 {
+			return fmt.Errorf("%v: no message has the common field %q", f.Desc.Path(), name)
+		}
+
+		ifaceName := "Has" + strs.GoCamelCase(name)
+		g.P("// ", ifaceName, " is implemented by the messages with the field ", name, ".")
+		g.P("type ", ifaceName, " interface {")
+		g.P(getterName, "() ", goType)
+		g.P("}")
+		g.P()
+		g.P("var (")
+		for _, m := range messages {
+			g.P("_ ", ifaceName, " = (*", m.GoIdent, ")(nil)")
+		}
+		g.P(")")
+		g.P()
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestCommonFieldInconsistentType(t *testing.T) {
+	opts := &descriptorpb.FileOptions{}
+	b := protowire.AppendTag(nil, commonField_fieldNumber, protowire.BytesType)
+	b = protowire.AppendString(b, "id")
+	opts.ProtoReflect().SetUnknown(b)
+
+	idField := func(typ descriptorpb.FieldDescriptorProto_Type) []*descriptorpb.FieldDescriptorProto {
+		return []*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String("id"),
+			JsonName: proto.String("id"),
+			Number:   proto.Int32(1),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}}
+	}
+	resp := generateFileWithParams(t, &descriptorpb.FileDescriptorProto{
+		Options: opts,
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("User"),
+			Field: idField(descriptorpb.FieldDescriptorProto_TYPE_STRING),
+		}, {
+			Name:  proto.String("Group"),
+			Field: idField(descriptorpb.FieldDescriptorProto_TYPE_INT64),
+		}},
+	}, "")
+	if got, want := resp.GetError(), `common field "id" has getter GetId() int64, but GetId() string`; !strings.Contains(got, want) {
+		t.Errorf("inconsistent common field: got error %q, want it to contain %q", got, want)
+	}
+	if len(resp.GetFile()) > 0 {
+		t.Errorf("inconsistent common field: got %d generated files, want none", len(resp.GetFile()))
+	}
+}
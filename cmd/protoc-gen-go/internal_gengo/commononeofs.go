@@ -0,0 +1,129 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/strs"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// resolveCommonOneofs finds the messages with each oneof named by the
+// common_oneof option of the file. The oneofs of all but the first of these
+// messages share the interface and wrapper types of the first, so that their
+// getters have the same signature. It reports an error if a oneof is not
+// declared in the same way in all messages.
+func resolveCommonOneofs(g *protogen.GeneratedFile, f *fileInfo) error {
+	if f.commonOneofs != nil {
+		return nil
+	}
+	f.commonOneofs = make(map[*protogen.Oneof]*protogen.Oneof)
+	for _, name := range optionStrings(f.Desc.Options().(*descriptorpb.FileOptions), commonOneof_fieldNumber) {
+		var first *protogen.Oneof
+		for _, m := range f.allMessages {
+			for _, oneof := range m.Oneofs {
+				if oneof.Desc.IsSynthetic() || oneof.Desc.Name() != protoreflect.Name(name) {
+					continue
+				}
+				if !m.isOpen() {
+					return fmt.Errorf("%v: common oneof %q requires the open struct API", oneof.Desc.FullName(), name)
+				}
+				if first == nil {
+					first = oneof
+				} else if err := checkCommonOneof(g, f, oneof, first); err != nil {
+					return err
+				}
+				f.commonOneofs[oneof] = first
+			}
+		}
+		if first == nil {
+			return fmt.Errorf("%v: no message has the common oneof %q", f.Desc.Path(), name)
+		}
+	}
+	return nil
+}
+
+// checkCommonOneof reports an error if the wrapper types of oneof would not
+// be identical to those of the first oneof with the same name.
+func checkCommonOneof(g *protogen.GeneratedFile, f *fileInfo, oneof, first *protogen.Oneof) error {
+	if len(oneof.Fields) != len(first.Fields) {
+		return fmt.Errorf("%v: common oneof %q has %d fields, but %d in %v",
+			oneof.Desc.FullName(), oneof.Desc.Name(), len(oneof.Fields), len(first.Fields), first.Parent.Desc.FullName())
+	}
+	for i, field := range oneof.Fields {
+		got, want := commonOneofMember(g, f, field), commonOneofMember(g, f, first.Fields[i])
+		if got != want {
+			return fmt.Errorf("%v: common oneof %q has field %s, but %s in %v",
+				oneof.Desc.FullName(), oneof.Desc.Name(), got, want, first.Parent.Desc.FullName())
+		}
+	}
+	return nil
+}
+
+// commonOneofMember returns the declaration of the field of a oneof wrapper
+// type.
+func commonOneofMember(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field) string {
+	goType, _ := fieldGoType(g, f, field)
+	tags := structTags{{"protobuf", fieldProtobufTagValue(field)}}
+	return field.GoName + " " + goType + " " + tags.String()
+}
+
+// sharedOneof returns the oneof whose interface and wrapper types are shared
+// by oneof, or nil if oneof has types of its own.
+func sharedOneof(f *fileInfo, oneof *protogen.Oneof) *protogen.Oneof {
+	first := f.commonOneofs[oneof]
+	if first == oneof {
+		return nil
+	}
+	return first
+}
+
+// genSharedOneofTypes generates the interface and wrapper types of oneof as
+// aliases of those of the oneof first.
+func genSharedOneofTypes(g *protogen.GeneratedFile, oneof, first *protogen.Oneof) {
+	g.P("// ", oneofInterfaceName(oneof), " is shared with ", first.Parent.GoIdent, ", as the oneof ", oneof.Desc.Name(), " is named")
+	g.P("// by the common_oneof option.")
+	g.P("type ", oneofInterfaceName(oneof), " = ", oneofInterfaceName(first))
+	g.P()
+	for i, field := range oneof.Fields {
+		g.AnnotateSymbol(field.GoIdent.GoName, protogen.Annotation{Location: field.Location})
+		g.P("type ", field.GoIdent, " = ", first.Fields[i].GoIdent)
+		g.P()
+	}
+}
+
+// genCommonOneofInterfaces generates an interface for each oneof named by the
+// common_oneof option of the file, along with static assertions that every
+// message with the oneof implements the interface.
+func genCommonOneofInterfaces(g *protogen.GeneratedFile, f *fileInfo) {
+	for _, name := range optionStrings(f.Desc.Options().(*descriptorpb.FileOptions), commonOneof_fieldNumber) {
+		var first *protogen.Oneof
+		var messages []*messageInfo
+		for _, m := range f.allMessages {
+			for _, oneof := range m.Oneofs {
+				if !oneof.Desc.IsSynthetic() && oneof.Desc.Name() == protoreflect.Name(name) {
+					first = f.commonOneofs[oneof]
+					messages = append(messages, m)
+				}
+			}
+		}
+
+		ifaceName := "Has" + strs.GoCamelCase(name)
+		g.P("// ", ifaceName, " is implemented by the messages with the oneof ", name, ".")
+		g.P("type ", ifaceName, " interface {")
+		g.P("Get", first.GoName, "() ", oneofInterfaceName(first))
+		g.P("}")
+		g.P()
+		g.P("var (")
+		for _, m := range messages {
+			g.P("_ ", ifaceName, " = (*", m.GoIdent, ")(nil)")
+		}
+		g.P(")")
+		g.P()
+	}
+}
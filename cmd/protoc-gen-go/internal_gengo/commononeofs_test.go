@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// commonOneofFile returns a file with the common_oneof option naming payload
+// and a message for each of the given types of the single field of its
+// payload oneof.
+func commonOneofFile(types ...descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FileDescriptorProto {
+	opts := &descriptorpb.FileOptions{}
+	b := protowire.AppendTag(nil, commonOneof_fieldNumber, protowire.BytesType)
+	b = protowire.AppendString(b, "payload")
+	opts.ProtoReflect().SetUnknown(b)
+
+	fd := &descriptorpb.FileDescriptorProto{Options: opts}
+	for i, typ := range types {
+		fd.MessageType = append(fd.MessageType, &descriptorpb.DescriptorProto{
+			Name: proto.String(string(rune('A' + i))),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:       proto.String("value"),
+				JsonName:   proto.String("value"),
+				Number:     proto.Int32(1),
+				Label:      descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:       typ.Enum(),
+				OneofIndex: proto.Int32(0),
+			}},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("payload")}},
+		})
+	}
+	return fd
+}
+
+func TestCommonOneofIncompatibleFields(t *testing.T) {
+	resp := generateFileWithParams(t, commonOneofFile(
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	), "")
+	if got, want := resp.GetError(), `common oneof "payload" has field Value int64`; !strings.Contains(got, want) {
+		t.Errorf("incompatible common oneof: got error %q, want it to contain %q", got, want)
+	}
+	if len(resp.GetFile()) > 0 {
+		t.Errorf("incompatible common oneof: got %d generated files, want none", len(resp.GetFile()))
+	}
+}
+
+func TestCommonOneofMissing(t *testing.T) {
+	fd := commonOneofFile()
+	fd.MessageType = []*descriptorpb.DescriptorProto{{Name: proto.String("Message")}}
+	resp := generateFileWithParams(t, fd, "")
+	if got, want := resp.GetError(), `no message has the common oneof "payload"`; !strings.Contains(got, want) {
+		t.Errorf("missing common oneof: got error %q, want it to contain %q", got, want)
+	}
+}
+
+func TestCommonOneofCompatible(t *testing.T) {
+	resp := generateFileWithParams(t, commonOneofFile(
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	), "")
+	if resp.GetError() != "" || len(resp.GetFile()) != 1 {
+		t.Fatalf("compatible common oneof: got error %q and %d files, want one file", resp.GetError(), len(resp.GetFile()))
+	}
+	content := resp.GetFile()[0].GetContent()
+	for _, want := range []string{
+		"type isB_Payload = isA_Payload",
+		"type B_Value = A_Value",
+		"GetPayload() isA_Payload",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("compatible common oneof: generated code does not contain %q", want)
+		}
+	}
+}
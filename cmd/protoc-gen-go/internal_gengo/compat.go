@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// v1WellKnownTypes lists the messages and enums for which the generator of
+// github.com/golang/protobuf generated an XXX_WellKnownType method.
+var v1WellKnownTypes = map[protoreflect.FullName]bool{
+	genid.Any_message_fullname:         true,
+	genid.Duration_message_fullname:    true,
+	genid.Empty_message_fullname:       true,
+	genid.Struct_message_fullname:      true,
+	genid.Value_message_fullname:       true,
+	genid.ListValue_message_fullname:   true,
+	genid.NullValue_enum_fullname:      true,
+	genid.Timestamp_message_fullname:   true,
+	genid.DoubleValue_message_fullname: true,
+	genid.FloatValue_message_fullname:  true,
+	genid.Int64Value_message_fullname:  true,
+	genid.UInt64Value_message_fullname: true,
+	genid.Int32Value_message_fullname:  true,
+	genid.UInt32Value_message_fullname: true,
+	genid.BoolValue_message_fullname:   true,
+	genid.StringValue_message_fullname: true,
+	genid.BytesValue_message_fullname:  true,
+}
+
+// genMessageWellKnownType generates the XXX_WellKnownType method of the
+// github.com/golang/protobuf API for well-known message types.
+func genMessageWellKnownType(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if !v1WellKnownTypes[m.Desc.FullName()] {
+		return
+	}
+	g.P("// XXX_WellKnownType returns the name of the well-known type ", m.GoIdent, ",")
+	g.P("// as expected of it by the github.com/golang/protobuf API.")
+	g.P("func (*", m.GoIdent, ") XXX_WellKnownType() string {")
+	g.P("return ", strconv.Quote(string(m.Desc.Name())))
+	g.P("}")
+	g.P()
+}
+
+// genEnumWellKnownType generates the XXX_WellKnownType method of the
+// github.com/golang/protobuf API for well-known enum types.
+func genEnumWellKnownType(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	if !v1WellKnownTypes[e.Desc.FullName()] {
+		return
+	}
+	g.P("// XXX_WellKnownType returns the name of the well-known type ", e.GoIdent, ",")
+	g.P("// as expected of it by the github.com/golang/protobuf API.")
+	g.P("func (", e.GoIdent, ") XXX_WellKnownType() string {")
+	g.P("return ", strconv.Quote(string(e.Desc.Name())))
+	g.P("}")
+	g.P()
+}
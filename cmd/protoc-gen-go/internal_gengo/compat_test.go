@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestCompatV1WellKnownType(t *testing.T) {
+	defer delete(generateCompat.enabled, "v1")
+
+	fd := protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto)
+	var fs flag.FlagSet
+	RegisterFlags(&fs)
+	gen, err := protogen.Options{ParamFunc: fs.Set}.New(&pluginpb.CodeGeneratorRequest{
+		Parameter:      proto.String("compat=v1"),
+		FileToGenerate: []string{fd.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{fd},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range gen.Files {
+		generateFiles(gen, f)
+	}
+	resp := gen.Response()
+	if resp.GetError() != "" || len(resp.GetFile()) != 1 {
+		t.Fatalf("generating %v: got error %q and %d files, want one file", fd.GetName(), resp.GetError(), len(resp.GetFile()))
+	}
+	const want = "func (*Timestamp) XXX_WellKnownType() string {\n\treturn \"Timestamp\"\n}"
+	if content := resp.GetFile()[0].GetContent(); !strings.Contains(content, want) {
+		t.Errorf("compat=v1 for %v: generated code does not contain:\n%s", fd.GetName(), want)
+	}
+}
+
+func TestCompatV1OrdinaryMessage(t *testing.T) {
+	defer delete(generateCompat.enabled, "v1")
+
+	resp := generateFileWithParams(t, &descriptorpb.FileDescriptorProto{
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Timestamp"),
+		}},
+	}, "compat=v1")
+	if resp.GetError() != "" || len(resp.GetFile()) != 1 {
+		t.Fatalf("compat=v1: got error %q and %d files, want one file", resp.GetError(), len(resp.GetFile()))
+	}
+	if content := resp.GetFile()[0].GetContent(); strings.Contains(content, "XXX_WellKnownType") {
+		t.Errorf("compat=v1 for goproto.test.Timestamp: generated code contains XXX_WellKnownType")
+	}
+}
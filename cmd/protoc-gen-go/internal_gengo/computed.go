@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isComputed reports whether a field is marked with the computed option,
+// meaning that its value is computed by the server and must not be set by
+// clients.
+func isComputed(field *protogen.Field) bool {
+	return optionBool(field.Desc.Options().(*descriptorpb.FieldOptions), computed_fieldNumber)
+}
+
+// hasComputedFields reports whether any field of the message is computed.
+func hasComputedFields(m *messageInfo) bool {
+	for _, field := range m.Fields {
+		if isComputed(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldSetterName returns the name of the setter of a field. The setter of a
+// computed field is unexported rather than omitted, since the generated
+// methods copying fields between messages, such as CloneVT, PatchFrom and
+// FromDTO, use it to keep the values set by the server. Those values are then
+// stored as by any other setter, including its freeze and tracking checks.
+func fieldSetterName(field *protogen.Field) string {
+	setterName, _ := field.MethodName("Set")
+	if isComputed(field) {
+		return "xxx_" + setterName
+	}
+	return setterName
+}
+
+// genMessageValidate generates the Validate method, which reports an error if
+// a computed field of a message is set.
+func genMessageValidate(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// Validate reports an error if a computed field of x is set.")
+	g.P("// Computed fields are set by the server and must be left unset on input.")
+	g.P("func (x *", m.GoIdent, ") Validate() error {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	for _, field := range m.Fields {
+		if !isComputed(field) {
+			continue
+		}
+		g.P("if ", fieldPopulatedCond(g, m, "x", field), " {")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(", strconv.Quote("computed field "+string(field.Desc.FullName())+" must not be set"), ")")
+		g.P("}")
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
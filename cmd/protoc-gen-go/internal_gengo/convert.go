@@ -0,0 +1,198 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/strs"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// convertTarget is a message named by the convert_to option.
+type convertTarget struct {
+	message *protogen.Message
+	file    *protogen.File
+}
+
+// methodName returns the name of the method converting a message to the
+// target, which is named after the Go package of the target.
+func (t convertTarget) methodName() string {
+	return "ConvertTo" + strs.GoCamelCase(string(t.file.GoPackageName))
+}
+
+func convertMessageFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "convertMessage")
+}
+
+func convertValueFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "convertValue")
+}
+
+func convertCounterpartFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "convertCounterpart")
+}
+
+// resolveConvertTargets looks up the message named by the convert_to option
+// of each message of the file. It reports an error if a named message is not
+// declared in the file or its dependencies.
+func resolveConvertTargets(gen *protogen.Plugin, f *fileInfo) error {
+	if f.convertTargets != nil {
+		return nil
+	}
+	f.convertTargets = make(map[*messageInfo]convertTarget)
+	var targets map[protoreflect.FullName]convertTarget
+	for _, m := range f.allMessages {
+		names := optionStrings(m.Desc.Options().(*descriptorpb.MessageOptions), convertTo_fieldNumber)
+		if len(names) == 0 {
+			continue
+		}
+		if targets == nil {
+			targets = make(map[protoreflect.FullName]convertTarget)
+			var walk func(*protogen.File, []*protogen.Message)
+			walk = func(file *protogen.File, messages []*protogen.Message) {
+				for _, message := range messages {
+					targets[message.Desc.FullName()] = convertTarget{message, file}
+					walk(file, message.Messages)
+				}
+			}
+			for _, file := range gen.Files {
+				walk(file, file.Messages)
+			}
+		}
+		name := names[len(names)-1]
+		t, ok := targets[protoreflect.FullName(name)]
+		if !ok {
+			return fmt.Errorf("%v: convert_to message %q not found; the file declaring it must be imported", m.Desc.FullName(), name)
+		}
+		if t.message.Desc.IsMapEntry() {
+			return fmt.Errorf("%v: convert_to message %q is a map entry", m.Desc.FullName(), name)
+		}
+		f.convertTargets[m] = t
+	}
+	return nil
+}
+
+// genMessageConvertTo generates the method converting a message to the message
+// named by its convert_to option.
+func genMessageConvertTo(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	t := f.convertTargets[m]
+	targetName := g.QualifiedGoIdent(t.message.GoIdent)
+	g.P("// ", t.methodName(), " returns a copy of x as a ", targetName, ", or nil if x is nil.")
+	g.P("// Fields are matched by name, or by number if no field has the same name,")
+	g.P("// and an error is reported if matched fields have different types.")
+	if convertStrict.enabled {
+		g.P("// Fields without a counterpart in either message are reported as an error.")
+	} else {
+		g.P("// Fields without a counterpart in ", targetName, " are skipped.")
+	}
+	g.P("// Unknown fields and extensions are not converted.")
+	g.P("func (x *", m.GoIdent, ") ", t.methodName(), "() (*", t.message.GoIdent, ", error) {")
+	g.P("if x == nil {")
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("y := new(", t.message.GoIdent, ")")
+	g.P("if err := ", convertMessageFuncName(f), "(x.ProtoReflect(), y.ProtoReflect(), ", convertStrict.enabled, "); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return y, nil")
+	g.P("}")
+	g.P()
+}
+
+// genFileConvert generates the functions implementing the methods generated
+// for the convert_to option.
+func genFileConvert(g *protogen.GeneratedFile, f *fileInfo) {
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	errorf := fmtPackage.Ident("Errorf")
+
+	g.P("// ", convertMessageFuncName(f), " sets the fields of dst to the fields of src with the")
+	g.P("// same name or, failing that, the same number, converting message values")
+	g.P("// recursively. If strict is set, fields without a counterpart are an error.")
+	g.P("func ", convertMessageFuncName(f), "(src, dst ", protoreflectIdent("Message"), ", strict bool) error {")
+	g.P("sfds, dfds := src.Descriptor().Fields(), dst.Descriptor().Fields()")
+	g.P("for i := 0; i < sfds.Len(); i++ {")
+	g.P("sfd := sfds.Get(i)")
+	g.P("dfd := ", convertCounterpartFuncName(f), "(sfd, dfds)")
+	g.P("switch {")
+	g.P("case dfd == nil:")
+	g.P("if strict {")
+	g.P("return ", errorf, `("cannot convert %v to %v: field %v has no counterpart", src.Descriptor().FullName(), dst.Descriptor().FullName(), sfd.Name())`)
+	g.P("}")
+	g.P("case sfd.IsList() != dfd.IsList() || sfd.IsMap() != dfd.IsMap() || sfd.Kind() != dfd.Kind(),")
+	g.P("sfd.IsMap() && (sfd.MapKey().Kind() != dfd.MapKey().Kind() || sfd.MapValue().Kind() != dfd.MapValue().Kind()):")
+	g.P("return ", errorf, `("cannot convert field %v to %v: incompatible types", sfd.FullName(), dfd.FullName())`)
+	g.P("}")
+	g.P("}")
+	g.P("if strict {")
+	g.P("for i := 0; i < dfds.Len(); i++ {")
+	g.P("if dfd := dfds.Get(i); ", convertCounterpartFuncName(f), "(dfd, sfds) == nil {")
+	g.P("return ", errorf, `("cannot convert %v to %v: field %v has no counterpart", src.Descriptor().FullName(), dst.Descriptor().FullName(), dfd.Name())`)
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("var err error")
+	g.P("src.Range(func(sfd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("if sfd.IsExtension() {")
+	g.P("return true")
+	g.P("}")
+	g.P("dfd := ", convertCounterpartFuncName(f), "(sfd, dfds)")
+	g.P("if dfd == nil {")
+	g.P("return true")
+	g.P("}")
+	g.P("switch {")
+	g.P("case sfd.IsList():")
+	g.P("sl, dl := v.List(), dst.NewField(dfd).List()")
+	g.P("for i := 0; i < sl.Len() && err == nil; i++ {")
+	g.P("var ev ", protoreflectIdent("Value"))
+	g.P("ev, err = ", convertValueFuncName(f), "(sfd, sl.Get(i), dl.NewElement, strict)")
+	g.P("dl.Append(ev)")
+	g.P("}")
+	g.P("dst.Set(dfd, ", protoreflectIdent("ValueOfList"), "(dl))")
+	g.P("case sfd.IsMap():")
+	g.P("sm, dm := v.Map(), dst.NewField(dfd).Map()")
+	g.P("sm.Range(func(k ", protoreflectIdent("MapKey"), ", mv ", protoreflectIdent("Value"), ") bool {")
+	g.P("mv, err = ", convertValueFuncName(f), "(sfd.MapValue(), mv, dm.NewValue, strict)")
+	g.P("dm.Set(k, mv)")
+	g.P("return err == nil")
+	g.P("})")
+	g.P("dst.Set(dfd, ", protoreflectIdent("ValueOfMap"), "(dm))")
+	g.P("default:")
+	g.P("v, err = ", convertValueFuncName(f), "(sfd, v, func() ", protoreflectIdent("Value"), " { return dst.NewField(dfd) }, strict)")
+	g.P("dst.Set(dfd, v)")
+	g.P("}")
+	g.P("return err == nil")
+	g.P("})")
+	g.P("return err")
+	g.P("}")
+	g.P()
+
+	g.P("// ", convertValueFuncName(f), " returns a copy of the singular value v of a field")
+	g.P("// described by fd, using newValue to create message values.")
+	g.P("func ", convertValueFuncName(f), "(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ", newValue func() ", protoreflectIdent("Value"), ", strict bool) (", protoreflectIdent("Value"), ", error) {")
+	g.P("switch fd.Kind() {")
+	g.P("case ", protoreflectIdent("MessageKind"), ", ", protoreflectIdent("GroupKind"), ":")
+	g.P("dv := newValue()")
+	g.P("return dv, ", convertMessageFuncName(f), "(v.Message(), dv.Message(), strict)")
+	g.P("case ", protoreflectIdent("BytesKind"), ":")
+	g.P("return ", protoreflectIdent("ValueOfBytes"), "(append([]byte(nil), v.Bytes()...)), nil")
+	g.P("}")
+	g.P("return v, nil")
+	g.P("}")
+	g.P()
+
+	g.P("// ", convertCounterpartFuncName(f), " returns the field of fds with the name of fd or,")
+	g.P("// failing that, with the number of fd.")
+	g.P("func ", convertCounterpartFuncName(f), "(fd ", protoreflectIdent("FieldDescriptor"), ", fds ", protoreflectIdent("FieldDescriptors"), ") ", protoreflectIdent("FieldDescriptor"), " {")
+	g.P("if c := fds.ByName(fd.Name()); c != nil {")
+	g.P("return c")
+	g.P("}")
+	g.P("return fds.ByNumber(fd.Number())")
+	g.P("}")
+	g.P()
+}
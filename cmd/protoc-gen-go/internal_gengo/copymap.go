@@ -0,0 +1,49 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isCopyMapGetter reports whether the getter of a field returns a copy of the
+// map held by the message, as enabled with getters=copymap.
+func isCopyMapGetter(field *protogen.Field) bool {
+	return generateGetters.enabled["copymap"] && field.Desc.IsMap()
+}
+
+// isCopiedMap reports whether the map of a field of a message is read
+// through a getter returning a copy, so that code modifying the map in place
+// must store it back with the setter.
+func isCopiedMap(m *messageInfo, field *protogen.Field) bool {
+	return !m.isOpen() && isCopyMapGetter(field)
+}
+
+// genCopyMapGetter generates a getter for a map field which returns a shallow
+// copy of the map, so that callers sharing a message cannot modify it through
+// the returned map.
+func genCopyMapGetter(g *protogen.GeneratedFile, m *messageInfo, field *protogen.Field, getterName, goType string) {
+	doc := protogen.Comments(" " + getterName + " returns a shallow copy of the " + string(field.Desc.Name()) + " map field, so that\n" +
+		" modifying the returned map does not modify x. The values of the copy are\n" +
+		" those of the field, so messages held by the map are shared with x.\n")
+	leadingComments := appendDeprecationSuffix(doc,
+		field.Desc.ParentFile(),
+		field.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated())
+	g.P(leadingComments, "func (x *", m.GoIdent, ") ", getterName, "() ", goType, " {")
+	g.P("if x != nil {")
+	structField := field.GoName
+	if m.isOpaque() {
+		if m.isTracked {
+			g.P("_ = x.XXX_ft_", field.GoName)
+		}
+		structField = "xxx_hidden_" + field.GoName
+	}
+	g.P("return ", mapsPackage.Ident("Clone"), "(x.", structField, ")")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func hasCycleFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "hasCycle")
+}
+
+func hasCycleValueFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "hasCycleValue")
+}
+
+// genMessageHasCycle generates the HasCycle method, which reports whether a
+// message holds itself through its message fields, and the hasCycle method
+// implementing the check.
+func genMessageHasCycle(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// HasCycle reports whether x, or a message nested in x, is held by one of")
+	g.P("// its own fields or by the fields of a message nested in it, in which case")
+	g.P("// marshaling x does not terminate. Such cycles may be created in memory,")
+	g.P("// but not by unmarshaling. A message held more than once without being its")
+	g.P("// own descendant is not a cycle.")
+	g.P("func (x *", m.GoIdent, ") HasCycle() bool {")
+	g.P("return x.hasCycle(make(map[any]bool))")
+	g.P("}")
+	g.P()
+
+	g.P("// hasCycle reports whether x, or a message nested in x, is one of the")
+	g.P("// messages in path, or is held by one of its own descendants.")
+	g.P("func (x *", m.GoIdent, ") hasCycle(path map[any]bool) bool {")
+	g.P("if x == nil {")
+	g.P("return false")
+	g.P("}")
+	g.P("if path[x] {")
+	g.P("return true")
+	g.P("}")
+	g.P("path[x] = true")
+	for _, field := range m.Fields {
+		if field.Message == nil {
+			continue
+		}
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		switch {
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genHasCycleCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsList():
+			g.P("for _, v := range ", v, " {")
+			genHasCycleCall(g, f, field.Message, "v")
+			g.P("}")
+		default:
+			genHasCycleCall(g, f, field.Message, v)
+		}
+	}
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		g.P("cycle := false")
+		g.P("x.ProtoReflect().Range(func(fd ", protoreflectPackage.Ident("FieldDescriptor"), ", v ", protoreflectPackage.Ident("Value"), ") bool {")
+		g.P("if fd.IsExtension() {")
+		g.P("cycle = ", hasCycleValueFuncName(f), "(fd, v, path)")
+		g.P("}")
+		g.P("return !cycle")
+		g.P("})")
+		g.P("if cycle {")
+		g.P("return true")
+		g.P("}")
+	}
+	g.P("delete(path, x)")
+	g.P("return false")
+	g.P("}")
+	g.P()
+}
+
+// genHasCycleCall generates code returning true if the message value v has a
+// cycle. Messages declared in other files are checked through reflection,
+// since they may not have been generated with the method.
+func genHasCycleCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P("if ", v, ".hasCycle(path) {")
+	} else {
+		g.P("if ", hasCycleFuncName(f), "(", v, ".ProtoReflect(), path) {")
+	}
+	g.P("return true")
+	g.P("}")
+}
+
+// genFileHasCycle generates the functions checking for cycles through
+// reflection, for messages declared in other files and extensions.
+func genFileHasCycle(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+
+	g.P("// ", hasCycleFuncName(f), " reports whether m, or a message nested in m, is one")
+	g.P("// of the messages in path, or is held by one of its own descendants.")
+	g.P("func ", hasCycleFuncName(f), "(m ", protoreflectIdent("Message"), ", path map[any]bool) (cycle bool) {")
+	g.P("if !m.IsValid() {")
+	g.P("return false")
+	g.P("}")
+	g.P("k := m.Interface()")
+	g.P("if path[k] {")
+	g.P("return true")
+	g.P("}")
+	g.P("path[k] = true")
+	g.P("m.Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("cycle = ", hasCycleValueFuncName(f), "(fd, v, path)")
+	g.P("return !cycle")
+	g.P("})")
+	g.P("delete(path, k)")
+	g.P("return cycle")
+	g.P("}")
+	g.P()
+
+	g.P("// ", hasCycleValueFuncName(f), " reports whether a message held in the value v")
+	g.P("// of the field fd has a cycle.")
+	g.P("func ", hasCycleValueFuncName(f), "(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ", path map[any]bool) bool {")
+	g.P("switch {")
+	g.P("case fd.IsList() && fd.Message() != nil:")
+	g.P("l := v.List()")
+	g.P("for i := 0; i < l.Len(); i++ {")
+	g.P("if ", hasCycleFuncName(f), "(l.Get(i).Message(), path) {")
+	g.P("return true")
+	g.P("}")
+	g.P("}")
+	g.P("case fd.IsMap() && fd.MapValue().Message() != nil:")
+	g.P("cycle := false")
+	g.P("v.Map().Range(func(_ ", protoreflectIdent("MapKey"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("cycle = ", hasCycleFuncName(f), "(v.Message(), path)")
+	g.P("return !cycle")
+	g.P("})")
+	g.P("return cycle")
+	g.P("case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:")
+	g.P("return ", hasCycleFuncName(f), "(v.Message(), path)")
+	g.P("}")
+	g.P("return false")
+	g.P("}")
+	g.P()
+}
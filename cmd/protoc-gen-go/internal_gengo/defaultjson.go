@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageDefaultJSON generates the DefaultJSON method, which returns the
+// JSON encoding of an empty message with every field present.
+func genMessageDefaultJSON(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	onceVar := messageVarName(f, m, "defaultJSONOnce")
+	dataVar := messageVarName(f, m, "defaultJSON")
+	g.P("var (")
+	g.P(onceVar, " ", syncPackage.Ident("Once"))
+	g.P(dataVar, " []byte")
+	g.P(")")
+	g.P()
+
+	g.P("// DefaultJSON returns the JSON encoding of an empty ", m.GoIdent, " in which every field")
+	g.P("// is present with its zero value, as marshaled by ", protojsonPackage.Ident("Marshal"), " with")
+	g.P("// EmitUnpopulated set. Required fields are not checked, since none of them")
+	g.P("// are set. The encoding is computed once, and a copy of it is returned by")
+	g.P("// each call.")
+	g.P("func (*", m.GoIdent, ") DefaultJSON() []byte {")
+	g.P(onceVar, ".Do(func() {")
+	g.P("b, err := ", protojsonPackage.Ident("MarshalOptions"), "{AllowPartial: true, EmitUnpopulated: true}.Marshal(new(", m.GoIdent, "))")
+	g.P("if err != nil {")
+	g.P("panic(err)")
+	g.P("}")
+	g.P(dataVar, " = b")
+	g.P("})")
+	g.P("return append([]byte(nil), ", dataVar, "...)")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageMaxDepth generates the MaxDepth method, which reports the length
+// of the longest chain of nested message values in a message.
+func genMessageMaxDepth(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MaxDepth returns the length of the longest chain of nested messages")
+	g.P("// starting at x, including x itself, or zero if x is nil.")
+	g.P("// A message without any populated message fields has a depth of one.")
+	g.P("func (x *", m.GoIdent, ") MaxDepth() int {")
+	g.P("if x == nil {")
+	g.P("return 0")
+	g.P("}")
+	g.P("d := 0")
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			if field.Message != nil {
+				getterName, _ := field.MethodName("Get")
+				genMaxDepth(g, f, field.Message, "x."+getterName+"()")
+			}
+			continue
+		}
+		v := fieldValueExpr(m, "x", field)
+		switch {
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genMaxDepth(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genMaxDepth(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Message != nil:
+			genMaxDepth(g, f, field.Message, v)
+		}
+	}
+	g.P("return 1 + d")
+	g.P("}")
+	g.P()
+}
+
+// genMaxDepth generates code raising d to the depth of the message value v.
+// Messages declared in other files that were not generated with the method
+// are treated as having a depth of one.
+func genMaxDepth(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P("if c := ", v, ".MaxDepth(); c > d {")
+		g.P("d = c")
+		g.P("}")
+		return
+	}
+	g.P("if ", v, " != nil {")
+	g.P("c := 1")
+	g.P("if m, ok := any(", v, ").(interface{ MaxDepth() int }); ok {")
+	g.P("c = m.MaxDepth()")
+	g.P("}")
+	g.P("if c > d {")
+	g.P("d = c")
+	g.P("}")
+	g.P("}")
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// descriptorIndexes returns the comma-separated indexes of a declaration
+// in the file, as returned by the deprecated Descriptor methods.
+func descriptorIndexes(loc protogen.Location) string {
+	var indexes []string
+	for i := 1; i < len(loc.Path); i += 2 {
+		indexes = append(indexes, strconv.Itoa(int(loc.Path[i])))
+	}
+	return strings.Join(indexes, ",")
+}
+
+// genMessageDescriptorIndexPath generates the DescriptorIndexPath method,
+// which returns the index path of a message in its file descriptor.
+func genMessageDescriptorIndexPath(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// DescriptorIndexPath returns the path of indexes locating ", m.GoIdent, " in the")
+	g.P("// descriptor of its file: the index of the top-level message, followed by")
+	g.P("// the index of each nested message.")
+	g.P("func (*", m.GoIdent, ") DescriptorIndexPath() []int {")
+	g.P("return []int{", descriptorIndexes(m.Location), "}")
+	g.P("}")
+	g.P()
+}
+
+// genEnumDescriptorIndexPath generates the DescriptorIndexPath method,
+// which returns the index path of an enum in its file descriptor.
+func genEnumDescriptorIndexPath(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	g.P("// DescriptorIndexPath returns the path of indexes locating ", e.GoIdent, " in the")
+	g.P("// descriptor of its file: the indexes of the messages enclosing it, if any,")
+	g.P("// followed by the index of the enum.")
+	g.P("func (", e.GoIdent, ") DescriptorIndexPath() []int {")
+	g.P("return []int{", descriptorIndexes(e.Location), "}")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func unknownFieldNumbersFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "unknownFieldNumbers")
+}
+
+// genMessageUnknownFieldNumbers generates the UnknownFieldNumbers method, which
+// reports the numbers of the unknown fields retained by a message.
+func genMessageUnknownFieldNumbers(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// UnknownFieldNumbers returns the distinct numbers of the unknown fields")
+	g.P("// retained by x, in ascending order. Only the top-level fields of the unknown")
+	g.P("// data are reported: the contents of groups and of length-delimited fields")
+	g.P("// are not inspected. Parsing stops at the first malformed field.")
+	g.P("func (x *", m.GoIdent, ") UnknownFieldNumbers() []", protoreflectPackage.Ident("FieldNumber"), " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("return ", unknownFieldNumbersFuncName(f), "(x.ProtoReflect().GetUnknown())")
+	g.P("}")
+	g.P()
+}
+
+// genFileUnknownFieldNumbers generates the function implementing
+// UnknownFieldNumbers for all messages of the file.
+func genFileUnknownFieldNumbers(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	fieldNumber := protoreflectPackage.Ident("FieldNumber")
+	g.P("func ", unknownFieldNumbersFuncName(f), "(b []byte) []", fieldNumber, " {")
+	g.P("var nums []", fieldNumber)
+	g.P("seen := make(map[", fieldNumber, "]bool)")
+	g.P("for len(b) > 0 {")
+	g.P("num, typ, n := ", protowirePackage.Ident("ConsumeTag"), "(b)")
+	g.P("if n < 0 {")
+	g.P("break")
+	g.P("}")
+	g.P("m := ", protowirePackage.Ident("ConsumeFieldValue"), "(num, typ, b[n:])")
+	g.P("if m < 0 {")
+	g.P("break")
+	g.P("}")
+	g.P("b = b[n+m:]")
+	g.P("if !seen[num] {")
+	g.P("seen[num] = true")
+	g.P("nums = append(nums, num)")
+	g.P("}")
+	g.P("}")
+	g.P(sortPackage.Ident("Slice"), "(nums, func(i, j int) bool { return nums[i] < nums[j] })")
+	g.P("return nums")
+	g.P("}")
+	g.P()
+}
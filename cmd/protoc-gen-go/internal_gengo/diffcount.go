@@ -0,0 +1,100 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func diffCountFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "diffCount")
+}
+
+func diffCountValueFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "diffCountValue")
+}
+
+// genMessageDiffCount generates the DiffCount method, which counts the leaf
+// fields which differ between two messages.
+func genMessageDiffCount(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// DiffCount returns the number of fields which differ between x and y,")
+	g.P("// counting the fields of nested messages rather than the messages themselves.")
+	g.P("// Repeated and map fields are compared element-wise: each element or entry")
+	g.P("// which differs, or which is present in only one of the messages, counts as")
+	g.P("// one difference, or as the differences of its fields for message values")
+	g.P("// present in both. Singular fields differ if they have different presence")
+	g.P("// or values. Extensions and unknown fields are not compared.")
+	g.P("func (x *", m.GoIdent, ") DiffCount(y *", m.GoIdent, ") int {")
+	g.P("return ", diffCountFuncName(f), "(x.ProtoReflect(), y.ProtoReflect())")
+	g.P("}")
+	g.P()
+}
+
+// genFileDiffCount generates the functions implementing DiffCount for all
+// messages of the file.
+func genFileDiffCount(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+
+	g.P("// ", diffCountFuncName(f), " returns the number of leaf fields which differ between x")
+	g.P("// and y, which have the same descriptor.")
+	g.P("func ", diffCountFuncName(f), "(x, y ", protoreflectIdent("Message"), ") int {")
+	g.P("n := 0")
+	g.P("fds := x.Descriptor().Fields()")
+	g.P("for i := 0; i < fds.Len(); i++ {")
+	g.P("fd := fds.Get(i)")
+	g.P("switch {")
+	g.P("case fd.IsList():")
+	g.P("lx, ly := x.Get(fd).List(), y.Get(fd).List()")
+	g.P("if lx.Len() < ly.Len() {")
+	g.P("lx, ly = ly, lx")
+	g.P("}")
+	g.P("n += lx.Len() - ly.Len()")
+	g.P("for j := 0; j < ly.Len(); j++ {")
+	g.P("n += ", diffCountValueFuncName(f), "(fd, lx.Get(j), ly.Get(j))")
+	g.P("}")
+	g.P("case fd.IsMap():")
+	g.P("mx, my := x.Get(fd).Map(), y.Get(fd).Map()")
+	g.P("mx.Range(func(k ", protoreflectIdent("MapKey"), ", vx ", protoreflectIdent("Value"), ") bool {")
+	g.P("if !my.Has(k) {")
+	g.P("n++")
+	g.P("} else {")
+	g.P("n += ", diffCountValueFuncName(f), "(fd.MapValue(), vx, my.Get(k))")
+	g.P("}")
+	g.P("return true")
+	g.P("})")
+	g.P("my.Range(func(k ", protoreflectIdent("MapKey"), ", _ ", protoreflectIdent("Value"), ") bool {")
+	g.P("if !mx.Has(k) {")
+	g.P("n++")
+	g.P("}")
+	g.P("return true")
+	g.P("})")
+	g.P("case fd.Message() != nil:")
+	g.P("if x.Has(fd) || y.Has(fd) {")
+	g.P("n += ", diffCountFuncName(f), "(x.Get(fd).Message(), y.Get(fd).Message())")
+	g.P("}")
+	g.P("case x.Has(fd) != y.Has(fd) || !x.Get(fd).Equal(y.Get(fd)):")
+	g.P("n++")
+	g.P("}")
+	g.P("}")
+	g.P("return n")
+	g.P("}")
+	g.P()
+
+	g.P("// ", diffCountValueFuncName(f), " returns the number of leaf fields which differ between")
+	g.P("// the values x and y of an element of fd, or 1 if they are scalars which differ.")
+	g.P("func ", diffCountValueFuncName(f), "(fd ", protoreflectIdent("FieldDescriptor"), ", x, y ", protoreflectIdent("Value"), ") int {")
+	g.P("if fd.Message() != nil {")
+	g.P("return ", diffCountFuncName(f), "(x.Message(), y.Message())")
+	g.P("}")
+	g.P("if !x.Equal(y) {")
+	g.P("return 1")
+	g.P("}")
+	g.P("return 0")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,335 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// dtoPackageName is the name of the Go package holding the data transfer
+// objects, in the "dto" subdirectory of the package of the messages.
+const dtoPackageName = "dto"
+
+// genDTOFiles generates the files for the "dto_out" parameter:
+//
+//   - a file in the dto subpackage declaring a plain struct for each message,
+//     which does not depend on the protobuf runtime, and
+//   - a file in the package of the messages declaring the ToDTO and FromDTO
+//     methods converting between messages and their data transfer objects.
+//
+// Messages declared in other files are held as their own data transfer
+// objects, so those files must also be generated with "dto_out".
+//
+// The conversions use the accessor methods for messages which do not use the
+// open struct API, so that they work with either variant of the hybrid API.
+func genDTOFiles(gen *protogen.Plugin, f *fileInfo) []*protogen.GeneratedFile {
+	dir, base := path.Split(f.GeneratedFilenamePrefix)
+	d := gen.NewGeneratedFile(dir+dtoPackageName+"/"+base+"_dto.go", dtoImportPath(f.GoImportPath))
+	genGeneratedHeader(gen, d, f)
+	d.P("// Package ", dtoPackageName, " declares data transfer objects for the messages of")
+	d.P("// package ", f.GoPackageName, ", which do not depend on the protobuf runtime.")
+	d.P("package ", dtoPackageName)
+	d.P()
+	for _, m := range f.allMessages {
+		if !m.Desc.IsMapEntry() {
+			genDTOStruct(d, f, m)
+		}
+	}
+
+	g := gen.NewGeneratedFile(f.GeneratedFilenamePrefix+"_dto.go", f.GoImportPath)
+	genGeneratedHeader(gen, g, f)
+	g.P("package ", f.GoPackageName)
+	g.P()
+	for _, m := range f.allMessages {
+		if !m.Desc.IsMapEntry() {
+			genMessageToDTO(g, f, m)
+			genMessageFromDTO(g, f, m)
+		}
+	}
+	return []*protogen.GeneratedFile{d, g}
+}
+
+func dtoImportPath(importPath protogen.GoImportPath) protogen.GoImportPath {
+	return importPath + "/" + dtoPackageName
+}
+
+// dtoIdent returns the identifier of the data transfer object of a message.
+func dtoIdent(message *protogen.Message) protogen.GoIdent {
+	return protogen.GoIdent{
+		GoName:       message.GoIdent.GoName,
+		GoImportPath: dtoImportPath(message.GoIdent.GoImportPath),
+	}
+}
+
+// dtoOneofIdent returns the identifier of the tagged union holding the value of
+// a oneof in the data transfer object of its message.
+func dtoOneofIdent(oneof *protogen.Oneof) protogen.GoIdent {
+	ident := dtoIdent(oneof.Parent)
+	ident.GoName += "_" + oneof.GoName
+	// Check for collisions with nested messages.
+Loop:
+	for {
+		for _, message := range oneof.Parent.Messages {
+			if message.GoIdent.GoName == ident.GoName {
+				ident.GoName += "_"
+				continue Loop
+			}
+		}
+		return ident
+	}
+}
+
+// checkDTOMessages reports an error if a field of a message holds a message
+// which cannot have a data transfer object, such as the well-known types,
+// whose Go packages are part of the protobuf runtime.
+func checkDTOMessages(f *fileInfo) error {
+	for _, m := range f.allMessages {
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+		for _, field := range m.Fields {
+			if field.Desc.IsMap() {
+				field = field.Message.Fields[1]
+			}
+			if field.Message != nil && strings.HasPrefix(string(field.Message.GoIdent.GoImportPath), runtimeTypesPrefix) {
+				return fmt.Errorf("%v: dto_out cannot represent field %v, since %v has no data transfer object", m.Desc.FullName(), field.Desc.Name(), field.Message.Desc.FullName())
+			}
+		}
+	}
+	return nil
+}
+
+// runtimeTypesPrefix is the prefix of the import paths of the Go packages of
+// the messages provided by the protobuf module.
+const runtimeTypesPrefix = "google.golang.org/protobuf/types/"
+
+// dtoElemType returns the type of a singular value of a field, or of an
+// element of a repeated field, in a data transfer object.
+func dtoElemType(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field) string {
+	switch {
+	case field.Desc.Kind() == protoreflect.EnumKind:
+		return "int32"
+	case field.Message != nil:
+		return "*" + g.QualifiedGoIdent(dtoIdent(field.Message))
+	}
+	goType, _ := fieldGoType(g, f, field)
+	if field.Desc.IsList() {
+		goType = strings.TrimPrefix(goType, "[]")
+	}
+	return goType
+}
+
+// dtoFieldType returns the type of a field in a data transfer object.
+// Fields with explicit presence are pointers.
+func dtoFieldType(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field) string {
+	switch {
+	case field.Desc.IsList():
+		return "[]" + dtoElemType(g, f, field)
+	case field.Desc.IsMap():
+		return "map[" + dtoElemType(g, f, field.Message.Fields[0]) + "]" + dtoElemType(g, f, field.Message.Fields[1])
+	case field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind && field.Desc.HasPresence():
+		return "*" + dtoElemType(g, f, field)
+	}
+	return dtoElemType(g, f, field)
+}
+
+func genDTOStruct(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ", m.GoIdent.GoName, " is the data transfer object for the ", m.Desc.FullName(), " message.")
+	g.P("type ", m.GoIdent.GoName, " struct {")
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			if field == field.Oneof.Fields[0] {
+				g.P(field.Oneof.GoName, " ", dtoOneofIdent(field.Oneof))
+			}
+			continue
+		}
+		g.P(field.GoName, " ", dtoFieldType(g, f, field))
+	}
+	g.P("}")
+	g.P()
+
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		ident := dtoOneofIdent(oneof)
+		g.P("// ", ident, " holds the value of the ", oneof.Desc.Name(), " oneof of ", m.GoIdent.GoName, ".")
+		g.P("// Case is the number of the member which is set, or zero if none is set.")
+		g.P("type ", ident, " struct {")
+		g.P("Case int32")
+		for _, field := range oneof.Fields {
+			g.P(field.GoName, " ", dtoElemType(g, f, field))
+		}
+		g.P("}")
+		g.P()
+	}
+}
+
+// dtoToExpr returns an expression converting the value v of a field, or an
+// element of a repeated field, to its representation in a data transfer
+// object.
+func dtoToExpr(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field, v string) string {
+	switch {
+	case field.Desc.Kind() == protoreflect.EnumKind:
+		return "int32(" + v + ")"
+	case field.Message != nil:
+		return v + ".ToDTO()"
+	}
+	return dtoCopyExpr(field, v)
+}
+
+// dtoFromExpr returns an expression converting the value v of a field, or an
+// element of a repeated field, in a data transfer object to a message value.
+func dtoFromExpr(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field, v string) string {
+	switch {
+	case field.Desc.Kind() == protoreflect.EnumKind:
+		return g.QualifiedGoIdent(field.Enum.GoIdent) + "(" + v + ")"
+	case field.Message != nil:
+		return "new(" + g.QualifiedGoIdent(field.Message.GoIdent) + ").FromDTO(" + v + ")"
+	}
+	return dtoCopyExpr(field, v)
+}
+
+// dtoCopyExpr returns an expression for a copy of the scalar value v of a
+// field, which is represented in the same way in messages and data transfer
+// objects. Bytes are copied so that the two do not share memory; an empty
+// value is only copied to a non-nil slice for fields with explicit presence,
+// for which it is set.
+func dtoCopyExpr(field *protogen.Field, v string) string {
+	switch {
+	case field.Desc.Kind() == protoreflect.BytesKind && field.Desc.HasPresence():
+		return "append([]byte{}, " + v + "...)"
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		return "append([]byte(nil), " + v + "...)"
+	}
+	return v
+}
+
+func genMessageToDTO(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	ident := g.QualifiedGoIdent(dtoIdent(m.Message))
+	g.P("// ToDTO returns a copy of x as a data transfer object, or nil if x is nil.")
+	g.P("func (x *", m.GoIdent, ") ToDTO() *", ident, " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("d := new(", ident, ")")
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		switch {
+		case isOneofMember(field):
+			v := genIfFieldPopulated(g, f, m, "x", field)
+			g.P("d.", field.Oneof.GoName, ".Case = ", field.Desc.Number())
+			g.P("d.", field.Oneof.GoName, ".", field.GoName, " = ", dtoToExpr(g, f, field, v))
+			g.P("}")
+		case field.Desc.IsList():
+			g.P("if l := x.", getterName, "(); len(l) > 0 {")
+			g.P("d.", field.GoName, " = make(", dtoFieldType(g, f, field), ", len(l))")
+			g.P("for i, e := range l {")
+			g.P("d.", field.GoName, "[i] = ", dtoToExpr(g, f, field, "e"))
+			g.P("}")
+			g.P("}")
+		case field.Desc.IsMap():
+			g.P("if mv := x.", getterName, "(); len(mv) > 0 {")
+			g.P("d.", field.GoName, " = make(", dtoFieldType(g, f, field), ", len(mv))")
+			g.P("for k, e := range mv {")
+			g.P("d.", field.GoName, "[k] = ", dtoToExpr(g, f, field.Message.Fields[1], "e"))
+			g.P("}")
+			g.P("}")
+		case field.Desc.Kind() == protoreflect.BytesKind && field.Desc.HasPresence():
+			v := genIfFieldPopulated(g, f, m, "x", field)
+			g.P("d.", field.GoName, " = ", dtoToExpr(g, f, field, v))
+			g.P("}")
+		case field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind && field.Desc.HasPresence():
+			v := genIfFieldPopulated(g, f, m, "x", field)
+			g.P("t := ", dtoToExpr(g, f, field, v))
+			g.P("d.", field.GoName, " = &t")
+			g.P("}")
+		default:
+			g.P("d.", field.GoName, " = ", dtoToExpr(g, f, field, "x."+getterName+"()"))
+		}
+	}
+	g.P("return d")
+	g.P("}")
+	g.P()
+}
+
+func genMessageFromDTO(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// FromDTO sets x to a copy of the data transfer object d and returns x.")
+	g.P("// If d is nil, x is reset.")
+	g.P("func (x *", m.GoIdent, ") FromDTO(d *", dtoIdent(m.Message), ") *", m.GoIdent, " {")
+	restore := genSaveTrackingState(g, m)
+	g.P(protoPackage.Ident("Reset"), "(x)")
+	if restore != nil {
+		restore()
+	}
+	g.P("if d == nil {")
+	g.P("return x")
+	g.P("}")
+	for _, field := range m.Fields {
+		switch {
+		case isOneofMember(field):
+			if field != field.Oneof.Fields[0] {
+				continue
+			}
+			g.P("switch d.", field.Oneof.GoName, ".Case {")
+			for _, member := range field.Oneof.Fields {
+				v := dtoFromExpr(g, f, member, "d."+field.Oneof.GoName+"."+member.GoName)
+				g.P("case ", member.Desc.Number(), ":")
+				if m.isOpen() {
+					g.P("x.", field.Oneof.GoName, " = &", opaqueFieldOneofType(member, false), "{", member.GoName, ": ", v, "}")
+				} else {
+					setterName := fieldSetterName(member)
+					g.P("x.", setterName, "(", v, ")")
+				}
+			}
+			g.P("}")
+		case field.Desc.IsList():
+			goType, _ := fieldGoType(g, f, field)
+			g.P("if len(d.", field.GoName, ") > 0 {")
+			g.P("l := make(", goType, ", len(d.", field.GoName, "))")
+			g.P("for i, e := range d.", field.GoName, " {")
+			g.P("l[i] = ", dtoFromExpr(g, f, field, "e"))
+			g.P("}")
+			g.P(fieldAssignStmt(m, "x", field, "l"))
+			g.P("}")
+		case field.Desc.IsMap():
+			goType, _ := fieldGoType(g, f, field)
+			g.P("if len(d.", field.GoName, ") > 0 {")
+			g.P("mv := make(", goType, ", len(d.", field.GoName, "))")
+			g.P("for k, e := range d.", field.GoName, " {")
+			g.P("mv[k] = ", dtoFromExpr(g, f, field.Message.Fields[1], "e"))
+			g.P("}")
+			g.P(fieldAssignStmt(m, "x", field, "mv"))
+			g.P("}")
+		case field.Message != nil:
+			g.P("if d.", field.GoName, " != nil {")
+			g.P(fieldAssignStmt(m, "x", field, dtoFromExpr(g, f, field, "d."+field.GoName)))
+			g.P("}")
+		case field.Desc.Kind() == protoreflect.BytesKind && field.Desc.HasPresence():
+			g.P("if d.", field.GoName, " != nil {")
+			g.P(fieldAssignStmt(m, "x", field, dtoFromExpr(g, f, field, "d."+field.GoName)))
+			g.P("}")
+		case field.Desc.Kind() != protoreflect.BytesKind && field.Desc.HasPresence():
+			g.P("if d.", field.GoName, " != nil {")
+			v := dtoFromExpr(g, f, field, "*d."+field.GoName)
+			if m.isOpen() {
+				g.P("t := ", v)
+				v = "&t"
+			}
+			g.P(fieldAssignStmt(m, "x", field, v))
+			g.P("}")
+		default:
+			g.P(fieldAssignStmt(m, "x", field, dtoFromExpr(g, f, field, "d."+field.GoName)))
+		}
+	}
+	g.P("return x")
+	g.P("}")
+	g.P()
+}
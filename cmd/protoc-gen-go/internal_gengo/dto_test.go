@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDTORuntimeMessage(t *testing.T) {
+	defer func() { generateDTO.enabled = false }()
+
+	resp := generateFileWithParams(t, &descriptorpb.FileDescriptorProto{
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("created"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".google.protobuf.Timestamp"),
+			}},
+		}},
+	}, "dto_out")
+	if got, want := resp.GetError(), "goproto.test.Event: dto_out cannot represent field created"; !strings.Contains(got, want) {
+		t.Errorf("dto_out with a Timestamp field: got error %q, want it to contain %q", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageEachExtension generates the EachExtension method on messages with
+// extension ranges, which calls a function for each extension set on a
+// message.
+func genMessageEachExtension(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if m.Desc.ExtensionRanges().Len() == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	g.P("// EachExtension calls f for each extension set on x with its type and value,")
+	g.P("// in undefined order, until f returns false. Extensions whose type is not")
+	g.P("// known are held in the unknown fields of x and are not visited.")
+	g.P("func (x *", m.GoIdent, ") EachExtension(f func(xt ", protoreflectIdent("ExtensionType"), ", v ", protoreflectIdent("Value"), ") bool) {")
+	g.P("x.ProtoReflect().Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("xd, ok := fd.(", protoreflectIdent("ExtensionTypeDescriptor"), ")")
+	g.P("if !ok {")
+	g.P("return true")
+	g.P("}")
+	g.P("return f(xd.Type(), v)")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageEachMethods generates an EachFoo method for each map field foo of
+// a message with message values, which iterates over the non-nil values.
+func genMessageEachMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsMap() || field.Message.Fields[1].Message == nil {
+			continue
+		}
+		keyType, _ := fieldGoType(g, f, field.Message.Fields[0])
+		valType, _ := fieldGoType(g, f, field.Message.Fields[1])
+		getterName, _ := field.MethodName("Get")
+		g.P("// Each", field.GoName, " calls f for each entry of the ", field.Desc.Name(), " field with a non-nil")
+		g.P("// value, in unspecified order, until f returns false.")
+		g.P("func (x *", m.GoIdent, ") Each", field.GoName, "(f func(k ", keyType, ", v ", valType, ") bool) {")
+		g.P("for k, v := range x.", getterName, "() {")
+		g.P("if v == nil {")
+		g.P("continue")
+		g.P("}")
+		g.P("if !f(k, v) {")
+		g.P("return")
+		g.P("}")
+		g.P("}")
+		g.P("}")
+		g.P()
+	}
+}
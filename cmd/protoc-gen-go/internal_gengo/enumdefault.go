@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genEnumDefault generates the Default method, which returns the default value
+// of an enum. This is the first value declared in the enum, which need not be
+// zero for a closed enum, and is the value of an enum field which has no
+// explicit default.
+func genEnumDefault(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	// Values are listed in the order of the descriptor,
+	// so the first one is the default.
+	def := e.Values[0]
+	g.P("// Default returns the default value of ", e.GoIdent, ", ", def.GoIdent, ".")
+	g.P("// Enum fields with an explicit default value use that value instead.")
+	g.P("func (", e.GoIdent, ") Default() ", e.GoIdent, " {")
+	g.P("return ", def.GoIdent)
+	g.P("}")
+	g.P()
+}
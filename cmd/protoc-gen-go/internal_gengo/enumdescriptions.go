@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genEnumDescription generates the Description method, which returns the text
+// of the comments of each enum value.
+func genEnumDescription(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	g.P("// Description returns the description of x given by the comments of the")
+	g.P("// enum value in the .proto file, or the name of the value if it has none.")
+	g.P("func (x ", e.GoIdent, ") Description() string {")
+	g.P("switch x {")
+	for _, value := range e.Values {
+		// Aliases share the description of the first value with the number.
+		if value.Desc != e.Desc.Values().ByNumber(value.Desc.Number()) {
+			continue
+		}
+		if desc := enumValueDescription(value); desc != "" {
+			g.P("case ", value.GoIdent, ":")
+			g.P("return ", strconv.Quote(desc))
+		}
+	}
+	g.P("}")
+	g.P("return x.String()")
+	g.P("}")
+	g.P()
+}
+
+// enumValueDescription returns the text of the leading comments of the enum
+// value, or of its trailing comments if it has no leading comments.
+func enumValueDescription(value *protogen.EnumValue) string {
+	comments := value.Comments.Leading
+	if comments == "" {
+		comments = value.Comments.Trailing
+	}
+	var lines []string
+	for _, line := range strings.Split(string(comments), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " ")
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genEnumLabel generates the Label method, which returns a label for an enum
+// value given by a function provided by the caller, such as a translation.
+func genEnumLabel(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	g.P("// Label returns the label of x given by lookup, which is called with the")
+	g.P("// full name of the enum and the number of x, or the name of the value if")
+	g.P("// lookup returns the empty string.")
+	g.P("func (x ", e.GoIdent, ") Label(lookup func(", protoreflectPackage.Ident("FullName"), ", ", protoreflectPackage.Ident("EnumNumber"), ") string) string {")
+	g.P("if label := lookup(", strconv.Quote(string(e.Desc.FullName())), ", ", protoreflectPackage.Ident("EnumNumber"), "(x)); label != \"\" {")
+	g.P("return label")
+	g.P("}")
+	g.P("return x.String()")
+	g.P("}")
+	g.P()
+}
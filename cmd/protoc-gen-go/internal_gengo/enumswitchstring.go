@@ -0,0 +1,45 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// switchStringMaxValues is the largest number of values of an enum whose
+// String method is generated as a switch, set with the "switchstring_max"
+// parameter.
+var switchStringMaxValues = 64
+
+// usesSwitchString reports whether the String method of an enum is generated
+// as a switch on its values rather than a lookup in its descriptor.
+func usesSwitchString(e *enumInfo) bool {
+	return generateEnums.enabled["switchstring"] && len(e.Values) <= switchStringMaxValues
+}
+
+// genEnumSwitchString generates a String method for an enum which returns the
+// name of each value from a switch.
+func genEnumSwitchString(g *protogen.GeneratedFile, e *enumInfo) {
+	g.P("func (x ", e.GoIdent, ") String() string {")
+	g.P("switch x {")
+	// As for the descriptor, the name of a number with aliases is the name
+	// of the first value declared with it.
+	seen := make(map[protoreflect.EnumNumber]bool)
+	for _, value := range e.Values {
+		if seen[value.Desc.Number()] {
+			continue
+		}
+		seen[value.Desc.Number()] = true
+		g.P("case ", value.GoIdent, ":")
+		g.P("return ", strconv.Quote(string(value.Desc.Name())))
+	}
+	g.P("}")
+	g.P("return ", strconvPackage.Ident("Itoa"), "(int(x))")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// envVarSuffix returns the name of the environment variable overriding field,
+// less its prefix, which is the proto name of the field in upper snake case
+// (e.g., "MAX_CONNS" for both max_conns and maxConns).
+func envVarSuffix(field *protogen.Field) string {
+	var b strings.Builder
+	var prev rune
+	for _, r := range string(field.Desc.Name()) {
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return b.String()
+}
+
+// genMessageApplyEnvOverrides generates the ApplyEnvOverrides method, which
+// sets the scalar fields of a message from environment variables named after
+// the fields.
+func genMessageApplyEnvOverrides(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	var fields []*protogen.Field
+	for _, field := range m.Fields {
+		if isKVField(field) {
+			fields = append(fields, field)
+		}
+	}
+
+	g.P("// ApplyEnvOverrides sets each singular scalar field of x from the environment")
+	g.P("// variable named by prefix followed by the proto name of the field in upper")
+	g.P("// snake case, if it is set. Enums are given by name or number, and bytes in")
+	g.P("// standard base64. Fields whose variable is not set are unchanged.")
+	g.P("// If a value cannot be parsed, an error is reported and x is left unchanged.")
+	g.P("func (x *", m.GoIdent, ") ApplyEnvOverrides(prefix string) error {")
+	if freezable(m) {
+		genCheckFrozen(g, m, "ApplyEnvOverrides")
+	}
+	if len(fields) == 0 {
+		g.P("return nil")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x)")
+	for _, field := range fields {
+		g.P("if s, ok := ", osPackage.Ident("LookupEnv"), "(prefix + ", strconv.Quote(envVarSuffix(field)), "); ok {")
+		v := genScalarParse(g, field, "environment variable", "prefix+"+strconv.Quote(envVarSuffix(field)), "s")
+		genKVFieldAssign(g, f, m, field, v)
+		g.P("}")
+	}
+	genReplaceContents(g, m, "y")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageEqualIgnoring generates the EqualIgnoring method, which compares
+// two messages without the fields with the given numbers.
+//
+// A number which is not that of a field is a programming error. Methods with
+// an error result, such as MarshalExcept, report it as an error; the others,
+// EqualIgnoring and Project, panic. All of them document it.
+func genMessageEqualIgnoring(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// EqualIgnoring reports whether x and y are equal according to ", protoPackage.Ident("Equal"), ",")
+	g.P("// ignoring the fields with the given numbers, which are cleared in copies of")
+	g.P("// x and y before they are compared. It panics if a number is not that of a")
+	g.P("// field of ", m.GoIdent.GoName, ".")
+	g.P("func (x *", m.GoIdent, ") EqualIgnoring(y *", m.GoIdent, ", ignore ...", protoreflectPackage.Ident("FieldNumber"), ") bool {")
+	g.P("fds := x.ProtoReflect().Descriptor().Fields()")
+	g.P("for _, num := range ignore {")
+	g.P("if fds.ByNumber(num) == nil {")
+	g.P("panic(", fmtPackage.Ident("Sprintf"), "(\"", m.Desc.FullName(), " has no field number %d\", num))")
+	g.P("}")
+	g.P("}")
+	g.P("if x == nil || y == nil || len(ignore) == 0 {")
+	g.P("return ", protoPackage.Ident("Equal"), "(x, y)")
+	g.P("}")
+	g.P("mx, my := ", protoPackage.Ident("CloneOf"), "(x).ProtoReflect(), ", protoPackage.Ident("CloneOf"), "(y).ProtoReflect()")
+	g.P("for _, num := range ignore {")
+	g.P("mx.Clear(fds.ByNumber(num))")
+	g.P("my.Clear(fds.ByNumber(num))")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("Equal"), "(mx.Interface(), my.Interface())")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func exportedMessageTypesFuncName(f *fileInfo) string {
+	return f.GoDescriptorIdent.GoName + "_MessageTypes"
+}
+
+// genFileExportedMessageTypes generates a function returning the message
+// infos of the file, for reflection helpers outside the generated package.
+// Map entries are left out, since their message infos are never initialized.
+func genFileExportedMessageTypes(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	name := exportedMessageTypesFuncName(f)
+	g.P("// ", name, " returns the message infos of the messages declared in")
+	g.P("// ", f.Desc.Path(), ", including nested messages but not map entries.")
+	g.P("// The returned slice is new on each call, but the message infos are shared")
+	g.P("// with the generated code and must not be modified.")
+	g.P("func ", name, "() []*", protoimplPackage.Ident("MessageInfo"), " {")
+	g.P("return []*", protoimplPackage.Ident("MessageInfo"), "{")
+	for i, m := range f.allMessages {
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+		g.P("&", messageTypesVarName(f), "[", i, "], // ", m.Desc.FullName())
+	}
+	g.P("}")
+	g.P("}")
+	g.P()
+}
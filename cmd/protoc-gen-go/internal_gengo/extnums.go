@@ -0,0 +1,31 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageSetExtensionNumbers generates the SetExtensionNumbers method on
+// messages with extension ranges, which lists the extensions set on a message.
+func genMessageSetExtensionNumbers(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if m.Desc.ExtensionRanges().Len() == 0 {
+		return
+	}
+	g.P("// SetExtensionNumbers returns the field numbers of the extensions set on x")
+	g.P("// in ascending order.")
+	g.P("func (x *", m.GoIdent, ") SetExtensionNumbers() []", protoreflectPackage.Ident("FieldNumber"), " {")
+	g.P("var nums []", protoreflectPackage.Ident("FieldNumber"))
+	g.P("x.ProtoReflect().Range(func(fd ", protoreflectPackage.Ident("FieldDescriptor"), ", _ ", protoreflectPackage.Ident("Value"), ") bool {")
+	g.P("if fd.IsExtension() {")
+	g.P("nums = append(nums, fd.Number())")
+	g.P("}")
+	g.P("return true")
+	g.P("})")
+	g.P(sortPackage.Ident("Slice"), "(nums, func(i, j int) bool { return nums[i] < nums[j] })")
+	g.P("return nums")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageCloneVT generates the CloneVT method, which deep-copies a message
+// field by field without going through protoreflect.
+func genMessageCloneVT(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// CloneVT returns a deep copy of x, or nil if x is nil. Unlike proto.Clone,")
+	g.P("// the fields of x are copied directly, and messages declared in the same")
+	g.P("// file are copied by their own CloneVT method. Other messages are copied")
+	g.P("// with proto.Clone. Retained unknown fields are copied as well.")
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		g.P("// Extension fields are copied with proto.Merge.")
+	}
+	g.P("func (x *", m.GoIdent, ") CloneVT() *", m.GoIdent, " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("y := new(", m.GoIdent, ")")
+	for _, field := range m.Fields {
+		genCloneField(g, f, m, field, "x", "y")
+	}
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+		g.P("if len(x.", genid.ExtensionFields_goname, ") > 0 {")
+		g.P("ext := new(", m.GoIdent, ").ProtoReflect()")
+		g.P("x.ProtoReflect().Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+		g.P("if fd.IsExtension() {")
+		g.P("ext.Set(fd, v)")
+		g.P("}")
+		g.P("return true")
+		g.P("})")
+		g.P(protoPackage.Ident("Merge"), "(y, ext.Interface())")
+		g.P("}")
+	}
+	g.P("if len(x.", genid.UnknownFields_goname, ") > 0 {")
+	g.P("y.", genid.UnknownFields_goname, " = append(", protoimplPackage.Ident("UnknownFields"), "(nil), x.", genid.UnknownFields_goname, "...)")
+	g.P("}")
+	g.P("return y")
+	g.P("}")
+	g.P()
+}
+
+// genCloneField generates an if statement setting a field of the message in
+// the variable dst to a deep copy of the same field of the message in the
+// variable src, if it is populated.
+func genCloneField(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, field *protogen.Field, src, dst string) {
+	v := genIfFieldPopulated(g, f, m, src, field)
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		oneofType := opaqueFieldOneofType(field, false)
+		g.P(dst, ".", field.Oneof.GoName, " = &", oneofType, "{", field.GoName, ": ", cloneVTExpr(g, f, field, v), "}")
+	case isOneofMember(field):
+		setterName := fieldSetterName(field)
+		g.P(dst, ".", setterName, "(", cloneVTExpr(g, f, field, v), ")")
+	case field.Desc.IsList():
+		goType, _ := fieldGoType(g, f, field)
+		g.P("l := make(", goType, ", len(", v, "))")
+		if field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind {
+			g.P("for i, e := range ", v, " {")
+			g.P("l[i] = ", cloneVTExpr(g, f, field, "e"))
+			g.P("}")
+		} else {
+			g.P("copy(l, ", v, ")")
+		}
+		g.P(fieldAssignStmt(m, dst, field, "l"))
+	case field.Desc.IsMap():
+		goType, _ := fieldGoType(g, f, field)
+		g.P("mv := make(", goType, ", len(", v, "))")
+		g.P("for k, e := range ", v, " {")
+		g.P("mv[k] = ", cloneVTExpr(g, f, field.Message.Fields[1], "e"))
+		g.P("}")
+		g.P(fieldAssignStmt(m, dst, field, "mv"))
+	default:
+		if _, pointer := fieldGoType(g, f, field); pointer && m.isOpen() {
+			g.P("t := ", v)
+			g.P(fieldAssignStmt(m, dst, field, "&t"))
+		} else {
+			g.P(fieldAssignStmt(m, dst, field, cloneVTExpr(g, f, field, v)))
+		}
+	}
+	g.P("}")
+}
+
+// cloneVTExpr returns an expression for a deep copy of the value v of a
+// singular field, or of an element of a repeated or map field, like
+// cloneValueExpr. Messages declared in the same file are copied by their
+// CloneVT method if it is generated.
+func cloneVTExpr(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field, v string) string {
+	if field.Message != nil && isLocalMessage(f, field.Message) && generateMethods.enabled["fastclone"] {
+		return v + ".CloneVT()"
+	}
+	return cloneValueExpr(g, field, v)
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageFieldDescriptorByGoName generates the FieldDescriptorByGoName
+// method, which resolves a field descriptor from the Go name of the field.
+func genMessageFieldDescriptorByGoName(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	// The map holds field numbers rather than descriptors since package-level
+	// variables are initialized before the file descriptor is built.
+	varName := messageVarName(f, m, "fieldNumbersByGoName")
+	g.P("var ", varName, " = map[string]", protoreflectPackage.Ident("FieldNumber"), "{")
+	for _, field := range m.Fields {
+		g.P(strconv.Quote(field.GoName), ": ", field.Desc.Number(), ",")
+	}
+	g.P("}")
+	g.P()
+
+	g.P("// FieldDescriptorByGoName returns the descriptor of the field of ", m.GoIdent, " with")
+	g.P("// the given Go field name, or nil if there is no such field. Members of a")
+	g.P("// oneof are identified by the field name within their wrapper type.")
+	g.P("func (*", m.GoIdent, ") FieldDescriptorByGoName(name string) ", protoreflectPackage.Ident("FieldDescriptor"), " {")
+	g.P("num, ok := ", varName, "[name]")
+	g.P("if !ok {")
+	g.P("return nil")
+	g.P("}")
+	g.P("return ", messageDescriptorExpr(f, m), ".Fields().ByNumber(num)")
+	g.P("}")
+	g.P()
+}
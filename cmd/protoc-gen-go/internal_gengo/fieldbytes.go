@@ -0,0 +1,33 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageFieldBytes generates the FieldBytes method, which returns the
+// wire-format encoding of a single field of a message.
+func genMessageFieldBytes(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// FieldBytes returns the wire-format encoding of the field of x with the")
+	g.P("// given number, including its tag, as it would appear in the encoding of x.")
+	g.P("// Every element of a repeated or map field is encoded, with map entries in")
+	g.P("// deterministic order, and an unpopulated field is encoded as no bytes.")
+	g.P("// Required fields are not checked, since the encoding holds only the one")
+	g.P("// field. An error is reported if the message has no field with the number.")
+	g.P("func (x *", m.GoIdent, ") FieldBytes(num ", protoreflectPackage.Ident("FieldNumber"), ") ([]byte, error) {")
+	g.P("m := x.ProtoReflect()")
+	g.P("fd := m.Descriptor().Fields().ByNumber(num)")
+	g.P("if fd == nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"message %v has no field with number %d\", m.Descriptor().FullName(), num)")
+	g.P("}")
+	g.P("field := m.New()")
+	g.P("if m.Has(fd) {")
+	g.P("field.Set(fd, m.Get(fd))")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("MarshalOptions"), "{AllowPartial: true, Deterministic: true}.Marshal(field.Interface())")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageFieldSizes generates the FieldSizes method, which returns the
+// size of the wire-format encoding of each populated field of a message.
+func genMessageFieldSizes(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// FieldSizes returns the size in bytes of the wire-format encoding of each")
+	g.P("// populated field of x, including extensions, by field number. The size of")
+	g.P("// a field includes its tags and every element of a repeated or map field.")
+	g.P("// The sizes add up to ", protoPackage.Ident("Size"), "(x), less the size of its unknown fields.")
+	g.P("func (x *", m.GoIdent, ") FieldSizes() map[", protoreflectPackage.Ident("FieldNumber"), "]int {")
+	g.P("m := x.ProtoReflect()")
+	g.P("sizes := make(map[", protoreflectPackage.Ident("FieldNumber"), "]int)")
+	g.P("m.Range(func(fd ", protoreflectPackage.Ident("FieldDescriptor"), ", v ", protoreflectPackage.Ident("Value"), ") bool {")
+	g.P("field := m.New()")
+	g.P("field.Set(fd, v)")
+	g.P("sizes[fd.Number()] = ", protoPackage.Ident("Size"), "(field.Interface())")
+	g.P("return true")
+	g.P("})")
+	g.P("return sizes")
+	g.P("}")
+	g.P()
+}
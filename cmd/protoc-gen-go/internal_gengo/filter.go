@@ -0,0 +1,41 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageFilterMethods generates a FilterFoo method for each repeated
+// message field foo of a message, which removes the elements rejected by a
+// predicate in place.
+func genMessageFilterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsList() || field.Message == nil {
+			continue
+		}
+		goType, _ := fieldGoType(g, f, field)
+		elemType := strings.TrimPrefix(goType, "[]")
+		v := fieldValueExpr(m, "x", field)
+		g.P("// Filter", field.GoName, " removes from the ", field.Desc.Name(), " field the elements for which")
+		g.P("// keep returns false, preserving the order of the remaining elements.")
+		g.P("// The elements are moved within the backing array of the field.")
+		g.P("func (x *", m.GoIdent, ") Filter", field.GoName, "(keep func(", elemType, ") bool) {")
+		g.P("l := ", v)
+		g.P("n := 0")
+		g.P("for _, v := range l {")
+		g.P("if keep(v) {")
+		g.P("l[n] = v")
+		g.P("n++")
+		g.P("}")
+		g.P("}")
+		g.P("clear(l[n:])")
+		g.P(fieldAssignStmt(m, "x", field, "l[:n]"))
+		g.P("}")
+		g.P()
+	}
+}
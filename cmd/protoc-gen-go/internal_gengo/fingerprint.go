@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isVolatileField reports whether field has the volatile option, and is
+// ignored by the Fingerprint method.
+func isVolatileField(field *protogen.Field) bool {
+	return optionBool(field.Desc.Options().(*descriptorpb.FieldOptions), volatile_fieldNumber)
+}
+
+// genMessageFingerprint generates the Fingerprint method, which hashes the
+// contents of a message other than its volatile fields, and the
+// ClearVolatileFields method used to discard them.
+func genMessageFingerprint(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// Fingerprint returns the 64-bit FNV-1a hash of the deterministic wire-format")
+	g.P("// encoding of x, ignoring the fields with the volatile option, as cleared by")
+	g.P("// ClearVolatileFields. Messages which differ only in volatile fields have the")
+	g.P("// same fingerprint when generated by the same binary. It returns 0 if x")
+	g.P("// cannot be marshaled.")
+	g.P("func (x *", m.GoIdent, ") Fingerprint() uint64 {")
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x)")
+	g.P("y.ClearVolatileFields()")
+	g.P("b, err := ", protoPackage.Ident("MarshalOptions"), "{AllowPartial: true, Deterministic: true}.Marshal(y)")
+	g.P("if err != nil {")
+	g.P("return 0")
+	g.P("}")
+	g.P("h := ", fnvPackage.Ident("New64a"), "()")
+	g.P("h.Write(b)")
+	g.P("return h.Sum64()")
+	g.P("}")
+	g.P()
+
+	g.P("// ClearVolatileFields clears the fields of x and of its nested messages which")
+	g.P("// have the volatile option. Volatile message fields are cleared entirely.")
+	g.P("// Messages declared in other files are only cleared if they were also")
+	g.P("// generated with the method.")
+	g.P("func (x *", m.GoIdent, ") ClearVolatileFields() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	var volatile []*protogen.Field
+	for _, field := range m.Fields {
+		if isVolatileField(field) {
+			volatile = append(volatile, field)
+		}
+	}
+	if len(volatile) > 0 {
+		g.P("m := x.ProtoReflect()")
+		g.P("fds := m.Descriptor().Fields()")
+		for _, field := range volatile {
+			g.P("m.Clear(fds.ByNumber(", field.Desc.Number(), ")) // ", field.Desc.Name())
+		}
+	}
+	for _, field := range m.Fields {
+		if field.Message == nil || isVolatileField(field) {
+			continue
+		}
+		switch {
+		case isOneofMember(field):
+			getterName, _ := field.MethodName("Get")
+			genClearVolatileCall(g, f, field.Message, "x."+getterName+"()")
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+				genClearVolatileCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsList():
+			g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+			genClearVolatileCall(g, f, field.Message, "v")
+			g.P("}")
+		default:
+			genClearVolatileCall(g, f, field.Message, fieldValueExpr(m, "x", field))
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// genClearVolatileCall generates a call of ClearVolatileFields on the message
+// value v. Messages declared in other files are only cleared if they were
+// also generated with the method.
+func genClearVolatileCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".ClearVolatileFields()")
+		return
+	}
+	g.P("if c, ok := any(", v, ").(interface{ ClearVolatileFields() }); ok {")
+	g.P("c.ClearVolatileFields()")
+	g.P("}")
+}
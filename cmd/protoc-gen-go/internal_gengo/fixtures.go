@@ -0,0 +1,173 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fixturesDepth is the number of levels of nested messages populated by the
+// fixtures, set with the "fixtures_depth" parameter.
+var fixturesDepth = 3
+
+// genFixturesFile generates the file for the "fixtures_out" parameter,
+// declaring a T_Fixture function for each top-level message T, which returns
+// a message with every field set to a sample value.
+//
+// The fixtures use the setters for messages which do not use the open struct
+// API, so that they work with either variant of the hybrid API.
+func genFixturesFile(gen *protogen.Plugin, f *fileInfo) *protogen.GeneratedFile {
+	g := gen.NewGeneratedFile(f.GeneratedFilenamePrefix+"_fixtures.go", f.GoImportPath)
+	genGeneratedHeader(gen, g, f)
+	g.P("package ", f.GoPackageName)
+	g.P()
+	for _, m := range f.Messages {
+		g.P("// ", m.GoIdent, "_Fixture returns a new ", m.GoIdent, " with every field set to a sample")
+		g.P("// value, for use in tests. Only the first member of each oneof is set, and")
+		g.P("// message fields are populated up to ", fixturesDepth, " levels of nesting.")
+		g.P("func ", m.GoIdent, "_Fixture() *", m.GoIdent, " {")
+		g.P("return ", fixtureFuncName(f, m), "(", fixturesDepth, ")")
+		g.P("}")
+		g.P()
+	}
+	for _, m := range f.allMessages {
+		if !m.Desc.IsMapEntry() {
+			genMessageFixture(g, f, m)
+		}
+	}
+	return g
+}
+
+func fixtureFuncName(f *fileInfo, message *protogen.Message) string {
+	return fileVarName(f.File, message.GoIdent.GoName+"_fixture")
+}
+
+// genMessageFixture generates the function populating a message for the
+// fixtures. Its message fields are set only while depth is positive.
+func genMessageFixture(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	var scalars, messages []*protogen.Field
+	for _, field := range m.Fields {
+		switch {
+		case isOneofMember(field) && field != field.Oneof.Fields[0]:
+		case fixtureHasMessage(field):
+			messages = append(messages, field)
+		default:
+			scalars = append(scalars, field)
+		}
+	}
+
+	name := fixtureFuncName(f, m.Message)
+	g.P("// ", name, " returns a new")
+	g.P("// ", m.GoIdent, " populated with sample values, with depth levels of nested messages.")
+	g.P("func ", name, "(depth int) *", m.GoIdent, " {")
+	g.P("x := &", m.GoIdent, "{}")
+	for _, field := range scalars {
+		genFixtureFieldAssign(g, f, m, field)
+	}
+	if len(messages) > 0 {
+		g.P("if depth <= 0 {")
+		g.P("return x")
+		g.P("}")
+	}
+	for _, field := range messages {
+		genFixtureFieldAssign(g, f, m, field)
+	}
+	g.P("return x")
+	g.P("}")
+	g.P()
+}
+
+// fixtureHasMessage reports whether the sample value of field holds a message.
+func fixtureHasMessage(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		field = field.Message.Fields[1]
+	}
+	return field.Message != nil
+}
+
+// genFixtureFieldAssign generates a statement setting field of the message x
+// to its sample value.
+func genFixtureFieldAssign(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, field *protogen.Field) {
+	goType, pointer := fieldGoType(g, f, field)
+	var v string
+	switch {
+	case field.Desc.IsMap():
+		key, val := field.Message.Fields[0], field.Message.Fields[1]
+		v = goType + "{" + fixtureValue(g, f, key) + ": " + fixtureValue(g, f, val) + "}"
+	case field.Desc.IsList():
+		v = goType + "{" + fixtureValue(g, f, field) + "}"
+	default:
+		v = fixtureValue(g, f, field)
+		if pointer && m.isOpen() && !isOneofMember(field) {
+			v = fixturePointer(g, field, v)
+		}
+	}
+	if isOneofMember(field) && m.isOpen() {
+		g.P("x.", field.Oneof.GoName, " = &", opaqueFieldOneofType(field, false), "{", field.GoName, ": ", v, "}")
+		return
+	}
+	g.P(fieldAssignStmt(m, "x", field, v))
+}
+
+// fixtureValue returns the sample value of a singular field, or of an element
+// of a repeated field: the name of the field for strings and bytes, its number
+// for numbers, true for bools, and the first value with a non-zero number for
+// enums, if any.
+func fixtureValue(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if isLocalMessage(f, field.Message) {
+			return fixtureFuncName(f, field.Message) + "(depth - 1)"
+		}
+		return "&" + g.QualifiedGoIdent(field.Message.GoIdent) + "{}"
+	case protoreflect.EnumKind:
+		value := field.Enum.Values[0]
+		for _, ev := range field.Enum.Values {
+			if ev.Desc.Number() != 0 {
+				value = ev
+				break
+			}
+		}
+		return g.QualifiedGoIdent(value.GoIdent)
+	case protoreflect.StringKind:
+		return strconv.Quote(string(field.Desc.Name()))
+	case protoreflect.BytesKind:
+		return "[]byte(" + strconv.Quote(string(field.Desc.Name())) + ")"
+	case protoreflect.BoolKind:
+		return "true"
+	default:
+		return strconv.Itoa(int(field.Desc.Number()))
+	}
+}
+
+// fixturePointer returns a pointer to the sample value v of a scalar field,
+// for fields with explicit presence in the open struct API.
+func fixturePointer(g *protogen.GeneratedFile, field *protogen.Field, v string) string {
+	var fn string
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		return v + ".Enum()"
+	case protoreflect.StringKind:
+		fn = "String"
+	case protoreflect.BoolKind:
+		fn = "Bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		fn = "Int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		fn = "Int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		fn = "Uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		fn = "Uint64"
+	case protoreflect.FloatKind:
+		fn = "Float32"
+	case protoreflect.DoubleKind:
+		fn = "Float64"
+	}
+	return g.QualifiedGoIdent(protoPackage.Ident(fn)) + "(" + v + ")"
+}
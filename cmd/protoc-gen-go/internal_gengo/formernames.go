@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// enumValueFormerNames returns the names given by the former_name option of
+// the enum value.
+func enumValueFormerNames(value *protogen.EnumValue) []string {
+	return optionStrings(value.Desc.Options().(*descriptorpb.EnumValueOptions), formerName_fieldNumber)
+}
+
+// formerNameGoIdent returns the Go identifier the enum value had when it was
+// named name, which has the same prefix as its current identifier.
+func formerNameGoIdent(value *protogen.EnumValue, name string) protogen.GoIdent {
+	ident := value.GoIdent
+	ident.GoName = strings.TrimSuffix(ident.GoName, string(value.Desc.Name())) + name
+	return ident
+}
+
+// checkEnumFormerNames reports an error if a name given by the former_name
+// option of an enum value is also the name of a value of the enum, or a former
+// name of another of its values.
+func checkEnumFormerNames(f *fileInfo) error {
+	for _, e := range f.allEnums {
+		names := make(map[string]bool)
+		for _, value := range e.Values {
+			names[string(value.Desc.Name())] = true
+		}
+		for _, value := range e.Values {
+			for _, name := range enumValueFormerNames(value) {
+				if names[name] {
+					return fmt.Errorf("%v: former_name %q is already used by enum %v", value.Desc.FullName(), name, e.Desc.FullName())
+				}
+				names[name] = true
+			}
+		}
+	}
+	return nil
+}
+
+// genEnumFormerNames generates constants aliasing the enum values with the
+// names given by their former_name option. The aliases are not added to the
+// enum value maps.
+func genEnumFormerNames(g *protogen.GeneratedFile, e *enumInfo) {
+	var found bool
+	for _, value := range e.Values {
+		if len(enumValueFormerNames(value)) > 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return
+	}
+	g.P("// Former names for ", e.GoIdent, " enum values.")
+	g.P("const (")
+	for _, value := range e.Values {
+		for _, name := range enumValueFormerNames(value) {
+			g.P(formerNameGoIdent(value, name), " ", e.GoIdent, " = ", value.GoIdent)
+		}
+	}
+	g.P(")")
+	g.P()
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestEnumFormerNameConflict(t *testing.T) {
+	opts := &descriptorpb.EnumValueOptions{}
+	b := protowire.AppendTag(nil, formerName_fieldNumber, protowire.BytesType)
+	b = protowire.AppendString(b, "COLOR_RED")
+	opts.ProtoReflect().SetUnknown(b)
+
+	resp := generateFileWithParams(t, &descriptorpb.FileDescriptorProto{
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Color"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("COLOR_RED"), Number: proto.Int32(0)},
+				{Name: proto.String("COLOR_CRIMSON"), Number: proto.Int32(1), Options: opts},
+			},
+		}},
+	}, "")
+	if got, want := resp.GetError(), `former_name "COLOR_RED" is already used by enum goproto.test.Color`; !strings.Contains(got, want) {
+		t.Errorf("conflicting former name: got error %q, want it to contain %q", got, want)
+	}
+	if len(resp.GetFile()) > 0 {
+		t.Errorf("conflicting former name: got %d generated files, want none", len(resp.GetFile()))
+	}
+}
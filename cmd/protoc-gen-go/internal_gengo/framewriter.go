@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageWriteFramed generates the WriteFramed method, which writes a
+// message prefixed with its length as a fixed-size 4-byte integer.
+func genMessageWriteFramed(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// WriteFramed marshals x and writes it to w as a frame consisting of the")
+	g.P("// length of the wire-format encoding as a 4-byte big-endian integer,")
+	g.P("// followed by the encoding itself.")
+	g.P("// It reports an error if the encoding exceeds math.MaxUint32 bytes.")
+	g.P("func (x *", m.GoIdent, ") WriteFramed(w ", ioPackage.Ident("Writer"), ") error {")
+	g.P("b, err := ", protoPackage.Ident("Marshal"), "(x)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("if uint64(len(b)) > ", mathPackage.Ident("MaxUint32"), " {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"", m.Desc.FullName(), ": frame size %d exceeds %d bytes\", len(b), uint64(", mathPackage.Ident("MaxUint32"), "))")
+	g.P("}")
+	g.P("frame := make([]byte, 4, 4+len(b))")
+	g.P(binaryPackage.Ident("BigEndian"), ".PutUint32(frame, uint32(len(b)))")
+	g.P("_, err = w.Write(append(frame, b...))")
+	g.P("return err")
+	g.P("}")
+	g.P()
+}
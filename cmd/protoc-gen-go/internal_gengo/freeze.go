@@ -0,0 +1,127 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// frozenFieldName is the name of the struct field recording whether a message
+// was frozen.
+const frozenFieldName = "xxx_frozen"
+
+// freezable reports whether a message can be frozen against writes by its
+// setters and clearers.
+func freezable(m *messageInfo) bool {
+	return generateMethods.enabled["freeze"] && !m.isOpen()
+}
+
+// genFrozenStructField generates the struct field recording whether a
+// message was frozen.
+func genFrozenStructField(g *protogen.GeneratedFile, sf *structFields) {
+	g.P(frozenFieldName, " bool")
+	sf.append(frozenFieldName)
+}
+
+// genCheckFrozen generates a statement making the method with the given name
+// panic if the message was frozen.
+func genCheckFrozen(g *protogen.GeneratedFile, m *messageInfo, method string) {
+	g.P("if x.", frozenFieldName, " {")
+	g.P(`panic("`, method, ` called on frozen message `, m.Desc.FullName(), `")`)
+	g.P("}")
+}
+
+// genReplaceContents generates statements replacing the contents of the
+// message x with those of the message y, by proto.Reset and proto.Merge. The
+// state recorded for tracking=touched and tracking=callback, which proto.Reset
+// clears, is kept.
+func genReplaceContents(g *protogen.GeneratedFile, m *messageInfo, y string) {
+	restore := genSaveTrackingState(g, m)
+	g.P(protoPackage.Ident("Reset"), "(x)")
+	g.P(protoPackage.Ident("Merge"), "(x, ", y, ")")
+	if restore != nil {
+		restore()
+	}
+}
+
+// genSaveTrackingState generates statements saving the state of the message x
+// recorded for tracking=touched and tracking=callback, and returns a function
+// generating the statements restoring it, or nil if x has no such state.
+func genSaveTrackingState(g *protogen.GeneratedFile, m *messageInfo) (restore func()) {
+	var fields []string
+	if tracksTouched(m) {
+		fields = append(fields, touchedFieldName)
+	}
+	if notifiesChanges(m) {
+		fields = append(fields, changeHookFieldName)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	for _, name := range fields {
+		g.P("saved_", name, " := x.", name)
+	}
+	return func() {
+		for _, name := range fields {
+			g.P("x.", name, " = saved_", name)
+		}
+	}
+}
+
+// genMessageFreeze generates the Freeze method, which makes the setters and
+// clearers of a message and of the messages it holds panic.
+func genMessageFreeze(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// Freeze marks x and the messages held by its fields as read-only, after")
+	g.P("// which their setters, clearers and Reset method panic. Getters remain")
+	g.P("// usable. Since Reset panics, proto.Reset and proto.Unmarshal, which reset")
+	g.P("// the message, panic as well, and so do the generated methods replacing its")
+	g.P("// contents. A message is frozen in place, and it cannot be unfrozen; use")
+	g.P("// proto.Clone to obtain a mutable copy.")
+	g.P("//")
+	g.P("// Freeze guards only the generated methods: writes through the exported")
+	g.P("// fields of the open API, through protoreflect.Message or by merging into")
+	g.P("// x are not prevented. Freeze must not be called concurrently with other")
+	g.P("// uses of x.")
+	g.P("func (x *", m.GoIdent, ") Freeze() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("x.", frozenFieldName, " = true")
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		switch {
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genFreezeCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genFreezeCall(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Message != nil:
+			genFreezeCall(g, f, field.Message, v)
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// genFreezeCall generates a call of Freeze on the message value v. Messages
+// declared in other files are only frozen if they were also generated with
+// the method.
+func genFreezeCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".Freeze()")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface{ Freeze() }); ok {")
+	g.P("m.Freeze()")
+	g.P("}")
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageSortedMaps generates a SortedFoo method for each map field Foo of
+// a message, which returns the entries of the map sorted by key.
+func genMessageSortedMaps(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsMap() {
+			continue
+		}
+		keyType, _ := fieldGoType(g, f, field.Message.Fields[0])
+		valType, _ := fieldGoType(g, f, field.Message.Fields[1])
+		entryType := "struct {\nKey " + keyType + "\nValue " + valType + "\n}"
+		less := "entries[i].Key < entries[j].Key"
+		if field.Desc.MapKey().Kind() == protoreflect.BoolKind {
+			less = "!entries[i].Key && entries[j].Key"
+		}
+		getterName, _ := field.MethodName("Get")
+
+		g.P("// Sorted", field.GoName, " returns the entries of the ", field.Desc.Name(), " map field sorted by")
+		g.P("// key, or nil if the map is empty, for callers which need to visit the map")
+		g.P("// in a stable order. Message values are the messages held by the map, and")
+		g.P("// are not copied, so their own map fields are sorted separately.")
+		g.P("func (x *", m.GoIdent, ") Sorted", field.GoName, "() []", entryType, " {")
+		g.P("mv := x.", getterName, "()")
+		g.P("if len(mv) == 0 {")
+		g.P("return nil")
+		g.P("}")
+		g.P("entries := make([]", entryType, ", 0, len(mv))")
+		g.P("for k, v := range mv {")
+		g.P("entries = append(entries, ", entryType, "{k, v})")
+		g.P("}")
+		g.P(sortPackage.Ident("Slice"), "(entries, func(i, j int) bool {")
+		g.P("return ", less)
+		g.P("})")
+		g.P("return entries")
+		g.P("}")
+		g.P()
+	}
+}
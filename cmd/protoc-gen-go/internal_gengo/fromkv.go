@@ -0,0 +1,115 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isKVField reports whether field may be set by FromKV, which is the case for
+// singular fields of a scalar type.
+func isKVField(field *protogen.Field) bool {
+	return field.Message == nil && !field.Desc.IsList()
+}
+
+// genMessageFromKV generates the FromKV method, which sets the scalar fields
+// of a message from a map of strings keyed by field name.
+func genMessageFromKV(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	errorf := fmtPackage.Ident("Errorf")
+	var keys, unsupported []string
+	for _, field := range m.Fields {
+		key := strconv.Quote(string(field.Desc.Name()))
+		if isKVField(field) {
+			keys = append(keys, key)
+		} else {
+			unsupported = append(unsupported, key)
+		}
+	}
+	if fromKVUnsupported.value != "error" {
+		keys = append(keys, unsupported...)
+		unsupported = nil
+	}
+
+	g.P("// FromKV sets the singular scalar fields of x from the values in kv, keyed")
+	g.P("// by the proto name of each field. Enums are given by name or number, and")
+	g.P("// bytes in standard base64. Fields without a key in kv are unchanged.")
+	if fromKVUnsupported.value == "error" {
+		g.P("// Keys naming a message, repeated or map field are reported as an error,")
+		g.P("// as are keys which do not name a field of x.")
+	} else {
+		g.P("// Keys naming a message, repeated or map field are ignored. Keys which do")
+		g.P("// not name a field of x are reported as an error.")
+	}
+	g.P("// If a value cannot be parsed, an error is reported and x is left unchanged.")
+	g.P("func (x *", m.GoIdent, ") FromKV(kv map[string]string) error {")
+	if freezable(m) {
+		genCheckFrozen(g, m, "FromKV")
+	}
+	g.P("for k := range kv {")
+	if len(keys) > 0 || len(unsupported) > 0 {
+		g.P("switch k {")
+		if len(keys) > 0 {
+			g.P("case ", strings.Join(keys, ", "), ":")
+		}
+		if len(unsupported) > 0 {
+			g.P("case ", strings.Join(unsupported, ", "), ":")
+			g.P("return ", errorf, "(\"key %q does not name a scalar field of message ", m.Desc.FullName(), "\", k)")
+		}
+		g.P("default:")
+	}
+	g.P("return ", errorf, "(\"unknown key %q for message ", m.Desc.FullName(), "\", k)")
+	if len(keys) > 0 || len(unsupported) > 0 {
+		g.P("}")
+	}
+	g.P("}")
+
+	var fields []*protogen.Field
+	for _, field := range m.Fields {
+		if isKVField(field) {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		g.P("return nil")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x)")
+	for _, field := range fields {
+		key := strconv.Quote(string(field.Desc.Name()))
+		g.P("if s, ok := kv[", key, "]; ok {")
+		v := genScalarParse(g, field, "key", key, "s")
+		genKVFieldAssign(g, f, m, field, v)
+		g.P("}")
+	}
+	genReplaceContents(g, m, "y")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genKVFieldAssign generates a statement setting the scalar field of the
+// message y to the parsed value v.
+func genKVFieldAssign(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, field *protogen.Field, v string) {
+	_, pointer := fieldGoType(g, f, field)
+	if pointer && m.isOpen() && !isOneofMember(field) {
+		if v != "v" {
+			g.P("v := ", v)
+		}
+		v = "&v"
+	}
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		g.P("y.", field.Oneof.GoName, " = &", opaqueFieldOneofType(field, false), "{", field.GoName, ": ", v, "}")
+	case isOneofMember(field):
+		g.P("y.", fieldSetterName(field), "(", v, ")")
+	default:
+		g.P(fieldAssignStmt(m, "y", field, v))
+	}
+}
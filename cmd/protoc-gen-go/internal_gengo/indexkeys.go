@@ -0,0 +1,167 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// indexKey is a repeated message field named by the index_key option of the
+// file, along with the field of its messages by which they are indexed.
+type indexKey struct {
+	container *messageInfo
+	field     *protogen.Field
+	key       *protogen.Field
+}
+
+// resolveIndexKeys returns the fields named by the index_key option of the
+// file, each of which is in the form "Message.field:key". It reports an error
+// if a field is not a repeated field of messages declared in the file, or if
+// its key is not a singular field of a type which may be a map key.
+func resolveIndexKeys(f *fileInfo) ([]indexKey, error) {
+	var keys []indexKey
+	keyOf := make(map[*protogen.Message]*protogen.Field)
+	for _, v := range optionStrings(f.Desc.Options().(*descriptorpb.FileOptions), indexKey_fieldNumber) {
+		name, keyName, ok := strings.Cut(v, ":")
+		i := strings.LastIndexByte(name, '.')
+		if !ok || i < 0 {
+			return nil, fmt.Errorf("%v: invalid index_key %q, want the form \"Message.field:key\"", f.Desc.Path(), v)
+		}
+		fullName := protoreflect.FullName(name[:i])
+		if pkg := f.Desc.Package(); pkg != "" {
+			fullName = pkg + "." + fullName
+		}
+		var container *messageInfo
+		for _, m := range f.allMessages {
+			if m.Desc.FullName() == fullName {
+				container = m
+			}
+		}
+		if container == nil {
+			return nil, fmt.Errorf("%v: index_key %q: no message %v in the file", f.Desc.Path(), v, fullName)
+		}
+		field := indexKeyField(container.Message, name[i+1:])
+		switch {
+		case field == nil:
+			return nil, fmt.Errorf("%v: index_key %q: message %v has no field %s", f.Desc.Path(), v, fullName, name[i+1:])
+		case !field.Desc.IsList() || field.Message == nil:
+			return nil, fmt.Errorf("%v: index_key %q: field %v is not a repeated message field", f.Desc.Path(), v, field.Desc.FullName())
+		case !isLocalMessage(f, field.Message):
+			return nil, fmt.Errorf("%v: index_key %q: field %v holds messages declared in another file", f.Desc.Path(), v, field.Desc.FullName())
+		}
+		key := indexKeyField(field.Message, keyName)
+		switch {
+		case key == nil:
+			return nil, fmt.Errorf("%v: index_key %q: message %v has no field %s", f.Desc.Path(), v, field.Message.Desc.FullName(), keyName)
+		case !isIndexKeyKind(key):
+			return nil, fmt.Errorf("%v: index_key %q: key %v is not a singular string, integer, bool or enum field", f.Desc.Path(), v, key.Desc.FullName())
+		case keyOf[field.Message] != nil && keyOf[field.Message] != key:
+			return nil, fmt.Errorf("%v: index_key %q: message %v is already indexed by %s", f.Desc.Path(), v, field.Message.Desc.FullName(), keyOf[field.Message].Desc.Name())
+		}
+		keyOf[field.Message] = key
+		keys = append(keys, indexKey{container, field, key})
+	}
+	return keys, nil
+}
+
+func indexKeyField(message *protogen.Message, name string) *protogen.Field {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// isIndexKeyKind reports whether field may be the key of an index, which is
+// the case for the singular fields whose type may be the key of a map field,
+// and enums.
+func isIndexKeyKind(field *protogen.Field) bool {
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind, protoreflect.BytesKind,
+		protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+func buildIndexFuncName(message *protogen.Message) string {
+	return "Build" + message.GoIdent.GoName + "Index"
+}
+
+// genFileIndexKeys generates a BuildTIndex function for each message T held
+// in a field named by the index_key option of the file, and a FooIndex method
+// for each such field foo.
+func genFileIndexKeys(g *protogen.GeneratedFile, f *fileInfo) error {
+	keys, err := resolveIndexKeys(f)
+	if err != nil {
+		return err
+	}
+	seen := make(map[*protogen.Message]bool)
+	for _, k := range keys {
+		if !seen[k.field.Message] {
+			seen[k.field.Message] = true
+			genBuildIndex(g, f, k)
+		}
+		keyType, _ := fieldGoType(g, f, k.key)
+		getterName, _ := k.field.MethodName("Get")
+		g.P("// ", k.field.GoName, "Index returns the messages of the ", k.field.Desc.Name(), " field indexed by")
+		g.P("// their ", k.key.Desc.Name(), " field, as built by ", buildIndexFuncName(k.field.Message), ".")
+		g.P("func (x *", k.container.GoIdent, ") ", k.field.GoName, "Index() (map[", keyType, "]*", k.field.Message.GoIdent, ", error) {")
+		g.P("return ", buildIndexFuncName(k.field.Message), "(x.", getterName, "())")
+		g.P("}")
+		g.P()
+	}
+	return nil
+}
+
+// genBuildIndex generates the BuildTIndex function indexing messages of type
+// T by their key field.
+func genBuildIndex(g *protogen.GeneratedFile, f *fileInfo, k indexKey) {
+	message, key := k.field.Message, k.key
+	keyType, _ := fieldGoType(g, f, key)
+	getterName, _ := key.MethodName("Get")
+	name := buildIndexFuncName(message)
+	g.P("// ", name, " returns the messages in items indexed by their ", key.Desc.Name(), " field.")
+	g.P("// Nil messages are skipped, and messages whose ", key.Desc.Name(), " field is not set")
+	g.P("// are indexed by its default value.")
+	if indexDuplicates.value == "last" {
+		g.P("// If several messages have the same ", key.Desc.Name(), ", the last of them is")
+		g.P("// kept, and no error is reported.")
+	} else {
+		g.P("// It reports an error if several messages have the same ", key.Desc.Name(), ".")
+	}
+	g.P("func ", name, "(items []*", message.GoIdent, ") (map[", keyType, "]*", message.GoIdent, ", error) {")
+	g.P("index := make(map[", keyType, "]*", message.GoIdent, ", len(items))")
+	g.P("for _, item := range items {")
+	g.P("if item == nil {")
+	g.P("continue")
+	g.P("}")
+	if indexDuplicates.value == "last" {
+		g.P("index[item.", getterName, "()] = item")
+	} else {
+		verb := "%v"
+		if key.Desc.Kind() == protoreflect.StringKind {
+			verb = "%q"
+		}
+		g.P("k := item.", getterName, "()")
+		g.P("if _, ok := index[k]; ok {")
+		g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"", message.Desc.FullName(), ": duplicate ", key.Desc.Name(), " ", verb, "\", k)")
+		g.P("}")
+		g.P("index[k] = item")
+	}
+	g.P("}")
+	g.P("return index, nil")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// indexKeyFile returns a file with the index_key option set to each of the
+// given values, and a message Container with a repeated field items of
+// messages Item, whose fields are name, a string, score, a double, and tags,
+// a repeated string.
+func indexKeyFile(values ...string) *descriptorpb.FileDescriptorProto {
+	opts := &descriptorpb.FileOptions{}
+	var b []byte
+	for _, v := range values {
+		b = protowire.AppendTag(b, indexKey_fieldNumber, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	opts.ProtoReflect().SetUnknown(b)
+
+	field := func(name string, num int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	items := field("items", 1, repeated, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	items.TypeName = proto.String(".goproto.test.Item")
+	return &descriptorpb.FileDescriptorProto{
+		Options: opts,
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Item"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("score", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+				field("tags", 3, repeated, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			},
+		}, {
+			Name:  proto.String("Container"),
+			Field: []*descriptorpb.FieldDescriptorProto{items},
+		}},
+	}
+}
+
+func TestIndexKeyErrors(t *testing.T) {
+	for _, test := range []struct {
+		values []string
+		want   string
+	}{
+		{[]string{"Container.items"}, `invalid index_key "Container.items"`},
+		{[]string{"items:name"}, `invalid index_key "items:name"`},
+		{[]string{"Missing.items:name"}, "no message goproto.test.Missing"},
+		{[]string{"Container.things:name"}, "message goproto.test.Container has no field things"},
+		{[]string{"Item.tags:name"}, "field goproto.test.Item.tags is not a repeated message field"},
+		{[]string{"Container.items:title"}, "message goproto.test.Item has no field title"},
+		{[]string{"Container.items:score"}, "key goproto.test.Item.score is not a singular"},
+		{[]string{"Container.items:tags"}, "key goproto.test.Item.tags is not a singular"},
+		{[]string{"Container.items:name", "Container.items:score"}, "key goproto.test.Item.score"},
+	} {
+		resp := generateFileWithParams(t, indexKeyFile(test.values...), "")
+		if got := resp.GetError(); !strings.Contains(got, test.want) {
+			t.Errorf("index_key %q: got error %q, want it to contain %q", test.values, got, test.want)
+		}
+		if len(resp.GetFile()) > 0 {
+			t.Errorf("index_key %q: got %d generated files, want none", test.values, len(resp.GetFile()))
+		}
+	}
+}
+
+func TestIndexKeyConflictingKeys(t *testing.T) {
+	fd := indexKeyFile("Container.items:name", "Container.items:name")
+	fd.MessageType[0].Field = append(fd.MessageType[0].Field, &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("id"),
+		JsonName: proto.String("id"),
+		Number:   proto.Int32(4),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+	})
+	if resp := generateFileWithParams(t, fd, ""); resp.GetError() != "" {
+		t.Errorf("index_key repeated with the same key: got error %q, want none", resp.GetError())
+	}
+	fd.Options = indexKeyFile("Container.items:name", "Container.items:id").Options
+	resp := generateFileWithParams(t, fd, "")
+	if got, want := resp.GetError(), "message goproto.test.Item is already indexed by name"; !strings.Contains(got, want) {
+		t.Errorf("index_key with different keys: got error %q, want it to contain %q", got, want)
+	}
+}
@@ -30,6 +30,18 @@ type fileInfo struct {
 	// This is updated by enum and message generation logic as necessary,
 	// and checked at the end of file generation.
 	needRawDesc bool
+
+	// pathConstants holds the path constants of each message,
+	// and is computed on first use by genMessagePathConstants.
+	pathConstants map[*messageInfo][]pathConstant
+
+	// convertTargets holds the message named by the convert_to option of
+	// each message, and is computed by resolveConvertTargets.
+	convertTargets map[*messageInfo]convertTarget
+
+	// commonOneofs holds the oneof whose types are used by each oneof named
+	// by the common_oneof option, and is computed by resolveCommonOneofs.
+	commonOneofs map[*protogen.Oneof]*protogen.Oneof
 }
 
 type structFields struct {
@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageInt64StringMethods generates a GetFooString and SetFooString method
+// for each singular 64-bit integer field foo of a message, which access the
+// field as a decimal string, the form used for 64-bit integers by protojson.
+func genMessageInt64StringMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if field.Desc.IsList() || field.Desc.IsMap() {
+			continue
+		}
+		var format, parse, parseArgs string
+		switch field.Desc.Kind() {
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			format, parse, parseArgs = "FormatInt", "ParseInt", "(s, 10, 64)"
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			format, parse, parseArgs = "FormatUint", "ParseUint", "(s, 10, 64)"
+		default:
+			continue
+		}
+		_, pointer := fieldGoType(g, f, field)
+		getterName, _ := field.MethodName("Get")
+
+		g.P("// ", getterName, "String returns the value of the ", field.Desc.Name(), " field as a decimal string.")
+		g.P("func (x *", m.GoIdent, ") ", getterName, "String() string {")
+		g.P("return ", strconvPackage.Ident(format), "(x.", getterName, "(), 10)")
+		g.P("}")
+		g.P()
+
+		g.P("// Set", field.GoName, "String sets the ", field.Desc.Name(), " field to the value of the decimal")
+		g.P("// string s. It reports an error and leaves x unchanged if s is not a valid value.")
+		g.P("func (x *", m.GoIdent, ") Set", field.GoName, "String(s string) error {")
+		g.P("v, err := ", strconvPackage.Ident(parse), parseArgs)
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		switch {
+		case isOneofMember(field) && m.isOpen():
+			oneofType := opaqueFieldOneofType(field, false)
+			g.P("x.", field.Oneof.GoName, " = &", oneofType, "{", field.GoName, ": v}")
+		case pointer && m.isOpen():
+			g.P(fieldAssignStmt(m, "x", field, "&v"))
+		case isOneofMember(field):
+			setterName := fieldSetterName(field)
+			g.P("x.", setterName, "(v)")
+		default:
+			g.P(fieldAssignStmt(m, "x", field, "v"))
+		}
+		g.P("return nil")
+		g.P("}")
+		g.P()
+	}
+}
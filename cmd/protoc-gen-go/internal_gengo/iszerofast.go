@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageIsZeroFast generates the IsZeroFast method, which reports whether
+// a message is empty by checking each of its fields directly.
+func genMessageIsZeroFast(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// IsZeroFast reports whether x has no populated fields, extensions or")
+	g.P("// unknown fields, like proto.Equal(x, &", m.GoIdent.GoName, "{}) but without reflection.")
+	g.P("// A nil message is zero.")
+	g.P("func (x *", m.GoIdent, ") IsZeroFast() bool {")
+	g.P("if x == nil {")
+	g.P("return true")
+	g.P("}")
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		g.P("if x.", opaqueOneofFieldName(oneof, m.isOpaque()), " != nil {")
+		g.P("return false")
+		g.P("}")
+	}
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			continue
+		}
+		g.P("if ", isZeroFastPopulatedCond(g, m, field), " {")
+		g.P("return false")
+		g.P("}")
+	}
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		g.P("if len(x.", genid.ExtensionFields_goname, ") > 0 {")
+		g.P("return false")
+		g.P("}")
+	}
+	g.P("return len(x.", genid.UnknownFields_goname, ") == 0")
+	g.P("}")
+	g.P()
+}
+
+// isZeroFastPopulatedCond returns a condition reporting whether a field of x
+// is populated. Unlike fieldPopulatedCond, it treats a negative zero as
+// populated for floating-point fields without presence, as proto.Equal does.
+func isZeroFastPopulatedCond(g *protogen.GeneratedFile, m *messageInfo, field *protogen.Field) string {
+	if !field.Desc.HasPresence() && !field.Desc.IsList() && !field.Desc.IsMap() {
+		switch field.Desc.Kind() {
+		case protoreflect.FloatKind:
+			return g.QualifiedGoIdent(mathPackage.Ident("Float32bits")) + "(" + fieldValueExpr(m, "x", field) + ") != 0"
+		case protoreflect.DoubleKind:
+			return g.QualifiedGoIdent(mathPackage.Ident("Float64bits")) + "(" + fieldValueExpr(m, "x", field) + ") != 0"
+		}
+	}
+	return fieldPopulatedCond(g, m, "x", field)
+}
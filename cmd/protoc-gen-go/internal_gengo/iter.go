@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genIterFile generates the file for the "helpers=iter" parameter, declaring
+// the AllFoo methods iterating over the repeated and map fields of messages.
+//
+// The iter package requires Go 1.23, so the methods are declared in a separate
+// file constrained to it, which keeps the rest of the generated code usable
+// with earlier versions. The methods use the getters, so that they work with
+// either variant of the hybrid API.
+func genIterFile(gen *protogen.Plugin, f *fileInfo) *protogen.GeneratedFile {
+	g := gen.NewGeneratedFile(f.GeneratedFilenamePrefix+"_iter.go", f.GoImportPath)
+	genGeneratedHeader(gen, g, f)
+	g.P("//go:build go1.23")
+	g.P()
+	g.P("package ", f.GoPackageName)
+	g.P()
+	for _, m := range f.allMessages {
+		if !m.Desc.IsMapEntry() {
+			genMessageIterMethods(g, f, m)
+		}
+	}
+	return g
+}
+
+// genMessageIterMethods generates an AllFoo method for each repeated field foo
+// of a message, and an AllFooEntries method for each map field foo.
+func genMessageIterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		switch {
+		case field.Desc.IsList():
+			goType, _ := fieldGoType(g, f, field)
+			elemType := goType[len("[]"):]
+			g.P("// All", field.GoName, " returns an iterator over the elements of the ", field.Desc.Name(), " field.")
+			g.P("func (x *", m.GoIdent, ") All", field.GoName, "() ", iterPackage.Ident("Seq"), "[", elemType, "] {")
+			g.P("return func(yield func(", elemType, ") bool) {")
+			g.P("for _, v := range x.", getterName, "() {")
+			g.P("if !yield(v) {")
+			g.P("return")
+			g.P("}")
+			g.P("}")
+			g.P("}")
+			g.P("}")
+			g.P()
+		case field.Desc.IsMap():
+			keyType, _ := fieldGoType(g, f, field.Message.Fields[0])
+			valType, _ := fieldGoType(g, f, field.Message.Fields[1])
+			g.P("// All", field.GoName, "Entries returns an iterator over the entries of the ", field.Desc.Name(), " field,")
+			g.P("// in unspecified order.")
+			g.P("func (x *", m.GoIdent, ") All", field.GoName, "Entries() ", iterPackage.Ident("Seq2"), "[", keyType, ", ", valType, "] {")
+			g.P("return func(yield func(", keyType, ", ", valType, ") bool) {")
+			g.P("for k, v := range x.", getterName, "() {")
+			g.P("if !yield(k, v) {")
+			g.P("return")
+			g.P("}")
+			g.P("}")
+			g.P("}")
+			g.P("}")
+			g.P()
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageJoinedMethods generates a FooJoined method for each repeated field
+// foo of a message with scalar or enum elements, which joins the string forms
+// of the elements.
+func genMessageJoinedMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsList() {
+			continue
+		}
+		// The strconv function formatting the elements and its arguments.
+		var fn, args string
+		switch field.Desc.Kind() {
+		case protoreflect.StringKind, protoreflect.EnumKind:
+		case protoreflect.BoolKind:
+			fn, args = "FormatBool", "v"
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			fn, args = "FormatInt", "int64(v), 10"
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			fn, args = "FormatInt", "v, 10"
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			fn, args = "FormatUint", "uint64(v), 10"
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			fn, args = "FormatUint", "v, 10"
+		case protoreflect.FloatKind:
+			fn, args = "FormatFloat", "float64(v), 'g', -1, 32"
+		case protoreflect.DoubleKind:
+			fn, args = "FormatFloat", "v, 'g', -1, 64"
+		default:
+			continue
+		}
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		g.P("// ", field.GoName, "Joined returns the elements of the ", field.Desc.Name(), " field joined by sep.")
+		switch {
+		case field.Desc.Kind() == protoreflect.EnumKind:
+			g.P("// Each element is given by the name of its enum value.")
+		case fn != "":
+			g.P("// Each element is formatted by ", strconvPackage.Ident(fn), ".")
+		}
+		g.P("func (x *", m.GoIdent, ") ", field.GoName, "Joined(sep string) string {")
+		if field.Desc.Kind() == protoreflect.StringKind {
+			g.P("return ", stringsPackage.Ident("Join"), "(", v, ", sep)")
+		} else {
+			g.P("l := ", v)
+			g.P("s := make([]string, len(l))")
+			g.P("for i, v := range l {")
+			if fn == "" {
+				g.P("s[i] = v.String()")
+			} else {
+				g.P("s[i] = ", strconvPackage.Ident(fn), "(", args, ")")
+			}
+			g.P("}")
+			g.P("return ", stringsPackage.Ident("Join"), "(s, sep)")
+		}
+		g.P("}")
+		g.P()
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// genMessageJSONMethods generates the MarshalJSON and UnmarshalJSON methods,
+// which implement the encoding/json interfaces with the protobuf JSON mapping.
+// With json=strict alone, only UnmarshalJSON is generated.
+func genMessageJSONMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	switch m.Desc.FullName() {
+	case genid.Struct_message_fullname, genid.Value_message_fullname, genid.ListValue_message_fullname:
+		return // already declared by genMessageKnownFunctions
+	}
+	strict := generateJSON.enabled["strict"]
+
+	numeric, always, never := jsonNumericFields(m), jsonRuleFields(m, isJSONAlways), jsonRuleFields(m, isJSONNever)
+	if generateJSON.enabled["methods"] && len(numeric)+len(always)+len(never) > 0 {
+		genMarshalJSONFields(g, m, numeric, always, never)
+	} else if generateJSON.enabled["methods"] {
+		g.P("// MarshalJSON implements json.Marshaler by marshaling x with ", protojsonPackage.Ident("Marshal"), ".")
+		g.P("func (x *", m.GoIdent, ") MarshalJSON() ([]byte, error) {")
+		g.P("return ", protojsonPackage.Ident("Marshal"), "(x)")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with")
+	g.P("// the protobuf JSON mapping.")
+	if strict {
+		g.P("// Unknown fields in b are reported as an error.")
+	} else {
+		g.P("// Unknown fields in b are discarded.")
+	}
+	g.P("func (x *", m.GoIdent, ") UnmarshalJSON(b []byte) error {")
+	g.P("return ", protojsonPackage.Ident("UnmarshalOptions"), "{DiscardUnknown: ", !strict, "}.Unmarshal(b, x)")
+	g.P("}")
+	g.P()
+}
+
+// isJSONNumericEnum reports whether an enum is marked with the json_numeric
+// option, meaning that its values are marshaled to JSON as numbers.
+func isJSONNumericEnum(e *protogen.Enum) bool {
+	return optionBool(e.Desc.Options().(*descriptorpb.EnumOptions), jsonNumeric_fieldNumber)
+}
+
+// jsonNumericFields returns the fields of a message whose values, or whose
+// map values, are of an enum marshaled to JSON as numbers.
+func jsonNumericFields(m *messageInfo) (fields []*protogen.Field) {
+	for _, field := range m.Fields {
+		e := field.Enum
+		if field.Desc.IsMap() {
+			e = field.Message.Fields[1].Enum
+		}
+		if e != nil && isJSONNumericEnum(e) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// isJSONAlways reports whether a field is marked with the json_always option,
+// meaning that MarshalJSON emits it even if it is not populated.
+func isJSONAlways(field *protogen.Field) bool {
+	return optionBool(field.Desc.Options().(*descriptorpb.FieldOptions), jsonAlways_fieldNumber)
+}
+
+// isJSONNever reports whether a field is marked with the json_never option,
+// meaning that MarshalJSON never emits it.
+func isJSONNever(field *protogen.Field) bool {
+	return optionBool(field.Desc.Options().(*descriptorpb.FieldOptions), jsonNever_fieldNumber)
+}
+
+// checkJSONFieldRules reports an error if a field of the file has options
+// which cannot be honored by MarshalJSON.
+func checkJSONFieldRules(f *fileInfo) error {
+	for _, m := range f.allMessages {
+		for _, field := range m.Fields {
+			if !isJSONAlways(field) {
+				continue
+			}
+			if isJSONNever(field) {
+				return fmt.Errorf("%v: field has both the json_always and json_never options", field.Desc.FullName())
+			}
+			if isOneofMember(field) {
+				return fmt.Errorf("%v: json_always option on a member of oneof %s", field.Desc.FullName(), field.Oneof.Desc.Name())
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFieldOverride is a set of fields whose values in the JSON encoding of a
+// message are taken from the encoding with different marshal options.
+type jsonFieldOverride struct {
+	opts    string // fields of protojson.MarshalOptions
+	fields  []*protogen.Field
+	missing bool // whether only fields missing from the encoding are replaced
+}
+
+// jsonRuleFields returns the fields of a message marked with an option.
+func jsonRuleFields(m *messageInfo, marked func(*protogen.Field) bool) (fields []*protogen.Field) {
+	for _, field := range m.Fields {
+		if marked(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// genMarshalJSONFields generates a MarshalJSON method which marshals x with
+// protojson.Marshal, and then replaces the values of the fields with the
+// json_always option and of the fields of enums with the json_numeric option,
+// and removes the fields with the json_never option.
+//
+// The values of the replaced fields are taken from encodings of the message
+// with EmitUnpopulated and UseEnumNumbers as needed. EmitUnpopulated applies
+// to nested messages as well, so it is only used for the fields which are
+// missing from the encoding, whose values do not contain messages.
+func genMarshalJSONFields(g *protogen.GeneratedFile, m *messageInfo, numeric, always, never []*protogen.Field) {
+	rawMessage := jsonPackage.Ident("RawMessage")
+	isNumeric := make(map[*protogen.Field]bool)
+	for _, field := range numeric {
+		isNumeric[field] = true
+	}
+	overrides := []*jsonFieldOverride{
+		{opts: "UseEnumNumbers: true"},
+		{opts: "EmitUnpopulated: true", missing: true},
+		{opts: "EmitUnpopulated: true, UseEnumNumbers: true", missing: true},
+	}
+	overrides[0].fields = numeric
+	for _, field := range always {
+		if isNumeric[field] {
+			overrides[2].fields = append(overrides[2].fields, field)
+		} else {
+			overrides[1].fields = append(overrides[1].fields, field)
+		}
+	}
+
+	g.P("// MarshalJSON implements json.Marshaler by marshaling x with ", protojsonPackage.Ident("Marshal"), ",")
+	var rules []string
+	if len(numeric) > 0 {
+		rules = append(rules, "the values of enums with the json_numeric option are marshaled as\n//     numbers rather than names")
+	}
+	if len(always) > 0 {
+		rules = append(rules, "the fields with the json_always option are emitted even if they are\n//     not populated, as null for fields with explicit presence")
+	}
+	if len(never) > 0 {
+		rules = append(rules, "the fields with the json_never option are never emitted")
+	}
+	g.P("// except that:")
+	for i, rule := range rules {
+		end := ","
+		if i == len(rules)-1 {
+			end = "."
+		}
+		g.P("//   - ", rule, end)
+	}
+	g.P("//")
+	g.P("// This applies to the fields of x only, and not to the fields of its nested")
+	g.P("// messages.")
+	g.P("func (x *", m.GoIdent, ") MarshalJSON() ([]byte, error) {")
+	g.P("b, err := ", protojsonPackage.Ident("Marshal"), "(x)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("var fields map[string]", rawMessage)
+	g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(b, &fields); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	for _, o := range overrides {
+		if len(o.fields) == 0 {
+			continue
+		}
+		g.P("{")
+		g.P("b, err := ", protojsonPackage.Ident("MarshalOptions"), "{", o.opts, "}.Marshal(x)")
+		g.P("if err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("var override map[string]", rawMessage)
+		g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(b, &override); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("for _, name := range []string{")
+		for _, field := range o.fields {
+			g.P(strconv.Quote(field.Desc.JSONName()), ",")
+		}
+		g.P("} {")
+		if o.missing {
+			g.P("if _, ok := fields[name]; ok {")
+			g.P("continue")
+			g.P("}")
+		}
+		g.P("if v, ok := override[name]; ok {")
+		g.P("fields[name] = v")
+		g.P("}")
+		g.P("}")
+		g.P("}")
+	}
+	var nullable []*protogen.Field
+	for _, field := range always {
+		if field.Desc.HasPresence() {
+			nullable = append(nullable, field)
+		}
+	}
+	if len(nullable) > 0 {
+		// Fields with explicit presence are not emitted by EmitUnpopulated.
+		g.P("for _, name := range []string{")
+		for _, field := range nullable {
+			g.P(strconv.Quote(field.Desc.JSONName()), ",")
+		}
+		g.P("} {")
+		g.P("if _, ok := fields[name]; !ok {")
+		g.P("fields[name] = ", rawMessage, `("null")`)
+		g.P("}")
+		g.P("}")
+	}
+	for _, field := range never {
+		g.P("delete(fields, ", strconv.Quote(field.Desc.JSONName()), ")")
+	}
+	g.P("return ", jsonPackage.Ident("Marshal"), "(fields)")
+	g.P("}")
+	g.P()
+}
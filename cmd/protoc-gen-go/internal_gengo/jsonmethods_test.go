@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// jsonRulesFile returns a file with a message whose field value has the
+// bool options with the given field numbers set, and is a member of a oneof
+// if inOneof is set.
+func jsonRulesFile(inOneof bool, nums ...protowire.Number) *descriptorpb.FileDescriptorProto {
+	opts := &descriptorpb.FieldOptions{}
+	var b []byte
+	for _, num := range nums {
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		b = protowire.AppendVarint(b, 1)
+	}
+	opts.ProtoReflect().SetUnknown(b)
+
+	m := &descriptorpb.DescriptorProto{
+		Name: proto.String("Message"),
+		Field: []*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String("value"),
+			JsonName: proto.String("value"),
+			Number:   proto.Int32(1),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Options:  opts,
+		}},
+	}
+	if inOneof {
+		m.Field[0].OneofIndex = proto.Int32(0)
+		m.OneofDecl = []*descriptorpb.OneofDescriptorProto{{Name: proto.String("choice")}}
+	}
+	return &descriptorpb.FileDescriptorProto{MessageType: []*descriptorpb.DescriptorProto{m}}
+}
+
+func TestJSONRulesConflict(t *testing.T) {
+	resp := generateFileWithParams(t, jsonRulesFile(false, jsonAlways_fieldNumber, jsonNever_fieldNumber), "json=methods")
+	defer delete(generateJSON.enabled, "methods")
+	if got, want := resp.GetError(), "goproto.test.Message.value: field has both the json_always and json_never options"; !strings.Contains(got, want) {
+		t.Errorf("conflicting JSON options: got error %q, want it to contain %q", got, want)
+	}
+	if len(resp.GetFile()) > 0 {
+		t.Errorf("conflicting JSON options: got %d generated files, want none", len(resp.GetFile()))
+	}
+}
+
+func TestJSONAlwaysOneofMember(t *testing.T) {
+	resp := generateFileWithParams(t, jsonRulesFile(true, jsonAlways_fieldNumber), "")
+	if got, want := resp.GetError(), "json_always option on a member of oneof choice"; !strings.Contains(got, want) {
+		t.Errorf("json_always on a oneof member: got error %q, want it to contain %q", got, want)
+	}
+}
+
+func TestJSONRules(t *testing.T) {
+	for _, nums := range [][]protowire.Number{{jsonAlways_fieldNumber}, {jsonNever_fieldNumber}} {
+		resp := generateFileWithParams(t, jsonRulesFile(false, nums...), "json=methods")
+		if resp.GetError() != "" || len(resp.GetFile()) != 1 {
+			t.Errorf("option %d: got error %q and %d files, want one file", nums[0], resp.GetError(), len(resp.GetFile()))
+			continue
+		}
+		if content := resp.GetFile()[0].GetContent(); !strings.Contains(content, "func (x *Message) MarshalJSON") {
+			t.Errorf("option %d: MarshalJSON not generated:\n%s", nums[0], content)
+		}
+	}
+	delete(generateJSON.enabled, "methods")
+}
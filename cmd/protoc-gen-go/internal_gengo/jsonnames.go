@@ -0,0 +1,23 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageJSONNames generates a package-level map from the name of each field
+// of a message to its JSON name.
+func genMessageJSONNames(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ", m.GoIdent.GoName, "_jsonNames maps the name of each field of ", m.GoIdent, " to its JSON name.")
+	g.P("var ", m.GoIdent.GoName, "_jsonNames = map[", protoreflectPackage.Ident("Name"), "]string{")
+	for _, field := range m.Fields {
+		g.P(strconv.Quote(string(field.Desc.Name())), ": ", strconv.Quote(field.Desc.JSONName()), ",")
+	}
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func jsonPatchFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "applyJSONPatch")
+}
+
+func jsonPatchOpFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "applyJSONPatchOp")
+}
+
+func jsonPatchElemFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "applyJSONPatchElem")
+}
+
+func jsonPatchFieldFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "jsonPatchField")
+}
+
+func jsonPatchIndexFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "jsonPatchIndex")
+}
+
+func jsonPatchValueFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "jsonPatchValue")
+}
+
+// genMessageApplyJSONPatch generates the ApplyJSONPatch method, which applies
+// a JSON Patch document (RFC 6902) to a message.
+func genMessageApplyJSONPatch(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ApplyJSONPatch applies the JSON Patch document (RFC 6902) in patch to x.")
+	g.P("// The tokens of a JSON pointer name fields by their JSON or proto name, and")
+	g.P("// elements of repeated fields by their index. Values are decoded as by")
+	g.P("// ", protojsonPackage.Ident("Unmarshal"), ".")
+	g.P("//")
+	g.P("// The add, replace and remove operations are supported on singular and")
+	g.P("// repeated fields and on elements of repeated fields, but not on map fields.")
+	g.P("// For fields, add and replace both set the field, and a null value clears")
+	g.P("// it. If an operation fails, an error is reported and x is left unchanged.")
+	g.P("func (x *", m.GoIdent, ") ApplyJSONPatch(patch []byte) error {")
+	if freezable(m) {
+		genCheckFrozen(g, m, "ApplyJSONPatch")
+	}
+	if restore := genSaveTrackingState(g, m); restore != nil {
+		g.P("err := ", jsonPatchFuncName(f), "(x.ProtoReflect(), patch)")
+		restore()
+		g.P("return err")
+	} else {
+		g.P("return ", jsonPatchFuncName(f), "(x.ProtoReflect(), patch)")
+	}
+	g.P("}")
+	g.P()
+}
+
+// genFileApplyJSONPatch generates the functions implementing ApplyJSONPatch
+// for all messages of the file.
+func genFileApplyJSONPatch(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	errorf := fmtPackage.Ident("Errorf")
+	rawMessage := jsonPackage.Ident("RawMessage")
+
+	g.P("// ", jsonPatchFuncName(f), " applies the JSON Patch document in patch to m.")
+	g.P("// The operations are applied to a copy of m, which replaces the contents")
+	g.P("// of m if all of them succeed.")
+	g.P("func ", jsonPatchFuncName(f), "(m ", protoreflectIdent("Message"), ", patch []byte) error {")
+	g.P("var ops []struct {")
+	g.P("Op    string          `json:\"op\"`")
+	g.P("Path  string          `json:\"path\"`")
+	g.P("Value ", rawMessage, " `json:\"value\"`")
+	g.P("}")
+	g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(patch, &ops); err != nil {")
+	g.P("return ", errorf, "(\"invalid JSON patch: %v\", err)")
+	g.P("}")
+	g.P("y := ", protoPackage.Ident("Clone"), "(m.Interface())")
+	g.P("for i, op := range ops {")
+	g.P("if err := ", jsonPatchOpFuncName(f), "(y.ProtoReflect(), op.Op, op.Path, op.Value); err != nil {")
+	g.P("return ", errorf, "(\"JSON patch operation %d: %v\", i, err)")
+	g.P("}")
+	g.P("}")
+	g.P(protoPackage.Ident("Reset"), "(m.Interface())")
+	g.P(protoPackage.Ident("Merge"), "(m.Interface(), y)")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+
+	g.P("// ", jsonPatchOpFuncName(f), " applies the operation op with the given value to the")
+	g.P("// location in m identified by the JSON pointer path.")
+	g.P("func ", jsonPatchOpFuncName(f), "(m ", protoreflectIdent("Message"), ", op, path string, value ", rawMessage, ") error {")
+	g.P("switch op {")
+	g.P(`case "add", "replace", "remove":`)
+	g.P("default:")
+	g.P("return ", errorf, "(\"unsupported operation %q\", op)")
+	g.P("}")
+	g.P(`if !`, stringsPackage.Ident("HasPrefix"), `(path, "/") {`)
+	g.P("return ", errorf, "(\"%s: invalid path %q\", op, path)")
+	g.P("}")
+	g.P(`tokens := `, stringsPackage.Ident("Split"), `(path[1:], "/")`)
+	g.P("for i, t := range tokens {")
+	g.P(`tokens[i] = `, stringsPackage.Ident("ReplaceAll"), `(`, stringsPackage.Ident("ReplaceAll"), `(t, "~1", "/"), "~0", "~")`)
+	g.P("}")
+	g.P("for {")
+	g.P("fd := ", jsonPatchFieldFuncName(f), "(m.Descriptor(), tokens[0])")
+	g.P("tokens = tokens[1:]")
+	g.P("switch {")
+	g.P("case fd == nil || fd.IsMap():")
+	g.P("return ", errorf, "(\"%s: unsupported path %q\", op, path)")
+	g.P("case len(tokens) == 0:")
+	g.P(`if op == "remove" {`)
+	g.P("m.Clear(fd)")
+	g.P("return nil")
+	g.P("}")
+	g.P("v, err := ", jsonPatchValueFuncName(f), "(m, fd, value, false)")
+	g.P("if err != nil {")
+	g.P("return ", errorf, "(\"%s %q: %v\", op, path, err)")
+	g.P("}")
+	g.P("if !v.IsValid() {")
+	g.P("m.Clear(fd)")
+	g.P("return nil")
+	g.P("}")
+	g.P("m.Set(fd, v)")
+	g.P("return nil")
+	g.P("case fd.IsList() && len(tokens) == 1:")
+	g.P("if err := ", jsonPatchElemFuncName(f), "(m, fd, op, tokens[0], value); err != nil {")
+	g.P("return ", errorf, "(\"%s %q: %v\", op, path, err)")
+	g.P("}")
+	g.P("return nil")
+	g.P("case fd.IsList() && fd.Message() != nil:")
+	g.P("l := m.Mutable(fd).List()")
+	g.P("i, ok := ", jsonPatchIndexFuncName(f), "(tokens[0], l.Len(), false)")
+	g.P("if !ok {")
+	g.P("return ", errorf, "(\"%s: path %q does not exist\", op, path)")
+	g.P("}")
+	g.P("m = l.Get(i).Message()")
+	g.P("tokens = tokens[1:]")
+	g.P("case fd.Message() != nil && !fd.IsList():")
+	g.P(`if op != "add" && !m.Has(fd) {`)
+	g.P("return ", errorf, "(\"%s: path %q does not exist\", op, path)")
+	g.P("}")
+	g.P("m = m.Mutable(fd).Message()")
+	g.P("default:")
+	g.P("return ", errorf, "(\"%s: unsupported path %q\", op, path)")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("// ", jsonPatchElemFuncName(f), " applies the operation op to the element of the")
+	g.P("// repeated field fd of m with the index given by the token.")
+	g.P("func ", jsonPatchElemFuncName(f), "(m ", protoreflectIdent("Message"), ", fd ", protoreflectIdent("FieldDescriptor"), ", op, token string, value ", rawMessage, ") error {")
+	g.P("l := m.Mutable(fd).List()")
+	g.P(`i, ok := `, jsonPatchIndexFuncName(f), `(token, l.Len(), op == "add")`)
+	g.P("if !ok {")
+	g.P("return ", errorf, "(\"index %q out of range\", token)")
+	g.P("}")
+	g.P(`if op == "remove" {`)
+	g.P("for j := i; j < l.Len()-1; j++ {")
+	g.P("l.Set(j, l.Get(j+1))")
+	g.P("}")
+	g.P("l.Truncate(l.Len() - 1)")
+	g.P("return nil")
+	g.P("}")
+	g.P("v, err := ", jsonPatchValueFuncName(f), "(m, fd, value, true)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P(`if op == "add" {`)
+	g.P("l.Append(v)")
+	g.P("for j := l.Len() - 1; j > i; j-- {")
+	g.P("l.Set(j, l.Get(j-1))")
+	g.P("}")
+	g.P("}")
+	g.P("l.Set(i, v)")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+
+	g.P("// ", jsonPatchFieldFuncName(f), " returns the field of md with the JSON or proto name")
+	g.P("// given by the token, or nil if there is none.")
+	g.P("func ", jsonPatchFieldFuncName(f), "(md ", protoreflectIdent("MessageDescriptor"), ", token string) ", protoreflectIdent("FieldDescriptor"), " {")
+	g.P("if fd := md.Fields().ByJSONName(token); fd != nil {")
+	g.P("return fd")
+	g.P("}")
+	g.P("return md.Fields().ByName(", protoreflectIdent("Name"), "(token))")
+	g.P("}")
+	g.P()
+
+	g.P("// ", jsonPatchIndexFuncName(f), " returns the index of a list with n elements given by")
+	g.P("// the token and whether it is in range. If add is set, the index may be n,")
+	g.P("// which is also given by the token \"-\".")
+	g.P("func ", jsonPatchIndexFuncName(f), "(token string, n int, add bool) (int, bool) {")
+	g.P(`if token == "-" && add {`)
+	g.P("return n, true")
+	g.P("}")
+	g.P(`if token == "" || (len(token) > 1 && token[0] == '0') {`)
+	g.P("return 0, false")
+	g.P("}")
+	g.P("i := 0")
+	g.P("for _, c := range token {")
+	g.P("if c < '0' || c > '9' || i > n {")
+	g.P("return 0, false")
+	g.P("}")
+	g.P("i = 10*i + int(c-'0')")
+	g.P("}")
+	g.P("return i, i < n || (add && i == n)")
+	g.P("}")
+	g.P()
+
+	g.P("// ", jsonPatchValueFuncName(f), " decodes the JSON value of the field fd of m, or of an")
+	g.P("// element of fd if elem is set. It returns an invalid value if the decoded")
+	g.P("// field is unset, such as for a null value.")
+	g.P("func ", jsonPatchValueFuncName(f), "(m ", protoreflectIdent("Message"), ", fd ", protoreflectIdent("FieldDescriptor"), ", value ", rawMessage, ", elem bool) (", protoreflectIdent("Value"), ", error) {")
+	g.P("if value == nil {")
+	g.P("return ", protoreflectIdent("Value"), "{}, ", errorf, "(\"missing value\")")
+	g.P("}")
+	g.P("if elem {")
+	g.P(`value = append(append(`, rawMessage, `("["), value...), ']')`)
+	g.P("}")
+	g.P("name, _ := ", jsonPackage.Ident("Marshal"), "(fd.JSONName())")
+	g.P(`b := append(append(append(append([]byte("{"), name...), ':'), value...), '}')`)
+	g.P("tmp := m.New()")
+	g.P("if err := ", protojsonPackage.Ident("Unmarshal"), "(b, tmp.Interface()); err != nil {")
+	g.P("return ", protoreflectIdent("Value"), "{}, err")
+	g.P("}")
+	g.P("switch {")
+	g.P("case elem:")
+	g.P("return tmp.Get(fd).List().Get(0), nil")
+	g.P("case !tmp.Has(fd):")
+	g.P("return ", protoreflectIdent("Value"), "{}, nil")
+	g.P("}")
+	g.P("return tmp.Get(fd), nil")
+	g.P("}")
+	g.P()
+}
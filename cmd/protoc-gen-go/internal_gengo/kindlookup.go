@@ -0,0 +1,33 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageKindByGoName generates the KindByGoName method, which resolves
+// the kind of a field from the Go name of the field.
+func genMessageKindByGoName(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	varName := messageVarName(f, m, "kindsByGoName")
+	g.P("var ", varName, " = map[string]", protoreflectPackage.Ident("Kind"), "{")
+	for _, field := range m.Fields {
+		g.P(strconv.Quote(field.GoName), ": ", protoreflectPackage.Ident(field.Desc.Kind().GoString()), ",")
+	}
+	g.P("}")
+	g.P()
+
+	g.P("// KindByGoName returns the kind of the field of ", m.GoIdent, " with the given")
+	g.P("// Go field name, and whether there is such a field. Members of a oneof are")
+	g.P("// identified by the field name within their wrapper type. The kind of a map")
+	g.P("// field is that of its entry message.")
+	g.P("func (*", m.GoIdent, ") KindByGoName(name string) (", protoreflectPackage.Ident("Kind"), ", bool) {")
+	g.P("k, ok := ", varName, "[name]")
+	g.P("return k, ok")
+	g.P("}")
+	g.P()
+}
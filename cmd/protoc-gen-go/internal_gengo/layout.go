@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// packedFieldOrder returns the fields of a message in the order in which the
+// corresponding struct fields are declared with the "layout=pack" parameter:
+// by descending size, which minimizes the padding inserted for alignment.
+// Fields of equal size retain their order of declaration in the .proto file.
+//
+// Reordering struct fields does not affect the wire format or reflection,
+// since the runtime identifies struct fields by name and protobuf tag.
+// The internal state field is emitted separately and always remains first.
+func packedFieldOrder(message *messageInfo) []*protogen.Field {
+	fields := append([]*protogen.Field(nil), message.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return structFieldSize(message, fields[i]) > structFieldSize(message, fields[j])
+	})
+	return fields
+}
+
+// structFieldSize returns the size in bytes of the struct field holding a field
+// of the message on a 64-bit platform.
+func structFieldSize(message *messageInfo, field *protogen.Field) int {
+	const (
+		pointerSize   = 8
+		interfaceSize = 2 * pointerSize
+		stringSize    = 2 * pointerSize
+		sliceSize     = 3 * pointerSize
+	)
+	if isOneofMember(field) {
+		return interfaceSize
+	}
+	switch {
+	case field.Desc.IsList():
+		if message.isOpaque() && field.Message != nil {
+			return pointerSize // *[]*T
+		}
+		return sliceSize
+	case field.Desc.IsMap():
+		return pointerSize
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return pointerSize
+	case protoreflect.BytesKind:
+		return sliceSize
+	}
+	if message.isOpaque() {
+		// Scalars of opaque messages track presence in a bitmap rather than
+		// through a pointer, except for strings.
+		if field.Desc.Kind() == protoreflect.StringKind && field.Desc.HasPresence() {
+			return pointerSize
+		}
+	} else if field.Desc.HasPresence() {
+		return pointerSize
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return stringSize
+	case protoreflect.BoolKind:
+		return 1
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind, protoreflect.DoubleKind:
+		return 8
+	default:
+		return 4
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func lenientFilterFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "lenientFilter")
+}
+
+func lenientWireTypeFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "lenientWireType")
+}
+
+// genMessageUnmarshalLenient generates the UnmarshalLenient method, which
+// unmarshals a message while skipping the fields with an unexpected wire type.
+func genMessageUnmarshalLenient(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// UnmarshalLenient parses the wire-format message in b and places the result")
+	g.P("// in x, like ", protoPackage.Ident("Unmarshal"), ". Fields of x or of its nested messages encoded")
+	g.P("// with an unexpected wire type, which Unmarshal retains as unknown fields,")
+	g.P("// are skipped instead, and each skipped field is described by a warning.")
+	g.P("func (x *", m.GoIdent, ") UnmarshalLenient(b []byte) (warnings []string, err error) {")
+	g.P("b, warnings = ", lenientFilterFuncName(f), "(x.ProtoReflect().Descriptor(), b, nil)")
+	g.P("return warnings, ", protoPackage.Ident("Unmarshal"), "(b, x)")
+	g.P("}")
+	g.P()
+}
+
+// genFileUnmarshalLenient generates the functions implementing
+// UnmarshalLenient for all messages of the file.
+func genFileUnmarshalLenient(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protowireType := protowirePackage.Ident("Type")
+	protoreflectKind := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name + "Kind") }
+
+	g.P("// ", lenientFilterFuncName(f), " returns a copy of b without the fields whose wire type")
+	g.P("// does not match the field of md with the same number, recursing into")
+	g.P("// message fields, and appends a warning to warnings for each removed field.")
+	g.P("// Malformed input is retained as is, to be reported by the parser.")
+	g.P("func ", lenientFilterFuncName(f), "(md ", protoreflectPackage.Ident("MessageDescriptor"), ", b []byte, warnings []string) ([]byte, []string) {")
+	g.P("out := make([]byte, 0, len(b))")
+	g.P("for len(b) > 0 {")
+	g.P("num, typ, n := ", protowirePackage.Ident("ConsumeTag"), "(b)")
+	g.P("if n < 0 {")
+	g.P("return append(out, b...), warnings")
+	g.P("}")
+	g.P("m := ", protowirePackage.Ident("ConsumeFieldValue"), "(num, typ, b[n:])")
+	g.P("if m < 0 {")
+	g.P("return append(out, b...), warnings")
+	g.P("}")
+	g.P("field, value := b[:n+m], b[n:n+m]")
+	g.P("b = b[n+m:]")
+	g.P()
+	g.P("fd := md.Fields().ByNumber(num)")
+	g.P("if fd == nil {")
+	g.P("out = append(out, field...) // unknown field or extension")
+	g.P("continue")
+	g.P("}")
+	g.P("want := ", lenientWireTypeFuncName(f), "(fd)")
+	g.P("packed := fd.IsList() && typ == ", protowirePackage.Ident("BytesType"), " && want != ", protowirePackage.Ident("BytesType"), " && want != ", protowirePackage.Ident("StartGroupType"))
+	g.P("switch {")
+	g.P("case typ != want && !packed:")
+	g.P("warnings = append(warnings, ", fmtPackage.Ident("Sprintf"), "(\"skipped field %v with wire type %d, want %d\", fd.FullName(), typ, want))")
+	g.P("case fd.Kind() == ", protoreflectKind("Message"), ":")
+	g.P("v, _ := ", protowirePackage.Ident("ConsumeBytes"), "(value)")
+	g.P("var nested []byte")
+	g.P("nested, warnings = ", lenientFilterFuncName(f), "(fd.Message(), v, warnings)")
+	g.P("out = ", protowirePackage.Ident("AppendTag"), "(out, num, typ)")
+	g.P("out = ", protowirePackage.Ident("AppendBytes"), "(out, nested)")
+	g.P("default:")
+	g.P("out = append(out, field...)")
+	g.P("}")
+	g.P("}")
+	g.P("return out, warnings")
+	g.P("}")
+	g.P()
+
+	g.P("// ", lenientWireTypeFuncName(f), " returns the wire type of the values of fd.")
+	g.P("func ", lenientWireTypeFuncName(f), "(fd ", protoreflectPackage.Ident("FieldDescriptor"), ") ", protowireType, " {")
+	g.P("switch fd.Kind() {")
+	g.P("case ", protoreflectKind("Bool"), ", ", protoreflectKind("Enum"), ",")
+	g.P(protoreflectKind("Int32"), ", ", protoreflectKind("Sint32"), ", ", protoreflectKind("Uint32"), ",")
+	g.P(protoreflectKind("Int64"), ", ", protoreflectKind("Sint64"), ", ", protoreflectKind("Uint64"), ":")
+	g.P("return ", protowirePackage.Ident("VarintType"))
+	g.P("case ", protoreflectKind("Fixed32"), ", ", protoreflectKind("Sfixed32"), ", ", protoreflectKind("Float"), ":")
+	g.P("return ", protowirePackage.Ident("Fixed32Type"))
+	g.P("case ", protoreflectKind("Fixed64"), ", ", protoreflectKind("Sfixed64"), ", ", protoreflectKind("Double"), ":")
+	g.P("return ", protowirePackage.Ident("Fixed64Type"))
+	g.P("case ", protoreflectKind("Group"), ":")
+	g.P("return ", protowirePackage.Ident("StartGroupType"))
+	g.P("default:")
+	g.P("return ", protowirePackage.Ident("BytesType"))
+	g.P("}")
+	g.P("}")
+	g.P()
+}
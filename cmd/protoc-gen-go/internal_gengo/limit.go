@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageLimitCollections generates the LimitCollections method, which caps
+// the number of elements held by every repeated and map field of a message.
+func genMessageLimitCollections(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// LimitCollections truncates every repeated field of x and trims every map")
+	g.P("// field of x to at most max entries, recursing into message values.")
+	g.P("// Map entries are retained in ascending key order.")
+	g.P("func (x *", m.GoIdent, ") LimitCollections(max int) {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("if max < 0 {")
+	g.P("max = 0")
+	g.P("}")
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			if field.Message != nil {
+				getterName, _ := field.MethodName("Get")
+				genLimitCollectionsCall(g, f, field.Message, "x."+getterName+"()")
+			}
+			continue
+		}
+		v := fieldValueExpr(m, "x", field)
+		switch {
+		case field.Desc.IsList():
+			g.P("if len(", v, ") > max {")
+			g.P(fieldAssignStmt(m, "x", field, v+"[:max]"))
+			g.P("}")
+			if field.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genLimitCollectionsCall(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsMap():
+			keyField, valField := field.Message.Fields[0], field.Message.Fields[1]
+			keyType, _ := fieldGoType(g, f, keyField)
+			less := "keys[i] < keys[j]"
+			if keyField.Desc.Kind() == protoreflect.BoolKind {
+				less = "!keys[i] && keys[j]"
+			}
+			mv := v
+			if isCopiedMap(m, field) {
+				mv = "mv"
+				g.P("if mv := ", v, "; len(mv) > max {")
+			} else {
+				g.P("if len(", v, ") > max {")
+			}
+			g.P("keys := make([]", keyType, ", 0, len(", mv, "))")
+			g.P("for k := range ", mv, " {")
+			g.P("keys = append(keys, k)")
+			g.P("}")
+			g.P(sortPackage.Ident("Slice"), "(keys, func(i, j int) bool { return ", less, " })")
+			g.P("for _, k := range keys[max:] {")
+			g.P("delete(", mv, ", k)")
+			g.P("}")
+			if isCopiedMap(m, field) {
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+			}
+			g.P("}")
+			if valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genLimitCollectionsCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Message != nil:
+			genLimitCollectionsCall(g, f, field.Message, v)
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// genLimitCollectionsCall generates a call of LimitCollections on the message
+// value v. Messages declared in other files are only limited if they were
+// also generated with the method.
+func genLimitCollectionsCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".LimitCollections(max)")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface{ LimitCollections(int) }); ok {")
+	g.P("m.LimitCollections(max)")
+	g.P("}")
+}
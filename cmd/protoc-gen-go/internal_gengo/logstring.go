@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// genMessageLogString generates the LogString method, which formats the
+// populated fields of a message on a single line for structured logs.
+func genMessageLogString(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// LogString returns a single-line rendering of the populated fields of x")
+	g.P("// as space-separated name=value pairs, in the order in which the fields")
+	g.P("// are declared. Message fields are rendered as <MessageName>, repeated and")
+	g.P("// map fields as their number of elements, and fields marked as sensitive")
+	g.P("// as <redacted>.")
+	g.P("func (x *", m.GoIdent, ") LogString() string {")
+	g.P("if x == nil {")
+	g.P(`return "<nil>"`)
+	g.P("}")
+	g.P("var b ", stringsPackage.Ident("Builder"))
+	for _, field := range m.Fields {
+		sensitive := isSensitiveField(field)
+		var v string
+		if isOneofMember(field) && m.isOpen() && (sensitive || field.Message != nil) {
+			// The value of the field is not rendered.
+			g.P("if _, ok := x.", field.Oneof.GoName, ".(*", opaqueFieldOneofType(field, false), "); ok {")
+		} else {
+			v = genIfFieldPopulated(g, f, m, "x", field)
+		}
+		g.P("if b.Len() > 0 {")
+		g.P("b.WriteByte(' ')")
+		g.P("}")
+		key := string(field.Desc.Name()) + "="
+		switch {
+		case sensitive:
+			g.P(`b.WriteString("`, key, `<redacted>")`)
+		case field.Desc.IsList() || field.Desc.IsMap():
+			g.P(fmtPackage.Ident("Fprintf"), `(&b, "`, key, `<%d elements>", len(`, v, "))")
+		case field.Message != nil:
+			g.P(`b.WriteString("`, key, `<`, field.Message.Desc.Name(), `>")`)
+		case field.Desc.Kind() == protoreflect.StringKind || field.Desc.Kind() == protoreflect.BytesKind:
+			g.P(fmtPackage.Ident("Fprintf"), `(&b, "`, key, `%q", `, v, ")")
+		default:
+			g.P(fmtPackage.Ident("Fprint"), `(&b, "`, key, `", `, v, ")")
+		}
+		g.P("}")
+	}
+	g.P("return b.String()")
+	g.P("}")
+	g.P()
+}
+
+// isSensitiveField reports whether the value of a field must not be logged,
+// as indicated by the sensitive option or the standard debug_redact option.
+func isSensitiveField(field *protogen.Field) bool {
+	opts := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return opts.GetDebugRedact() || optionBool(opts, sensitive_fieldNumber)
+}
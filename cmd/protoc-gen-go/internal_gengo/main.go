@@ -42,13 +42,24 @@ var GenerateVersionMarkers = true
 // Standard library dependencies.
 const (
 	base64Package  = protogen.GoImportPath("encoding/base64")
+	binaryPackage  = protogen.GoImportPath("encoding/binary")
+	fmtPackage     = protogen.GoImportPath("fmt")
+	fnvPackage     = protogen.GoImportPath("hash/fnv")
+	hexPackage     = protogen.GoImportPath("encoding/hex")
+	ioPackage      = protogen.GoImportPath("io")
+	iterPackage    = protogen.GoImportPath("iter")
 	jsonPackage    = protogen.GoImportPath("encoding/json")
+	mapsPackage    = protogen.GoImportPath("maps")
 	mathPackage    = protogen.GoImportPath("math")
+	osPackage      = protogen.GoImportPath("os")
 	reflectPackage = protogen.GoImportPath("reflect")
+	sha256Package  = protogen.GoImportPath("crypto/sha256")
 	sortPackage    = protogen.GoImportPath("sort")
+	strconvPackage = protogen.GoImportPath("strconv")
 	stringsPackage = protogen.GoImportPath("strings")
 	syncPackage    = protogen.GoImportPath("sync")
 	timePackage    = protogen.GoImportPath("time")
+	urlPackage     = protogen.GoImportPath("net/url")
 	utf8Package    = protogen.GoImportPath("unicode/utf8")
 	unsafePackage  = protogen.GoImportPath("unsafe")
 )
@@ -60,9 +71,11 @@ const (
 // on the dependencies of generated source code.
 var (
 	protoPackage         goImportPath = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	protowirePackage     goImportPath = protogen.GoImportPath("google.golang.org/protobuf/encoding/protowire")
 	protoifacePackage    goImportPath = protogen.GoImportPath("google.golang.org/protobuf/runtime/protoiface")
 	protoimplPackage     goImportPath = protogen.GoImportPath("google.golang.org/protobuf/runtime/protoimpl")
 	protojsonPackage     goImportPath = protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+	prototextPackage     goImportPath = protogen.GoImportPath("google.golang.org/protobuf/encoding/prototext")
 	protoreflectPackage  goImportPath = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
 	protoregistryPackage goImportPath = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoregistry")
 )
@@ -93,6 +106,19 @@ func generateFiles(gen *protogen.Plugin, file *protogen.File) []*protogen.Genera
 	generated := []*protogen.GeneratedFile{
 		generateOneFile(gen, file, f, ""),
 	}
+	if generateDTO.enabled {
+		if err := checkDTOMessages(f); err != nil {
+			gen.Error(err)
+		} else {
+			generated = append(generated, genDTOFiles(gen, f)...)
+		}
+	}
+	if generateHelpers.enabled["iter"] {
+		generated = append(generated, genIterFile(gen, f))
+	}
+	if generateFixtures.enabled {
+		generated = append(generated, genFixturesFile(gen, f))
+	}
 	if f.APILevel == gofeaturespb.GoFeatures_API_HYBRID {
 		// Update all APILevel fields to OPAQUE
 		f.APILevel = gofeaturespb.GoFeatures_API_OPAQUE
@@ -108,6 +134,22 @@ func generateOneFile(gen *protogen.Plugin, file *protogen.File, f *fileInfo, var
 	filename := file.GeneratedFilenamePrefix + variant + ".pb.go"
 	g := gen.NewGeneratedFile(filename, file.GoImportPath)
 
+	if err := resolveConvertTargets(gen, f); err != nil {
+		gen.Error(err)
+		g.Skip()
+		return g
+	}
+	if err := resolveCommonOneofs(g, f); err != nil {
+		gen.Error(err)
+		g.Skip()
+		return g
+	}
+	if err := checkJSONFieldRules(f); err != nil {
+		gen.Error(err)
+		g.Skip()
+		return g
+	}
+
 	var packageDoc protogen.Comments
 	if !gen.InternalStripForEditionsDiff() {
 		genStandaloneComments(g, f, int32(genid.FileDescriptorProto_Syntax_field_number))
@@ -144,6 +186,11 @@ func generateOneFile(gen *protogen.Plugin, file *protogen.File, f *fileInfo, var
 	for _, message := range f.allMessages {
 		genMessage(g, f, message)
 	}
+	if err := genFileOptionalDecls(g, f); err != nil {
+		gen.Error(err)
+		g.Skip()
+		return g
+	}
 	genExtensions(g, f)
 
 	// The descriptor contains a lot of information about the syntax which is
@@ -326,6 +373,7 @@ func genEnum(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
 		g.P(")")
 		g.P()
 	}
+	genEnumFormerNames(g, e)
 
 	// Enum value maps.
 	g.P("// Enum value maps for ", e.GoIdent, ".")
@@ -360,10 +408,14 @@ func genEnum(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
 	g.P()
 
 	// String method.
-	g.P("func (x ", e.GoIdent, ") String() string {")
-	g.P("return ", protoimplPackage.Ident("X"), ".EnumStringOf(x.Descriptor(), ", protoreflectPackage.Ident("EnumNumber"), "(x))")
-	g.P("}")
-	g.P()
+	if usesSwitchString(e) {
+		genEnumSwitchString(g, e)
+	} else {
+		g.P("func (x ", e.GoIdent, ") String() string {")
+		g.P("return ", protoimplPackage.Ident("X"), ".EnumStringOf(x.Descriptor(), ", protoreflectPackage.Ident("EnumNumber"), "(x))")
+		g.P("}")
+		g.P()
+	}
 
 	genEnumReflectMethods(g, f, e)
 
@@ -387,17 +439,15 @@ func genEnum(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
 
 	// EnumDescriptor method.
 	if e.genRawDescMethod {
-		var indexes []string
-		for i := 1; i < len(e.Location.Path); i += 2 {
-			indexes = append(indexes, strconv.Itoa(int(e.Location.Path[i])))
-		}
 		g.P("// Deprecated: Use ", e.GoIdent, ".Descriptor instead.")
 		g.P("func (", e.GoIdent, ") EnumDescriptor() ([]byte, []int) {")
-		g.P("return ", rawDescVarName(f), "GZIP(), []int{", strings.Join(indexes, ","), "}")
+		g.P("return ", rawDescVarName(f), "GZIP(), []int{", descriptorIndexes(e.Location), "}")
 		g.P("}")
 		g.P()
 		f.needRawDesc = true
 	}
+
+	genEnumOptionalMethods(g, f, e)
 }
 
 func genMessage(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
@@ -586,6 +636,9 @@ func genMessageMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
 func genMessageBaseMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
 	// Reset method.
 	g.P("func (x *", m.GoIdent, ") Reset() {")
+	if freezable(m) {
+		genCheckFrozen(g, m, "Reset")
+	}
 	g.P("*x = ", m.GoIdent, "{}")
 	g.P("mi := &", messageTypesVarName(f), "[", f.allMessagesByPtr[m], "]")
 	g.P("ms := ", protoimplPackage.Ident("X"), ".MessageStateOf(", protoimplPackage.Ident("Pointer"), "(x))")
@@ -594,9 +647,18 @@ func genMessageBaseMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInf
 	g.P()
 
 	// String method.
-	g.P("func (x *", m.GoIdent, ") String() string {")
-	g.P("return ", protoimplPackage.Ident("X"), ".MessageStringOf(x)")
-	g.P("}")
+	if optionBool(m.Desc.Options().(*descriptorpb.MessageOptions), omitString_fieldNumber) {
+		g.P("// String returns the full name of the message type. The contents of the")
+		g.P("// message are omitted by the omit_string option; use prototext.Format")
+		g.P("// to render them.")
+		g.P("func (x *", m.GoIdent, ") String() string {")
+		g.P("return ", strconv.Quote(string(m.Desc.FullName())))
+		g.P("}")
+	} else {
+		g.P("func (x *", m.GoIdent, ") String() string {")
+		g.P("return ", protoimplPackage.Ident("X"), ".MessageStringOf(x)")
+		g.P("}")
+	}
 	g.P()
 
 	// ProtoMessage method.
@@ -608,13 +670,9 @@ func genMessageBaseMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInf
 
 	// Descriptor method.
 	if m.genRawDescMethod {
-		var indexes []string
-		for i := 1; i < len(m.Location.Path); i += 2 {
-			indexes = append(indexes, strconv.Itoa(int(m.Location.Path[i])))
-		}
 		g.P("// Deprecated: Use ", m.GoIdent, ".ProtoReflect.Descriptor instead.")
 		g.P("func (*", m.GoIdent, ") Descriptor() ([]byte, []int) {")
-		g.P("return ", rawDescVarName(f), "GZIP(), []int{", strings.Join(indexes, ","), "}")
+		g.P("return ", rawDescVarName(f), "GZIP(), []int{", descriptorIndexes(m.Location), "}")
 		g.P("}")
 		g.P()
 		f.needRawDesc = true
@@ -0,0 +1,97 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageMapStrings generates the MapStrings method, which replaces the
+// value of every string field of a message with the result of a function.
+func genMessageMapStrings(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MapStrings replaces the value of every populated string field of x and of")
+	g.P("// its nested messages with the result of calling f with the name of the field")
+	g.P("// and its value. Each element of a repeated string field and each string")
+	g.P("// value of a map field is replaced likewise. Map keys are left unchanged.")
+	g.P("func (x *", m.GoIdent, ") MapStrings(f func(fieldName ", protoreflectPackage.Ident("Name"), ", s string) string) {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	for _, field := range m.Fields {
+		name := strconv.Quote(string(field.Desc.Name()))
+		switch {
+		case field.Desc.IsMap():
+			valField := field.Message.Fields[1]
+			switch {
+			case valField.Desc.Kind() == protoreflect.StringKind && isCopiedMap(m, field):
+				g.P("if mv := ", fieldValueExpr(m, "x", field), "; len(mv) > 0 {")
+				g.P("for k, s := range mv {")
+				g.P("mv[k] = f(", name, ", s)")
+				g.P("}")
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+				g.P("}")
+			case valField.Desc.Kind() == protoreflect.StringKind:
+				v := fieldValueExpr(m, "x", field)
+				g.P("for k, s := range ", v, " {")
+				g.P(v, "[k] = f(", name, ", s)")
+				g.P("}")
+			case valField.Message != nil:
+				g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+				genMapStringsCall(g, f, valField.Message, "v")
+				g.P("}")
+			default:
+				continue
+			}
+		case field.Desc.IsList() && field.Desc.Kind() == protoreflect.StringKind:
+			v := fieldValueExpr(m, "x", field)
+			g.P("for i, s := range ", v, " {")
+			g.P(v, "[i] = f(", name, ", s)")
+			g.P("}")
+		case field.Desc.IsList() && field.Message != nil:
+			g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+			genMapStringsCall(g, f, field.Message, "v")
+			g.P("}")
+		case field.Desc.IsList():
+			continue
+		case field.Message != nil:
+			getterName, _ := field.MethodName("Get")
+			genMapStringsCall(g, f, field.Message, "x."+getterName+"()")
+		case field.Desc.Kind() == protoreflect.StringKind:
+			v := genIfFieldPopulated(g, f, m, "x", field)
+			if m.isOpen() {
+				dst := v
+				if _, pointer := fieldGoType(g, f, field); pointer && !isOneofMember(field) {
+					dst = "*" + fieldValueExpr(m, "x", field)
+				}
+				g.P(dst, " = f(", name, ", ", v, ")")
+			} else {
+				g.P("x.", fieldSetterName(field), "(f(", name, ", ", v, "))")
+			}
+			g.P("}")
+		default:
+			continue
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// genMapStringsCall generates a call of MapStrings on the message value v.
+// Messages declared in other files are only mapped if they were also
+// generated with the method.
+func genMapStringsCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".MapStrings(f)")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface {")
+	g.P("MapStrings(func(", protoreflectPackage.Ident("Name"), ", string) string)")
+	g.P("}); ok {")
+	g.P("m.MapStrings(f)")
+	g.P("}")
+}
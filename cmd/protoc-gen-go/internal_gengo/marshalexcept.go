@@ -0,0 +1,35 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageMarshalExcept generates the MarshalExcept method, which marshals
+// a message without the fields with the given numbers.
+func genMessageMarshalExcept(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MarshalExcept returns the wire-format encoding of x without the fields")
+	g.P("// with the given numbers, which are cleared in a copy of x before it is")
+	g.P("// marshaled. It reports an error if a number is not that of a field of")
+	g.P("// ", m.GoIdent.GoName, ".")
+	g.P("func (x *", m.GoIdent, ") MarshalExcept(except ...", protoreflectPackage.Ident("FieldNumber"), ") ([]byte, error) {")
+	g.P("fds := x.ProtoReflect().Descriptor().Fields()")
+	g.P("for _, num := range except {")
+	g.P("if fds.ByNumber(num) == nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(\"", m.Desc.FullName(), " has no field number %d\", num)")
+	g.P("}")
+	g.P("}")
+	g.P("if x == nil || len(except) == 0 {")
+	g.P("return ", protoPackage.Ident("Marshal"), "(x)")
+	g.P("}")
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x).ProtoReflect()")
+	g.P("for _, num := range except {")
+	g.P("y.Clear(fds.ByNumber(num))")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("Marshal"), "(y.Interface())")
+	g.P("}")
+	g.P()
+}
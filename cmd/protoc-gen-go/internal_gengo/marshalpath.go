@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func marshalPathFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "marshalPath")
+}
+
+// genMessageMarshalPath generates the MarshalPath method, which marshals the
+// submessage of a message addressed by a dotted path of field names.
+func genMessageMarshalPath(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MarshalPath returns the wire-format encoding of the submessage of x")
+	g.P("// addressed by path, which is a dot-separated list of field names. Every")
+	g.P("// component of the path must name a singular message field. An unset")
+	g.P("// submessage is marshaled as an empty message.")
+	g.P("func (x *", m.GoIdent, ") MarshalPath(path string) ([]byte, error) {")
+	g.P("return ", marshalPathFuncName(f), "(x.ProtoReflect(), path)")
+	g.P("}")
+	g.P()
+}
+
+// genFileMarshalPath generates the function implementing MarshalPath for all
+// messages of the file, which resolves the path against the descriptor of the
+// message.
+func genFileMarshalPath(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	errorf := fmtPackage.Ident("Errorf")
+	g.P("func ", marshalPathFuncName(f), "(m ", protoreflectPackage.Ident("Message"), ", path string) ([]byte, error) {")
+	g.P("md := m.Descriptor()")
+	g.P("for _, name := range ", stringsPackage.Ident("Split"), "(path, \".\") {")
+	g.P("fd := m.Descriptor().Fields().ByName(", protoreflectPackage.Ident("Name"), "(name))")
+	g.P("switch {")
+	g.P("case fd == nil:")
+	g.P("return nil, ", errorf, "(\"invalid path %q for message %v: no field %q in %v\", path, md.FullName(), name, m.Descriptor().FullName())")
+	g.P("case fd.Message() == nil || fd.Cardinality() == ", protoreflectPackage.Ident("Repeated"), ":")
+	g.P("return nil, ", errorf, "(\"invalid path %q for message %v: %v is not a singular message field\", path, md.FullName(), fd.FullName())")
+	g.P("}")
+	g.P("m = m.Get(fd).Message()")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("Marshal"), "(m.Interface())")
+	g.P("}")
+	g.P()
+}
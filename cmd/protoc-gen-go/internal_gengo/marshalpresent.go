@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func clearImplicitZeroFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "clearImplicitZero")
+}
+
+// genMessageMarshalPresentOnly generates the MarshalPresentOnly method, which
+// marshals a message without its fields with implicit presence which hold
+// the zero value of their type.
+func genMessageMarshalPresentOnly(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MarshalPresentOnly returns the wire-format encoding of a copy of x from")
+	g.P("// which the fields with implicit presence holding the zero value of their")
+	g.P("// type have been cleared, recursively, leaving the fields with explicit")
+	g.P("// presence which were set, even to their zero value.")
+	g.P("//")
+	g.P("// Fields with implicit presence, such as proto3 scalar fields declared")
+	g.P("// without the optional label, cannot tell an explicit zero value from an")
+	g.P("// unset field. Since ", protoPackage.Ident("Marshal"), " already omits most of them when they")
+	g.P("// hold the zero value, the output only differs from that of proto.Marshal")
+	g.P("// for floating-point fields holding negative zero, which are omitted too.")
+	g.P("func (x *", m.GoIdent, ") MarshalPresentOnly() ([]byte, error) {")
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x)")
+	g.P(clearImplicitZeroFuncName(f), "(y.ProtoReflect())")
+	g.P("return ", protoPackage.Ident("Marshal"), "(y)")
+	g.P("}")
+	g.P()
+}
+
+// genFileMarshalPresentOnly generates the function implementing
+// MarshalPresentOnly for all messages of the file.
+func genFileMarshalPresentOnly(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	g.P("// ", clearImplicitZeroFuncName(f), " clears the fields of m and of its nested messages")
+	g.P("// which have implicit presence and hold the default value of their type.")
+	g.P("func ", clearImplicitZeroFuncName(f), "(m ", protoreflectIdent("Message"), ") {")
+	g.P("m.Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P("switch {")
+	g.P("case fd.IsList() && fd.Message() != nil:")
+	g.P("for i, l := 0, v.List(); i < l.Len(); i++ {")
+	g.P(clearImplicitZeroFuncName(f), "(l.Get(i).Message())")
+	g.P("}")
+	g.P("case fd.IsMap() && fd.MapValue().Message() != nil:")
+	g.P("v.Map().Range(func(_ ", protoreflectIdent("MapKey"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P(clearImplicitZeroFuncName(f), "(v.Message())")
+	g.P("return true")
+	g.P("})")
+	g.P("case fd.IsList() || fd.IsMap():")
+	g.P("case fd.Message() != nil:")
+	g.P(clearImplicitZeroFuncName(f), "(v.Message())")
+	g.P("case !fd.HasPresence() && v.Equal(fd.Default()):")
+	g.P("m.Clear(fd)")
+	g.P("}")
+	g.P("return true")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
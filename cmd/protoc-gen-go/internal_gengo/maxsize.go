@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageCheckMaxSize generates the CheckMaxSize method, which rejects
+// messages whose wire-format encoding is too large.
+func genMessageCheckMaxSize(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// CheckMaxSize reports an error if the wire-format encoding of x, as given")
+	g.P("// by ", protoPackage.Ident("Size"), ", is longer than limit bytes. The size of x and of its")
+	g.P("// nested messages is cached, so it need not be recomputed by a following")
+	g.P("// marshal with ", protoPackage.Ident("MarshalOptions"), ".UseCachedSize.")
+	g.P("func (x *", m.GoIdent, ") CheckMaxSize(limit int) error {")
+	g.P("if n := ", protoPackage.Ident("Size"), "(x); n > limit {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"", m.Desc.FullName(), ": message size %d exceeds the limit of %d bytes\", n, limit)")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
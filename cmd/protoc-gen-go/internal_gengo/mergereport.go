@@ -0,0 +1,126 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageMergeReport generates the MergeReport method, which merges
+// another message into a message and reports the conflicting fields.
+func genMessageMergeReport(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MergeReport merges src into x as by proto.Merge, except that a singular")
+	g.P("// field populated in both x and src with different values keeps the value")
+	g.P("// of x, and its name is reported in conflicts, in the order in which the")
+	g.P("// fields are declared. Setting a different member of a oneof than the one")
+	g.P("// set in x is also a conflict.")
+	g.P("//")
+	g.P("// Singular message fields are merged recursively, and reported if merging")
+	g.P("// them reports a conflict; messages declared in other files are compared")
+	g.P("// as a whole instead. Repeated fields are appended to, and the entries of")
+	g.P("// map fields are copied into x. Unknown fields and extensions are not merged.")
+	g.P("func (x *", m.GoIdent, ") MergeReport(src *", m.GoIdent, ") (conflicts []", protoreflectPackage.Ident("Name"), ", err error) {")
+	g.P("if src == nil || x == src {")
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("if x == nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(", strconv.Quote("cannot merge into nil *"+m.GoIdent.GoName), ")")
+	g.P("}")
+	for _, field := range m.Fields {
+		name := strconv.Quote(string(field.Desc.Name()))
+		v := genIfFieldPopulated(g, f, m, "src", field)
+		switch {
+		case field.Desc.IsList():
+			l := fieldValueExpr(m, "x", field)
+			if field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind {
+				g.P("l := ", l)
+				g.P("for _, e := range ", v, " {")
+				g.P("l = append(l, ", cloneValueExpr(g, field, "e"), ")")
+				g.P("}")
+				l = "l"
+			} else {
+				l = "append(" + l + ", " + v + "...)"
+			}
+			g.P(fieldAssignStmt(m, "x", field, l))
+		case field.Desc.IsMap():
+			goType, _ := fieldGoType(g, f, field)
+			g.P("mv := ", fieldValueExpr(m, "x", field))
+			g.P("if mv == nil {")
+			g.P("mv = make(", goType, ", len(", v, "))")
+			if !isCopiedMap(m, field) {
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+			}
+			g.P("}")
+			g.P("for k, e := range ", v, " {")
+			g.P("mv[k] = ", cloneValueExpr(g, field.Message.Fields[1], "e"))
+			g.P("}")
+			if isCopiedMap(m, field) {
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+			}
+		default:
+			getterName, _ := field.MethodName("Get")
+			g.P("if ", fieldPopulatedCond(g, m, "x", field), " {")
+			switch {
+			case field.Message != nil && isLocalMessage(f, field.Message):
+				g.P("c, err := x.", getterName, "().MergeReport(", v, ")")
+				g.P("if err != nil {")
+				g.P("return conflicts, err")
+				g.P("}")
+				g.P("if len(c) > 0 {")
+				g.P("conflicts = append(conflicts, ", name, ")")
+				g.P("}")
+			case field.Message != nil:
+				g.P("if !", protoPackage.Ident("Equal"), "(x.", getterName, "(), ", v, ") {")
+				g.P("conflicts = append(conflicts, ", name, ")")
+				g.P("}")
+			case field.Desc.Kind() == protoreflect.BytesKind:
+				g.P("if string(x.", getterName, "()) != string(", v, ") {")
+				g.P("conflicts = append(conflicts, ", name, ")")
+				g.P("}")
+			default:
+				g.P("if x.", getterName, "() != ", v, " {")
+				g.P("conflicts = append(conflicts, ", name, ")")
+				g.P("}")
+			}
+			if isOneofMember(field) {
+				g.P("} else if ", mergeReportOneofPopulatedCond(m, field.Oneof), " {")
+				g.P("conflicts = append(conflicts, ", name, ")")
+			}
+			g.P("} else {")
+			switch {
+			case isOneofMember(field) && m.isOpen():
+				oneofType := opaqueFieldOneofType(field, false)
+				g.P("x.", field.Oneof.GoName, " = &", oneofType, "{", field.GoName, ": ", cloneValueExpr(g, field, v), "}")
+			case isOneofMember(field):
+				setterName := fieldSetterName(field)
+				g.P("x.", setterName, "(", cloneValueExpr(g, field, v), ")")
+			default:
+				if _, pointer := fieldGoType(g, f, field); pointer && m.isOpen() {
+					g.P("t := ", v)
+					g.P(fieldAssignStmt(m, "x", field, "&t"))
+				} else {
+					g.P(fieldAssignStmt(m, "x", field, cloneValueExpr(g, field, v)))
+				}
+			}
+			g.P("}")
+		}
+		g.P("}")
+	}
+	g.P("return conflicts, nil")
+	g.P("}")
+	g.P()
+}
+
+// mergeReportOneofPopulatedCond returns a condition reporting whether any
+// member of a oneof of the message in x is set.
+func mergeReportOneofPopulatedCond(m *messageInfo, oneof *protogen.Oneof) string {
+	if m.isOpen() {
+		return "x." + oneof.GoName + " != nil"
+	}
+	return "x." + oneof.MethodName("Has") + "()"
+}
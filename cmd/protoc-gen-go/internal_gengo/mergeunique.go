@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageMergeUniqueMethods generates a MergeUniqueFoo method for each
+// repeated field foo of a message with comparable elements, which appends the
+// values not already present in the field.
+func genMessageMergeUniqueMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsList() {
+			continue
+		}
+		switch field.Desc.Kind() {
+		case protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+			continue
+		}
+		goType, _ := fieldGoType(g, f, field)
+		elemType := strings.TrimPrefix(goType, "[]")
+		v := fieldValueExpr(m, "x", field)
+		g.P("// MergeUnique", field.GoName, " appends to the ", field.Desc.Name(), " field each of vals which is not")
+		g.P("// already present in the field or earlier in vals, preserving their order.")
+		g.P("func (x *", m.GoIdent, ") MergeUnique", field.GoName, "(vals ...", elemType, ") {")
+		g.P("l := ", v)
+		g.P("seen := make(map[", elemType, "]struct{}, len(l)+len(vals))")
+		g.P("for _, v := range l {")
+		g.P("seen[v] = struct{}{}")
+		g.P("}")
+		g.P("for _, v := range vals {")
+		g.P("if _, ok := seen[v]; !ok {")
+		g.P("seen[v] = struct{}{}")
+		g.P("l = append(l, v)")
+		g.P("}")
+		g.P("}")
+		g.P(fieldAssignStmt(m, "x", field, "l"))
+		g.P("}")
+		g.P()
+	}
+}
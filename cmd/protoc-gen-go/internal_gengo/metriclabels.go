@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageMetricFieldLabels generates the MetricFieldLabels method, which
+// returns the names of the fields of a message for use as metric labels.
+func genMessageMetricFieldLabels(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	varName := messageVarName(f, m, "metricFieldLabels")
+	g.P("var ", varName, " = []string{")
+	for _, field := range m.Fields {
+		g.P(strconv.Quote(string(field.Desc.Name())), ",")
+	}
+	g.P("}")
+	g.P()
+
+	g.P("// MetricFieldLabels returns the proto names of the fields of ", m.GoIdent.GoName, " in the")
+	g.P("// order in which they are declared, for use as the label names of metrics.")
+	g.P("// Fields of nested messages are not included. The returned slice is shared")
+	g.P("// by all calls and must not be modified.")
+	g.P("func (*", m.GoIdent, ") MetricFieldLabels() []string {")
+	g.P("return ", varName)
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageNestedMessageCount generates the NestedMessageCount method, which
+// counts the message values reachable from a message.
+func genMessageNestedMessageCount(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// NestedMessageCount returns the number of non-nil messages reachable from x,")
+	g.P("// not counting x itself, including the elements of repeated and map fields.")
+	g.P("func (x *", m.GoIdent, ") NestedMessageCount() int {")
+	g.P("if x == nil {")
+	g.P("return 0")
+	g.P("}")
+	g.P("n := 0")
+	for _, field := range m.Fields {
+		if isOneofMember(field) {
+			if field.Message != nil {
+				getterName, _ := field.MethodName("Get")
+				genNestedMessageCount(g, f, field.Message, "x."+getterName+"()")
+			}
+			continue
+		}
+		v := fieldValueExpr(m, "x", field)
+		switch {
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genNestedMessageCount(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genNestedMessageCount(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Message != nil:
+			genNestedMessageCount(g, f, field.Message, v)
+		}
+	}
+	g.P("return n")
+	g.P("}")
+	g.P()
+}
+
+// genNestedMessageCount generates code adding the message value v and the
+// messages reachable from it to n. Messages declared in other files are only
+// recursed into if they were also generated with the method.
+func genNestedMessageCount(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	g.P("if ", v, " != nil {")
+	if isLocalMessage(f, message) {
+		g.P("n += 1 + ", v, ".NestedMessageCount()")
+	} else {
+		g.P("n++")
+		g.P("if m, ok := any(", v, ").(interface{ NestedMessageCount() int }); ok {")
+		g.P("n += m.NestedMessageCount()")
+		g.P("}")
+	}
+	g.P("}")
+}
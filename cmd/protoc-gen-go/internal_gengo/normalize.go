@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageNormalizePresence generates the NormalizePresence method, which
+// resets the fields with implicit presence which equal the default value of
+// their type to the canonical default.
+//
+// Fields with implicit presence are not marshaled if they equal the default
+// value of their type, so only the values which compare equal to the
+// default without being identical to it need to be reset: negative zero for
+// floating-point fields, and empty non-nil slices for bytes fields. The
+// latter are only reset for the open struct API, since the setters of the
+// other APIs do not distinguish them from nil.
+func genMessageNormalizePresence(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// NormalizePresence clears the fields of x and of its nested messages which")
+	g.P("// have implicit presence and equal the default value of their type, so that")
+	g.P("// they are not marshaled. A field has implicit presence if it is a singular")
+	g.P("// scalar field, other than a oneof member, declared without the optional")
+	g.P("// label in proto3, or with features.field_presence = IMPLICIT in editions.")
+	g.P("// Fields of proto2 messages, message fields and repeated and map fields")
+	g.P("// are not affected. Since only negative zero and empty bytes values equal")
+	g.P("// the default of their type without being identical to it, other fields")
+	g.P("// are left unchanged.")
+	g.P("func (x *", m.GoIdent, ") NormalizePresence() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	for _, field := range m.Fields {
+		if field.Message != nil {
+			switch {
+			case isOneofMember(field):
+				getterName, _ := field.MethodName("Get")
+				genNormalizePresenceCall(g, f, field.Message, "x."+getterName+"()")
+			case field.Desc.IsMap():
+				if valField := field.Message.Fields[1]; valField.Message != nil {
+					g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+					genNormalizePresenceCall(g, f, valField.Message, "v")
+					g.P("}")
+				}
+			case field.Desc.IsList():
+				g.P("for _, v := range ", fieldValueExpr(m, "x", field), " {")
+				genNormalizePresenceCall(g, f, field.Message, "v")
+				g.P("}")
+			default:
+				genNormalizePresenceCall(g, f, field.Message, fieldValueExpr(m, "x", field))
+			}
+			continue
+		}
+		if field.Desc.HasPresence() || field.Desc.IsList() {
+			continue
+		}
+		v := fieldValueExpr(m, "x", field)
+		switch field.Desc.Kind() {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			g.P("if ", v, " == 0 { // also true for negative zero")
+			g.P(fieldAssignStmt(m, "x", field, "0"))
+			g.P("}")
+		case protoreflect.BytesKind:
+			if m.isOpen() {
+				g.P("if ", v, " != nil && len(", v, ") == 0 {")
+				g.P(fieldAssignStmt(m, "x", field, "nil"))
+				g.P("}")
+			}
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// genNormalizePresenceCall generates a call of NormalizePresence on the
+// message value v. Messages declared in other files are only normalized if
+// they were also generated with the method.
+func genNormalizePresenceCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".NormalizePresence()")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface{ NormalizePresence() }); ok {")
+	g.P("m.NormalizePresence()")
+	g.P("}")
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageOneofConstructors generates a NewT_Foo function for each oneof
+// wrapper type T_Foo of a message, which wraps a value for assignment to the
+// oneof field.
+//
+// The wrapper types are unexported for the opaque API, which sets oneof
+// members with setters, so no constructors are generated for it.
+func genMessageOneofConstructors(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if m.isOpaque() {
+		return
+	}
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		for _, field := range oneof.Fields {
+			wrapper := opaqueFieldOneofType(field, false)
+			goType, _ := fieldGoType(g, f, field)
+			g.P("// New", wrapper.GoName, " returns a ", wrapper.GoName, " holding v, which may be assigned")
+			g.P("// to the ", oneof.GoName, " field of ", m.GoIdent, " to set the ", field.Desc.Name(), " field.")
+			g.P("func New", wrapper.GoName, "(v ", goType, ") *", wrapper, " {")
+			g.P("return &", wrapper, "{", field.GoName, ": v}")
+			g.P("}")
+			g.P()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// oneofTaggedType is the type of the discriminated representation of a oneof
+// used by the FooTagged methods. It is a type literal rather than a named
+// type, so that the methods of all messages use the same type.
+const oneofTaggedType = "struct {\nType string `json:\"type\"`\nValue any `json:\"value\"`\n}"
+
+// genMessageOneofTaggedMethods generates the FooTagged and SetFooTagged
+// methods for each oneof union Foo, which convert the oneof to and from a
+// value discriminated by the name of the member which is set.
+func genMessageOneofTaggedMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		g.P("// ", oneof.GoName, "Tagged returns the member of the ", oneof.Desc.Name(), " oneof which is set")
+		g.P("// as a discriminated value, whose Type is the proto name of the member and")
+		g.P("// whose Value is the value of its getter. Both are zero if no member is set.")
+		g.P("func (x *", m.GoIdent, ") ", oneof.GoName, "Tagged() (t ", oneofTaggedType, ") {")
+		g.P("if x == nil {")
+		g.P("return t")
+		g.P("}")
+		g.P("switch x.", opaqueOneofFieldName(oneof, m.isOpaque()), ".(type) {")
+		for _, field := range oneof.Fields {
+			getterName, _ := field.MethodName("Get")
+			g.P("case *", opaqueFieldOneofType(field, m.isOpaque()), ":")
+			g.P("t.Type, t.Value = ", strconv.Quote(string(field.Desc.Name())), ", x.", getterName, "()")
+		}
+		g.P("}")
+		g.P("return t")
+		g.P("}")
+		g.P()
+
+		g.P("// Set", oneof.GoName, "Tagged sets the ", oneof.Desc.Name(), " oneof from a discriminated value as")
+		g.P("// returned by ", oneof.GoName, "Tagged. An empty Type clears the oneof. It reports an")
+		g.P("// error if Type does not name a member of the oneof, or if Value does not")
+		g.P("// have the Go type of that member.")
+		g.P("func (x *", m.GoIdent, ") Set", oneof.GoName, "Tagged(t ", oneofTaggedType, ") error {")
+		g.P("switch t.Type {")
+		g.P(`case "":`)
+		if m.isOpen() {
+			g.P("x.", oneof.GoName, " = nil")
+		} else {
+			g.P("x.", oneof.MethodName("Clear"), "()")
+		}
+		for _, field := range oneof.Fields {
+			goType, _ := fieldGoType(g, f, field)
+			name := strconv.Quote(string(field.Desc.Name()))
+			g.P("case ", name, ":")
+			g.P("v, ok := t.Value.(", goType, ")")
+			g.P("if !ok {")
+			g.P("return ", fmtPackage.Ident("Errorf"), "(\"", oneof.Desc.FullName(), ": value of type %T for member %q, want ", goType, "\", t.Value, t.Type)")
+			g.P("}")
+			if m.isOpen() {
+				g.P("x.", oneof.GoName, " = &", opaqueFieldOneofType(field, false), "{", field.GoName, ": v}")
+			} else {
+				g.P("x.", fieldSetterName(field), "(v)")
+			}
+		}
+		g.P("default:")
+		g.P("return ", fmtPackage.Ident("Errorf"), "(\"", oneof.Desc.FullName(), ": unknown member %q\", t.Type)")
+		g.P("}")
+		g.P("return nil")
+		g.P("}")
+		g.P()
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageOneofValueGetters generates a GetFooValue method for each oneof
+// union Foo, which returns the value of the member which is set.
+func genMessageOneofValueGetters(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, oneof := range m.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		g.P("// Get", oneof.GoName, "Value returns the value of the member of the ", oneof.Desc.Name(), " oneof")
+		g.P("// which is set, or nil if none of them is set.")
+		g.P("func (x *", m.GoIdent, ") Get", oneof.GoName, "Value() any {")
+		g.P("if x == nil {")
+		g.P("return nil")
+		g.P("}")
+		g.P("switch x.", opaqueOneofFieldName(oneof, m.isOpaque()), ".(type) {")
+		for _, field := range oneof.Fields {
+			getterName, _ := field.MethodName("Get")
+			g.P("case *", opaqueFieldOneofType(field, m.isOpaque()), ":")
+			g.P("return x.", getterName, "()")
+		}
+		g.P("default:")
+		g.P("return nil")
+		g.P("}")
+		g.P("}")
+		g.P()
+	}
+}
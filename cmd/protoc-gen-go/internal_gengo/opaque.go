@@ -51,6 +51,9 @@ func opaqueGenMessage(g *protogen.GeneratedFile, f *fileInfo, message *messageIn
 	g.P(genid.State_goname, " ", protoimplPackage.Ident("MessageState"), tags)
 	sf.append(genid.State_goname)
 	fields := message.Fields
+	if generateLayout.enabled["pack"] {
+		fields = packedFieldOrder(message)
+	}
 	for _, field := range fields {
 		opaqueGenMessageField(g, f, message, field, sf)
 	}
@@ -63,6 +66,7 @@ func opaqueGenMessage(g *protogen.GeneratedFile, f *fileInfo, message *messageIn
 	opaqueGenMessageMethods(g, f, message)
 	opaqueGenMessageBuilder(g, f, message)
 	opaqueGenOneofWrapperTypes(g, f, message)
+	genMessageOptionalMethods(g, f, message)
 }
 
 // opaqueGenMessageField generates a struct field.
@@ -207,6 +211,15 @@ func opaqueGenMessageInternalFields(g *protogen.GeneratedFile, f *fileInfo, mess
 		g.P("XXX_presence [", (opaqueNumPresenceFields(message)+31)/32, "]uint32")
 		sf.append("XXX_presence")
 	}
+	if tracksTouched(message) {
+		genTouchedStructField(g, message, sf)
+	}
+	if freezable(message) {
+		genFrozenStructField(g, sf)
+	}
+	if notifiesChanges(message) {
+		genChangeHookStructField(g, sf)
+	}
 	if message.Desc.ExtensionRanges().Len() > 0 {
 		g.P(genid.ExtensionFields_goname, " ", protoimplPackage.Ident("ExtensionFields"))
 		sf.append(genid.ExtensionFields_goname)
@@ -331,6 +344,11 @@ func opaqueGenGet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo,
 		return
 	}
 
+	if isCopyMapGetter(field) {
+		genCopyMapGetter(g, message, field, getterName, goType)
+		return
+	}
+
 	// Non-oneof field for open type message.
 	if !message.isOpaque() {
 		g.P(leadingComments, "func (x *", message.GoIdent, ") ", getterName, "() ", goType, " {")
@@ -445,6 +463,9 @@ func opaqueGenGet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo,
 func opaqueGenSet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo, field *protogen.Field) {
 	goType, pointer := opaqueFieldGoType(g, f, message, field)
 	setterName, bcName := field.MethodName("Set")
+	if isComputed(field) {
+		setterName, bcName = fieldSetterName(field), ""
+	}
 
 	// If we need a backwards compatible setter name, we add it now.
 	if bcName != "" {
@@ -469,6 +490,15 @@ func opaqueGenSet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo,
 	// Oneof field.
 	if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
 		g.P(leadingComments, "func (x *", message.GoIdent, ") ", setterName, "(v ", goType, ") {")
+		if freezable(message) {
+			genCheckFrozen(g, message, setterName)
+		}
+		if tracksTouched(message) {
+			genSetTouched(g, message, field)
+		}
+		if notifiesChanges(message) {
+			genNotifyFieldChange(g, field)
+		}
 		structPtr := "x"
 		if message.isOpaque() && message.isTracked {
 			// Add access to zero field for tracking
@@ -491,6 +521,15 @@ func opaqueGenSet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo,
 	// Non-oneof field for open type message.
 	if !message.isOpaque() {
 		g.P(leadingComments, "func (x *", message.GoIdent, ") ", setterName, "(v ", goType, ") {")
+		if freezable(message) {
+			genCheckFrozen(g, message, setterName)
+		}
+		if tracksTouched(message) {
+			genSetTouched(g, message, field)
+		}
+		if notifiesChanges(message) {
+			genNotifyFieldChange(g, field)
+		}
 		if field.Desc.Cardinality() != protoreflect.Repeated && field.Desc.Kind() == protoreflect.BytesKind {
 			g.P("if v == nil { v = []byte{} }")
 		}
@@ -508,6 +547,15 @@ func opaqueGenSet(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo,
 
 	// Non-oneof field for opaque type message.
 	g.P(leadingComments, "func (x *", message.GoIdent, ") ", setterName, "(v ", goType, ") {")
+	if freezable(message) {
+		genCheckFrozen(g, message, setterName)
+	}
+	if tracksTouched(message) {
+		genSetTouched(g, message, field)
+	}
+	if notifiesChanges(message) {
+		genNotifyFieldChange(g, field)
+	}
 	structPtr := "x"
 	if message.isTracked {
 		// Add access to zero field for tracking
@@ -663,6 +711,9 @@ func opaqueGenClear(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo
 	// Oneof field.
 	if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
 		g.P(leadingComments, "func (x *", message.GoIdent, ") ", clearerName, "() {")
+		if freezable(message) {
+			genCheckFrozen(g, message, clearerName)
+		}
 		structPtr := "x"
 		if message.isOpaque() && message.isTracked {
 			// Add access to zero field for tracking
@@ -679,6 +730,9 @@ func opaqueGenClear(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo
 	// Non-oneof field in open message.
 	if !message.isOpaque() {
 		g.P(leadingComments, "func (x *", message.GoIdent, ") ", clearerName, "() {")
+		if freezable(message) {
+			genCheckFrozen(g, message, clearerName)
+		}
 		g.P("x.", field.GoName, " = nil")
 		g.P("}")
 		g.P()
@@ -687,6 +741,9 @@ func opaqueGenClear(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo
 
 	// Non-oneof field in opaque message.
 	g.P(leadingComments, "func (x *", message.GoIdent, ") ", clearerName, "() {")
+	if freezable(message) {
+		genCheckFrozen(g, message, clearerName)
+	}
 	structPtr := "x"
 	if message.isTracked {
 		// Add access to zero field for tracking
@@ -777,6 +834,9 @@ func opaqueGenClearOneof(g *protogen.GeneratedFile, f *fileInfo, message *messag
 	fieldtrackNoInterface(g, message.noInterface)
 	clearerName := oneof.MethodName("Clear")
 	g.P("func (x *", message.GoIdent, ") ", clearerName, "() {")
+	if freezable(message) {
+		genCheckFrozen(g, message, clearerName)
+	}
 	structPtr := "x"
 	if message.isOpaque() && message.isTracked {
 		// Add access to zero field for tracking
@@ -860,7 +920,8 @@ func opaqueGenMessageBuilder(g *protogen.GeneratedFile, f *fileInfo, message *me
 	g.P(leadingComments, "type ", bName, " struct {")
 	g.P("_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.")
 	g.P()
-	for _, field := range message.Fields {
+	fields := builderFields(message)
+	for i, field := range fields {
 		oneof := field.Oneof
 
 		goType, pointer := opaqueBuilderFieldGoType(g, f, message, field)
@@ -876,7 +937,7 @@ func opaqueGenMessageBuilder(g *protogen.GeneratedFile, f *fileInfo, message *me
 		if (oneof == nil || oneof.Desc.IsSynthetic()) && message.isTracked {
 			tag = "`go:\"track\"`"
 		}
-		if oneof != nil && oneof.Fields[0] == field && !oneof.Desc.IsSynthetic() {
+		if oneof != nil && (i == 0 || fields[i-1].Oneof != oneof) && !oneof.Desc.IsSynthetic() {
 			if oneof.Comments.Leading != "" {
 				g.P(oneof.Comments.Leading)
 				g.P()
@@ -889,7 +950,7 @@ func opaqueGenMessageBuilder(g *protogen.GeneratedFile, f *fileInfo, message *me
 			field.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated())
 		g.P(leadingComments,
 			field.BuilderFieldName(), " ", goType, " ", tag)
-		if oneof != nil && oneof.Fields[len(oneof.Fields)-1] == field && !oneof.Desc.IsSynthetic() {
+		if oneof != nil && (i == len(fields)-1 || fields[i+1].Oneof != oneof) && !oneof.Desc.IsSynthetic() {
 			g.P("// -- end of ", opaqueOneofFieldName(oneof, message.isOpaque()))
 		}
 	}
@@ -899,6 +960,19 @@ func opaqueGenMessageBuilder(g *protogen.GeneratedFile, f *fileInfo, message *me
 	opaqueGenBuildMethod(g, f, message, bName)
 }
 
+// builderFields returns the fields of a message which have a field in its
+// builder. Computed fields are set by the server, not by clients building
+// the message, and are left out.
+func builderFields(message *messageInfo) []*protogen.Field {
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if !isComputed(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
 // opaqueGenBuildMethod generates the actual Build method for the builder
 func opaqueGenBuildMethod(g *protogen.GeneratedFile, f *fileInfo, message *messageInfo, bName string) {
 	// Build method on the builder type.
@@ -921,7 +995,7 @@ func opaqueGenBuildMethod(g *protogen.GeneratedFile, f *fileInfo, message *messa
 	}
 	g.P("_, _ = b, x")
 
-	for _, field := range message.Fields {
+	for _, field := range builderFields(message) {
 		oneof := field.Oneof
 		if oneof != nil && !oneof.Desc.IsSynthetic() {
 			qual := ""
@@ -1018,6 +1092,15 @@ func opaqueGenOneofWrapperTypes(g *protogen.GeneratedFile, f *fileInfo, message
 			continue
 		}
 		ifName := opaqueOneofInterfaceName(oneof)
+		var wrapperTypes []protogen.GoIdent
+		for _, field := range oneof.Fields {
+			wrapperTypes = append(wrapperTypes, opaqueFieldOneofType(field, message.isOpaque()))
+		}
+		if first := sharedOneof(f, oneof); first != nil {
+			genSharedOneofTypes(g, oneof, first)
+			genOneofWrapperAssertions(g, ifName, wrapperTypes)
+			continue
+		}
 		g.P("type ", ifName, " interface {")
 		g.P(ifName, "()")
 		g.P("}")
@@ -1048,6 +1131,7 @@ func opaqueGenOneofWrapperTypes(g *protogen.GeneratedFile, f *fileInfo, message
 			g.P("func (*", opaqueFieldOneofType(field, message.isOpaque()), ") ", ifName, "() {}")
 			g.P()
 		}
+		genOneofWrapperAssertions(g, ifName, wrapperTypes)
 	}
 }
 
@@ -0,0 +1,921 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Optional methods which may be enabled with the "methods" parameter.
+var generateMethods = newFlagValues("methods",
+	"limit",            // LimitCollections
+	"tomap",            // ToMap
+	"fdlookup",         // FieldDescriptorByGoName
+	"batch",            // MarshalTSlice and MarshalTStream
+	"unknownpreserve",  // HasUnknownFields and UnknownFieldBytes
+	"enumdefault",      // Default, on enums
+	"setbynum",         // SetByNumber
+	"sizetable",        // T_fieldTagSizes
+	"clearpaths",       // ClearPaths
+	"patchmerge",       // PatchFrom
+	"lenientunmarshal", // UnmarshalLenient
+	"extnums",          // SetExtensionNumbers, on extendable messages
+	"framewriter",      // WriteFramed
+	"msgcount",         // NestedMessageCount
+	"depth",            // MaxDepth
+	"logstring",        // LogString
+	"marshalexcept",    // MarshalExcept
+	"templatemap",      // TemplateData
+	"unmarshallimit",   // UnmarshalMax
+	"equalignore",      // EqualIgnoring
+	"applydefaults",    // ApplyDefaults
+	"snapshot",         // Snapshot
+	"bytelen",          // TotalStringBytes
+	"jsonpatch",        // ApplyJSONPatch
+	"cachekey",         // CacheKey
+	"requiredcheck",    // UnmarshalRequired
+	"wireorder",        // FieldsInWireOrder
+	"clearkind",        // ClearKind
+	"defaultjson",      // DefaultJSON
+	"freeze",           // Freeze, checked by setters and clearers (experimental)
+	"urlvalues",        // URLValues and FromURLValues
+	"detectunknown",    // UnknownFieldNumbers
+	"fastclone",        // CloneVT
+	"mergereport",      // MergeReport
+	"marshalpath",      // MarshalPath
+	"eachext",          // EachExtension, on extendable messages
+	"utf8check",        // ValidateUTF8
+	"typedeps",         // MessageDependencies
+	"fieldbytes",       // FieldBytes
+	"maptext",          // MapStrings
+	"diffcount",        // DiffCount
+	"text",             // MarshalText and UnmarshalText
+	"marshalpresent",   // MarshalPresentOnly
+	"descindex",        // DescriptorIndexPath, on messages and enums
+	"fromkv",           // FromKV
+	"maxsize",          // CheckMaxSize
+	"streamrepeated",   // StreamFoo, for each repeated field Foo
+	"kindlookup",       // KindByGoName
+	"stripunknown",     // StripUnknownFields
+	"oneoftagged",      // FooTagged and SetFooTagged, for each oneof Foo
+	"byjsonname",       // GetByJSONName and SetByJSONName
+	"wirehex",          // WireHex and TFromWireHex
+	"iszerofast",       // IsZeroFast
+	"project",          // Project
+	"metriclabels",     // MetricFieldLabels
+	"coalesce",         // CoalesceWith
+	"freezemaps",       // SortedFoo, for each map field Foo
+	"cyclecheck",       // HasCycle
+	"fieldsizes",       // FieldSizes
+	"envoverride",      // ApplyEnvOverrides
+	"fingerprint",      // Fingerprint and ClearVolatileFields
+)
+
+// Struct layouts which may be selected with the "layout" parameter.
+var generateLayout = newFlagValues("layout",
+	"pack", // order fields by descending size to minimize padding
+)
+
+// Oneof accessors which may be enabled with the "oneofs" parameter.
+var generateOneofs = newFlagValues("oneofs",
+	"value", // GetFooValue, for each oneof Foo
+)
+
+// Constants which may be enabled with the "constants" parameter.
+var generateConstants = newFlagValues("constants",
+	"paths",  // T_FooPath, for each field foo
+	"syntax", // File_foo_proto_edition
+)
+
+// Compile-time assertions which may be enabled with the "assertions" parameter.
+var generateAssertions = newFlagValues("assertions",
+	"oneof", // var _ isT_Foo = (*T_Bar)(nil), for each oneof wrapper type T_Bar
+)
+
+// Maps which may be enabled with the "maps" parameter.
+var generateMaps = newFlagValues("maps",
+	"jsonnames", // T_jsonNames
+)
+
+// Enum methods which may be enabled with the "enums" parameter.
+var generateEnums = newFlagValues("enums",
+	"descriptions", // Description
+	"label",        // Label
+	"switchstring", // String as a switch on the values
+)
+
+// Helper methods which may be enabled with the "helpers" parameter.
+var generateHelpers = newFlagValues("helpers",
+	"at",          // FooAt, for each repeated field foo
+	"eachmsg",     // EachFoo, for each map field foo with message values
+	"int64string", // GetFooString and SetFooString, for each 64-bit integer field foo
+	"mergeunique", // MergeUniqueFoo, for each repeated field foo with comparable elements
+	"joined",      // FooJoined, for each repeated field foo with scalar or enum elements
+	"iter",        // AllFoo and AllFooEntries, for each repeated and map field foo, in a separate file
+	"filter",      // FilterFoo, for each repeated message field foo
+)
+
+// Variants of the getters which may be enabled with the "getters" parameter.
+var generateGetters = newFlagValues("getters",
+	"copymap", // GetFoo returns a shallow copy, for each map field foo
+)
+
+// JSON methods which may be enabled with the "json" parameter.
+var generateJSON = newFlagValues("json",
+	"methods", // MarshalJSON and UnmarshalJSON, discarding unknown fields
+	"strict",  // UnmarshalJSON, rejecting unknown fields
+)
+
+// Pooling of messages, selected with the "pooling" parameter.
+var generatePooling = newFlagValues("pooling",
+	"sync", // TPool, GetT and PutT, using a sync.Pool
+)
+
+// Constructors which may be enabled with the "constructors" parameter.
+var generateConstructors = newFlagValues("constructors",
+	"oneof", // NewT_Foo, for each oneof wrapper type T_Foo
+)
+
+// Experimental tracking of field writes, enabled with the "tracking" parameter.
+var generateTracking = newFlagValues("tracking",
+	"touched",  // TouchedFields and ClearTouched, recorded by setters
+	"callback", // OnFieldChange, called by setters
+)
+
+// Compatibility with the API of other protobuf versions, enabled with the
+// "compat" parameter.
+var generateCompat = newFlagValues("compat",
+	"v1", // XXX_WellKnownType, on well-known types, for github.com/golang/protobuf
+)
+
+// Reflection support which may be enabled with the "reflection" parameter.
+var generateReflection = newFlagValues("reflection",
+	"exportmsgtypes", // File_foo_proto_MessageTypes
+)
+
+// Normalization of messages, enabled with the "normalize" parameter.
+var generateNormalize = newFlagValues("normalize",
+	"presence", // NormalizePresence
+)
+
+// Naming of the keys returned by ToMap, selected with the "tomap_names"
+// parameter. The JSON name is used by default.
+var toMapNames = newChoiceFlag("tomap_names", "json", "proto")
+
+// Handling of nil messages passed to the batch marshal functions, selected
+// with the "batch_nil" parameter. Nil messages are an error by default.
+var batchNil = newChoiceFlag("batch_nil", "error", "skip")
+
+// Encoding of the keys returned by CacheKey, selected with the "cachekey"
+// parameter. The marshaled message is encoded as is by default, and its
+// SHA-256 hash is encoded instead with "sha256".
+var cacheKeyEncoding = newChoiceFlag("cachekey", "raw", "sha256")
+
+// Handling of query parameters which do not name a scalar field, selected
+// with the "urlvalues_unknown" parameter. They are ignored by FromURLValues
+// by default.
+var urlValuesUnknown = newChoiceFlag("urlvalues_unknown", "ignore", "error")
+
+// Handling of keys which name a message, repeated or map field, selected with
+// the "fromkv_unsupported" parameter. They are ignored by FromKV by default.
+var fromKVUnsupported = newChoiceFlag("fromkv_unsupported", "skip", "error")
+
+// Handling of messages with the same key in the indexes built for the
+// index_key option, selected with the "index_duplicates" parameter. They are
+// reported as an error by default, and the last of them is kept with "last".
+var indexDuplicates = newChoiceFlag("index_duplicates", "error", "last")
+
+// generateDTO, set with the "dto_out" parameter, generates data transfer
+// objects for messages in a dto subpackage, along with conversion methods.
+var generateDTO = newBoolFlag("dto_out")
+
+// generateFixtures, set with the "fixtures_out" parameter, generates a
+// fixture function for each top-level message in a separate file.
+var generateFixtures = newBoolFlag("fixtures_out")
+
+// convertStrict, set with the "convert_strict" parameter, makes the methods
+// generated for the convert_to option report an error for fields without a
+// counterpart, instead of skipping them.
+var convertStrict = newBoolFlag("convert_strict")
+
+// optionalFlags lists the generator parameters controlling optional
+// code generation.
+var optionalFlags = []*flagValues{
+	generateMethods,
+	generateLayout,
+	generateOneofs,
+	generateConstants,
+	generateAssertions,
+	generateMaps,
+	generateEnums,
+	generateHelpers,
+	generateGetters,
+	generateJSON,
+	generatePooling,
+	generateConstructors,
+	generateTracking,
+	generateNormalize,
+	generateCompat,
+	generateReflection,
+}
+
+// optionalChoiceFlags lists the generator parameters selecting one of several
+// variants of optional code.
+var optionalChoiceFlags = []*choiceFlag{
+	toMapNames,
+	batchNil,
+	cacheKeyEncoding,
+	urlValuesUnknown,
+	fromKVUnsupported,
+	indexDuplicates,
+}
+
+// optionalBoolFlags lists the boolean generator parameters controlling
+// optional code generation.
+var optionalBoolFlags = []*boolFlag{
+	generateDTO,
+	generateFixtures,
+	convertStrict,
+}
+
+// flagConflicts lists combinations of generator parameters which are known to
+// produce incorrect code. Each parameter is in the form "name=value".
+var flagConflicts = []flagConflict{
+	{"methods=freeze", "pooling=sync", "PutT resets the messages returned to the pool, which unfreezes or panics on frozen messages still shared by their readers"},
+}
+
+type flagConflict struct {
+	a, b   string
+	reason string
+}
+
+// RegisterFlags registers the generator parameters controlling optional
+// code generation with fs.
+func RegisterFlags(fs *flag.FlagSet) {
+	for _, fv := range optionalFlags {
+		fs.Var(fv, fv.name, fmt.Sprintf("optional code to generate, any of: %s", strings.Join(fv.known, ", ")))
+	}
+	for _, cf := range optionalChoiceFlags {
+		fs.Var(cf, cf.name, fmt.Sprintf("variant of optional code to generate, one of: %s", strings.Join(cf.known, ", ")))
+	}
+	for _, bf := range optionalBoolFlags {
+		fs.Var(bf, bf.name, "generate optional code")
+	}
+	fs.IntVar(&pathConstantsDepth, "paths_depth", pathConstantsDepth, "levels of nested message fields with path constants")
+	fs.IntVar(&unmarshalMaxDepth, "unmarshal_max_depth", unmarshalMaxDepth, "levels of nested messages accepted by UnmarshalMax")
+	fs.IntVar(&fixturesDepth, "fixtures_depth", fixturesDepth, "levels of nested messages populated by the fixtures")
+	fs.IntVar(&switchStringMaxValues, "switchstring_max", switchStringMaxValues, "values of the largest enum with a switch-based String")
+}
+
+// ValidateFlags reports an error if the enabled generator parameters are
+// known to conflict with each other. It is to be called once, after the
+// parameters have been parsed and before any file is generated.
+func ValidateFlags() error {
+	for _, c := range flagConflicts {
+		if flagEnabled(c.a) && flagEnabled(c.b) {
+			return fmt.Errorf("generator parameters %s and %s cannot be used together: %s", c.a, c.b, c.reason)
+		}
+	}
+	return nil
+}
+
+// flagEnabled reports whether the parameter, in the form "name=value",
+// is enabled.
+func flagEnabled(param string) bool {
+	name, value, _ := strings.Cut(param, "=")
+	for _, fv := range optionalFlags {
+		if fv.name == name {
+			return fv.enabled[value]
+		}
+	}
+	for _, cf := range optionalChoiceFlags {
+		if cf.name == name {
+			return cf.value == value
+		}
+	}
+	return false
+}
+
+// flagValues is a flag.Value holding a set of enabled names, each of which
+// must be one of a fixed list of known names.
+//
+// Multiple names may be enabled by repeating the parameter or by separating
+// the names with a '+' (e.g., "methods=a+b" is equivalent to
+// "methods=a,methods=b").
+type flagValues struct {
+	name    string
+	known   []string
+	enabled map[string]bool
+}
+
+func newFlagValues(name string, known ...string) *flagValues {
+	return &flagValues{name: name, known: known, enabled: make(map[string]bool)}
+}
+
+func (fv *flagValues) String() string {
+	if fv == nil {
+		return ""
+	}
+	var ss []string
+	for _, s := range fv.known {
+		if fv.enabled[s] {
+			ss = append(ss, s)
+		}
+	}
+	return strings.Join(ss, "+")
+}
+
+func (fv *flagValues) Set(value string) error {
+	for _, s := range strings.Split(value, "+") {
+		if !fv.isKnown(s) {
+			return fmt.Errorf("unknown value %q for parameter %q: want one of %s", s, fv.name, strings.Join(fv.known, ", "))
+		}
+		fv.enabled[s] = true
+	}
+	return nil
+}
+
+func (fv *flagValues) isKnown(s string) bool {
+	for _, k := range fv.known {
+		if k == s {
+			return true
+		}
+	}
+	return false
+}
+
+// choiceFlag is a flag.Value holding one of a fixed list of known names.
+// The parameter may be repeated, but only with the same name; the empty
+// value selects the default, which is documented by each parameter.
+type choiceFlag struct {
+	name  string
+	known []string
+	value string
+}
+
+func newChoiceFlag(name string, known ...string) *choiceFlag {
+	return &choiceFlag{name: name, known: known}
+}
+
+func (cf *choiceFlag) String() string {
+	if cf == nil {
+		return ""
+	}
+	return cf.value
+}
+
+func (cf *choiceFlag) Set(value string) error {
+	if !slices.Contains(cf.known, value) {
+		return fmt.Errorf("unknown value %q for parameter %q: want one of %s", value, cf.name, strings.Join(cf.known, ", "))
+	}
+	if cf.value != "" && cf.value != value {
+		return fmt.Errorf("conflicting values %q and %q for parameter %q: want only one of %s", cf.value, value, cf.name, strings.Join(cf.known, ", "))
+	}
+	cf.value = value
+	return nil
+}
+
+// boolFlag is a flag.Value holding a boolean generator parameter, which is
+// enabled if given without a value (e.g., "name" is equivalent to "name=true").
+type boolFlag struct {
+	name    string
+	enabled bool
+}
+
+func newBoolFlag(name string) *boolFlag {
+	return &boolFlag{name: name}
+}
+
+func (bf *boolFlag) String() string {
+	if bf == nil {
+		return ""
+	}
+	return strconv.FormatBool(bf.enabled)
+}
+
+func (bf *boolFlag) Set(value string) error {
+	switch value {
+	case "true", "":
+		bf.enabled = true
+	case "false":
+		bf.enabled = false
+	default:
+		return fmt.Errorf(`bad value for parameter %q: want "true" or "false"`, bf.name)
+	}
+	return nil
+}
+
+// genMessageOptionalMethods generates the methods of a message which have been
+// enabled through generator parameters.
+func genMessageOptionalMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if generateMethods.enabled["limit"] {
+		genMessageLimitCollections(g, f, m)
+	}
+	if generateMethods.enabled["tomap"] {
+		genMessageToMap(g, f, m)
+	}
+	if generateMethods.enabled["fdlookup"] {
+		genMessageFieldDescriptorByGoName(g, f, m)
+	}
+	if generateMethods.enabled["batch"] {
+		genMessageBatchMarshal(g, f, m)
+	}
+	if generateMethods.enabled["unknownpreserve"] {
+		genMessageUnknownFieldsMethods(g, f, m)
+	}
+	if generateMethods.enabled["setbynum"] {
+		genMessageSetByNumber(g, f, m)
+	}
+	if generateMethods.enabled["sizetable"] {
+		genMessageFieldTagSizes(g, f, m)
+	}
+	if generateMethods.enabled["clearpaths"] {
+		genMessageClearPaths(g, f, m)
+	}
+	if generateMethods.enabled["patchmerge"] {
+		genMessagePatchFrom(g, f, m)
+	}
+	if generateMethods.enabled["lenientunmarshal"] {
+		genMessageUnmarshalLenient(g, f, m)
+	}
+	if generateMethods.enabled["extnums"] {
+		genMessageSetExtensionNumbers(g, f, m)
+	}
+	if generateMethods.enabled["framewriter"] {
+		genMessageWriteFramed(g, f, m)
+	}
+	if generateMethods.enabled["msgcount"] {
+		genMessageNestedMessageCount(g, f, m)
+	}
+	if generateMethods.enabled["depth"] {
+		genMessageMaxDepth(g, f, m)
+	}
+	if _, ok := f.convertTargets[m]; ok {
+		genMessageConvertTo(g, f, m)
+	}
+	if generateMethods.enabled["marshalexcept"] {
+		genMessageMarshalExcept(g, f, m)
+	}
+	if generateMethods.enabled["unmarshallimit"] {
+		genMessageUnmarshalMax(g, f, m)
+	}
+	if generateMethods.enabled["equalignore"] {
+		genMessageEqualIgnoring(g, f, m)
+	}
+	if generateMethods.enabled["applydefaults"] {
+		genMessageApplyDefaults(g, f, m)
+	}
+	if generateMethods.enabled["snapshot"] {
+		genMessageSnapshot(g, f, m)
+	}
+	if generateMethods.enabled["bytelen"] {
+		genMessageTotalStringBytes(g, f, m)
+	}
+	if generateMethods.enabled["jsonpatch"] {
+		genMessageApplyJSONPatch(g, f, m)
+	}
+	if generateMethods.enabled["cachekey"] {
+		genMessageCacheKey(g, f, m)
+	}
+	if generateMethods.enabled["requiredcheck"] {
+		genMessageUnmarshalRequired(g, f, m)
+	}
+	if generateMethods.enabled["wireorder"] {
+		genMessageFieldsInWireOrder(g, f, m)
+	}
+	if generateMethods.enabled["clearkind"] {
+		genMessageClearKind(g, f, m)
+	}
+	if generateMethods.enabled["defaultjson"] {
+		genMessageDefaultJSON(g, f, m)
+	}
+	if freezable(m) {
+		genMessageFreeze(g, f, m)
+	}
+	if generateMethods.enabled["urlvalues"] {
+		genMessageURLValues(g, f, m)
+		genMessageFromURLValues(g, f, m)
+	}
+	if generateMethods.enabled["detectunknown"] {
+		genMessageUnknownFieldNumbers(g, f, m)
+	}
+	if generateMethods.enabled["fastclone"] {
+		genMessageCloneVT(g, f, m)
+	}
+	if generateMethods.enabled["mergereport"] {
+		genMessageMergeReport(g, f, m)
+	}
+	if generateMethods.enabled["marshalpath"] {
+		genMessageMarshalPath(g, f, m)
+	}
+	if generateMethods.enabled["eachext"] {
+		genMessageEachExtension(g, f, m)
+	}
+	if generateMethods.enabled["utf8check"] {
+		genMessageValidateUTF8(g, f, m)
+	}
+	if generateMethods.enabled["typedeps"] {
+		genMessageDependencies(g, f, m)
+	}
+	if generateMethods.enabled["fieldbytes"] {
+		genMessageFieldBytes(g, f, m)
+	}
+	if generateMethods.enabled["maptext"] {
+		genMessageMapStrings(g, f, m)
+	}
+	if generateMethods.enabled["diffcount"] {
+		genMessageDiffCount(g, f, m)
+	}
+	if generateMethods.enabled["text"] {
+		genMessageTextMethods(g, f, m)
+	}
+	if generateMethods.enabled["marshalpresent"] {
+		genMessageMarshalPresentOnly(g, f, m)
+	}
+	if generateMethods.enabled["descindex"] {
+		genMessageDescriptorIndexPath(g, f, m)
+	}
+	if generateMethods.enabled["fromkv"] {
+		genMessageFromKV(g, f, m)
+	}
+	if generateMethods.enabled["maxsize"] {
+		genMessageCheckMaxSize(g, f, m)
+	}
+	if generateMethods.enabled["streamrepeated"] {
+		genMessageStreamRepeated(g, f, m)
+	}
+	if generateMethods.enabled["kindlookup"] {
+		genMessageKindByGoName(g, f, m)
+	}
+	if generateMethods.enabled["stripunknown"] {
+		genMessageStripUnknownFields(g, f, m)
+	}
+	if generateMethods.enabled["oneoftagged"] {
+		genMessageOneofTaggedMethods(g, f, m)
+	}
+	if generateMethods.enabled["byjsonname"] {
+		genMessageByJSONName(g, f, m)
+	}
+	if generateMethods.enabled["wirehex"] {
+		genMessageWireHex(g, f, m)
+	}
+	if generateMethods.enabled["iszerofast"] {
+		genMessageIsZeroFast(g, f, m)
+	}
+	if generateMethods.enabled["project"] {
+		genMessageProject(g, f, m)
+	}
+	if generateMethods.enabled["metriclabels"] {
+		genMessageMetricFieldLabels(g, f, m)
+	}
+	if generateMethods.enabled["coalesce"] {
+		genMessageCoalesceWith(g, f, m)
+	}
+	if generateMethods.enabled["freezemaps"] {
+		genMessageSortedMaps(g, f, m)
+	}
+	if generateMethods.enabled["cyclecheck"] {
+		genMessageHasCycle(g, f, m)
+	}
+	if generateMethods.enabled["fieldsizes"] {
+		genMessageFieldSizes(g, f, m)
+	}
+	if generateMethods.enabled["envoverride"] {
+		genMessageApplyEnvOverrides(g, f, m)
+	}
+	if generateMethods.enabled["fingerprint"] {
+		genMessageFingerprint(g, f, m)
+	}
+	if generateCompat.enabled["v1"] {
+		genMessageWellKnownType(g, f, m)
+	}
+	if hasComputedFields(m) {
+		genMessageValidate(g, f, m)
+	}
+	if generatePooling.enabled["sync"] {
+		genMessagePool(g, f, m)
+	}
+	if generateMethods.enabled["templatemap"] {
+		genMessageTemplateData(g, f, m)
+	}
+	if generateJSON.enabled["methods"] || generateJSON.enabled["strict"] {
+		genMessageJSONMethods(g, f, m)
+	}
+	if generateMethods.enabled["logstring"] {
+		genMessageLogString(g, f, m)
+	}
+	if generateTracking.enabled["touched"] {
+		genMessageTouchedFields(g, f, m)
+	}
+	if generateTracking.enabled["callback"] {
+		genMessageOnFieldChange(g, f, m)
+	}
+	if generateConstructors.enabled["oneof"] {
+		genMessageOneofConstructors(g, f, m)
+	}
+	if generateNormalize.enabled["presence"] {
+		genMessageNormalizePresence(g, f, m)
+	}
+	if generateOneofs.enabled["value"] {
+		genMessageOneofValueGetters(g, f, m)
+	}
+	if generateConstants.enabled["paths"] {
+		genMessagePathConstants(g, f, m)
+	}
+	if generateMaps.enabled["jsonnames"] {
+		genMessageJSONNames(g, f, m)
+	}
+	if generateHelpers.enabled["at"] {
+		genMessageAtMethods(g, f, m)
+	}
+	if generateHelpers.enabled["eachmsg"] {
+		genMessageEachMethods(g, f, m)
+	}
+	if generateHelpers.enabled["int64string"] {
+		genMessageInt64StringMethods(g, f, m)
+	}
+	if generateHelpers.enabled["mergeunique"] {
+		genMessageMergeUniqueMethods(g, f, m)
+	}
+	if generateHelpers.enabled["joined"] {
+		genMessageJoinedMethods(g, f, m)
+	}
+	if generateHelpers.enabled["filter"] {
+		genMessageFilterMethods(g, f, m)
+	}
+}
+
+// requireSetters reports an error if a message of the file has no setters,
+// which the generator parameter param relies on. protoc-gen-go has no
+// parameter enabling setters: they are generated for every message not using
+// the open API, so that is the requirement checked here.
+func requireSetters(f *fileInfo, param string) error {
+	for _, m := range f.allMessages {
+		if m.isOpen() && !m.Desc.IsMapEntry() {
+			return fmt.Errorf("%v: %v requires setters, which are not generated for messages using the open API", m.Desc.FullName(), param)
+		}
+	}
+	return nil
+}
+
+// genFileOptionalDecls generates the file-level declarations shared by the
+// methods which have been enabled through generator parameters, and those
+// requested by custom options of the file.
+func genFileOptionalDecls(g *protogen.GeneratedFile, f *fileInfo) error {
+	for _, param := range []struct {
+		name    string
+		enabled bool
+	}{
+		{"tracking=touched", generateTracking.enabled["touched"]},
+		{"tracking=callback", generateTracking.enabled["callback"]},
+		{"methods=freeze", generateMethods.enabled["freeze"]},
+	} {
+		if !param.enabled {
+			continue
+		}
+		if err := requireSetters(f, param.name); err != nil {
+			return err
+		}
+	}
+	if err := checkEnumFormerNames(f); err != nil {
+		return err
+	}
+	if generateMethods.enabled["clearpaths"] {
+		genFileClearPaths(g, f)
+	}
+	if generateMethods.enabled["lenientunmarshal"] {
+		genFileUnmarshalLenient(g, f)
+	}
+	if len(f.convertTargets) > 0 {
+		genFileConvert(g, f)
+	}
+	if generateMethods.enabled["jsonpatch"] {
+		genFileApplyJSONPatch(g, f)
+	}
+	if generateMethods.enabled["clearkind"] {
+		genFileClearKind(g, f)
+	}
+	if generateMethods.enabled["detectunknown"] {
+		genFileUnknownFieldNumbers(g, f)
+	}
+	if generateMethods.enabled["marshalpath"] {
+		genFileMarshalPath(g, f)
+	}
+	if generateMethods.enabled["typedeps"] {
+		genFileMessageDependencies(g, f)
+	}
+	if generateMethods.enabled["diffcount"] {
+		genFileDiffCount(g, f)
+	}
+	if generateMethods.enabled["text"] {
+		genFileTextOptions(g, f)
+	}
+	if generateMethods.enabled["marshalpresent"] {
+		genFileMarshalPresentOnly(g, f)
+	}
+	if generateMethods.enabled["stripunknown"] {
+		genFileStripUnknownFields(g, f)
+	}
+	if generateMethods.enabled["cyclecheck"] {
+		genFileHasCycle(g, f)
+	}
+	if generateConstants.enabled["syntax"] {
+		genFileEditionConstant(g, f)
+	}
+	if generateReflection.enabled["exportmsgtypes"] {
+		genFileExportedMessageTypes(g, f)
+	}
+	if err := genFileIndexKeys(g, f); err != nil {
+		return err
+	}
+	genCommonOneofInterfaces(g, f)
+	return genCommonFieldInterfaces(g, f)
+}
+
+// genEnumOptionalMethods generates the methods of an enum which have been
+// enabled through generator parameters.
+func genEnumOptionalMethods(g *protogen.GeneratedFile, f *fileInfo, e *enumInfo) {
+	if generateMethods.enabled["enumdefault"] {
+		genEnumDefault(g, f, e)
+	}
+	if generateEnums.enabled["descriptions"] {
+		genEnumDescription(g, f, e)
+	}
+	if generateEnums.enabled["label"] {
+		genEnumLabel(g, f, e)
+	}
+	if generateMethods.enabled["descindex"] {
+		genEnumDescriptorIndexPath(g, f, e)
+	}
+	if generateCompat.enabled["v1"] {
+		genEnumWellKnownType(g, f, e)
+	}
+}
+
+// fieldValueExpr returns an expression reading the value of a field of the
+// message in the variable recv, which must not be a member of a non-synthetic
+// oneof.
+//
+// For the open struct API, the expression accesses the struct field directly,
+// otherwise it calls the getter.
+func fieldValueExpr(m *messageInfo, recv string, field *protogen.Field) string {
+	if m.isOpen() {
+		return recv + "." + field.GoName
+	}
+	getterName, _ := field.MethodName("Get")
+	return recv + "." + getterName + "()"
+}
+
+// fieldAssignStmt returns a statement assigning the expression v to a field of
+// the message in the variable recv, which must not be a member of a
+// non-synthetic oneof.
+//
+// For the open struct API, the statement assigns the struct field directly,
+// otherwise it calls the setter.
+func fieldAssignStmt(m *messageInfo, recv string, field *protogen.Field, v string) string {
+	if m.isOpen() {
+		return recv + "." + field.GoName + " = " + v
+	}
+	setterName := fieldSetterName(field)
+	return recv + "." + setterName + "(" + v + ")"
+}
+
+// messageVarName returns the name of an unexported package-level variable
+// holding generated data about the message.
+func messageVarName(f *fileInfo, m *messageInfo, suffix string) string {
+	return fileVarName(f.File, m.GoIdent.GoName+"_"+suffix)
+}
+
+// messageDescriptorExpr returns an expression for the descriptor of the
+// message, which is valid once the file has been initialized.
+func messageDescriptorExpr(f *fileInfo, m *messageInfo) string {
+	return fmt.Sprintf("%s[%d].Descriptor()", messageTypesVarName(f), f.allMessagesByPtr[m])
+}
+
+// isOneofMember reports whether the field is a member of a non-synthetic oneof.
+func isOneofMember(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// isLocalMessage reports whether the message is declared in the file being
+// generated, in which case it is known to have the same optional methods.
+func isLocalMessage(f *fileInfo, message *protogen.Message) bool {
+	return message.Desc.ParentFile().Path() == f.Desc.Path()
+}
+
+// cloneValueExpr returns an expression for a deep copy of the value v of a
+// singular field, or of an element of a repeated or map field.
+func cloneValueExpr(g *protogen.GeneratedFile, field *protogen.Field, v string) string {
+	switch {
+	case field.Message != nil:
+		return g.QualifiedGoIdent(protoPackage.Ident("CloneOf")) + "(" + v + ")"
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		// A copy of an empty value must not be nil, which is unset for
+		// fields with explicit presence.
+		return "append([]byte{}, " + v + "...)"
+	}
+	return v
+}
+
+// fieldPopulatedCond returns a condition reporting whether a field of the
+// message in the variable recv is populated in the sense of
+// protoreflect.Message.Has.
+func fieldPopulatedCond(g *protogen.GeneratedFile, m *messageInfo, recv string, field *protogen.Field) string {
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		oneofType := g.QualifiedGoIdent(opaqueFieldOneofType(field, false))
+		return "_, ok := " + recv + "." + field.Oneof.GoName + ".(*" + oneofType + "); ok"
+	case field.Desc.HasPresence() && !m.isOpen():
+		hasserName, _ := field.MethodName("Has")
+		return recv + "." + hasserName + "()"
+	}
+	v := fieldValueExpr(m, recv, field)
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return "len(" + v + ") > 0"
+	case field.Desc.HasPresence():
+		return v + " != nil"
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		return v
+	case field.Desc.Kind() == protoreflect.StringKind:
+		return v + ` != ""`
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		return "len(" + v + ") > 0"
+	}
+	return v + " != 0"
+}
+
+// fieldUnpopulatedCond returns the negation of the condition returned by
+// fieldPopulatedCond.
+func fieldUnpopulatedCond(g *protogen.GeneratedFile, m *messageInfo, recv string, field *protogen.Field) string {
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		oneofType := g.QualifiedGoIdent(opaqueFieldOneofType(field, false))
+		return "_, ok := " + recv + "." + field.Oneof.GoName + ".(*" + oneofType + "); !ok"
+	case field.Desc.HasPresence() && !m.isOpen():
+		hasserName, _ := field.MethodName("Has")
+		return "!" + recv + "." + hasserName + "()"
+	}
+	v := fieldValueExpr(m, recv, field)
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return "len(" + v + ") == 0"
+	case field.Desc.HasPresence():
+		return v + " == nil"
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		return "!" + v
+	case field.Desc.Kind() == protoreflect.StringKind:
+		return v + ` == ""`
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		return "len(" + v + ") == 0"
+	}
+	return v + " == 0"
+}
+
+// genIfFieldPopulated generates the opening of an if statement, whose body is
+// executed when a field of the message in the variable recv is populated in the sense of
+// protoreflect.Message.Has, and returns an expression for the value of the
+// field within that body. The value of a pointer field is read by its getter,
+// so that the expression may be the operand of a selector.
+func genIfFieldPopulated(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, recv string, field *protogen.Field) (value string) {
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		oneofType := opaqueFieldOneofType(field, false)
+		g.P("if v, ok := ", recv, ".", field.Oneof.GoName, ".(*", oneofType, "); ok {")
+		return "v." + field.GoName
+	case field.Desc.HasPresence() && !m.isOpen():
+		hasserName, _ := field.MethodName("Has")
+		getterName, _ := field.MethodName("Get")
+		g.P("if ", recv, ".", hasserName, "() {")
+		return recv + "." + getterName + "()"
+	}
+	v := fieldValueExpr(m, recv, field)
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		g.P("if len(", v, ") > 0 {")
+	case field.Desc.HasPresence():
+		g.P("if ", v, " != nil {")
+		if _, pointer := fieldGoType(g, f, field); pointer {
+			getterName, _ := field.MethodName("Get")
+			return recv + "." + getterName + "()"
+		}
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		g.P("if ", v, " {")
+	case field.Desc.Kind() == protoreflect.StringKind:
+		g.P("if ", v, ` != "" {`)
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		g.P("if len(", v, ") > 0 {")
+	default:
+		g.P("if ", v, " != 0 {")
+	}
+	return v
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// generateWithParams runs the generator over a minimal .proto file with the
+// given generator parameters and returns the generator response.
+func generateWithParams(t *testing.T, params string) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	return generateFileWithParams(t, &descriptorpb.FileDescriptorProto{
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Message"),
+		}},
+	}, params)
+}
+
+// generateFileWithParams runs the generator over the proto3 file test.proto
+// with the contents of fd and the given generator parameters, and returns the
+// generator response. The files imported by fd are taken from
+// protoregistry.GlobalFiles.
+func generateFileWithParams(t *testing.T, fd *descriptorpb.FileDescriptorProto, params string) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	fd = proto.CloneOf(fd)
+	fd.Name = proto.String("test.proto")
+	fd.Syntax = proto.String("proto3")
+	fd.Package = proto.String("goproto.test")
+	if fd.Options == nil {
+		fd.Options = &descriptorpb.FileOptions{}
+	}
+	fd.Options.GoPackage = proto.String("example.com/test")
+
+	var files []*descriptorpb.FileDescriptorProto
+	for _, dep := range fd.GetDependency() {
+		d, err := protoregistry.GlobalFiles.FindFileByPath(dep)
+		if err != nil {
+			t.Fatalf("import of %q: %v", dep, err)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(d))
+	}
+	files = append(files, fd)
+
+	var fs flag.FlagSet
+	RegisterFlags(&fs)
+	gen, err := protogen.Options{ParamFunc: fs.Set}.New(&pluginpb.CodeGeneratorRequest{
+		Parameter:      proto.String(params),
+		FileToGenerate: []string{"test.proto"},
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatalf("protogen.Options.New(%q): %v", params, err)
+	}
+	if err := ValidateFlags(); err != nil {
+		gen.Error(err)
+		return gen.Response()
+	}
+	for _, f := range gen.Files {
+		if f.Generate {
+			generateFiles(gen, f)
+		}
+	}
+	return gen.Response()
+}
+
+// resetFlags disables every optional generator parameter, which otherwise
+// retain the values enabled by earlier runs of the generator.
+func resetFlags() {
+	for _, fv := range optionalFlags {
+		fv.enabled = make(map[string]bool)
+	}
+	for _, cf := range optionalChoiceFlags {
+		cf.value = ""
+	}
+	for _, bf := range optionalBoolFlags {
+		bf.enabled = false
+	}
+}
+
+func TestRequireSetters(t *testing.T) {
+	defer resetFlags()
+	for _, param := range []string{
+		"tracking=touched",
+		"tracking=callback",
+		"methods=freeze",
+	} {
+		resetFlags()
+		resp := generateWithParams(t, param)
+		if got, want := resp.GetError(), param+" requires setters"; !strings.Contains(got, want) {
+			t.Errorf("%v with the open API: got error %q, want it to contain %q", param, got, want)
+		}
+		if len(resp.GetFile()) > 0 {
+			t.Errorf("%v with the open API: got %d generated files, want none", param, len(resp.GetFile()))
+		}
+	}
+}
+
+func TestFlagConflicts(t *testing.T) {
+	defer resetFlags()
+	for _, tt := range []struct {
+		params  string
+		wantErr string
+	}{
+		{
+			params:  "methods=freeze,pooling=sync",
+			wantErr: "methods=freeze and pooling=sync cannot be used together",
+		},
+		{params: "pooling=sync"},
+		{params: "tomap_names=proto"},
+		{params: "methods=batch,batch_nil=skip,batch_nil=skip"},
+		{params: "index_duplicates=last"},
+		{params: "methods=cachekey,cachekey=sha256"},
+	} {
+		resetFlags()
+		resp := generateWithParams(t, tt.params)
+		if tt.wantErr == "" {
+			if resp.GetError() != "" {
+				t.Errorf("%q: unexpected error %q", tt.params, resp.GetError())
+			}
+			if len(resp.GetFile()) != 1 {
+				t.Errorf("%q: got %d generated files, want 1", tt.params, len(resp.GetFile()))
+			}
+			continue
+		}
+		if got := resp.GetError(); !strings.Contains(got, tt.wantErr) {
+			t.Errorf("%q: got error %q, want it to contain %q", tt.params, got, tt.wantErr)
+		}
+		if len(resp.GetFile()) > 0 {
+			t.Errorf("%q: got %d generated files, want none", tt.params, len(resp.GetFile()))
+		}
+	}
+}
+
+func TestChoiceFlagConflictingValues(t *testing.T) {
+	defer resetFlags()
+	for _, tt := range []struct {
+		name   string
+		values []string
+	}{
+		{"tomap_names", []string{"json", "proto"}},
+		{"batch_nil", []string{"error", "skip"}},
+		{"cachekey", []string{"raw", "sha256"}},
+		{"urlvalues_unknown", []string{"ignore", "error"}},
+		{"fromkv_unsupported", []string{"skip", "error"}},
+		{"index_duplicates", []string{"error", "last"}},
+	} {
+		resetFlags()
+		var fs flag.FlagSet
+		RegisterFlags(&fs)
+		for range 2 {
+			if err := fs.Set(tt.name, tt.values[0]); err != nil {
+				t.Errorf("Set(%q, %q): %v", tt.name, tt.values[0], err)
+			}
+		}
+		if err := fs.Set(tt.name, tt.values[1]); err == nil {
+			t.Errorf("Set(%q, %q) after Set(%q, %q): got nil error, want error", tt.name, tt.values[1], tt.name, tt.values[0])
+		}
+		if err := fs.Set(tt.name, strings.Join(tt.values, "+")); err == nil {
+			t.Errorf("Set(%q, %q): got nil error, want error", tt.name, strings.Join(tt.values, "+"))
+		}
+	}
+}
+
+func TestUnknownFlagValue(t *testing.T) {
+	var fs flag.FlagSet
+	RegisterFlags(&fs)
+	if err := fs.Set("methods", "nosuchmethod"); err == nil {
+		t.Errorf(`Set("methods", "nosuchmethod"): got nil error, want error`)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Field numbers of the custom options understood by the generator.
+// See cmd/protoc-gen-go/testdata/options/options.proto for their declarations.
+const (
+	commonField_fieldNumber = 51001 // FileOptions
+	sensitive_fieldNumber   = 51002 // FieldOptions
+	convertTo_fieldNumber   = 51003 // MessageOptions
+	formerName_fieldNumber  = 51004 // EnumValueOptions
+	omitString_fieldNumber  = 51005 // MessageOptions
+	computed_fieldNumber    = 51006 // FieldOptions
+	jsonNumeric_fieldNumber = 51007 // EnumOptions
+	commonOneof_fieldNumber = 51008 // FileOptions
+	jsonAlways_fieldNumber  = 51009 // FieldOptions
+	jsonNever_fieldNumber   = 51010 // FieldOptions
+	indexKey_fieldNumber    = 51011 // FileOptions
+	volatile_fieldNumber    = 51012 // FieldOptions
+)
+
+// optionStrings returns the values of a string option with the given field
+// number in the order in which they appear.
+//
+// The option is decoded from unknown fields to avoid a dependency on the
+// proto declaring it from protoc-gen-go.
+func optionStrings(opts proto.Message, num protowire.Number) (vs []string) {
+	b := opts.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		n, typ, m := protowire.ConsumeTag(b)
+		b = b[m:]
+		if n == num && typ == protowire.BytesType {
+			v, _ := protowire.ConsumeString(b)
+			vs = append(vs, v)
+		}
+		m = protowire.ConsumeFieldValue(n, typ, b)
+		b = b[m:]
+	}
+	return vs
+}
+
+// optionBool returns the value of a bool option with the given field number.
+// If the option appears more than once, the last value is used.
+func optionBool(opts proto.Message, num protowire.Number) (v bool) {
+	b := opts.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		n, typ, m := protowire.ConsumeTag(b)
+		b = b[m:]
+		if n == num && typ == protowire.VarintType {
+			x, _ := protowire.ConsumeVarint(b)
+			v = protowire.DecodeBool(x)
+		}
+		m = protowire.ConsumeFieldValue(n, typ, b)
+		b = b[m:]
+	}
+	return v
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessagePatchFrom generates the PatchFrom method, which merges the
+// populated fields of another message into a message.
+func genMessagePatchFrom(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// PatchFrom merges the fields of src which are populated into x, leaving the")
+	g.P("// other fields of x untouched. Unlike proto.Merge, a field of src which")
+	g.P("// is set to its zero value without explicit presence is not copied.")
+	g.P("//")
+	g.P("// Singular message fields are patched recursively, repeated fields are")
+	g.P("// replaced by a copy of the field of src, and the entries of map fields are")
+	g.P("// copied into the map of x. The values copied from src are deep copies.")
+	g.P("func (x *", m.GoIdent, ") PatchFrom(src *", m.GoIdent, ") {")
+	g.P("if src == nil || x == src {")
+	g.P("return")
+	g.P("}")
+	for _, field := range m.Fields {
+		v := genIfFieldPopulated(g, f, m, "src", field)
+		switch {
+		case isOneofMember(field) && m.isOpen():
+			oneofType := opaqueFieldOneofType(field, false)
+			g.P("x.", field.Oneof.GoName, " = &", oneofType, "{", field.GoName, ": ", cloneValueExpr(g, field, v), "}")
+		case isOneofMember(field):
+			setterName := fieldSetterName(field)
+			g.P("x.", setterName, "(", cloneValueExpr(g, field, v), ")")
+		case field.Desc.IsList():
+			goType, _ := fieldGoType(g, f, field)
+			g.P("l := make(", goType, ", len(", v, "))")
+			if field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind {
+				g.P("for i, e := range ", v, " {")
+				g.P("l[i] = ", cloneValueExpr(g, field, "e"))
+				g.P("}")
+			} else {
+				g.P("copy(l, ", v, ")")
+			}
+			g.P(fieldAssignStmt(m, "x", field, "l"))
+		case field.Desc.IsMap():
+			goType, _ := fieldGoType(g, f, field)
+			g.P("mv := ", fieldValueExpr(m, "x", field))
+			g.P("if mv == nil {")
+			g.P("mv = make(", goType, ", len(", v, "))")
+			if !isCopiedMap(m, field) {
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+			}
+			g.P("}")
+			g.P("for k, e := range ", v, " {")
+			g.P("mv[k] = ", cloneValueExpr(g, field.Message.Fields[1], "e"))
+			g.P("}")
+			if isCopiedMap(m, field) {
+				g.P(fieldAssignStmt(m, "x", field, "mv"))
+			}
+		case field.Message != nil:
+			g.P("if d := ", fieldValueExpr(m, "x", field), "; d != nil {")
+			if isLocalMessage(f, field.Message) {
+				g.P("d.PatchFrom(", v, ")")
+			} else {
+				g.P(protoPackage.Ident("Merge"), "(d, ", v, ")")
+			}
+			g.P("} else {")
+			g.P(fieldAssignStmt(m, "x", field, cloneValueExpr(g, field, v)))
+			g.P("}")
+		default:
+			if _, pointer := fieldGoType(g, f, field); pointer && m.isOpen() {
+				g.P("t := ", v)
+				g.P(fieldAssignStmt(m, "x", field, "&t"))
+			} else {
+				g.P(fieldAssignStmt(m, "x", field, cloneValueExpr(g, field, v)))
+			}
+		}
+		g.P("}")
+	}
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// pathConstantsDepth is the number of levels of nested message fields for
+// which path constants are generated, set with the "paths_depth" parameter.
+var pathConstantsDepth = 1
+
+type pathConstant struct {
+	name string
+	path string
+}
+
+// genMessagePathConstants generates a constant holding the path of each field
+// of a message, as used in field masks, and of the fields of singular message
+// fields up to pathConstantsDepth levels of nesting.
+func genMessagePathConstants(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if f.pathConstants == nil {
+		f.pathConstants = filePathConstants(f)
+	}
+	consts := f.pathConstants[m]
+	if len(consts) == 0 {
+		return
+	}
+	g.P("// Paths of the fields of ", m.GoIdent, ".")
+	g.P("const (")
+	for _, c := range consts {
+		g.P(c.name, " = ", strconv.Quote(c.path))
+	}
+	g.P(")")
+	g.P()
+}
+
+// filePathConstants returns the path constants of every message of the file.
+//
+// The constant for the path "foo.bar" of message T is named T_Foo_BarPath,
+// which is also the name of the constant for the path "bar" of a nested
+// message T.Foo. In that case, the constant for the longer path is renamed
+// by appending underscores, as done for oneof wrappers which conflict with
+// nested messages.
+func filePathConstants(f *fileInfo) map[*messageInfo][]pathConstant {
+	consts := make(map[*messageInfo][]pathConstant)
+	used := make(map[string]bool) // names of the constants added so far
+	add := func(p fieldPath) {
+		name := p.prefix + "Path"
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		consts[p.m] = append(consts[p.m], pathConstant{name, p.path})
+	}
+
+	// Add the constants one level of nesting at a time, so that a shorter
+	// path always takes precedence over a longer one with the same name.
+	var paths []fieldPath
+	for _, m := range f.allMessages {
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+		for _, field := range m.Fields {
+			p := fieldPath{m, m.GoIdent.GoName + "_" + field.GoName, string(field.Desc.Name()), field}
+			add(p)
+			paths = append(paths, p)
+		}
+	}
+	for depth := 0; depth < pathConstantsDepth; depth++ {
+		var nestedPaths []fieldPath
+		for _, p := range paths {
+			// Field masks cannot address the elements of repeated and map fields.
+			if p.field.Message == nil || p.field.Desc.IsList() || p.field.Desc.IsMap() {
+				continue
+			}
+			for _, field := range p.field.Message.Fields {
+				nested := fieldPath{p.m, p.prefix + "_" + field.GoName, p.path + "." + string(field.Desc.Name()), field}
+				add(nested)
+				nestedPaths = append(nestedPaths, nested)
+			}
+		}
+		paths = nestedPaths
+	}
+	return consts
+}
+
+// fieldPath is the path of a field relative to the message m.
+type fieldPath struct {
+	m      *messageInfo
+	prefix string // name of the constant, without the Path suffix
+	path   string
+	field  *protogen.Field
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessagePool generates a sync.Pool of a message type, along with the
+// functions getting messages from and returning them to the pool.
+func genMessagePool(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	name := m.GoIdent.GoName
+	pool, get, put := name+"Pool", "Get"+name, "Put"+name
+
+	g.P("// ", pool, " is a pool of ", name, " messages, used by ", get, " and ", put, ".")
+	g.P("var ", pool, " = ", syncPackage.Ident("Pool"), "{New: func() any { return new(", m.GoIdent, ") }}")
+	g.P()
+
+	g.P("// ", get, " returns an empty ", name, " from ", pool, ".")
+	g.P("func ", get, "() *", m.GoIdent, " {")
+	g.P("return ", pool, ".Get().(*", m.GoIdent, ")")
+	g.P("}")
+	g.P()
+
+	// Repeated and map fields keep their capacity, which is only accessible
+	// in the struct fields of the open API.
+	var keep, elems []string
+	if !m.isOpaque() {
+		for _, field := range m.Fields {
+			switch {
+			case field.Desc.IsList():
+				keep = append(keep, field.GoName)
+				elems = append(elems, field.GoName+": x."+field.GoName+"[:0]")
+			case field.Desc.IsMap():
+				keep = append(keep, field.GoName)
+				elems = append(elems, field.GoName+": x."+field.GoName)
+			}
+		}
+	}
+	g.P("// ", put, " resets x and returns it to ", pool, ".")
+	if len(keep) > 0 {
+		g.P("// The capacity of the repeated and map fields of x is retained.")
+	}
+	g.P("// x must not be used, or retained anywhere, after it is returned to the pool.")
+	g.P("func ", put, "(x *", m.GoIdent, ") {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	if len(keep) == 0 {
+		g.P("x.Reset()")
+	} else {
+		for _, name := range keep {
+			g.P("clear(x.", name, ")")
+		}
+		g.P("*x = ", m.GoIdent, "{", strings.Join(elems, ", "), "}")
+	}
+	g.P(pool, ".Put(x)")
+	g.P("}")
+	g.P()
+}
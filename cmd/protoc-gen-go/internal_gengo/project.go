@@ -0,0 +1,37 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageProject generates the Project method, which deep-copies the
+// fields of a message with the given numbers into a new message.
+func genMessageProject(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// Project returns a new message holding deep copies of the fields of x with")
+	g.P("// the given numbers, and no other fields. The fields are copied directly,")
+	g.P("// without reflection. Extensions and unknown fields are not copied. It")
+	g.P("// returns nil if x is nil, and panics if a number is not that of a field of")
+	g.P("// ", m.GoIdent.GoName, ".")
+	g.P("func (x *", m.GoIdent, ") Project(fields ...", protoreflectPackage.Ident("FieldNumber"), ") *", m.GoIdent, " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("y := new(", m.GoIdent, ")")
+	g.P("for _, num := range fields {")
+	g.P("switch num {")
+	for _, field := range m.Fields {
+		g.P("case ", field.Desc.Number(), ":")
+		genCloneField(g, f, m, field, "x", "y")
+	}
+	g.P("default:")
+	g.P("panic(", fmtPackage.Ident("Sprintf"), "(\"", m.Desc.FullName(), " has no field number %d\", num))")
+	g.P("}")
+	g.P("}")
+	g.P("return y")
+	g.P("}")
+	g.P()
+}
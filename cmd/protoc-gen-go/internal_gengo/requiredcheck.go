@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageUnmarshalRequired generates the UnmarshalRequired method, which
+// unmarshals a message and reports the first missing required field, and the
+// checkRequired method implementing the check. Extensions are not known to
+// the generated code, so extendable messages fall back to
+// proto.CheckInitialized once their own fields are checked.
+func genMessageUnmarshalRequired(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// UnmarshalRequired parses the wire-format message in b and places the result")
+	g.P("// in x, like ", protoPackage.Ident("Unmarshal"), ". If a required field of x or of a message")
+	g.P("// nested in x is not set, it reports an error naming the first such field.")
+	g.P("func (x *", m.GoIdent, ") UnmarshalRequired(b []byte) error {")
+	g.P("if err := (", protoPackage.Ident("UnmarshalOptions"), "{AllowPartial: true}).Unmarshal(b, x); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return x.checkRequired()")
+	g.P("}")
+	g.P()
+
+	g.P("// checkRequired reports an error naming the first required field of x or of")
+	g.P("// a message nested in x which is not set.")
+	g.P("func (x *", m.GoIdent, ") checkRequired() error {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	for _, field := range m.Fields {
+		if field.Desc.Cardinality() != protoreflect.Required {
+			continue
+		}
+		if m.isOpen() {
+			g.P("if x.", field.GoName, " == nil {")
+		} else {
+			hasserName, _ := field.MethodName("Has")
+			g.P("if !x.", hasserName, "() {")
+		}
+		g.P("return ", fmtPackage.Ident("Errorf"), "(", strconv.Quote("required field "+string(field.Desc.FullName())+" not set"), ")")
+		g.P("}")
+	}
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		switch {
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genCheckRequiredCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genCheckRequiredCall(g, f, field.Message, "v")
+				g.P("}")
+			}
+		case field.Message != nil:
+			genCheckRequiredCall(g, f, field.Message, v)
+		}
+	}
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		g.P("return ", protoPackage.Ident("CheckInitialized"), "(x)")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genCheckRequiredCall generates code returning the error reported by the
+// required field check of the message value v. Messages declared in other
+// files are checked with proto.CheckInitialized.
+func genCheckRequiredCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P("if err := ", v, ".checkRequired(); err != nil {")
+		g.P("return err")
+		g.P("}")
+		return
+	}
+	g.P("if v := ", v, "; v != nil {")
+	g.P("if err := ", protoPackage.Ident("CheckInitialized"), "(v); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageSetByNumber generates the SetByNumber method, which sets a field
+// identified by its number without going through protoreflect.Message.Set.
+func genMessageSetByNumber(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// SetByNumber sets the field of x with the given number to v, which must")
+	g.P("// have the Go type of the field. For fields with explicit presence, v is the")
+	g.P("// value of the field, and not a pointer to it. Setting a oneof member clears")
+	g.P("// the other members of the oneof.")
+	g.P("func (x *", m.GoIdent, ") SetByNumber(num ", protoreflectPackage.Ident("FieldNumber"), ", v any) error {")
+	g.P("switch num {")
+	for _, field := range m.Fields {
+		g.P("case ", field.Desc.Number(), ":")
+		genSetFieldFromAny(g, f, m, field, "v")
+	}
+	g.P("default:")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(", strconv.Quote("unknown field number %d for message "+string(m.Desc.FullName())), ", num)")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genSetFieldFromAny generates statements setting a field of x to the value
+// of the any-typed variable v, or returning an error if v does not have the
+// Go type of the field.
+func genSetFieldFromAny(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, field *protogen.Field, v string) {
+	goType, pointer := fieldGoType(g, f, field)
+	g.P("t, ok := ", v, ".(", goType, ")")
+	g.P("if !ok {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(", strconv.Quote("invalid type %T for field "+string(field.Desc.FullName())), ", ", v, ")")
+	g.P("}")
+	switch {
+	case isOneofMember(field) && m.isOpen():
+		oneofType := opaqueFieldOneofType(field, false)
+		g.P("x.", field.Oneof.GoName, " = &", oneofType, "{", field.GoName, ": t}")
+	case pointer && m.isOpen():
+		g.P(fieldAssignStmt(m, "x", field, "&t"))
+	case isOneofMember(field):
+		setterName := fieldSetterName(field)
+		g.P("x.", setterName, "(t)")
+	default:
+		g.P(fieldAssignStmt(m, "x", field, "t"))
+	}
+}
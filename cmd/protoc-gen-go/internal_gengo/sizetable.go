@@ -0,0 +1,24 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// genMessageFieldTagSizes generates a package-level table of the encoded size
+// of the tag of each field of a message.
+func genMessageFieldTagSizes(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// ", m.GoIdent.GoName, "_fieldTagSizes maps the number of each field of ", m.GoIdent, " to")
+	g.P("// the size in bytes of its encoded tag, which is the fixed overhead of every")
+	g.P("// occurrence of the field on the wire.")
+	g.P("var ", m.GoIdent.GoName, "_fieldTagSizes = map[", protoreflectPackage.Ident("FieldNumber"), "]int{")
+	for _, field := range m.Fields {
+		g.P(field.Desc.Number(), ": ", protowire.SizeTag(field.Desc.Number()), ",")
+	}
+	g.P("}")
+	g.P()
+}
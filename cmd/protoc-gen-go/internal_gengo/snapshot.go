@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageSnapshot generates the Snapshot method, which returns a deep copy
+// of a message intended to be shared by concurrent readers.
+func genMessageSnapshot(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// Snapshot returns a deep copy of x, or nil if x is nil, for read-only use.")
+	g.P("// The copy shares no memory with x, so later modifications of x are not")
+	g.P("// visible through it. The copy must not be modified; as long as it is not,")
+	g.P("// it is safe for concurrent use by multiple goroutines.")
+	g.P("func (x *", m.GoIdent, ") Snapshot() *", m.GoIdent, " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("CloneOf"), "(x)")
+	g.P("}")
+	g.P()
+}
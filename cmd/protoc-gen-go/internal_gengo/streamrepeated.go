@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageStreamRepeated generates a StreamFoo method for each repeated
+// field Foo of a message, which writes the elements of the field to a writer
+// one at a time.
+func genMessageStreamRepeated(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	for _, field := range m.Fields {
+		if !field.Desc.IsList() {
+			continue
+		}
+		getterName, _ := field.MethodName("Get")
+		g.P("// Stream", field.GoName, " writes the wire-format encoding of the ", field.Desc.Name(), " field of x")
+		g.P("// to w, one element at a time, without marshaling the field as a whole.")
+		g.P("// Each element is written with its own tag, so the output is decoded like")
+		g.P("// an encoding of x holding only the field, even if the field is packed.")
+		g.P("func (x *", m.GoIdent, ") Stream", field.GoName, "(w ", ioPackage.Ident("Writer"), ") error {")
+		if field.Desc.Kind() == protoreflect.MessageKind {
+			g.P("opts := ", protoPackage.Ident("MarshalOptions"), "{UseCachedSize: true}")
+		}
+		g.P("var buf []byte")
+		g.P("for _, v := range x.", getterName, "() {")
+		genStreamRepeatedAppend(g, field)
+		g.P("if _, err := w.Write(buf); err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("}")
+		g.P("return nil")
+		g.P("}")
+		g.P()
+	}
+}
+
+// genStreamRepeatedAppend generates statements setting buf to the tag of the
+// repeated field followed by the encoding of its element v.
+func genStreamRepeatedAppend(g *protogen.GeneratedFile, field *protogen.Field) {
+	wire := func(name string) protogen.GoIdent { return protowirePackage.Ident(name) }
+	num := field.Desc.Number()
+	appendTag := func(typ string) {
+		g.P("buf = ", wire("AppendTag"), "(buf[:0], ", num, ", ", wire(typ), ")")
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind:
+		appendTag("BytesType")
+		g.P("buf = ", wire("AppendVarint"), "(buf, uint64(", protoPackage.Ident("Size"), "(v)))")
+		g.P("var err error")
+		g.P("if buf, err = opts.MarshalAppend(buf, v); err != nil {")
+		g.P("return err")
+		g.P("}")
+	case protoreflect.GroupKind:
+		appendTag("StartGroupType")
+		g.P("var err error")
+		g.P("if buf, err = (", protoPackage.Ident("MarshalOptions"), "{}).MarshalAppend(buf, v); err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("buf = ", wire("AppendTag"), "(buf, ", num, ", ", wire("EndGroupType"), ")")
+	case protoreflect.StringKind:
+		appendTag("BytesType")
+		g.P("buf = ", wire("AppendString"), "(buf, v)")
+	case protoreflect.BytesKind:
+		appendTag("BytesType")
+		g.P("buf = ", wire("AppendBytes"), "(buf, v)")
+	case protoreflect.BoolKind:
+		appendTag("VarintType")
+		g.P("buf = ", wire("AppendVarint"), "(buf, ", wire("EncodeBool"), "(v))")
+	case protoreflect.Sint32Kind:
+		appendTag("VarintType")
+		g.P("buf = ", wire("AppendVarint"), "(buf, ", wire("EncodeZigZag"), "(int64(v)))")
+	case protoreflect.Sint64Kind:
+		appendTag("VarintType")
+		g.P("buf = ", wire("AppendVarint"), "(buf, ", wire("EncodeZigZag"), "(v))")
+	case protoreflect.FloatKind:
+		appendTag("Fixed32Type")
+		g.P("buf = ", wire("AppendFixed32"), "(buf, ", mathPackage.Ident("Float32bits"), "(v))")
+	case protoreflect.DoubleKind:
+		appendTag("Fixed64Type")
+		g.P("buf = ", wire("AppendFixed64"), "(buf, ", mathPackage.Ident("Float64bits"), "(v))")
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		appendTag("Fixed32Type")
+		g.P("buf = ", wire("AppendFixed32"), "(buf, uint32(v))")
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		appendTag("Fixed64Type")
+		g.P("buf = ", wire("AppendFixed64"), "(buf, uint64(v))")
+	default:
+		// Int32, int64, uint32, uint64 and enum values, which are sign-extended
+		// to 64 bits if negative.
+		appendTag("VarintType")
+		g.P("buf = ", wire("AppendVarint"), "(buf, uint64(v))")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+)
+
+func stripUnknownFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "stripUnknownFields")
+}
+
+func stripUnknownValueFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "stripUnknownFieldsValue")
+}
+
+// genMessageStripUnknownFields generates the StripUnknownFields method, which
+// discards the unknown fields of a message and of all messages it holds.
+func genMessageStripUnknownFields(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// StripUnknownFields discards the unknown fields retained by x and by every")
+	g.P("// message held in its fields, including repeated fields, map values and")
+	g.P("// extensions.")
+	g.P("func (x *", m.GoIdent, ") StripUnknownFields() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("x.", genid.UnknownFields_goname, " = nil")
+	for _, field := range m.Fields {
+		if field.Message == nil {
+			continue
+		}
+		if isOneofMember(field) {
+			getterName, _ := field.MethodName("Get")
+			genStripUnknownCall(g, f, field.Message, "x."+getterName+"()")
+			continue
+		}
+		v := fieldValueExpr(m, "x", field)
+		switch {
+		case field.Desc.IsList():
+			g.P("for _, v := range ", v, " {")
+			genStripUnknownCall(g, f, field.Message, "v")
+			g.P("}")
+		case field.Desc.IsMap():
+			if valField := field.Message.Fields[1]; valField.Message != nil {
+				g.P("for _, v := range ", v, " {")
+				genStripUnknownCall(g, f, valField.Message, "v")
+				g.P("}")
+			}
+		default:
+			genStripUnknownCall(g, f, field.Message, v)
+		}
+	}
+	if m.Desc.ExtensionRanges().Len() > 0 {
+		g.P("x.ProtoReflect().Range(func(fd ", protoreflectPackage.Ident("FieldDescriptor"), ", v ", protoreflectPackage.Ident("Value"), ") bool {")
+		g.P("if fd.IsExtension() {")
+		g.P(stripUnknownValueFuncName(f), "(fd, v)")
+		g.P("}")
+		g.P("return true")
+		g.P("})")
+	}
+	g.P("}")
+	g.P()
+}
+
+// genStripUnknownCall generates a call of StripUnknownFields on the message
+// value v. Messages declared in other files are stripped through reflection,
+// since they may not have been generated with the method.
+func genStripUnknownCall(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P(v, ".StripUnknownFields()")
+		return
+	}
+	g.P(stripUnknownFuncName(f), "(", v, ".ProtoReflect())")
+}
+
+// genFileStripUnknownFields generates the functions discarding unknown fields
+// through reflection, for messages declared in other files and extensions.
+func genFileStripUnknownFields(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+
+	g.P("// ", stripUnknownFuncName(f), " discards the unknown fields of m and of every")
+	g.P("// message it holds.")
+	g.P("func ", stripUnknownFuncName(f), "(m ", protoreflectIdent("Message"), ") {")
+	g.P("if !m.IsValid() {")
+	g.P("return")
+	g.P("}")
+	g.P("if m.GetUnknown() != nil {")
+	g.P("m.SetUnknown(nil)")
+	g.P("}")
+	g.P("m.Range(func(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P(stripUnknownValueFuncName(f), "(fd, v)")
+	g.P("return true")
+	g.P("})")
+	g.P("}")
+	g.P()
+
+	g.P("// ", stripUnknownValueFuncName(f), " discards the unknown fields of the messages")
+	g.P("// held in the value v of the field fd.")
+	g.P("func ", stripUnknownValueFuncName(f), "(fd ", protoreflectIdent("FieldDescriptor"), ", v ", protoreflectIdent("Value"), ") {")
+	g.P("switch {")
+	g.P("case fd.IsList() && fd.Message() != nil:")
+	g.P("l := v.List()")
+	g.P("for i := 0; i < l.Len(); i++ {")
+	g.P(stripUnknownFuncName(f), "(l.Get(i).Message())")
+	g.P("}")
+	g.P("case fd.IsMap() && fd.MapValue().Message() != nil:")
+	g.P("v.Map().Range(func(_ ", protoreflectIdent("MapKey"), ", v ", protoreflectIdent("Value"), ") bool {")
+	g.P(stripUnknownFuncName(f), "(v.Message())")
+	g.P("return true")
+	g.P("})")
+	g.P("case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:")
+	g.P(stripUnknownFuncName(f), "(v.Message())")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
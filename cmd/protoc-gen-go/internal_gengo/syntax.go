@@ -0,0 +1,35 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genFileEditionConstant generates a constant holding the syntax or edition
+// of the file, as declared in the .proto file.
+func genFileEditionConstant(g *protogen.GeneratedFile, f *fileInfo) {
+	name := f.GoDescriptorIdent.GoName + "_edition"
+	if f.Desc.Syntax() == protoreflect.Editions {
+		g.P("// ", name, " is the edition of ", f.Desc.Path(), ".")
+	} else {
+		g.P("// ", name, " is the syntax of ", f.Desc.Path(), ".")
+	}
+	g.P("const ", name, " = ", strconv.Quote(fileEdition(f)))
+	g.P()
+}
+
+// fileEdition returns the syntax or edition of a file as it appears in the
+// syntax or edition declaration of the .proto file.
+func fileEdition(f *fileInfo) string {
+	if f.Desc.Syntax() == protoreflect.Editions {
+		return strings.TrimPrefix(f.Proto.GetEdition().String(), "EDITION_")
+	}
+	return f.Desc.Syntax().String()
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageTemplateData generates the TemplateData method, which returns the
+// fields of a message in a map suitable for use with text/template and
+// html/template.
+func genMessageTemplateData(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// TemplateData returns the fields of x keyed by JSON field name, for use as")
+	g.P("// the data of a text/template or html/template. Unlike ToMap, it includes")
+	g.P("// every field: nested messages are the messages themselves, enums are")
+	g.P("// represented by the name of their value, and fields with presence which")
+	g.P("// are not populated are nil.")
+	g.P("func (x *", m.GoIdent, ") TemplateData() map[string]any {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("m := make(map[string]any, ", len(m.Fields), ")")
+	for _, field := range m.Fields {
+		dst := "m[" + strconv.Quote(field.Desc.JSONName()) + "]"
+		switch {
+		case field.Desc.IsList():
+			v := fieldValueExpr(m, "x", field)
+			if field.Enum == nil {
+				g.P(dst, " = ", v)
+				continue
+			}
+			g.P("{")
+			g.P("s := make([]string, len(", v, "))")
+			g.P("for i, v := range ", v, " {")
+			g.P("s[i] = v.String()")
+			g.P("}")
+			g.P(dst, " = s")
+			g.P("}")
+		case field.Desc.IsMap():
+			v := fieldValueExpr(m, "x", field)
+			keyField, valField := field.Message.Fields[0], field.Message.Fields[1]
+			if valField.Enum == nil {
+				g.P(dst, " = ", v)
+				continue
+			}
+			keyType, _ := fieldGoType(g, f, keyField)
+			g.P("{")
+			g.P("mv := make(map[", keyType, "]string, len(", v, "))")
+			g.P("for k, v := range ", v, " {")
+			g.P("mv[k] = v.String()")
+			g.P("}")
+			g.P(dst, " = mv")
+			g.P("}")
+		case field.Desc.HasPresence():
+			v := genIfFieldPopulated(g, f, m, "x", field)
+			genTemplateDataValue(g, field, dst, v)
+			g.P("} else {")
+			g.P(dst, " = nil")
+			g.P("}")
+		default:
+			genTemplateDataValue(g, field, dst, fieldValueExpr(m, "x", field))
+		}
+	}
+	g.P("return m")
+	g.P("}")
+	g.P()
+}
+
+// genTemplateDataValue generates an assignment of the singular value v of the
+// field to dst, representing enums by the name of their value.
+func genTemplateDataValue(g *protogen.GeneratedFile, field *protogen.Field, dst, v string) {
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		g.P(dst, " = ", v, ".String()")
+		return
+	}
+	g.P(dst, " = ", v)
+}
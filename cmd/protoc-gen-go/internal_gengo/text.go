@@ -0,0 +1,57 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func textMarshalOptionsVarName(f *fileInfo) string {
+	return f.GoDescriptorIdent.GoName + "_textMarshalOptions"
+}
+
+func textUnmarshalOptionsVarName(f *fileInfo) string {
+	return f.GoDescriptorIdent.GoName + "_textUnmarshalOptions"
+}
+
+// genMessageTextMethods generates the MarshalText and UnmarshalText methods,
+// which implement the encoding interfaces with the protobuf text format.
+func genMessageTextMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// MarshalText implements encoding.TextMarshaler by marshaling x in the")
+	g.P("// protobuf text format with the options in the package variable")
+	g.P("// ", textMarshalOptionsVarName(f), ".")
+	g.P("// The output is unstable and is not meant to be compared.")
+	g.P("func (x *", m.GoIdent, ") MarshalText() ([]byte, error) {")
+	g.P("return ", textMarshalOptionsVarName(f), ".Marshal(x)")
+	g.P("}")
+	g.P()
+
+	g.P("// UnmarshalText implements encoding.TextUnmarshaler by unmarshaling b in the")
+	g.P("// protobuf text format into x with the options in the package variable")
+	g.P("// ", textUnmarshalOptionsVarName(f), ".")
+	g.P("func (x *", m.GoIdent, ") UnmarshalText(b []byte) error {")
+	g.P("return ", textUnmarshalOptionsVarName(f), ".Unmarshal(b, x)")
+	g.P("}")
+	g.P()
+}
+
+// genFileTextOptions generates the variables holding the options used by the
+// MarshalText and UnmarshalText methods of the messages of the file.
+func genFileTextOptions(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	g.P("// ", textMarshalOptionsVarName(f), " are the options")
+	g.P("// used by the MarshalText methods of the messages in ", f.Desc.Path(), ".")
+	g.P("// They may be changed, such as to set Multiline and Indent, before any of")
+	g.P("// the methods is called. The zero options are those of ", prototextPackage.Ident("Marshal"), ".")
+	g.P("var ", textMarshalOptionsVarName(f), " = ", prototextPackage.Ident("MarshalOptions"), "{}")
+	g.P()
+	g.P("// ", textUnmarshalOptionsVarName(f), " are the options")
+	g.P("// used by the UnmarshalText methods of the messages in ", f.Desc.Path(), ".")
+	g.P("// They may be changed before any of the methods is called.")
+	g.P("var ", textUnmarshalOptionsVarName(f), " = ", prototextPackage.Ident("UnmarshalOptions"), "{}")
+	g.P()
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageToMap generates the ToMap method, which returns a generic
+// representation of the populated fields of a message.
+func genMessageToMap(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	naming := "JSON"
+	if toMapNames.value == "proto" {
+		naming = "proto"
+	}
+	g.P("// ToMap returns a generic representation of the populated fields of x,")
+	g.P("// keyed by ", naming, " field name. Messages are converted recursively,")
+	g.P("// enums are represented by the name of their value, repeated fields by")
+	g.P("// []any and map fields by a map of the same key type with values of type any.")
+	g.P("func (x *", m.GoIdent, ") ToMap() map[string]any {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("m := make(map[string]any)")
+	for _, field := range m.Fields {
+		key := field.Desc.JSONName()
+		if naming == "proto" {
+			key = string(field.Desc.Name())
+		}
+		v := genIfFieldPopulated(g, f, m, "x", field)
+		switch {
+		case field.Desc.IsList():
+			g.P("s := make([]any, len(", v, "))")
+			g.P("for i, v := range ", v, " {")
+			genToMapValue(g, f, field, "s[i]", "v")
+			g.P("}")
+			g.P("m[", strconv.Quote(key), "] = s")
+		case field.Desc.IsMap():
+			keyType, _ := fieldGoType(g, f, field.Message.Fields[0])
+			g.P("mv := make(map[", keyType, "]any, len(", v, "))")
+			g.P("for k, v := range ", v, " {")
+			genToMapValue(g, f, field.Message.Fields[1], "mv[k]", "v")
+			g.P("}")
+			g.P("m[", strconv.Quote(key), "] = mv")
+		default:
+			genToMapValue(g, f, field, "m["+strconv.Quote(key)+"]", v)
+		}
+		g.P("}")
+	}
+	g.P("return m")
+	g.P("}")
+	g.P()
+}
+
+// genToMapValue generates an assignment of the singular value v of the field
+// to dst, converting messages and enums to their generic representation.
+func genToMapValue(g *protogen.GeneratedFile, f *fileInfo, field *protogen.Field, dst, v string) {
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		g.P(dst, " = ", v, ".String()")
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if isLocalMessage(f, field.Message) {
+			g.P(dst, " = ", v, ".ToMap()")
+			return
+		}
+		// Messages declared in other files may not have been generated with
+		// the ToMap method, in which case the message itself is used.
+		g.P("if tm, ok := any(", v, ").(interface{ ToMap() map[string]any }); ok {")
+		g.P(dst, " = tm.ToMap()")
+		g.P("} else {")
+		g.P(dst, " = ", v)
+		g.P("}")
+	default:
+		g.P(dst, " = ", v)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// touchedFieldName is the name of the struct field holding the bitset of
+// fields written by setters.
+const touchedFieldName = "xxx_touched"
+
+// tracksTouched reports whether the setters of a message record the fields
+// they write.
+func tracksTouched(m *messageInfo) bool {
+	return generateTracking.enabled["touched"] && !m.isOpen() && len(m.Fields) > 0
+}
+
+// genTouchedStructField generates the bitset of touched fields of a message.
+func genTouchedStructField(g *protogen.GeneratedFile, m *messageInfo, sf *structFields) {
+	g.P(touchedFieldName, " [", (len(m.Fields)+31)/32, "]uint32")
+	sf.append(touchedFieldName)
+}
+
+// genSetTouched generates a statement recording that a setter wrote field.
+func genSetTouched(g *protogen.GeneratedFile, m *messageInfo, field *protogen.Field) {
+	i := fieldIndex(m, field)
+	g.P("x.", touchedFieldName, "[", i/32, "] |= 1 << ", i%32)
+}
+
+// fieldIndex returns the index of field in the fields of m.
+func fieldIndex(m *messageInfo, field *protogen.Field) int {
+	for i, fd := range m.Fields {
+		if fd == field {
+			return i
+		}
+	}
+	panic("field " + string(field.Desc.FullName()) + " not found in " + string(m.Desc.FullName()))
+}
+
+// genMessageTouchedFields generates the TouchedFields and ClearTouched
+// methods, which report and reset the fields written by setters.
+func genMessageTouchedFields(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	if !tracksTouched(m) {
+		return
+	}
+	g.P("// TouchedFields returns the names of the fields of x which were written by")
+	g.P("// a setter since x was created or ClearTouched was last called, in the")
+	g.P("// order in which the fields are declared. Reset forgets the fields, as")
+	g.P("// ClearTouched does, but the generated methods replacing the contents of x,")
+	g.P("// such as FromKV, remember them while not recording the fields they write.")
+	g.P("func (x *", m.GoIdent, ") TouchedFields() []", protoreflectPackage.Ident("Name"), " {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("var names []", protoreflectPackage.Ident("Name"))
+	for i, field := range m.Fields {
+		g.P("if x.", touchedFieldName, "[", i/32, "]&(1<<", i%32, ") != 0 {")
+		g.P("names = append(names, ", fmt.Sprintf("%q", field.Desc.Name()), ")")
+		g.P("}")
+	}
+	g.P("return names")
+	g.P("}")
+	g.P()
+
+	g.P("// ClearTouched forgets which fields of x were written by setters.")
+	g.P("// The values of the fields are unchanged.")
+	g.P("func (x *", m.GoIdent, ") ClearTouched() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("x.", touchedFieldName, " = [", (len(m.Fields)+31)/32, "]uint32{}")
+	g.P("}")
+	g.P()
+}
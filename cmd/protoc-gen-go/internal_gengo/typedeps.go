@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func messageDependenciesFuncName(f *fileInfo) string {
+	return fileVarName(f.File, "messageDependencies")
+}
+
+// genMessageDependencies generates the MessageDependencies method, which lists
+// the message types reachable from the fields of a message.
+func genMessageDependencies(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	onceVar := messageVarName(f, m, "messageDependenciesOnce")
+	dataVar := messageVarName(f, m, "messageDependencies")
+	g.P("var (")
+	g.P(onceVar, " ", syncPackage.Ident("Once"))
+	g.P(dataVar, " []", protoreflectPackage.Ident("FullName"))
+	g.P(")")
+	g.P()
+
+	g.P("// MessageDependencies returns the full names of the message types which a")
+	g.P("// ", m.GoIdent, " can contain, in ascending order. These are the types of its message")
+	g.P("// fields, including the elements of repeated fields and the values of map")
+	g.P("// fields, and recursively the types of their fields. The message type itself")
+	g.P("// is included only if it is reachable from its fields. Extensions are not")
+	g.P("// considered. The names are computed once from the descriptor, and a copy")
+	g.P("// of them is returned by each call.")
+	g.P("func (*", m.GoIdent, ") MessageDependencies() []", protoreflectPackage.Ident("FullName"), " {")
+	g.P(onceVar, ".Do(func() {")
+	g.P(dataVar, " = ", messageDependenciesFuncName(f), "(", messageDescriptorExpr(f, m), ")")
+	g.P("})")
+	g.P("return append([]", protoreflectPackage.Ident("FullName"), "(nil), ", dataVar, "...)")
+	g.P("}")
+	g.P()
+}
+
+// genFileMessageDependencies generates the function implementing
+// MessageDependencies for all messages of the file.
+func genFileMessageDependencies(g *protogen.GeneratedFile, f *fileInfo) {
+	if len(f.allMessages) == 0 {
+		return
+	}
+	protoreflectIdent := func(name string) protogen.GoIdent { return protoreflectPackage.Ident(name) }
+	g.P("// ", messageDependenciesFuncName(f), " returns the sorted full names of the message")
+	g.P("// types reachable from the fields of md, other than map entries.")
+	g.P("func ", messageDependenciesFuncName(f), "(md ", protoreflectIdent("MessageDescriptor"), ") []", protoreflectIdent("FullName"), " {")
+	g.P("seen := make(map[", protoreflectIdent("FullName"), "]bool)")
+	g.P("var names []", protoreflectIdent("FullName"))
+	g.P("var walk func(", protoreflectIdent("MessageDescriptor"), ")")
+	g.P("walk = func(md ", protoreflectIdent("MessageDescriptor"), ") {")
+	g.P("fds := md.Fields()")
+	g.P("for i := 0; i < fds.Len(); i++ {")
+	g.P("d := fds.Get(i).Message()")
+	g.P("if d == nil || seen[d.FullName()] {")
+	g.P("continue")
+	g.P("}")
+	g.P("seen[d.FullName()] = true")
+	g.P("if !d.IsMapEntry() {")
+	g.P("names = append(names, d.FullName())")
+	g.P("}")
+	g.P("walk(d)")
+	g.P("}")
+	g.P("}")
+	g.P("walk(md)")
+	g.P(sortPackage.Ident("Slice"), "(names, func(i, j int) bool { return names[i] < names[j] })")
+	g.P("return names")
+	g.P("}")
+	g.P()
+}
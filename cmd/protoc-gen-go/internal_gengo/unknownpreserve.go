@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/internal/genid"
+)
+
+// genMessageUnknownFieldsMethods generates the HasUnknownFields and
+// UnknownFieldBytes methods, which expose the unknown fields retained by a
+// message. Unknown fields are held separately from extension fields, so the
+// methods are the same whether or not the message has extension ranges.
+func genMessageUnknownFieldsMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// HasUnknownFields reports whether x retains any unknown fields.")
+	g.P("func (x *", m.GoIdent, ") HasUnknownFields() bool {")
+	g.P("return x != nil && len(x.", genid.UnknownFields_goname, ") > 0")
+	g.P("}")
+	g.P()
+
+	g.P("// UnknownFieldBytes returns the raw wire encoding of the unknown fields")
+	g.P("// retained by x. The caller must not modify the content of the returned slice.")
+	g.P("func (x *", m.GoIdent, ") UnknownFieldBytes() []byte {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("return x.", genid.UnknownFields_goname)
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// unmarshalMaxDepth is the number of levels of nested messages accepted by
+// UnmarshalMax, set with the "unmarshal_max_depth" parameter.
+var unmarshalMaxDepth = 64
+
+// genMessageUnmarshalMax generates the UnmarshalMax method, which rejects
+// oversized and deeply nested input before allocating its contents.
+func genMessageUnmarshalMax(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// UnmarshalMax parses the wire-format message in b and places the result")
+	g.P("// in x, like ", protoPackage.Ident("Unmarshal"), ". It reports an error without parsing b")
+	g.P("// if b is longer than maxBytes, and an error if messages are nested more")
+	g.P("// than ", unmarshalMaxDepth, " levels deep.")
+	g.P("func (x *", m.GoIdent, ") UnmarshalMax(b []byte, maxBytes int) error {")
+	g.P("if len(b) > maxBytes {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"", m.Desc.FullName(), ": message size %d exceeds the limit of %d bytes\", len(b), maxBytes)")
+	g.P("}")
+	g.P("return ", protoPackage.Ident("UnmarshalOptions"), "{RecursionLimit: ", unmarshalMaxDepth, "}.Unmarshal(b, x)")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageURLValues generates the URLValues method, which flattens the
+// scalar fields of a message into query parameters.
+func genMessageURLValues(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// URLValues returns the populated scalar fields of x as query parameters,")
+	g.P("// keyed by the form tag of each field. A repeated field is given by one value")
+	g.P("// per element. Enums are given by number, and bytes in standard base64.")
+	g.P("// Message and map fields are omitted.")
+	g.P("func (x *", m.GoIdent, ") URLValues() ", urlPackage.Ident("Values"), " {")
+	g.P("vs := ", urlPackage.Ident("Values"), "{}")
+	g.P("if x == nil {")
+	g.P("return vs")
+	g.P("}")
+	for _, field := range m.Fields {
+		if field.Message != nil {
+			continue
+		}
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		key := fieldFORMTagValue(field)
+		if field.Desc.IsList() {
+			g.P("for _, v := range ", v, " {")
+			g.P(append(append([]any{"vs.Add(", strconv.Quote(key), ", "}, urlValueArgs(field, "v")...), ")")...)
+			g.P("}")
+			continue
+		}
+		g.P(append([]any{"if "}, append(urlValuePresentCond(m, field), " {")...)...)
+		g.P(append(append([]any{"vs.Set(", strconv.Quote(key), ", "}, urlValueArgs(field, v)...), ")")...)
+		g.P("}")
+	}
+	g.P("return vs")
+	g.P("}")
+	g.P()
+}
+
+// urlValuePresentCond returns the arguments of g.P printing a condition which
+// reports whether the singular field of the message in x is populated.
+func urlValuePresentCond(m *messageInfo, field *protogen.Field) []any {
+	switch {
+	case field.Desc.HasPresence() && !m.isOpen():
+		hasserName, _ := field.MethodName("Has")
+		return []any{"x.", hasserName, "()"}
+	case isOneofMember(field):
+		return []any{"_, ok := x.Get", field.Oneof.GoName, "().(*", opaqueFieldOneofType(field, false), "); ok"}
+	case field.Desc.HasPresence():
+		return []any{"x.", field.GoName, " != nil"}
+	}
+	getterName, _ := field.MethodName("Get")
+	v := "x." + getterName + "()"
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return []any{v}
+	case protoreflect.StringKind:
+		return []any{v, ` != ""`}
+	case protoreflect.BytesKind:
+		return []any{"len(", v, ") > 0"}
+	}
+	return []any{v, " != 0"}
+}
+
+// urlValueArgs returns the arguments of g.P printing an expression which
+// formats the scalar value v of field as a query parameter value.
+func urlValueArgs(field *protogen.Field, v string) []any {
+	formatInt := strconvPackage.Ident("FormatInt")
+	formatUint := strconvPackage.Ident("FormatUint")
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return []any{v}
+	case protoreflect.BytesKind:
+		return []any{base64Package.Ident("StdEncoding"), ".EncodeToString(", v, ")"}
+	case protoreflect.BoolKind:
+		return []any{strconvPackage.Ident("FormatBool"), "(", v, ")"}
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return []any{formatInt, "(int64(", v, "), 10)"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return []any{formatInt, "(", v, ", 10)"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return []any{formatUint, "(uint64(", v, "), 10)"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return []any{formatUint, "(", v, ", 10)"}
+	case protoreflect.FloatKind:
+		return []any{strconvPackage.Ident("FormatFloat"), "(float64(", v, "), 'g', -1, 32)"}
+	}
+	return []any{strconvPackage.Ident("FormatFloat"), "(", v, ", 'g', -1, 64)"}
+}
+
+// genMessageFromURLValues generates the FromURLValues method, which sets the
+// scalar fields of a message from query parameters.
+func genMessageFromURLValues(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	errorf := fmtPackage.Ident("Errorf")
+	var keys []string
+	for _, field := range m.Fields {
+		if field.Message == nil {
+			keys = append(keys, strconv.Quote(fieldFORMTagValue(field)))
+		}
+	}
+
+	g.P("// FromURLValues sets the scalar fields of x from the query parameters in vs,")
+	g.P("// keyed by the form tag of each field. A singular field is set to the first")
+	g.P("// value of its key, and a repeated field is replaced by all of them. Enums")
+	g.P("// are given by name or number, and bytes in standard base64. Fields without")
+	g.P("// a key in vs are unchanged.")
+	if urlValuesUnknown.value == "error" {
+		g.P("// Keys which do not name a scalar field of x are reported as an error.")
+	} else {
+		g.P("// Keys which do not name a scalar field of x are ignored.")
+	}
+	g.P("// If a value cannot be parsed, an error is reported and x is left unchanged.")
+	g.P("func (x *", m.GoIdent, ") FromURLValues(vs ", urlPackage.Ident("Values"), ") error {")
+	if freezable(m) {
+		genCheckFrozen(g, m, "FromURLValues")
+	}
+	if urlValuesUnknown.value == "error" {
+		g.P("for k := range vs {")
+		if len(keys) > 0 {
+			g.P("switch k {")
+			g.P("case ", strings.Join(keys, ", "), ":")
+			g.P("default:")
+		}
+		g.P("return ", errorf, "(\"unknown query parameter %q for message ", m.Desc.FullName(), "\", k)")
+		if len(keys) > 0 {
+			g.P("}")
+		}
+		g.P("}")
+	}
+	if len(keys) == 0 {
+		g.P("return nil")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("y := ", protoPackage.Ident("CloneOf"), "(x)")
+	for _, field := range m.Fields {
+		if field.Message != nil {
+			continue
+		}
+		key := strconv.Quote(fieldFORMTagValue(field))
+		if field.Desc.IsList() {
+			goType, _ := fieldGoType(g, f, field)
+			g.P("if s, ok := vs[", key, "]; ok {")
+			g.P("l := make(", goType, ", len(s))")
+			g.P("for i, s := range s {")
+			g.P("l[i] = ", genScalarParse(g, field, "query parameter", key, "s"))
+			g.P("}")
+			g.P(fieldAssignStmt(m, "y", field, "l"))
+			g.P("}")
+			continue
+		}
+		g.P("if s := vs[", key, "]; len(s) > 0 {")
+		v := genScalarParse(g, field, "query parameter", key, "s[0]")
+		genKVFieldAssign(g, f, m, field, v)
+		g.P("}")
+	}
+	genReplaceContents(g, m, "y")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genScalarParse generates statements parsing the string src with the given
+// quoted key, and returns an expression for the parsed value, which has the Go
+// type of a scalar element of field. Errors are reported as errors of the
+// key, which is described by what (e.g., "query parameter").
+func genScalarParse(g *protogen.GeneratedFile, field *protogen.Field, what, key, src string) string {
+	// The parsing function and its arguments, and the conversion of the
+	// parsed value to the Go type of the field, if any.
+	var fn protogen.GoIdent
+	var args, conv string
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return src
+	case protoreflect.BytesKind:
+		fn, args = protogen.GoIdent{GoName: "StdEncoding.DecodeString", GoImportPath: base64Package}, src
+	case protoreflect.BoolKind:
+		fn, args = strconvPackage.Ident("ParseBool"), src
+	case protoreflect.EnumKind:
+		fn, args = strconvPackage.Ident("ParseInt"), src+", 10, 32"
+		conv = g.QualifiedGoIdent(field.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		fn, args, conv = strconvPackage.Ident("ParseInt"), src+", 10, 32", "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		fn, args = strconvPackage.Ident("ParseInt"), src+", 10, 64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		fn, args, conv = strconvPackage.Ident("ParseUint"), src+", 10, 32", "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		fn, args = strconvPackage.Ident("ParseUint"), src+", 10, 64"
+	case protoreflect.FloatKind:
+		fn, args, conv = strconvPackage.Ident("ParseFloat"), src+", 32", "float32"
+	case protoreflect.DoubleKind:
+		fn, args = strconvPackage.Ident("ParseFloat"), src+", 64"
+	}
+	v := "v"
+	if conv != "" {
+		v = "n"
+	}
+	g.P(v, ", err := ", fn, "(", args, ")")
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		valueMap := protogen.GoIdent{
+			GoName:       field.Enum.GoIdent.GoName + "_value",
+			GoImportPath: field.Enum.GoIdent.GoImportPath,
+		}
+		g.P("if e, ok := ", valueMap, "[", src, "]; ok {")
+		g.P("n, err = int64(e), nil")
+		g.P("}")
+	}
+	g.P("if err != nil {")
+	g.P("return ", fmtPackage.Ident("Errorf"), "(\"", what, " %q: %v\", ", key, ", err)")
+	g.P("}")
+	if conv != "" {
+		return conv + "(n)"
+	}
+	return v
+}
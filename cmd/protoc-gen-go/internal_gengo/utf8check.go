@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genMessageValidateUTF8 generates the ValidateUTF8 method, which reports an
+// error for the first string field of a message holding invalid UTF-8.
+func genMessageValidateUTF8(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	validString := utf8Package.Ident("ValidString")
+	isString := func(field *protogen.Field) bool { return field.Desc.Kind() == protoreflect.StringKind }
+
+	g.P("// ValidateUTF8 reports an error naming the first string field of x, or of")
+	g.P("// its nested messages, which holds invalid UTF-8. The elements of repeated")
+	g.P("// fields and the keys and values of map fields are checked. Fields are")
+	g.P("// checked regardless of their utf8_validation feature.")
+	g.P("func (x *", m.GoIdent, ") ValidateUTF8() error {")
+	g.P("if x == nil {")
+	g.P("return nil")
+	g.P("}")
+	for _, field := range m.Fields {
+		getterName, _ := field.MethodName("Get")
+		v := "x." + getterName + "()"
+		invalid := "return " + g.QualifiedGoIdent(fmtPackage.Ident("Errorf")) + "(" +
+			strconv.Quote("field "+string(field.Desc.FullName())+" contains invalid UTF-8") + ")"
+		switch {
+		case field.Desc.IsMap():
+			keyField, valField := field.Message.Fields[0], field.Message.Fields[1]
+			if !isString(keyField) && !isString(valField) && valField.Message == nil {
+				continue
+			}
+			vars := "_, v"
+			switch {
+			case isString(keyField) && (isString(valField) || valField.Message != nil):
+				vars = "k, v"
+			case isString(keyField):
+				vars = "k"
+			}
+			g.P("for ", vars, " := range ", v, " {")
+			var conds []string
+			if isString(keyField) {
+				conds = append(conds, "!"+g.QualifiedGoIdent(validString)+"(k)")
+			}
+			if isString(valField) {
+				conds = append(conds, "!"+g.QualifiedGoIdent(validString)+"(v)")
+			}
+			if len(conds) > 0 {
+				g.P("if ", strings.Join(conds, " || "), " {")
+				g.P(invalid)
+				g.P("}")
+			}
+			if valField.Message != nil {
+				genValidateUTF8Call(g, f, valField.Message, "v")
+			}
+			g.P("}")
+		case field.Desc.IsList() && (isString(field) || field.Message != nil):
+			g.P("for _, v := range ", v, " {")
+			if field.Message != nil {
+				genValidateUTF8Call(g, f, field.Message, "v")
+			} else {
+				g.P("if !", validString, "(v) {")
+				g.P(invalid)
+				g.P("}")
+			}
+			g.P("}")
+		case field.Desc.IsList():
+		case field.Message != nil:
+			genValidateUTF8Call(g, f, field.Message, v)
+		case isString(field):
+			g.P("if !", validString, "(", v, ") {")
+			g.P(invalid)
+			g.P("}")
+		}
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// genValidateUTF8Call generates a call of ValidateUTF8 on the message value v,
+// returning its error. Messages declared in other files are only checked if
+// they were also generated with the method.
+func genValidateUTF8Call(g *protogen.GeneratedFile, f *fileInfo, message *protogen.Message, v string) {
+	if isLocalMessage(f, message) {
+		g.P("if err := ", v, ".ValidateUTF8(); err != nil {")
+		g.P("return err")
+		g.P("}")
+		return
+	}
+	g.P("if m, ok := any(", v, ").(interface{ ValidateUTF8() error }); ok {")
+	g.P("if err := m.ValidateUTF8(); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+}
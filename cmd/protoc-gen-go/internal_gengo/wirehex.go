@@ -0,0 +1,44 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageWireHex generates the WireHex method and the TFromWireHex
+// function, which convert a message to and from the hexadecimal encoding of
+// its wire format, for pasting into bug reports and decoding tools.
+func genMessageWireHex(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	g.P("// WireHex returns the hexadecimal encoding of the wire format of x. The")
+	g.P("// message is marshaled deterministically, so equal messages have the same")
+	g.P("// encoding.")
+	g.P("func (x *", m.GoIdent, ") WireHex() (string, error) {")
+	g.P("b, err := ", protoPackage.Ident("MarshalOptions"), "{Deterministic: true}.Marshal(x)")
+	g.P("if err != nil {")
+	g.P(`return "", err`)
+	g.P("}")
+	g.P("return ", hexPackage.Ident("EncodeToString"), "(b), nil")
+	g.P("}")
+	g.P()
+
+	funcName := m.GoIdent.GoName + "FromWireHex"
+	g.P("// ", funcName, " returns the message whose wire format has the hexadecimal")
+	g.P("// encoding s, as returned by WireHex.")
+	g.P("func ", funcName, "(s string) (*", m.GoIdent, ", error) {")
+	g.P("b, err := ", hexPackage.Ident("DecodeString"), "(s)")
+	g.P("if err != nil {")
+	g.P("return nil, ", fmtPackage.Ident("Errorf"), "(", strconv.Quote(string(m.Desc.FullName())+": invalid wire hex: %w"), ", err)")
+	g.P("}")
+	g.P("x := new(", m.GoIdent, ")")
+	g.P("if err := ", protoPackage.Ident("Unmarshal"), "(b, x); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return x, nil")
+	g.P("}")
+	g.P()
+}
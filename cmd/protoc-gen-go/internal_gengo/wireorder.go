@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal_gengo
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genMessageFieldsInWireOrder generates the FieldsInWireOrder method, which
+// returns the field descriptors of a message sorted by field number.
+func genMessageFieldsInWireOrder(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
+	onceVar := messageVarName(f, m, "wireOrderOnce")
+	dataVar := messageVarName(f, m, "wireOrder")
+	g.P("var (")
+	g.P(onceVar, " ", syncPackage.Ident("Once"))
+	g.P(dataVar, " []", protoreflectPackage.Ident("FieldDescriptor"))
+	g.P(")")
+	g.P()
+
+	g.P("// FieldsInWireOrder returns the descriptors of the fields of ", m.GoIdent, " and of the")
+	g.P("// extensions of ", m.GoIdent, " declared in the same file, sorted by field number.")
+	g.P("// The slice is shared by all callers and must not be modified.")
+	g.P("func (*", m.GoIdent, ") FieldsInWireOrder() []", protoreflectPackage.Ident("FieldDescriptor"), " {")
+	g.P(onceVar, ".Do(func() {")
+	g.P("fds := ", messageDescriptorExpr(f, m), ".Fields()")
+	g.P("s := make([]", protoreflectPackage.Ident("FieldDescriptor"), ", 0, fds.Len())")
+	g.P("for i := 0; i < fds.Len(); i++ {")
+	g.P("s = append(s, fds.Get(i))")
+	g.P("}")
+	for i, x := range f.allExtensions {
+		if x.Extendee.Desc.FullName() == m.Desc.FullName() {
+			g.P("s = append(s, ", extensionTypesVarName(f), "[", i, "].TypeDescriptor())")
+		}
+	}
+	g.P(sortPackage.Ident("Slice"), "(s, func(i, j int) bool { return s[i].Number() < s[j].Number() })")
+	g.P(dataVar, " = s")
+	g.P("})")
+	g.P("return ", dataVar)
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	iszerofastpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/iszerofast"
+)
+
+func TestIsZeroFast(t *testing.T) {
+	withUnknown := &iszerofastpb.Record{}
+	withUnknown.ProtoReflect().SetUnknown([]byte{0xf8, 0x06, 0x01}) // field 111, varint 1
+	for _, m := range []*iszerofastpb.Record{
+		{},
+		{Id: 1},
+		{Name: "n"},
+		{Data: []byte{}},
+		{Data: []byte{0}},
+		{Score: math.Copysign(0, -1)},
+		{Ratio: float32(math.NaN())},
+		{Ok: true},
+		{Version: proto.Int64(0)},
+		{Child: &iszerofastpb.Record{}},
+		{Ids: []int32{}},
+		{Ids: []int32{0}},
+		{Attrs: map[string]string{}},
+		{Attrs: map[string]string{"": ""}},
+		{Status: iszerofastpb.Status_STATUS_ACTIVE},
+		{Choice: &iszerofastpb.Record_Text{}},
+		{Choice: &iszerofastpb.Record_Nested{}},
+		withUnknown,
+	} {
+		want := proto.Equal(m, &iszerofastpb.Record{})
+		if got := m.IsZeroFast(); got != want {
+			t.Errorf("IsZeroFast() of %v = %v, want %v", m, got, want)
+		}
+	}
+	if !(*iszerofastpb.Record)(nil).IsZeroFast() {
+		t.Errorf("IsZeroFast() of a nil message = false, want true")
+	}
+}
+
+func TestIsZeroFastExtensions(t *testing.T) {
+	m := &iszerofastpb.Legacy{}
+	if !m.IsZeroFast() {
+		t.Errorf("IsZeroFast() of an empty message = false, want true")
+	}
+	proto.SetExtension(m, iszerofastpb.E_Note, "")
+	if m.IsZeroFast() {
+		t.Errorf("IsZeroFast() with an extension = true, want false")
+	}
+	if m := (&iszerofastpb.Legacy{Weight: proto.Float32(0)}); m.IsZeroFast() {
+		t.Errorf("IsZeroFast() of %v = true, want false", m)
+	}
+}
+
+func TestIsZeroFastHybrid(t *testing.T) {
+	for _, m := range []*iszerofastpb.Entry{
+		iszerofastpb.Entry_builder{}.Build(),
+		iszerofastpb.Entry_builder{Key: proto.String("")}.Build(),
+		iszerofastpb.Entry_builder{Parent: iszerofastpb.Entry_builder{}.Build()}.Build(),
+		iszerofastpb.Entry_builder{Values: []string{""}}.Build(),
+		iszerofastpb.Entry_builder{Size: 1}.Build(),
+		iszerofastpb.Entry_builder{Number: proto.Int32(0)}.Build(),
+	} {
+		want := proto.Equal(m, &iszerofastpb.Entry{})
+		if got := m.IsZeroFast(); got != want {
+			t.Errorf("IsZeroFast() of %v = %v, want %v", m, got, want)
+		}
+	}
+}
+
+func BenchmarkIsZeroFast(b *testing.B) {
+	m := &iszerofastpb.Record{Attrs: map[string]string{}}
+	b.Run("IsZeroFast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.IsZeroFast()
+		}
+	})
+	b.Run("proto.Equal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			proto.Equal(m, &iszerofastpb.Record{})
+		}
+	})
+}
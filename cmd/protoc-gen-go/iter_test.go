@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	iterpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/iter"
+)
+
+func TestIterRepeated(t *testing.T) {
+	m := &iterpb.Inventory{Tags: []string{"a", "b", "c"}}
+	var got []string
+	for v := range m.AllTags() {
+		got = append(got, v)
+	}
+	if diff := cmp.Diff([]string{"a", "b", "c"}, got); diff != "" {
+		t.Errorf("AllTags() mismatch (-want +got):\n%s", diff)
+	}
+
+	got = nil
+	for v := range m.AllTags() {
+		got = append(got, v)
+		if v == "b" {
+			break
+		}
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, got); diff != "" {
+		t.Errorf("AllTags() with break mismatch (-want +got):\n%s", diff)
+	}
+
+	item := &iterpb.Inventory_Item{Name: "item"}
+	m.Items = []*iterpb.Inventory_Item{item}
+	if got := slices.Collect(m.AllItems()); len(got) != 1 || got[0] != item {
+		t.Errorf("AllItems() = %v, want [%v]", got, item)
+	}
+}
+
+func TestIterMap(t *testing.T) {
+	m := &iterpb.Inventory{Counts: map[string]int32{"a": 1, "b": 2, "c": 3}}
+	got := make(map[string]int32)
+	for k, v := range m.AllCountsEntries() {
+		got[k] = v
+	}
+	if diff := cmp.Diff(m.Counts, got); diff != "" {
+		t.Errorf("AllCountsEntries() mismatch (-want +got):\n%s", diff)
+	}
+
+	n := 0
+	for range m.AllCountsEntries() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("AllCountsEntries() with break yielded %d entries, want 1", n)
+	}
+}
+
+func TestIterNil(t *testing.T) {
+	var m *iterpb.Inventory
+	for v := range m.AllTags() {
+		t.Errorf("nil.AllTags() yielded %v", v)
+	}
+	for k, v := range m.AllByIdEntries() {
+		t.Errorf("nil.AllByIdEntries() yielded (%v, %v)", k, v)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	joinedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/joined"
+)
+
+func TestJoined(t *testing.T) {
+	m := &joinedpb.Report{
+		Counts:  []int32{1, -2, 30},
+		Levels:  []joinedpb.Report_Level{joinedpb.Report_LEVEL_HIGH, joinedpb.Report_LEVEL_LOW, 7},
+		Names:   []string{"a", "b"},
+		Ids:     []uint64{math.MaxUint64},
+		Flags:   []bool{true, false},
+		Ratios:  []float64{0.5, 1e21},
+		Weights: []float32{0.1},
+	}
+	for _, tt := range []struct {
+		name      string
+		got, want string
+	}{
+		{"CountsJoined", m.CountsJoined(","), "1,-2,30"},
+		{"LevelsJoined", m.LevelsJoined(", "), "LEVEL_HIGH, LEVEL_LOW, 7"},
+		{"NamesJoined", m.NamesJoined("/"), "a/b"},
+		{"IdsJoined", m.IdsJoined(","), "18446744073709551615"},
+		{"FlagsJoined", m.FlagsJoined(" "), "true false"},
+		{"RatiosJoined", m.RatiosJoined(","), "0.5,1e+21"},
+		{"WeightsJoined", m.WeightsJoined(","), "0.1"},
+		{"empty", new(joinedpb.Report).CountsJoined(","), ""},
+		{"nil", (*joinedpb.Report)(nil).LevelsJoined(","), ""},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%v = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
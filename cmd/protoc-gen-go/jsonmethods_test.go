@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	methodspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/methods"
+	numericpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/numeric"
+	strictpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/strict"
+)
+
+const (
+	cleanJSON   = `{"userName": "gopher", "options": {"verbose": true}}`
+	unknownJSON = `{"userName": "gopher", "options": {"verbose": true, "color": "blue"}}`
+)
+
+func TestJSONStrict(t *testing.T) {
+	got := new(strictpb.Request)
+	if err := json.Unmarshal([]byte(cleanJSON), got); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", cleanJSON, err)
+	}
+	want := &strictpb.Request{UserName: "gopher", Options: &strictpb.Options{Verbose: true}}
+	if !proto.Equal(got, want) {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", cleanJSON, got, want)
+	}
+
+	if err := json.Unmarshal([]byte(unknownJSON), new(strictpb.Request)); err == nil {
+		t.Errorf("json.Unmarshal(%s) with unknown field: got nil error, want error", unknownJSON)
+	}
+
+	// Strict unmarshaling is a modifier of the methods generated by
+	// json=methods, so the messages still marshal with the JSON mapping.
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v): %v", want, err)
+	}
+	got = new(strictpb.Request)
+	if err := json.Unmarshal(b, got); err != nil || !proto.Equal(got, want) {
+		t.Errorf("json.Unmarshal(json.Marshal(%v)) = %v, %v; want %v", want, got, err, want)
+	}
+}
+
+func TestJSONMethodsDiscardUnknown(t *testing.T) {
+	got := new(methodspb.Request)
+	if err := json.Unmarshal([]byte(unknownJSON), got); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", unknownJSON, err)
+	}
+	want := &methodspb.Request{UserName: "gopher", Options: &methodspb.Options{Verbose: true}}
+	if !proto.Equal(got, want) {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", unknownJSON, got, want)
+	}
+}
+
+func TestJSONNumericEnums(t *testing.T) {
+	m := &numericpb.Account{
+		Name:     "name",
+		Status:   numericpb.LegacyStatus_LEGACY_STATUS_ACTIVE,
+		Color:    numericpb.Color_COLOR_RED,
+		History:  []numericpb.LegacyStatus{numericpb.LegacyStatus_LEGACY_STATUS_CLOSED},
+		ByRegion: map[string]numericpb.LegacyStatus{"eu": numericpb.LegacyStatus_LEGACY_STATUS_ACTIVE},
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v): %v", m, err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", b, err)
+	}
+	want := map[string]any{
+		"name":     "name",
+		"status":   1.0,
+		"color":    "COLOR_RED",
+		"history":  []any{2.0},
+		"byRegion": map[string]any{"eu": 1.0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Marshal(%v) = %s, want %v", m, b, want)
+	}
+
+	rt := new(numericpb.Account)
+	if err := json.Unmarshal(b, rt); err != nil || !proto.Equal(rt, m) {
+		t.Errorf("json.Unmarshal(%s) = %v, %v; want %v", b, rt, err, m)
+	}
+
+	// Messages without a field of a numeric enum marshal enums as names.
+	b, err = json.Marshal(&numericpb.Label{Color: numericpb.Color_COLOR_RED})
+	if err != nil || !strings.Contains(string(b), `"COLOR_RED"`) {
+		t.Errorf("json.Marshal(Label) = %s, %v; want the enum name", b, err)
+	}
+}
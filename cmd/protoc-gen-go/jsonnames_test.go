@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	jsonnamespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/maps/jsonnames"
+)
+
+func TestJSONNames(t *testing.T) {
+	fields := (&jsonnamespb.Account{}).ProtoReflect().Descriptor().Fields()
+	if got, want := len(jsonnamespb.Account_jsonNames), fields.Len(); got != want {
+		t.Errorf("len(Account_jsonNames) = %d, want one entry per field (%d)", got, want)
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if got, want := jsonnamespb.Account_jsonNames[fd.Name()], fd.JSONName(); got != want {
+			t.Errorf("Account_jsonNames[%q] = %q, want %q", fd.Name(), got, want)
+		}
+	}
+
+	// Explicit JSON names are used as is.
+	for name, want := range map[string]string{
+		"account_id": "id",
+		"sso_token":  "SSO",
+	} {
+		if got := jsonnamespb.Account_jsonNames[protoreflect.Name(name)]; got != want {
+			t.Errorf("Account_jsonNames[%q] = %q, want explicit JSON name %q", name, got, want)
+		}
+	}
+}
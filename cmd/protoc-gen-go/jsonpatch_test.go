@@ -0,0 +1,115 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	jsonpatchpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/jsonpatch"
+)
+
+func newPatchServer() *jsonpatchpb.Server {
+	return &jsonpatchpb.Server{
+		DisplayName:    "old",
+		MaxConnections: 10,
+		Tags:           []string{"a", "b"},
+		Primary:        &jsonpatchpb.Server_Endpoint{Host: "primary", Port: 80},
+		Replicas:       []*jsonpatchpb.Server_Endpoint{{Host: "r0"}},
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		patch string
+		edit  func(*jsonpatchpb.Server)
+	}{{
+		name:  "replace scalar",
+		patch: `[{"op": "replace", "path": "/displayName", "value": "new"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.DisplayName = "new" },
+	}, {
+		name:  "replace scalar by proto name",
+		patch: `[{"op": "replace", "path": "/max_connections", "value": "9007199254740993"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.MaxConnections = 9007199254740993 },
+	}, {
+		name:  "replace nested scalar",
+		patch: `[{"op": "replace", "path": "/primary/port", "value": 443}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Primary.Port = 443 },
+	}, {
+		name:  "add to repeated field",
+		patch: `[{"op": "add", "path": "/tags/-", "value": "c"}, {"op": "add", "path": "/tags/0", "value": "z"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Tags = []string{"z", "a", "b", "c"} },
+	}, {
+		name:  "add message to repeated field",
+		patch: `[{"op": "add", "path": "/replicas/1", "value": {"host": "r1"}}]`,
+		edit: func(m *jsonpatchpb.Server) {
+			m.Replicas = append(m.Replicas, &jsonpatchpb.Server_Endpoint{Host: "r1"})
+		},
+	}, {
+		name:  "replace in repeated message",
+		patch: `[{"op": "replace", "path": "/replicas/0/host", "value": "r9"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Replicas[0].Host = "r9" },
+	}, {
+		name:  "remove message field",
+		patch: `[{"op": "remove", "path": "/primary"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Primary = nil },
+	}, {
+		name:  "remove repeated element",
+		patch: `[{"op": "remove", "path": "/tags/0"}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Tags = []string{"b"} },
+	}, {
+		name:  "replace message field",
+		patch: `[{"op": "replace", "path": "/primary", "value": {"host": "h"}}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Primary = &jsonpatchpb.Server_Endpoint{Host: "h"} },
+	}, {
+		name:  "add optional field",
+		patch: `[{"op": "add", "path": "/enabled", "value": false}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Enabled = proto.Bool(false) },
+	}, {
+		name:  "null clears field",
+		patch: `[{"op": "replace", "path": "/primary", "value": null}]`,
+		edit:  func(m *jsonpatchpb.Server) { m.Primary = nil },
+	}} {
+		got, want := newPatchServer(), newPatchServer()
+		tt.edit(want)
+		if err := got.ApplyJSONPatch([]byte(tt.patch)); err != nil {
+			t.Errorf("%v: ApplyJSONPatch(%v): %v", tt.name, tt.patch, err)
+			continue
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("%v: ApplyJSONPatch(%v) = %v, want %v", tt.name, tt.patch, got, want)
+		}
+	}
+}
+
+func TestApplyJSONPatchErrors(t *testing.T) {
+	for _, patch := range []string{
+		`{}`,
+		`[{"op": "move", "from": "/tags/0", "path": "/tags/1"}]`,
+		`[{"op": "test", "path": "/displayName", "value": "old"}]`,
+		`[{"op": "replace", "path": "displayName", "value": "new"}]`,
+		`[{"op": "replace", "path": "/noSuchField", "value": 1}]`,
+		`[{"op": "replace", "path": "/displayName"}]`,
+		`[{"op": "replace", "path": "/displayName", "value": 1}]`,
+		`[{"op": "add", "path": "/labels/key", "value": "v"}]`,
+		`[{"op": "add", "path": "/displayName/x", "value": "v"}]`,
+		`[{"op": "add", "path": "/tags/3", "value": "c"}]`,
+		`[{"op": "replace", "path": "/tags/-", "value": "c"}]`,
+		`[{"op": "remove", "path": "/tags/01"}]`,
+		`[{"op": "remove", "path": "/replicas/1/host"}]`,
+		// The first operation succeeds, but is not applied as the second fails.
+		`[{"op": "replace", "path": "/displayName", "value": "new"}, {"op": "remove", "path": "/tags/5"}]`,
+	} {
+		m := newPatchServer()
+		if err := m.ApplyJSONPatch([]byte(patch)); err == nil {
+			t.Errorf("ApplyJSONPatch(%v): got nil error, want error", patch)
+		}
+		if want := newPatchServer(); !proto.Equal(m, want) {
+			t.Errorf("ApplyJSONPatch(%v) failed, but modified the message: got %v, want %v", patch, m, want)
+		}
+	}
+}
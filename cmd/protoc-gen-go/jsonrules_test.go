@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	rulespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/rules"
+)
+
+func TestJSONRulesMarshal(t *testing.T) {
+	for _, test := range []struct {
+		m    *rulespb.User
+		want map[string]any
+	}{{
+		m: &rulespb.User{},
+		want: map[string]any{
+			"retries":  0.0,
+			"level":    0.0,
+			"kind":     "KIND_UNSPECIFIED",
+			"tags":     []any{},
+			"nickname": nil,
+			"manager":  nil,
+		},
+	}, {
+		m: &rulespb.User{
+			Name:     "n",
+			Retries:  2,
+			Password: "secret",
+			Level:    rulespb.Level_LEVEL_HIGH,
+			Kind:     rulespb.Kind_KIND_USER,
+			Tags:     []string{"t"},
+			Nickname: proto.String(""),
+			Manager:  &rulespb.User{Password: "secret"},
+			Priority: rulespb.Level_LEVEL_HIGH,
+			Internal: &rulespb.User{Name: "i"},
+		},
+		want: map[string]any{
+			"name":     "n",
+			"retries":  2.0,
+			"level":    1.0,
+			"kind":     "KIND_USER",
+			"tags":     []any{"t"},
+			"nickname": "",
+			// The options apply to the fields of nested messages only when
+			// they are marshaled by their own MarshalJSON.
+			"manager":  map[string]any{"password": "secret"},
+			"priority": 1.0,
+		},
+	}} {
+		b, err := json.Marshal(test.m)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v): %v", test.m, err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", b, err)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("json.Marshal(%v) mismatch (-want +got):\n%s", test.m, diff)
+		}
+
+		// The result can be unmarshaled with protojson, without the fields
+		// which are never emitted.
+		want := proto.CloneOf(test.m)
+		want.Password, want.Internal = "", nil
+		u := &rulespb.User{}
+		if err := protojson.Unmarshal(b, u); err != nil || !proto.Equal(u, want) {
+			t.Errorf("protojson.Unmarshal(%s) = %v, %v, want %v", b, u, err, want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	kindlookuppb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/kindlookup"
+)
+
+func TestKindByGoName(t *testing.T) {
+	m := &kindlookuppb.Account{}
+	for _, test := range []struct {
+		goName string
+		want   protoreflect.Kind
+	}{
+		{goName: "Balance", want: protoreflect.Sint64Kind},
+		{goName: "Aliases", want: protoreflect.StringKind},
+		{goName: "Tier", want: protoreflect.EnumKind},
+		{goName: "Children", want: protoreflect.MessageKind},
+		{goName: "OwnerId", want: protoreflect.Fixed32Kind},
+		{goName: "Parent", want: protoreflect.MessageKind},
+	} {
+		got, ok := m.KindByGoName(test.goName)
+		if !ok || got != test.want {
+			t.Errorf("KindByGoName(%q) = %v, %v, want %v, true", test.goName, got, ok, test.want)
+		}
+	}
+
+	for _, name := range []string{"", "balance", "Owner", "Unknown"} {
+		if got, ok := m.KindByGoName(name); ok {
+			t.Errorf("KindByGoName(%q) = %v, true, want false", name, got)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	lenientpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/lenientunmarshal"
+)
+
+func TestUnmarshalLenient(t *testing.T) {
+	// The value field is encoded as a string instead of a varint,
+	// both in the message and in the nested message.
+	var nested []byte
+	nested = protowire.AppendTag(nested, 1, protowire.BytesType)
+	nested = protowire.AppendString(nested, "nested")
+	nested = protowire.AppendTag(nested, 2, protowire.BytesType)
+	nested = protowire.AppendString(nested, "7")
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, "sensor")
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "42")
+	b = protowire.AppendTag(b, 3, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 0x3ff0000000000000) // 1.0
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendBytes(b, protowire.AppendVarint(protowire.AppendVarint(nil, 1), 2)) // packed
+	b = protowire.AppendTag(b, 4, protowire.VarintType)
+	b = protowire.AppendVarint(b, 3) // unpacked
+	b = protowire.AppendTag(b, 5, protowire.BytesType)
+	b = protowire.AppendBytes(b, nested)
+	b = protowire.AppendTag(b, 100, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1) // unknown
+
+	// The strict parser retains the mismatched fields as unknown fields.
+	strict := &lenientpb.Reading{}
+	if err := proto.Unmarshal(b, strict); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if strict.GetValue() != 0 || len(strict.ProtoReflect().GetUnknown()) == 0 {
+		t.Fatalf("proto.Unmarshal: got value %d and unknown fields %x, want mismatched field in unknown fields",
+			strict.GetValue(), strict.ProtoReflect().GetUnknown())
+	}
+
+	got := &lenientpb.Reading{}
+	warnings, err := got.UnmarshalLenient(b)
+	if err != nil {
+		t.Fatalf("UnmarshalLenient: %v", err)
+	}
+	want := &lenientpb.Reading{
+		Sensor:   "sensor",
+		Scale:    1,
+		Samples:  []int32{1, 2, 3},
+		Previous: &lenientpb.Reading{Sensor: "nested"},
+	}
+	want.ProtoReflect().SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 1))
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalLenient:\ngot:  %v\nwant: %v", got, want)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("UnmarshalLenient: got warnings %q, want 2 warnings", warnings)
+	}
+	for _, w := range warnings {
+		if !strings.Contains(w, "goproto.protoc.methods.lenientunmarshal.Reading.value") {
+			t.Errorf("UnmarshalLenient: got warning %q, want it to name the value field", w)
+		}
+	}
+}
+
+func TestUnmarshalLenientStrict(t *testing.T) {
+	in := &lenientpb.Reading{Sensor: "sensor", Value: 42, Samples: []int32{1, 2}}
+	b, err := proto.Marshal(in)
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	got := &lenientpb.Reading{}
+	warnings, err := got.UnmarshalLenient(b)
+	if err != nil || len(warnings) > 0 {
+		t.Fatalf("UnmarshalLenient of valid input = %q, %v; want no warnings and no error", warnings, err)
+	}
+	if !proto.Equal(got, in) {
+		t.Errorf("UnmarshalLenient:\ngot:  %v\nwant: %v", got, in)
+	}
+
+	// Malformed input is still an error.
+	if _, err := got.UnmarshalLenient(b[:len(b)-1]); err == nil {
+		t.Errorf("UnmarshalLenient of truncated input: got nil error, want error")
+	}
+}
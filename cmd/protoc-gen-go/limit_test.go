@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	limitpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/limit"
+)
+
+func TestLimitCollections(t *testing.T) {
+	m := &limitpb.Collections{
+		Values: []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		Counts: map[string]int32{"e": 5, "c": 3, "a": 1, "d": 4, "b": 2},
+		Flags:  map[bool]string{true: "yes", false: "no"},
+		Single: &limitpb.Collections{
+			Values: []int32{0, 1, 2, 3, 4},
+		},
+		Children: []*limitpb.Collections{
+			{Values: []int32{0, 1, 2, 3, 4}},
+			{Values: []int32{5, 6, 7, 8}},
+			nil,
+			{},
+		},
+		Choice: &limitpb.Collections_Chosen{
+			Chosen: &limitpb.Collections{Values: []int32{0, 1, 2, 3}},
+		},
+	}
+	m.LimitCollections(3)
+
+	if got, want := m.GetValues(), []int32{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Values = %v, want %v", got, want)
+	}
+	if got, want := m.GetCounts(), map[string]int32{"a": 1, "b": 2, "c": 3}; !cmp.Equal(got, want) {
+		t.Errorf("Counts = %v, want %v", got, want)
+	}
+	if got, want := len(m.GetFlags()), 2; got != want {
+		t.Errorf("len(Flags) = %v, want %v", got, want)
+	}
+	if got, want := m.GetSingle().GetValues(), []int32{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Single.Values = %v, want %v", got, want)
+	}
+	if got, want := len(m.GetChildren()), 3; got != want {
+		t.Fatalf("len(Children) = %v, want %v", got, want)
+	}
+	if got, want := m.GetChildren()[0].GetValues(), []int32{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Children[0].Values = %v, want %v", got, want)
+	}
+	if got, want := m.GetChildren()[1].GetValues(), []int32{5, 6, 7}; !cmp.Equal(got, want) {
+		t.Errorf("Children[1].Values = %v, want %v", got, want)
+	}
+	if got, want := m.GetChosen().GetValues(), []int32{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Chosen.Values = %v, want %v", got, want)
+	}
+
+	m.LimitCollections(1)
+	if got, want := m.GetFlags(), map[bool]string{false: "no"}; !cmp.Equal(got, want) {
+		t.Errorf("Flags = %v, want %v", got, want)
+	}
+
+	var nilMsg *limitpb.Collections
+	nilMsg.LimitCollections(3) // must not panic
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	logstringpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/logstring"
+)
+
+func TestLogString(t *testing.T) {
+	tests := []struct {
+		desc string
+		m    *logstringpb.Request
+		want string
+	}{
+		{"nil", nil, "<nil>"},
+		{"empty", &logstringpb.Request{}, ""},
+		{
+			desc: "scalars",
+			m: &logstringpb.Request{
+				Path:    "/a\nb",
+				Code:    404,
+				Retry:   true,
+				Status:  logstringpb.Status_STATUS_ACTIVE,
+				Latency: proto.Float64(0),
+				Client:  &logstringpb.Request_UserAgent{UserAgent: "curl"},
+			},
+			want: `path="/a\nb" code=404 retry=true status=STATUS_ACTIVE latency=0 user_agent="curl"`,
+		},
+		{
+			desc: "submessages and collections",
+			m: &logstringpb.Request{
+				Payload: &logstringpb.Request_Payload{Data: []byte("large")},
+				Headers: []string{"a", "b"},
+				Labels:  map[string]string{"k": "v"},
+				Client:  &logstringpb.Request_Raw{Raw: &logstringpb.Request_Payload{}},
+			},
+			want: "payload=<Payload> headers=<2 elements> labels=<1 elements> raw=<Payload>",
+		},
+		{
+			desc: "sensitive",
+			m: &logstringpb.Request{
+				Code:  1,
+				Token: []byte("secret-token"),
+				Email: "user@example.com",
+			},
+			want: "code=1 token=<redacted> email=<redacted>",
+		},
+	}
+	for _, tt := range tests {
+		got := tt.m.LogString()
+		if got != tt.want {
+			t.Errorf("%s: LogString() = %q, want %q", tt.desc, got, tt.want)
+		}
+		if strings.Contains(got, "\n") {
+			t.Errorf("%s: LogString() = %q, want a single line", tt.desc, got)
+		}
+	}
+}
@@ -39,6 +39,7 @@ func main() {
 		plugins                               = flags.String("plugins", "", "deprecated option")
 		experimentalStripNonFunctionalCodegen = flags.Bool("experimental_strip_nonfunctional_codegen", false, "experimental_strip_nonfunctional_codegen true means that the plugin will not emit certain parts of the generated code in order to make it possible to compare a proto2/proto3 file with its equivalent (according to proto spec) editions file. Primarily, this is the encoded descriptor.")
 	)
+	gengo.RegisterFlags(&flags)
 	protogen.Options{
 		ParamFunc:                    flags.Set,
 		InternalStripForEditionsDiff: experimentalStripNonFunctionalCodegen,
@@ -47,6 +48,11 @@ func main() {
 			return errors.New("protoc-gen-go: plugins are not supported; use 'protoc --go-grpc_out=...' to generate gRPC\n\n" +
 				"See " + grpcDocURL + " for more information.")
 		}
+		// Refuse to generate subtly wrong code for known-broken combinations
+		// of generator parameters.
+		if err := gengo.ValidateFlags(); err != nil {
+			return err
+		}
 		for _, f := range gen.Files {
 			if f.Generate {
 				gengo.GenerateFile(gen, f)
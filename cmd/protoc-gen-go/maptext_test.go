@@ -0,0 +1,84 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	maptextpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maptext"
+)
+
+func upperStrings(_ protoreflect.Name, s string) string { return strings.ToUpper(s) }
+
+func TestMapStrings(t *testing.T) {
+	m := &maptextpb.Patient{
+		Name:       "ann",
+		Nickname:   proto.String("annie"),
+		Age:        30,
+		Aliases:    []string{"a", "b"},
+		Attributes: map[string]string{"eyes": "blue"},
+		Emergency:  &maptextpb.Patient_Contact{Name: "bob", Phone: "555"},
+		Relatives:  []*maptextpb.Patient_Contact{{Name: "carl"}},
+		Doctors:    map[string]*maptextpb.Patient_Contact{"gp": {Name: "dora"}},
+		Id:         &maptextpb.Patient_Ssn{Ssn: "ssn"},
+	}
+	m.MapStrings(upperStrings)
+	want := &maptextpb.Patient{
+		Name:       "ANN",
+		Nickname:   proto.String("ANNIE"),
+		Age:        30,
+		Aliases:    []string{"A", "B"},
+		Attributes: map[string]string{"eyes": "BLUE"},
+		Emergency:  &maptextpb.Patient_Contact{Name: "BOB", Phone: "555"},
+		Relatives:  []*maptextpb.Patient_Contact{{Name: "CARL"}},
+		Doctors:    map[string]*maptextpb.Patient_Contact{"gp": {Name: "DORA"}},
+		Id:         &maptextpb.Patient_Ssn{Ssn: "SSN"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("MapStrings(upper) = %v, want %v", m, want)
+	}
+
+	var names []protoreflect.Name
+	(&maptextpb.Patient{Name: "ann", Aliases: []string{"a"}}).MapStrings(func(name protoreflect.Name, s string) string {
+		names = append(names, name)
+		return s
+	})
+	if got, want := len(names), 2; got != want || names[0] != "name" || names[1] != "aliases" {
+		t.Errorf("MapStrings called f with field names %v, want [name aliases]", names)
+	}
+}
+
+func TestMapStringsHybrid(t *testing.T) {
+	m := maptextpb.Record_builder{
+		Title:  proto.String("title"),
+		Body:   "body",
+		Notes:  []string{"note"},
+		Lines:  map[int32]string{1: "line"},
+		Parent: maptextpb.Record_builder{Title: proto.String("parent")}.Build(),
+		Url:    proto.String("url"),
+	}.Build()
+	m.MapStrings(upperStrings)
+	want := maptextpb.Record_builder{
+		Title:  proto.String("TITLE"),
+		Body:   "BODY",
+		Notes:  []string{"NOTE"},
+		Lines:  map[int32]string{1: "LINE"},
+		Parent: maptextpb.Record_builder{Title: proto.String("PARENT")}.Build(),
+		Url:    proto.String("URL"),
+	}.Build()
+	if !proto.Equal(m, want) {
+		t.Errorf("MapStrings(upper) = %v, want %v", m, want)
+	}
+
+	m = maptextpb.Record_builder{}.Build()
+	m.MapStrings(func(protoreflect.Name, string) string { return "set" })
+	if m.HasTitle() || m.HasUrl() || m.GetBody() != "" {
+		t.Errorf("MapStrings populated unpopulated fields: %v", m)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	marshalexceptpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalexcept"
+)
+
+func TestMarshalExcept(t *testing.T) {
+	m := &marshalexceptpb.Snapshot{
+		Id:          "id",
+		OwnerEmail:  "user@example.com",
+		Credentials: &marshalexceptpb.Snapshot_Credentials{Token: "secret"},
+		Tags:        []string{"a"},
+	}
+	b, err := m.MarshalExcept(2, 3)
+	if err != nil {
+		t.Fatalf("MarshalExcept(2, 3): %v", err)
+	}
+	got := new(marshalexceptpb.Snapshot)
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	want := &marshalexceptpb.Snapshot{Id: "id", Tags: []string{"a"}}
+	if !proto.Equal(got, want) {
+		t.Errorf("MarshalExcept(2, 3) encoded %v, want %v", got, want)
+	}
+	if m.GetOwnerEmail() == "" || m.GetCredentials() == nil {
+		t.Errorf("MarshalExcept modified the message: %v", m)
+	}
+
+	b, err = m.MarshalExcept()
+	if err != nil {
+		t.Fatalf("MarshalExcept(): %v", err)
+	}
+	if want, _ := proto.Marshal(m); string(b) != string(want) {
+		t.Errorf("MarshalExcept() = %x, want %x", b, want)
+	}
+}
+
+func TestMarshalExceptUnknownNumber(t *testing.T) {
+	m := &marshalexceptpb.Snapshot{Id: "id"}
+	if _, err := m.MarshalExcept(1, 99); err == nil {
+		t.Errorf("MarshalExcept(1, 99): got nil error, want error for unknown field number")
+	}
+	var nilMsg *marshalexceptpb.Snapshot
+	if b, err := nilMsg.MarshalExcept(1); err != nil || len(b) > 0 {
+		t.Errorf("nil.MarshalExcept(1) = %x, %v; want empty, nil", b, err)
+	}
+}
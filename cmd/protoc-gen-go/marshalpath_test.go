@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	marshalpathpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpath"
+)
+
+func TestMarshalPath(t *testing.T) {
+	geo := &marshalpathpb.Customer_Geo{Lat: 1.5, Lng: -2}
+	address := &marshalpathpb.Customer_Address{City: "city", Geo: geo}
+	mailing := &marshalpathpb.Customer_Address{City: "mailing"}
+	m := &marshalpathpb.Customer{
+		Name:    "name",
+		Address: address,
+		Contact: &marshalpathpb.Customer_Mailing{Mailing: mailing},
+	}
+	for _, test := range []struct {
+		path string
+		want proto.Message
+	}{
+		{"address", address},
+		{"address.geo", geo},
+		{"mailing", mailing},
+		{"mailing.geo", &marshalpathpb.Customer_Geo{}},
+	} {
+		b, err := m.MarshalPath(test.path)
+		if err != nil {
+			t.Errorf("MarshalPath(%q) error: %v", test.path, err)
+			continue
+		}
+		got := test.want.ProtoReflect().New().Interface()
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Errorf("MarshalPath(%q): cannot unmarshal result: %v", test.path, err)
+			continue
+		}
+		if !proto.Equal(got, test.want) {
+			t.Errorf("MarshalPath(%q) = %v, want %v", test.path, got, test.want)
+		}
+	}
+}
+
+func TestMarshalPathErrors(t *testing.T) {
+	m := &marshalpathpb.Customer{Name: "name", Address: &marshalpathpb.Customer_Address{City: "city"}}
+	for _, path := range []string{
+		"",
+		"name",
+		"address.city",
+		"address.geo.lat",
+		"previous",
+		"named",
+		"phone",
+		"missing",
+		"address.missing",
+		"address.",
+	} {
+		if b, err := m.MarshalPath(path); err == nil {
+			t.Errorf("MarshalPath(%q) = %v, want error", path, b)
+		}
+	}
+}
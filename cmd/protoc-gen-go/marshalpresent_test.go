@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	marshalpresentpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpresent"
+)
+
+func TestMarshalPresentOnly(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	for _, test := range []struct {
+		name string
+		m    *marshalpresentpb.UpdateProfile
+		want []byte
+	}{{
+		name: "one implicit field",
+		m:    &marshalpresentpb.UpdateProfile{DisplayName: "gopher"},
+		want: protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "gopher"),
+	}, {
+		name: "one explicit field set to zero",
+		m:    &marshalpresentpb.UpdateProfile{Followers: proto.Int32(0)},
+		want: protowire.AppendVarint(protowire.AppendTag(nil, 4, protowire.VarintType), 0),
+	}, {
+		name: "negative zero",
+		m:    &marshalpresentpb.UpdateProfile{Bio: proto.String(""), Rating: negZero},
+		want: protowire.AppendString(protowire.AppendTag(nil, 2, protowire.BytesType), ""),
+	}, {
+		name: "nested negative zero",
+		m: &marshalpresentpb.UpdateProfile{
+			Location: &marshalpresentpb.UpdateProfile_Location{Latitude: negZero},
+			Visited:  []*marshalpresentpb.UpdateProfile_Location{{Latitude: negZero}},
+		},
+		want: protowire.AppendBytes(protowire.AppendTag(
+			protowire.AppendBytes(protowire.AppendTag(nil, 6, protowire.BytesType), nil),
+			7, protowire.BytesType), nil),
+	}, {
+		name: "empty",
+		m:    &marshalpresentpb.UpdateProfile{},
+		want: nil,
+	}} {
+		got, err := test.m.MarshalPresentOnly()
+		if err != nil {
+			t.Errorf("%v: MarshalPresentOnly() error: %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%v: MarshalPresentOnly() = %x, want %x", test.name, got, test.want)
+		}
+	}
+
+	m := &marshalpresentpb.UpdateProfile{Rating: negZero}
+	if _, err := m.MarshalPresentOnly(); err != nil {
+		t.Fatal(err)
+	}
+	if !math.Signbit(m.Rating) {
+		t.Errorf("MarshalPresentOnly() modified the message: Rating = %v", m.Rating)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	maxsizepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maxsize"
+)
+
+func TestCheckMaxSize(t *testing.T) {
+	m := &maxsizepb.Record{
+		Key:    "key",
+		Chunks: []*maxsizepb.Record_Chunk{{Data: make([]byte, 100)}, {}},
+	}
+	size := proto.Size(m)
+	for _, limit := range []int{size, size + 1} {
+		if err := m.CheckMaxSize(limit); err != nil {
+			t.Errorf("CheckMaxSize(%d) for a message of %d bytes: %v", limit, size, err)
+		}
+	}
+	err := m.CheckMaxSize(size - 1)
+	if err == nil {
+		t.Fatalf("CheckMaxSize(%d) for a message of %d bytes: got nil error, want error", size-1, size)
+	}
+	if !strings.Contains(err.Error(), strconv.Itoa(size)) {
+		t.Errorf("CheckMaxSize(%d) error %q does not include the size %d", size-1, err, size)
+	}
+	if err := (*maxsizepb.Record)(nil).CheckMaxSize(0); err != nil {
+		t.Errorf("nil.CheckMaxSize(0): %v", err)
+	}
+}
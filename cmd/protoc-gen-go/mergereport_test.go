@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mergereportpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/mergereport"
+)
+
+func TestMergeReport(t *testing.T) {
+	for _, test := range []struct {
+		desc          string
+		x, src, want  *mergereportpb.Profile
+		wantConflicts []protoreflect.Name
+	}{{
+		desc: "no conflict",
+		x:    &mergereportpb.Profile{Name: "name", Tags: []string{"a"}, Labels: map[string]string{"k": "x"}},
+		src: &mergereportpb.Profile{
+			Name:    "name",
+			Age:     proto.Int32(0),
+			Tags:    []string{"b"},
+			Labels:  map[string]string{"k": "y", "l": "z"},
+			Address: &mergereportpb.Profile_Address{City: "city"},
+			Contact: &mergereportpb.Profile_Email{Email: "email"},
+		},
+		want: &mergereportpb.Profile{
+			Name:    "name",
+			Age:     proto.Int32(0),
+			Tags:    []string{"a", "b"},
+			Labels:  map[string]string{"k": "y", "l": "z"},
+			Address: &mergereportpb.Profile_Address{City: "city"},
+			Contact: &mergereportpb.Profile_Email{Email: "email"},
+		},
+	}, {
+		desc:          "scalar conflict",
+		x:             &mergereportpb.Profile{Name: "x", Age: proto.Int32(1), Avatar: []byte{1}},
+		src:           &mergereportpb.Profile{Name: "src", Age: proto.Int32(1), Avatar: []byte{2}},
+		want:          &mergereportpb.Profile{Name: "x", Age: proto.Int32(1), Avatar: []byte{1}},
+		wantConflicts: []protoreflect.Name{"name", "avatar"},
+	}, {
+		desc:          "nested message conflict",
+		x:             &mergereportpb.Profile{Address: &mergereportpb.Profile_Address{City: "x"}},
+		src:           &mergereportpb.Profile{Address: &mergereportpb.Profile_Address{City: "src", Street: "street"}},
+		want:          &mergereportpb.Profile{Address: &mergereportpb.Profile_Address{City: "x", Street: "street"}},
+		wantConflicts: []protoreflect.Name{"address"},
+	}, {
+		desc:          "oneof conflict",
+		x:             &mergereportpb.Profile{Contact: &mergereportpb.Profile_Email{Email: "email"}},
+		src:           &mergereportpb.Profile{Contact: &mergereportpb.Profile_Mailing{Mailing: &mergereportpb.Profile_Address{}}},
+		want:          &mergereportpb.Profile{Contact: &mergereportpb.Profile_Email{Email: "email"}},
+		wantConflicts: []protoreflect.Name{"mailing"},
+	}, {
+		desc: "oneof message merged",
+		x:    &mergereportpb.Profile{Contact: &mergereportpb.Profile_Mailing{Mailing: &mergereportpb.Profile_Address{City: "city"}}},
+		src:  &mergereportpb.Profile{Contact: &mergereportpb.Profile_Mailing{Mailing: &mergereportpb.Profile_Address{Street: "street"}}},
+		want: &mergereportpb.Profile{Contact: &mergereportpb.Profile_Mailing{Mailing: &mergereportpb.Profile_Address{City: "city", Street: "street"}}},
+	}} {
+		got := proto.Clone(test.x).(*mergereportpb.Profile)
+		conflicts, err := got.MergeReport(test.src)
+		if err != nil {
+			t.Errorf("%v: MergeReport: %v", test.desc, err)
+			continue
+		}
+		if diff := cmp.Diff(test.wantConflicts, conflicts); diff != "" {
+			t.Errorf("%v: MergeReport conflicts mismatch (-want +got):\n%s", test.desc, diff)
+		}
+		if !proto.Equal(got, test.want) {
+			t.Errorf("%v: MergeReport result = %v, want %v", test.desc, got, test.want)
+		}
+	}
+}
+
+func TestMergeReportCopies(t *testing.T) {
+	src := &mergereportpb.Profile{Address: &mergereportpb.Profile_Address{City: "city"}}
+	x := &mergereportpb.Profile{}
+	if _, err := x.MergeReport(src); err != nil {
+		t.Fatal(err)
+	}
+	src.Address.City = "changed"
+	if got := x.GetAddress().GetCity(); got != "city" {
+		t.Errorf("modifying src after MergeReport changed x: GetAddress().GetCity() = %q", got)
+	}
+}
+
+func TestMergeReportNil(t *testing.T) {
+	x := &mergereportpb.Profile{Name: "name"}
+	if conflicts, err := x.MergeReport(nil); err != nil || conflicts != nil {
+		t.Errorf("MergeReport(nil) = %v, %v, want nil, nil", conflicts, err)
+	}
+	if _, err := (*mergereportpb.Profile)(nil).MergeReport(x); err == nil {
+		t.Errorf("nil.MergeReport succeeded, want error")
+	}
+}
+
+func TestMergeReportHybrid(t *testing.T) {
+	x := mergereportpb.Account_builder{
+		Owner:  proto.String(""),
+		Parent: mergereportpb.Account_builder{Balance: 1}.Build(),
+		Tier:   proto.String("gold"),
+	}.Build()
+	src := mergereportpb.Account_builder{
+		Owner:   proto.String("owner"),
+		Balance: 5,
+		Linked:  []*mergereportpb.Account{{}},
+		Parent:  mergereportpb.Account_builder{Balance: 2}.Build(),
+		Sponsor: &mergereportpb.Account{},
+	}.Build()
+	conflicts, err := x.MergeReport(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]protoreflect.Name{"owner", "parent", "sponsor"}, conflicts); diff != "" {
+		t.Errorf("MergeReport conflicts mismatch (-want +got):\n%s", diff)
+	}
+	want := mergereportpb.Account_builder{
+		Owner:   proto.String(""),
+		Balance: 5,
+		Linked:  []*mergereportpb.Account{{}},
+		Parent:  mergereportpb.Account_builder{Balance: 1}.Build(),
+		Tier:    proto.String("gold"),
+	}.Build()
+	if !proto.Equal(x, want) {
+		t.Errorf("MergeReport result = %v, want %v", x, want)
+	}
+}
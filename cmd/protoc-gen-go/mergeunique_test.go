@@ -0,0 +1,50 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	mergeuniquepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/mergeunique"
+)
+
+func TestMergeUnique(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		have, add []string
+		want      []string
+	}{
+		{"empty", nil, nil, nil},
+		{"into empty", nil, []string{"a", "b", "a"}, []string{"a", "b"}},
+		{"disjoint", []string{"a", "b"}, []string{"c", "d"}, []string{"a", "b", "c", "d"}},
+		{"overlapping", []string{"a", "b"}, []string{"b", "c", "a", "d"}, []string{"a", "b", "c", "d"}},
+		{"subset", []string{"a", "b"}, []string{"b"}, []string{"a", "b"}},
+		{"existing duplicates kept", []string{"a", "a"}, []string{"a", "b"}, []string{"a", "a", "b"}},
+	} {
+		m := &mergeuniquepb.Aggregate{Tags: tt.have}
+		m.MergeUniqueTags(tt.add...)
+		if diff := cmp.Diff(tt.want, m.GetTags()); diff != "" {
+			t.Errorf("%v: MergeUniqueTags(%q) mismatch (-want +got):\n%s", tt.name, tt.add, diff)
+		}
+	}
+}
+
+func TestMergeUniqueKinds(t *testing.T) {
+	m := &mergeuniquepb.Aggregate{
+		Ids:   []int64{3, 1},
+		Kinds: []mergeuniquepb.Kind{mergeuniquepb.Kind_KIND_A},
+	}
+	m.MergeUniqueIds(1, 2, 3, 2)
+	m.MergeUniqueKinds(mergeuniquepb.Kind_KIND_B, mergeuniquepb.Kind_KIND_A, mergeuniquepb.Kind_KIND_UNSPECIFIED)
+	if diff := cmp.Diff([]int64{3, 1, 2}, m.GetIds()); diff != "" {
+		t.Errorf("MergeUniqueIds mismatch (-want +got):\n%s", diff)
+	}
+	wantKinds := []mergeuniquepb.Kind{mergeuniquepb.Kind_KIND_A, mergeuniquepb.Kind_KIND_B, mergeuniquepb.Kind_KIND_UNSPECIFIED}
+	if diff := cmp.Diff(wantKinds, m.GetKinds()); diff != "" {
+		t.Errorf("MergeUniqueKinds mismatch (-want +got):\n%s", diff)
+	}
+}
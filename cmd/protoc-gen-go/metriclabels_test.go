@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+
+	metriclabelspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/metriclabels"
+)
+
+func TestMetricFieldLabels(t *testing.T) {
+	for _, test := range []struct {
+		m interface {
+			proto.Message
+			MetricFieldLabels() []string
+		}
+		want []string
+	}{
+		{&metriclabelspb.Request{}, []string{"http_method", "status_code", "route", "user_agent", "service_name", "tags"}},
+		{&metriclabelspb.Request_Route{}, []string{"path"}},
+		{&metriclabelspb.Empty{}, []string{}},
+	} {
+		got := test.m.MetricFieldLabels()
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%T.MetricFieldLabels() mismatch (-want +got):\n%s", test.m, diff)
+		}
+		fields := test.m.ProtoReflect().Descriptor().Fields()
+		for i := 0; i < fields.Len() && i < len(got); i++ {
+			if name := string(fields.Get(i).Name()); got[i] != name {
+				t.Errorf("%T.MetricFieldLabels()[%d] = %q, want the declared field %q", test.m, i, got[i], name)
+			}
+		}
+	}
+	if got := (*metriclabelspb.Request)(nil).MetricFieldLabels(); len(got) != 6 {
+		t.Errorf("nil.MetricFieldLabels() = %v, want the labels of Request", got)
+	}
+}
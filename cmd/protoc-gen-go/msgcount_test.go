@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	msgcountpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/msgcount"
+)
+
+func TestNestedMessageCount(t *testing.T) {
+	tests := []struct {
+		desc string
+		m    *msgcountpb.Node
+		want int
+	}{
+		{"nil", nil, 0},
+		{"empty", &msgcountpb.Node{}, 0},
+		{
+			desc: "nested message",
+			m:    &msgcountpb.Node{Child: &msgcountpb.Node{Child: &msgcountpb.Node{}}},
+			want: 2,
+		},
+		{
+			desc: "repeated message",
+			m: &msgcountpb.Node{Children: []*msgcountpb.Node{
+				{}, nil, {Child: &msgcountpb.Node{}},
+			}},
+			want: 3,
+		},
+		{
+			desc: "message-valued map",
+			m: &msgcountpb.Node{
+				Named:   map[string]*msgcountpb.Node{"a": {}, "b": {Children: []*msgcountpb.Node{{}}}, "nil": nil},
+				Weights: map[string]int32{"a": 1},
+			},
+			want: 3,
+		},
+		{
+			desc: "oneof and external message",
+			m: &msgcountpb.Node{
+				Created: &timestamppb.Timestamp{Seconds: 1},
+				Choice:  &msgcountpb.Node_Chosen{Chosen: &msgcountpb.Node{}},
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.m.NestedMessageCount(); got != tt.want {
+			t.Errorf("%s: NestedMessageCount() = %d, want %d", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestNestedMessageCountAllocs(t *testing.T) {
+	m := &msgcountpb.Node{
+		Child:    &msgcountpb.Node{},
+		Children: []*msgcountpb.Node{{}},
+		Named:    map[string]*msgcountpb.Node{"a": {}},
+		Created:  &timestamppb.Timestamp{},
+	}
+	if allocs := testing.AllocsPerRun(10, func() { m.NestedMessageCount() }); allocs > 0 {
+		t.Errorf("NestedMessageCount() allocated %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	presencepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/normalize/presence"
+)
+
+func TestNormalizePresence(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	m := &presencepb.Reading{
+		Value:       negZero,
+		Offset:      proto.Float64(0),
+		Scale:       float32(negZero),
+		Raw:         []byte{},
+		Checksum:    []byte{},
+		Calibration: &presencepb.Reading{Value: negZero},
+		Samples:     []*presencepb.Reading{{Value: negZero}},
+		ByUnit:      map[string]*presencepb.Reading{"c": {Value: negZero}},
+		Source:      &presencepb.Reading_DerivedFrom{DerivedFrom: &presencepb.Reading{Value: negZero}},
+	}
+	m.NormalizePresence()
+
+	if math.Signbit(m.Value) || math.Signbit(float64(m.Scale)) {
+		t.Errorf("NormalizePresence() left negative zero in implicit presence fields: %v", m)
+	}
+	if m.Raw != nil {
+		t.Errorf("NormalizePresence() left Raw = %#v, want nil", m.Raw)
+	}
+	if m.Offset == nil || m.Checksum == nil {
+		t.Errorf("NormalizePresence() cleared an explicit presence field: %v", m)
+	}
+	for _, nested := range []*presencepb.Reading{m.Calibration, m.Samples[0], m.ByUnit["c"], m.GetDerivedFrom()} {
+		if math.Signbit(nested.Value) {
+			t.Errorf("NormalizePresence() did not normalize nested message %v", nested)
+		}
+	}
+
+	// Negative zero is marshaled for an implicit presence field, but not once
+	// the field has been normalized.
+	m = &presencepb.Reading{Value: negZero, Offset: proto.Float64(negZero)}
+	m.NormalizePresence()
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := proto.Marshal(&presencepb.Reading{Offset: proto.Float64(negZero)})
+	if string(b) != string(want) {
+		t.Errorf("proto.Marshal() after NormalizePresence() = %x, want %x", b, want)
+	}
+}
+
+func TestNormalizePresenceEditions(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	m := presencepb.Measurement_builder{
+		Value:    negZero,
+		Offset:   proto.Float64(0),
+		Previous: presencepb.Measurement_builder{Value: negZero}.Build(),
+	}.Build()
+	m.NormalizePresence()
+	if math.Signbit(m.GetValue()) || math.Signbit(m.GetPrevious().GetValue()) {
+		t.Errorf("NormalizePresence() left negative zero in implicit presence fields: %v", m)
+	}
+	if !m.HasOffset() {
+		t.Errorf("NormalizePresence() cleared the explicit presence field offset")
+	}
+}
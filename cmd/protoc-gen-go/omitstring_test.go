@@ -0,0 +1,42 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	omitstringpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/omitstring"
+)
+
+func TestOmitString(t *testing.T) {
+	m := &omitstringpb.Upload{Name: "name", Payload: []byte("a large payload")}
+	const want = "goproto.protoc.omitstring.Upload"
+	if got := m.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := fmt.Sprintf("%v", m); got != want {
+		t.Errorf("fmt.Sprintf(%%v) = %q, want %q", got, want)
+	}
+
+	var pm proto.Message = m
+	if got := prototext.Format(pm); !strings.Contains(got, "a large payload") {
+		t.Errorf("prototext.Format() = %q, want it to render the payload", got)
+	}
+	if got := proto.Clone(pm); !proto.Equal(got, m) {
+		t.Errorf("proto.Clone() = %v, want %v", prototext.Format(got), prototext.Format(m))
+	}
+}
+
+func TestOmitStringOtherMessages(t *testing.T) {
+	m := &omitstringpb.Receipt{Name: "receipt"}
+	if got := m.String(); !strings.Contains(got, "receipt") {
+		t.Errorf("String() of message without omit_string = %q, want it to render the name", got)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	oneofpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constructors/oneof"
+)
+
+func TestOneofConstructors(t *testing.T) {
+	click := &oneofpb.Event_Click{X: 1, Y: 2}
+	for _, tt := range []struct {
+		got  *oneofpb.Event
+		want *oneofpb.Event
+	}{
+		{
+			&oneofpb.Event{Payload: oneofpb.NewEvent_Click_(click)},
+			&oneofpb.Event{Payload: &oneofpb.Event_Click_{Click: click}},
+		},
+		{
+			&oneofpb.Event{Payload: oneofpb.NewEvent_Text("")},
+			&oneofpb.Event{Payload: &oneofpb.Event_Text{Text: ""}},
+		},
+		{
+			&oneofpb.Event{Payload: oneofpb.NewEvent_Key(oneofpb.Event_KEY_CODE_ENTER)},
+			&oneofpb.Event{Payload: &oneofpb.Event_Key{Key: oneofpb.Event_KEY_CODE_ENTER}},
+		},
+		{
+			&oneofpb.Event{Payload: oneofpb.NewEvent_Raw([]byte("raw"))},
+			&oneofpb.Event{Payload: &oneofpb.Event_Raw{Raw: []byte("raw")}},
+		},
+	} {
+		if !proto.Equal(tt.got, tt.want) {
+			t.Errorf("got %v, want %v", tt.got, tt.want)
+		}
+	}
+	if got := oneofpb.NewEvent_Click_(click).Click; got != click {
+		t.Errorf("NewEvent_Click_(click).Click = %p, want %p", got, click)
+	}
+}
+
+func TestOneofConstructorsVariants(t *testing.T) {
+	// The wrapper types are only exported by the hybrid file, which is used
+	// without the protoopaque build tag.
+	for _, tt := range []struct {
+		path string
+		want bool
+	}{
+		{"testdata/constructors/oneof/hybrid.pb.go", true},
+		{"testdata/constructors/oneof/hybrid_protoopaque.pb.go", false},
+	} {
+		b, err := os.ReadFile(tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := bytes.Contains(b, []byte("func NewHybridEvent_Text(")); got != tt.want {
+			t.Errorf("%v declares NewHybridEvent_Text: %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
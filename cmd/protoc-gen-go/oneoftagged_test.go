@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	oneoftaggedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/oneoftagged"
+)
+
+func TestOneofTaggedRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		m        *oneoftaggedpb.Shape
+		wantType string
+	}{
+		{&oneoftaggedpb.Shape{Name: "none"}, ""},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Circle_{Circle: &oneoftaggedpb.Shape_Circle{Radius: 2}}}, "circle"},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Sides{Sides: 0}}, "sides"},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Label{Label: "l"}}, "label"},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Color{Color: oneoftaggedpb.Color_COLOR_RED}}, "color"},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Raw{Raw: []byte{1}}}, "raw"},
+		{&oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Created{Created: &timestamppb.Timestamp{Seconds: 1}}}, "created"},
+	} {
+		tagged := test.m.KindTagged()
+		if tagged.Type != test.wantType {
+			t.Errorf("KindTagged() of %v has Type %q, want %q", test.m, tagged.Type, test.wantType)
+		}
+		got := &oneoftaggedpb.Shape{Name: test.m.GetName(), Kind: &oneoftaggedpb.Shape_Label{Label: "old"}}
+		if err := got.SetKindTagged(tagged); err != nil {
+			t.Errorf("SetKindTagged(%v): %v", tagged, err)
+			continue
+		}
+		if !proto.Equal(got, test.m) {
+			t.Errorf("SetKindTagged(KindTagged()) = %v, want %v", got, test.m)
+		}
+	}
+}
+
+func TestOneofTaggedJSON(t *testing.T) {
+	m := &oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Label{Label: "x"}}
+	b, err := json.Marshal(m.KindTagged())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"type":"label","value":"x"}`; got != want {
+		t.Errorf("json.Marshal(KindTagged()) = %s, want %s", got, want)
+	}
+	if got := (*oneoftaggedpb.Shape)(nil).KindTagged(); got.Type != "" || got.Value != nil {
+		t.Errorf("nil.KindTagged() = %v, want zero", got)
+	}
+}
+
+func TestOneofTaggedErrors(t *testing.T) {
+	m := &oneoftaggedpb.Shape{Kind: &oneoftaggedpb.Shape_Sides{Sides: 3}}
+	tagged := m.KindTagged()
+
+	tagged.Type = "triangle"
+	if err := m.SetKindTagged(tagged); err == nil || !strings.Contains(err.Error(), `unknown member "triangle"`) {
+		t.Errorf("SetKindTagged with an unknown Type: got error %v, want unknown member", err)
+	}
+	tagged.Type = "version"
+	if err := m.SetKindTagged(tagged); err == nil || !strings.Contains(err.Error(), `unknown member "version"`) {
+		t.Errorf("SetKindTagged with a field outside the oneof: got error %v, want unknown member", err)
+	}
+	tagged.Type, tagged.Value = "sides", int64(4)
+	if err := m.SetKindTagged(tagged); err == nil || !strings.Contains(err.Error(), "value of type int64") {
+		t.Errorf("SetKindTagged with a mistyped Value: got error %v, want a type error", err)
+	}
+	if got := m.GetSides(); got != 3 {
+		t.Errorf("failed SetKindTagged changed the oneof: GetSides() = %v, want 3", got)
+	}
+}
+
+func TestOneofTaggedHybrid(t *testing.T) {
+	parent := oneoftaggedpb.Event_builder{User: proto.String("u")}.Build()
+	m := oneoftaggedpb.Event_builder{Parent: parent}.Build()
+	tagged := m.TargetTagged()
+	if tagged.Type != "parent" || tagged.Value != parent {
+		t.Errorf("TargetTagged() = %v, want parent %v", tagged, parent)
+	}
+	got := oneoftaggedpb.Event_builder{User: proto.String("old")}.Build()
+	if err := got.SetTargetTagged(tagged); err != nil || !proto.Equal(got, m) {
+		t.Errorf("SetTargetTagged(%v) = %v, %v, want %v", tagged, got, err, m)
+	}
+	tagged.Type = ""
+	if err := got.SetTargetTagged(tagged); err != nil || got.HasTarget() {
+		t.Errorf("SetTargetTagged with an empty Type left the oneof set: %v, %v", got, err)
+	}
+}
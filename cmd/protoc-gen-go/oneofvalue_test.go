@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	valuepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/oneofs/value"
+)
+
+func TestOneofValue(t *testing.T) {
+	nested := &valuepb.Payload{}
+	for _, test := range []struct {
+		desc string
+		m    *valuepb.Payload
+		want any
+	}{
+		{"text", &valuepb.Payload{Content: &valuepb.Payload_Text{Text: "text"}}, "text"},
+		{"number", &valuepb.Payload{Content: &valuepb.Payload_Number{Number: 42}}, int64(42)},
+		{"nested", &valuepb.Payload{Content: &valuepb.Payload_Nested{Nested: nested}}, nested},
+		{"unset", &valuepb.Payload{}, nil},
+		{"nil message", nil, nil},
+	} {
+		if got := test.m.GetContentValue(); got != test.want {
+			t.Errorf("%s: GetContentValue() = %v (%T), want %v (%T)", test.desc, got, got, test.want, test.want)
+		}
+	}
+
+	m := &valuepb.Payload{Other: &valuepb.Payload_Flag{Flag: true}}
+	if got := m.GetOtherValue(); got != true {
+		t.Errorf("GetOtherValue() = %v, want true", got)
+	}
+	if got := m.GetContentValue(); got != nil {
+		t.Errorf("GetContentValue() with only other set = %v, want nil", got)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"unsafe"
+
+	"google.golang.org/protobuf/proto"
+
+	packpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/layout/pack"
+)
+
+func TestPackedLayoutSize(t *testing.T) {
+	packed, unpacked := unsafe.Sizeof(packpb.Packed{}), unsafe.Sizeof(packpb.Unpacked{})
+	if packed >= unpacked {
+		t.Errorf("unsafe.Sizeof(Packed{}) = %d, want less than unsafe.Sizeof(Unpacked{}) = %d", packed, unpacked)
+	}
+}
+
+func TestPackedLayoutRoundTrip(t *testing.T) {
+	want := &packpb.Packed{
+		A: true,
+		B: 2,
+		C: true,
+		D: &packpb.Packed{G: 7},
+		E: true,
+		F: "f",
+		G: 7,
+		H: 8.5,
+		I: true,
+		J: []int32{10, 11},
+		K: true,
+		Choice: &packpb.Packed_M{
+			M: "m",
+		},
+		N: true,
+	}
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	got := &packpb.Packed{}
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("round trip mismatch:\ngot:  %v\nwant: %v", got, want)
+	}
+
+	// The wire format is independent of the struct layout.
+	unpacked := &packpb.Unpacked{}
+	if err := proto.Unmarshal(b, unpacked); err != nil {
+		t.Fatalf("proto.Unmarshal into Unpacked: %v", err)
+	}
+	b2, err := proto.MarshalOptions{Deterministic: true}.Marshal(unpacked)
+	if err != nil {
+		t.Fatalf("proto.Marshal(Unpacked): %v", err)
+	}
+	b1, err := proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	if err != nil {
+		t.Fatalf("proto.Marshal(Packed): %v", err)
+	}
+	if string(b1) != string(b2) {
+		t.Errorf("Packed and Unpacked encodings differ:\ngot:  %x\nwant: %x", b1, b2)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	patchmergepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/patchmerge"
+)
+
+func newPatchDestination() *patchmergepb.Profile {
+	return &patchmergepb.Profile{
+		Name:     "old name",
+		Age:      30,
+		Active:   proto.Bool(true),
+		Nickname: proto.String("old nickname"),
+		Avatar:   []byte("old avatar"),
+		Manager: &patchmergepb.Profile{
+			Name: "manager",
+			Age:  50,
+		},
+		Tags:    []string{"old"},
+		Labels:  map[string]string{"kept": "old", "replaced": "old"},
+		Updated: &timestamppb.Timestamp{Seconds: 1, Nanos: 1},
+		Contact: &patchmergepb.Profile_Email{Email: "old@example.com"},
+	}
+}
+
+func TestPatchFrom(t *testing.T) {
+	src := &patchmergepb.Profile{
+		Age:    31,
+		Active: proto.Bool(false), // explicitly set to the zero value
+		Manager: &patchmergepb.Profile{
+			Name: "new manager",
+		},
+		Tags:    []string{"new", "tags"},
+		Reports: []*patchmergepb.Profile{{Name: "report"}},
+		Labels:  map[string]string{"replaced": "new", "added": "new"},
+		Updated: &timestamppb.Timestamp{Seconds: 2},
+		Contact: &patchmergepb.Profile_Delegate{
+			Delegate: &patchmergepb.Profile{Name: "delegate"},
+		},
+	}
+	srcCopy := proto.CloneOf(src)
+
+	got := newPatchDestination()
+	got.PatchFrom(src)
+
+	want := newPatchDestination()
+	want.Age = 31
+	want.Active = proto.Bool(false)
+	want.Manager.Name = "new manager"
+	want.Tags = []string{"new", "tags"}
+	want.Reports = []*patchmergepb.Profile{{Name: "report"}}
+	want.Labels = map[string]string{"kept": "old", "replaced": "new", "added": "new"}
+	want.Updated = &timestamppb.Timestamp{Seconds: 2, Nanos: 1}
+	want.Contact = &patchmergepb.Profile_Delegate{
+		Delegate: &patchmergepb.Profile{Name: "delegate"},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("PatchFrom:\ngot:  %v\nwant: %v", got, want)
+	}
+	if !proto.Equal(src, srcCopy) {
+		t.Errorf("PatchFrom modified the source:\ngot:  %v\nwant: %v", src, srcCopy)
+	}
+
+	// The patched message must not share values with the source.
+	src.GetReports()[0].Name = "modified"
+	src.GetContact().(*patchmergepb.Profile_Delegate).Delegate.Name = "modified"
+	if !proto.Equal(got, want) {
+		t.Errorf("PatchFrom result shares values with the source:\ngot:  %v\nwant: %v", got, want)
+	}
+}
+
+func TestPatchFromEmpty(t *testing.T) {
+	for _, src := range []*patchmergepb.Profile{nil, {}} {
+		got := newPatchDestination()
+		got.PatchFrom(src)
+		if want := newPatchDestination(); !proto.Equal(got, want) {
+			t.Errorf("PatchFrom(%v):\ngot:  %v\nwant: %v", src, got, want)
+		}
+	}
+}
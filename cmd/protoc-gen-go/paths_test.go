@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pathspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/paths"
+)
+
+func TestPathConstants(t *testing.T) {
+	for _, test := range []struct {
+		got, want string
+	}{
+		{pathspb.Order_IdPath, "id"},
+		{pathspb.Order_CustomerPath, "customer"},
+		{pathspb.Order_PreviousCustomersPath, "previous_customers"},
+		{pathspb.Order_InvoiceAddressPath, "invoice_address"},
+		{pathspb.Order_InvoiceAddress_StreetPath, "invoice_address.street"},
+		{pathspb.OrderAddress_CityPath, "city"},
+
+		// The constants for the fields of the nested message Order.Customer
+		// take precedence over those for the nested paths of Order.
+		{pathspb.Order_Customer_NamePath, "name"},
+		{pathspb.Order_Customer_NamePath_, "customer.name"},
+		{pathspb.Order_Customer_AddressPath_, "customer.address"},
+		{pathspb.Order_Customer_Address_StreetPath, "address.street"},
+
+		// Generated with paths_depth=2.
+		{pathspb.DeepOrder_Customer_Address_StreetPath_, "customer.address.street"},
+		{pathspb.DeepOrder_Customer_Address_StreetPath, "address.street"},
+	} {
+		if test.got != test.want {
+			t.Errorf("path constant = %q, want %q", test.got, test.want)
+		}
+	}
+}
+
+func TestPathConstantsValid(t *testing.T) {
+	for _, test := range []struct {
+		m     proto.Message
+		paths []string
+	}{{
+		m: &pathspb.Order{},
+		paths: []string{
+			pathspb.Order_IdPath,
+			pathspb.Order_CustomerPath,
+			pathspb.Order_PreviousCustomersPath,
+			pathspb.Order_LabelsPath,
+			pathspb.Order_CardPath,
+			pathspb.Order_InvoiceAddressPath,
+			pathspb.Order_Customer_NamePath_,
+			pathspb.Order_Customer_AddressPath_,
+			pathspb.Order_InvoiceAddress_StreetPath,
+			pathspb.Order_InvoiceAddress_CityPath,
+		},
+	}, {
+		m: &pathspb.Order_Customer{},
+		paths: []string{
+			pathspb.Order_Customer_NamePath,
+			pathspb.Order_Customer_AddressPath,
+			pathspb.Order_Customer_Address_StreetPath,
+			pathspb.Order_Customer_Address_CityPath,
+		},
+	}, {
+		m: &pathspb.DeepOrder{},
+		paths: []string{
+			pathspb.DeepOrder_Customer_Address_StreetPath_,
+			pathspb.DeepOrder_Customer_Address_CityPath_,
+		},
+	}} {
+		if _, err := fieldmaskpb.New(test.m, test.paths...); err != nil {
+			t.Errorf("fieldmaskpb.New(%T, %q): %v", test.m, test.paths, err)
+		}
+	}
+}
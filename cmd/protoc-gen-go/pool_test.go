@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	syncpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/pooling/sync"
+)
+
+func TestMessagePool(t *testing.T) {
+	x := syncpb.GetEvent()
+	x.Name = "event"
+	x.Values = append(x.Values, 1, 2, 3)
+	x.Attributes = append(x.Attributes, &syncpb.Event_Attribute{Key: "k"})
+	x.Labels = map[string]string{"a": "b"}
+	values := x.Values
+	syncpb.PutEvent(x)
+
+	if !proto.Equal(x, &syncpb.Event{}) {
+		t.Errorf("PutEvent did not reset the message: %v", x)
+	}
+	if len(x.Values) != 0 || cap(x.Values) != cap(values) {
+		t.Errorf("PutEvent: Values has len %d, cap %d; want len 0, cap %d", len(x.Values), cap(x.Values), cap(values))
+	}
+	if x.Labels == nil {
+		t.Errorf("PutEvent: Labels is nil, want the map to be retained")
+	}
+	if got := x.Attributes[:1][0]; got != nil {
+		t.Errorf("PutEvent: Attributes retains the element %v, want nil", got)
+	}
+
+	syncpb.PutEvent(nil) // must not panic
+}
+
+func BenchmarkMessagePool(b *testing.B) {
+	fill := func(x *syncpb.Event) {
+		x.Name = "event"
+		for i := 0; i < 16; i++ {
+			x.Values = append(x.Values, int64(i))
+		}
+	}
+	b.Run("Pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x := syncpb.GetEvent()
+			fill(x)
+			syncpb.PutEvent(x)
+		}
+	})
+	b.Run("New", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x := new(syncpb.Event)
+			fill(x)
+			sink = x
+		}
+	})
+}
+
+var sink any
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	projectpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/project"
+)
+
+func newProjectOrder() *projectpb.Order {
+	return &projectpb.Order{
+		Id:          "o1",
+		Customer:    &projectpb.Customer{Name: "c"},
+		Items:       []*projectpb.Item{{Sku: "s", Quantity: 2}},
+		Attachments: map[string][]byte{"a": {1}},
+		Priority:    proto.Int32(0),
+		Created:     &timestamppb.Timestamp{Seconds: 1},
+		Payment:     &projectpb.Order_InvoiceTo{InvoiceTo: &projectpb.Customer{Name: "i"}},
+	}
+}
+
+func TestProject(t *testing.T) {
+	m := newProjectOrder()
+	got := m.Project(1, 2)
+	want := &projectpb.Order{Id: "o1", Customer: &projectpb.Customer{Name: "c"}}
+	if !proto.Equal(got, want) {
+		t.Errorf("Project(1, 2) = %v, want %v", got, want)
+	}
+	if got.GetCustomer() == m.GetCustomer() {
+		t.Errorf("Project(1, 2) shares the customer message with the original")
+	}
+	got.GetCustomer().Name = "changed"
+	if !proto.Equal(m, newProjectOrder()) {
+		t.Errorf("modifying the result of Project modified the original: %v", m)
+	}
+
+	if got := m.Project(); !proto.Equal(got, &projectpb.Order{}) {
+		t.Errorf("Project() = %v, want empty", got)
+	}
+	if got, want := m.Project(1, 2, 3, 4, 5, 6, 8), newProjectOrder(); !proto.Equal(got, want) {
+		t.Errorf("Project of all fields = %v, want %v", got, want)
+	}
+	// The unset member of a oneof does not clear the member which is set.
+	if got, want := m.Project(8, 7), (&projectpb.Order{Payment: m.Payment}); !proto.Equal(got, want) {
+		t.Errorf("Project(8, 7) = %v, want %v", got, want)
+	}
+	if got := (*projectpb.Order)(nil).Project(1); got != nil {
+		t.Errorf("nil.Project(1) = %v, want nil", got)
+	}
+}
+
+func TestProjectUnknownField(t *testing.T) {
+	defer func() {
+		r := recover()
+		if s, _ := r.(string); !strings.Contains(s, "has no field number 9") {
+			t.Errorf("Project(1, 9) panicked with %v, want a message naming field number 9", r)
+		}
+	}()
+	newProjectOrder().Project(1, 9)
+}
+
+func TestProjectHybrid(t *testing.T) {
+	m := projectpb.Shipment_builder{
+		Carrier:  proto.String("c"),
+		Previous: projectpb.Shipment_builder{Carrier: proto.String("p")}.Build(),
+		Stops:    []string{"a", "b"},
+	}.Build()
+	got := m.Project(2, 3)
+	want := projectpb.Shipment_builder{
+		Previous: projectpb.Shipment_builder{Carrier: proto.String("p")}.Build(),
+		Stops:    []string{"a", "b"},
+	}.Build()
+	if !proto.Equal(got, want) || got.GetPrevious() == m.GetPrevious() {
+		t.Errorf("Project(2, 3) = %v, want a deep copy of %v", got, want)
+	}
+}
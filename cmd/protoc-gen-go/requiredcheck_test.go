@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	requiredcheckpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/requiredcheck"
+)
+
+func completeOrder() *requiredcheckpb.Order {
+	return &requiredcheckpb.Order{
+		Id:        proto.Int64(1),
+		First:     &requiredcheckpb.Order_Item{Sku: proto.String("a")},
+		Items:     []*requiredcheckpb.Order_Item{{Sku: proto.String("b")}},
+		BySku:     map[string]*requiredcheckpb.Order_Item{"c": {Sku: proto.String("c")}},
+		Signature: []byte{},
+	}
+}
+
+func TestUnmarshalRequired(t *testing.T) {
+	want := completeOrder()
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(requiredcheckpb.Order)
+	if err := got.UnmarshalRequired(b); err != nil {
+		t.Fatalf("UnmarshalRequired of a complete message: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalRequired() = %v, want %v", got, want)
+	}
+}
+
+func TestUnmarshalRequiredMissing(t *testing.T) {
+	for _, tt := range []struct {
+		edit func(*requiredcheckpb.Order)
+		want string
+	}{
+		{func(m *requiredcheckpb.Order) { m.Id = nil }, "Order.id"},
+		{func(m *requiredcheckpb.Order) { m.First = nil }, "Order.first"},
+		{func(m *requiredcheckpb.Order) { m.Signature = nil }, "Order.signature"},
+		{func(m *requiredcheckpb.Order) { m.First.Sku = nil }, "Order.Item.sku"},
+		{func(m *requiredcheckpb.Order) { m.Items[0].Sku = nil }, "Order.Item.sku"},
+		{func(m *requiredcheckpb.Order) { m.BySku["c"].Sku = nil }, "Order.Item.sku"},
+		{func(m *requiredcheckpb.Order) {
+			proto.SetExtension(m, requiredcheckpb.E_ExtraItem, &requiredcheckpb.Order_Item{})
+		}, "Order.Item.sku"},
+	} {
+		m := completeOrder()
+		tt.edit(m)
+		b, err := proto.MarshalOptions{AllowPartial: true}.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = new(requiredcheckpb.Order).UnmarshalRequired(b)
+		if want := "required field goproto.protoc.methods.requiredcheck." + tt.want + " not set"; err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("UnmarshalRequired of %v: got error %v, want it to contain %q", m, err, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	setbynumpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/setbynum"
+)
+
+func TestSetByNumber(t *testing.T) {
+	got := &setbynumpb.Target{}
+	for _, set := range []struct {
+		num protoreflect.FieldNumber
+		v   any
+	}{
+		{1, int32(5)},
+		{2, "name"},
+		{3, &setbynumpb.Target{Count: 1}},
+		{4, []string{"a", "b"}},
+		{5, map[string]int64{"x": 1}},
+		{6, true},
+	} {
+		if err := got.SetByNumber(set.num, set.v); err != nil {
+			t.Errorf("SetByNumber(%d, %v): %v", set.num, set.v, err)
+		}
+	}
+	want := &setbynumpb.Target{
+		Count:  5,
+		Name:   proto.String("name"),
+		Child:  &setbynumpb.Target{Count: 1},
+		Tags:   []string{"a", "b"},
+		Totals: map[string]int64{"x": 1},
+		Kind:   &setbynumpb.Target_Flag{Flag: true},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("after SetByNumber:\ngot:  %v\nwant: %v", got, want)
+	}
+
+	// Setting another member of the oneof replaces the current one.
+	other := &setbynumpb.Target{Count: 2}
+	if err := got.SetByNumber(7, other); err != nil {
+		t.Errorf("SetByNumber(7, %v): %v", other, err)
+	}
+	if got.GetOther() != other || got.GetFlag() {
+		t.Errorf("after SetByNumber(7, %v): got oneof %v, want Other", other, got.GetKind())
+	}
+}
+
+func TestSetByNumberErrors(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		num  protoreflect.FieldNumber
+		v    any
+	}{
+		{"scalar type mismatch", 1, int64(5)},
+		{"pointer to scalar", 2, proto.String("name")},
+		{"message type mismatch", 3, &setbynumpb.Target_Flag{}},
+		{"nil interface", 3, nil},
+		{"unknown field", 100, int32(1)},
+	} {
+		m := &setbynumpb.Target{}
+		if err := m.SetByNumber(test.num, test.v); err == nil {
+			t.Errorf("%s: SetByNumber(%d, %v) = nil, want error", test.desc, test.num, test.v)
+		}
+		if !proto.Equal(m, &setbynumpb.Target{}) {
+			t.Errorf("%s: SetByNumber(%d, %v) modified the message: %v", test.desc, test.num, test.v, m)
+		}
+	}
+}
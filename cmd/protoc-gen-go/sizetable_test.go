@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sizetablepb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/sizetable"
+)
+
+func TestFieldTagSizes(t *testing.T) {
+	want := map[protoreflect.FieldNumber]int{
+		1:         1,
+		15:        1,
+		16:        2,
+		2047:      2,
+		2048:      3,
+		262143:    3,
+		262144:    4,
+		33554431:  4,
+		33554432:  5,
+		536870911: 5,
+	}
+	fields := (&sizetablepb.Sized{}).ProtoReflect().Descriptor().Fields()
+	if got, want := len(sizetablepb.Sized_fieldTagSizes), fields.Len(); got != want {
+		t.Errorf("len(Sized_fieldTagSizes) = %d, want one entry per field (%d)", got, want)
+	}
+	for i := 0; i < fields.Len(); i++ {
+		num := fields.Get(i).Number()
+		got, ok := sizetablepb.Sized_fieldTagSizes[num]
+		if !ok {
+			t.Errorf("Sized_fieldTagSizes[%d]: missing entry", num)
+			continue
+		}
+		if got != want[num] {
+			t.Errorf("Sized_fieldTagSizes[%d] = %d, want %d", num, got, want[num])
+		}
+		if encoded := len(protowire.AppendTag(nil, num, protowire.VarintType)); got != encoded {
+			t.Errorf("Sized_fieldTagSizes[%d] = %d, but the encoded tag is %d bytes", num, got, encoded)
+		}
+	}
+}
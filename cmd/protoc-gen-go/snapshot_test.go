@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	snapshotpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/snapshot"
+)
+
+func TestSnapshot(t *testing.T) {
+	m := &snapshotpb.Routes{
+		ByName:   map[string]*snapshotpb.Routes_Route{"a": {Target: "a", Key: []byte{1}}},
+		Fallback: []*snapshotpb.Routes_Route{{Target: "b"}},
+		Version:  1,
+	}
+	s := m.Snapshot()
+	if !proto.Equal(s, m) {
+		t.Fatalf("Snapshot() = %v, want %v", s, m)
+	}
+	want := proto.Clone(m)
+
+	s.ByName["a"].Target = "changed"
+	s.ByName["a"].Key[0] = 2
+	s.ByName["c"] = &snapshotpb.Routes_Route{}
+	s.Fallback[0].Target = "changed"
+	s.Version = 2
+	if !proto.Equal(m, want) {
+		t.Errorf("modifying the snapshot modified the original: got %v, want %v", m, want)
+	}
+
+	var nilRoutes *snapshotpb.Routes
+	if got := nilRoutes.Snapshot(); got != nil {
+		t.Errorf("nil.Snapshot() = %v, want nil", got)
+	}
+}
+
+func TestSnapshotConcurrentReads(t *testing.T) {
+	m := &snapshotpb.Routes{ByName: map[string]*snapshotpb.Routes_Route{"a": {Target: "a"}}}
+	s := m.Snapshot()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := proto.Marshal(s); err != nil {
+				t.Error(err)
+			}
+			_ = s.GetByName()["a"].GetTarget()
+		}()
+	}
+	// Writes to the original do not race with reads of the snapshot.
+	m.ByName["a"].Target = "b"
+	wg.Wait()
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	streamrepeatedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/streamrepeated"
+)
+
+func TestStreamRepeated(t *testing.T) {
+	m := &streamrepeatedpb.Batch{
+		Name:    "batch",
+		Events:  []*streamrepeatedpb.Batch_Event{{Id: "a", At: 1}, {}, {Id: "c"}},
+		Counts:  []int32{0, -1, math.MaxInt32},
+		Deltas:  []int64{-2, 2, math.MinInt64},
+		Ids:     []uint32{1, math.MaxUint32},
+		Flags:   []bool{true, false},
+		Weights: []float32{0.5, float32(math.Inf(-1))},
+		Scores:  []float64{-1.25, math.MaxFloat64},
+		Hashes:  []uint32{0xdeadbeef},
+		Offsets: []int64{-7, 7},
+		Labels:  []string{"", "x"},
+		Blobs:   [][]byte{{}, {1, 2}},
+		Levels:  []streamrepeatedpb.Batch_Level{streamrepeatedpb.Batch_LEVEL_HIGH, -1},
+		Totals:  map[string]int32{"ignored": 1},
+	}
+	var all bytes.Buffer
+	for _, test := range []struct {
+		name   string
+		stream func(io.Writer) error
+		want   *streamrepeatedpb.Batch
+	}{
+		{"events", m.StreamEvents, &streamrepeatedpb.Batch{Events: m.Events}},
+		{"counts", m.StreamCounts, &streamrepeatedpb.Batch{Counts: m.Counts}},
+		{"deltas", m.StreamDeltas, &streamrepeatedpb.Batch{Deltas: m.Deltas}},
+		{"ids", m.StreamIds, &streamrepeatedpb.Batch{Ids: m.Ids}},
+		{"flags", m.StreamFlags, &streamrepeatedpb.Batch{Flags: m.Flags}},
+		{"weights", m.StreamWeights, &streamrepeatedpb.Batch{Weights: m.Weights}},
+		{"scores", m.StreamScores, &streamrepeatedpb.Batch{Scores: m.Scores}},
+		{"hashes", m.StreamHashes, &streamrepeatedpb.Batch{Hashes: m.Hashes}},
+		{"offsets", m.StreamOffsets, &streamrepeatedpb.Batch{Offsets: m.Offsets}},
+		{"labels", m.StreamLabels, &streamrepeatedpb.Batch{Labels: m.Labels}},
+		{"blobs", m.StreamBlobs, &streamrepeatedpb.Batch{Blobs: m.Blobs}},
+		{"levels", m.StreamLevels, &streamrepeatedpb.Batch{Levels: m.Levels}},
+	} {
+		var buf bytes.Buffer
+		if err := test.stream(io.MultiWriter(&buf, &all)); err != nil {
+			t.Fatalf("Stream %v: %v", test.name, err)
+		}
+		got := new(streamrepeatedpb.Batch)
+		if err := proto.Unmarshal(buf.Bytes(), got); err != nil {
+			t.Fatalf("Stream %v: unmarshaling the output: %v", test.name, err)
+		}
+		if !proto.Equal(got, test.want) {
+			t.Errorf("Stream %v: decoded %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	got := &streamrepeatedpb.Batch{Name: m.Name, Totals: m.Totals}
+	if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(all.Bytes(), got); err != nil {
+		t.Fatalf("unmarshaling all streamed fields: %v", err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("all streamed fields decoded to %v, want %v", got, m)
+	}
+}
+
+func TestStreamRepeatedGroup(t *testing.T) {
+	m := &streamrepeatedpb.Log{
+		Entry: []*streamrepeatedpb.Log_Entry{{Text: proto.String("a")}, {}},
+		Codes: []int32{3},
+	}
+	var buf bytes.Buffer
+	if err := m.StreamEntry(&buf); err != nil {
+		t.Fatalf("StreamEntry: %v", err)
+	}
+	got := new(streamrepeatedpb.Log)
+	if err := proto.Unmarshal(buf.Bytes(), got); err != nil {
+		t.Fatalf("unmarshaling the output of StreamEntry: %v", err)
+	}
+	if want := (&streamrepeatedpb.Log{Entry: m.Entry}); !proto.Equal(got, want) {
+		t.Errorf("StreamEntry: decoded %v, want %v", got, want)
+	}
+}
+
+func TestStreamRepeatedEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (*streamrepeatedpb.Batch)(nil).StreamEvents(&buf); err != nil || buf.Len() != 0 {
+		t.Errorf("nil.StreamEvents: wrote %d bytes with error %v, want nothing", buf.Len(), err)
+	}
+}
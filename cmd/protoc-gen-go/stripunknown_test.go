@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	stripunknownpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/stripunknown"
+)
+
+func TestStripUnknownFields(t *testing.T) {
+	// newEnvelope returns a message with known fields at several levels of
+	// nesting, each of which is given unknown fields if withUnknown is set.
+	newEnvelope := func(withUnknown bool) *stripunknownpb.Envelope {
+		n := protowire.Number(1000)
+		unknown := func(m proto.Message) {
+			if withUnknown {
+				n++
+				m.ProtoReflect().SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, n, protowire.VarintType), 1))
+			}
+		}
+		item := func(name string) *stripunknownpb.Envelope_Item {
+			child := &stripunknownpb.Envelope_Item{Name: proto.String(name + ".child")}
+			unknown(child)
+			m := &stripunknownpb.Envelope_Item{Name: proto.String(name), Child: child}
+			unknown(m)
+			return m
+		}
+		metadata, _ := structpb.NewStruct(map[string]any{"k": "v"})
+		unknown(metadata)
+		unknown(metadata.Fields["k"])
+		m := &stripunknownpb.Envelope{
+			Id:       proto.String("id"),
+			Item:     item("item"),
+			Items:    []*stripunknownpb.Envelope_Item{item("items0"), item("items1")},
+			ByName:   map[string]*stripunknownpb.Envelope_Item{"a": item("a")},
+			Metadata: metadata,
+			Body:     &stripunknownpb.Envelope_Single{Single: item("single")},
+		}
+		proto.SetExtension(m, stripunknownpb.E_Extra, item("extra"))
+		unknown(m)
+		return m
+	}
+
+	b, err := proto.Marshal(newEnvelope(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(stripunknownpb.Envelope)
+	if err := proto.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	if !hasUnknownFields(got.ProtoReflect()) {
+		t.Fatalf("decoded message has no unknown fields")
+	}
+	got.StripUnknownFields()
+	if hasUnknownFields(got.ProtoReflect()) {
+		t.Errorf("StripUnknownFields() left unknown fields in %v", got)
+	}
+	if want := newEnvelope(false); !proto.Equal(got, want) {
+		t.Errorf("StripUnknownFields() = %v, want %v", got, want)
+	}
+
+	(*stripunknownpb.Envelope)(nil).StripUnknownFields()
+	new(stripunknownpb.Envelope).StripUnknownFields()
+}
+
+// hasUnknownFields reports whether m or any message it holds has unknown
+// fields.
+func hasUnknownFields(m protoreflect.Message) bool {
+	found := len(m.GetUnknown()) > 0
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len() && !found; i++ {
+				found = hasUnknownFields(v.List().Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				found = hasUnknownFields(v.Message())
+				return !found
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			found = hasUnknownFields(v.Message())
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	syntaxpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/syntax"
+)
+
+func TestFileEditionConstant(t *testing.T) {
+	for _, tt := range []struct {
+		fd      protoreflect.FileDescriptor
+		edition string
+		want    string
+	}{
+		{syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_proto2_proto, syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_proto2_proto_edition, "proto2"},
+		{syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto, syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_edition, "proto3"},
+		{syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_editions_proto, syntaxpb.File_cmd_protoc_gen_go_testdata_constants_syntax_editions_proto_edition, "2023"},
+	} {
+		if tt.edition != tt.want {
+			t.Errorf("%v: edition constant = %q, want %q", tt.fd.Path(), tt.edition, tt.want)
+		}
+		want := tt.fd.Syntax().String()
+		if tt.fd.Syntax() == protoreflect.Editions {
+			want = strings.TrimPrefix(protodesc.ToFileDescriptorProto(tt.fd).GetEdition().String(), "EDITION_")
+		}
+		if tt.edition != want {
+			t.Errorf("%v: edition constant = %q, want %q from the descriptor", tt.fd.Path(), tt.edition, want)
+		}
+	}
+}
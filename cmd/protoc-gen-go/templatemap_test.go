@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+
+	templatemappb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/templatemap"
+)
+
+func TestTemplateData(t *testing.T) {
+	home := &templatemappb.Profile_Address{City: "home"}
+	m := &templatemappb.Profile{
+		UserName: "gopher",
+		Status:   templatemappb.Status_STATUS_ACTIVE,
+		Home:     home,
+		History:  []templatemappb.Status{templatemappb.Status_STATUS_UNSPECIFIED, templatemappb.Status_STATUS_ACTIVE},
+		Roles:    map[string]templatemappb.Status{"admin": templatemappb.Status_STATUS_ACTIVE},
+		Contact:  &templatemappb.Profile_Phone{Phone: "555"},
+
+		PreviousStatus: templatemappb.Status_STATUS_UNSPECIFIED.Enum(),
+	}
+	got := m.TemplateData()
+
+	if got["home"] != home {
+		t.Errorf(`TemplateData()["home"] = %v, want the nested message pointer %p`, got["home"], home)
+	}
+	if got, want := got["status"], "STATUS_ACTIVE"; got != want {
+		t.Errorf(`TemplateData()["status"] = %v, want %q`, got, want)
+	}
+	for _, key := range []string{"nickname", "mailing"} {
+		if v, ok := got[key]; !ok || v != nil {
+			t.Errorf("TemplateData()[%q] = %v, %v; want nil, true", key, v, ok)
+		}
+	}
+	if diff := cmp.Diff([]string{"STATUS_UNSPECIFIED", "STATUS_ACTIVE"}, got["history"]); diff != "" {
+		t.Errorf(`TemplateData()["history"] mismatch (-want +got):\n%s`, diff)
+	}
+	if diff := cmp.Diff(map[string]string{"admin": "STATUS_ACTIVE"}, got["roles"]); diff != "" {
+		t.Errorf(`TemplateData()["roles"] mismatch (-want +got):\n%s`, diff)
+	}
+	if got, want := got["previousStatus"], "STATUS_UNSPECIFIED"; got != want {
+		t.Errorf(`TemplateData()["previousStatus"] = %v, want %q`, got, want)
+	}
+	if got, want := got["phone"], "555"; got != want {
+		t.Errorf(`TemplateData()["phone"] = %v, want %q`, got, want)
+	}
+
+	tmpl := template.Must(template.New("").Parse(`{{.userName}} {{.status}} {{.home.GetCity}}`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, got); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if got, want := b.String(), "gopher STATUS_ACTIVE home"; got != want {
+		t.Errorf("template output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/assertions/oneof/hybrid.proto
+
+//go:build protoopaque
+
+package oneof
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Refund struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Target isRefund_Target        `protobuf_oneof:"target"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Refund) GetAccount() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*refund_Account); ok {
+			return x.Account
+		}
+	}
+	return ""
+}
+
+func (x *Refund) GetVoucher() int64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*refund_Voucher); ok {
+			return x.Voucher
+		}
+	}
+	return 0
+}
+
+func (x *Refund) SetAccount(v string) {
+	x.xxx_hidden_Target = &refund_Account{v}
+}
+
+func (x *Refund) SetVoucher(v int64) {
+	x.xxx_hidden_Target = &refund_Voucher{v}
+}
+
+func (x *Refund) HasTarget() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Target != nil
+}
+
+func (x *Refund) HasAccount() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*refund_Account)
+	return ok
+}
+
+func (x *Refund) HasVoucher() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*refund_Voucher)
+	return ok
+}
+
+func (x *Refund) ClearTarget() {
+	x.xxx_hidden_Target = nil
+}
+
+func (x *Refund) ClearAccount() {
+	if _, ok := x.xxx_hidden_Target.(*refund_Account); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+func (x *Refund) ClearVoucher() {
+	if _, ok := x.xxx_hidden_Target.(*refund_Voucher); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+const Refund_Target_not_set_case case_Refund_Target = 0
+const Refund_Account_case case_Refund_Target = 1
+const Refund_Voucher_case case_Refund_Target = 2
+
+func (x *Refund) WhichTarget() case_Refund_Target {
+	if x == nil {
+		return Refund_Target_not_set_case
+	}
+	switch x.xxx_hidden_Target.(type) {
+	case *refund_Account:
+		return Refund_Account_case
+	case *refund_Voucher:
+		return Refund_Voucher_case
+	default:
+		return Refund_Target_not_set_case
+	}
+}
+
+type Refund_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Target:
+	Account *string
+	Voucher *int64
+	// -- end of xxx_hidden_Target
+}
+
+func (b0 Refund_builder) Build() *Refund {
+	m0 := &Refund{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Account != nil {
+		x.xxx_hidden_Target = &refund_Account{*b.Account}
+	}
+	if b.Voucher != nil {
+		x.xxx_hidden_Target = &refund_Voucher{*b.Voucher}
+	}
+	return m0
+}
+
+type case_Refund_Target protoreflect.FieldNumber
+
+func (x case_Refund_Target) String() string {
+	md := file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isRefund_Target interface {
+	isRefund_Target()
+}
+
+type refund_Account struct {
+	Account string `protobuf:"bytes,1,opt,name=account,oneof"`
+}
+
+type refund_Voucher struct {
+	Voucher int64 `protobuf:"varint,2,opt,name=voucher,oneof"`
+}
+
+func (*refund_Account) isRefund_Target() {}
+
+func (*refund_Voucher) isRefund_Target() {}
+
+var _ isRefund_Target = (*refund_Account)(nil)
+var _ isRefund_Target = (*refund_Voucher)(nil)
+
+var File_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/assertions/oneof/hybrid.proto\x12\x1fgoproto.protoc.assertions.oneof\x1a!google/protobuf/go_features.proto\"J\n" +
+	"\x06Refund\x12\x1a\n" +
+	"\aaccount\x18\x01 \x01(\tH\x00R\aaccount\x12\x1a\n" +
+	"\avoucher\x18\x02 \x01(\x03H\x00R\avoucherB\b\n" +
+	"\x06targetBPZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/assertions/oneof\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_goTypes = []any{
+	(*Refund)(nil), // 0: goproto.protoc.assertions.oneof.Refund
+}
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*refund_Account)(nil),
+		(*refund_Voucher)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_hybrid_proto_depIdxs = nil
+}
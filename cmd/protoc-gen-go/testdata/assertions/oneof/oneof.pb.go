@@ -0,0 +1,294 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/assertions/oneof/oneof.proto
+
+package oneof
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Payment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Method:
+	//
+	//	*Payment_Card_
+	//	*Payment_Iban
+	//	*Payment_Cash
+	Method isPayment_Method `protobuf_oneof:"method"`
+	// Types that are valid to be assigned to Receipt:
+	//
+	//	*Payment_Email
+	//	*Payment_Phone
+	Receipt       isPayment_Receipt `protobuf_oneof:"receipt"`
+	Note          *string           `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty" form:"note" uri:"note"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetMethod() isPayment_Method {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
+
+func (x *Payment) GetCard() *Payment_Card {
+	if x != nil {
+		if x, ok := x.Method.(*Payment_Card_); ok {
+			return x.Card
+		}
+	}
+	return nil
+}
+
+func (x *Payment) GetIban() string {
+	if x != nil {
+		if x, ok := x.Method.(*Payment_Iban); ok {
+			return x.Iban
+		}
+	}
+	return ""
+}
+
+func (x *Payment) GetCash() bool {
+	if x != nil {
+		if x, ok := x.Method.(*Payment_Cash); ok {
+			return x.Cash
+		}
+	}
+	return false
+}
+
+func (x *Payment) GetReceipt() isPayment_Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *Payment) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Receipt.(*Payment_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Payment) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Receipt.(*Payment_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Payment) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type isPayment_Method interface {
+	isPayment_Method()
+}
+
+type Payment_Card_ struct {
+	Card *Payment_Card `protobuf:"bytes,1,opt,name=card,proto3,oneof"`
+}
+
+type Payment_Iban struct {
+	Iban string `protobuf:"bytes,2,opt,name=iban,proto3,oneof"`
+}
+
+type Payment_Cash struct {
+	Cash bool `protobuf:"varint,3,opt,name=cash,proto3,oneof"`
+}
+
+func (*Payment_Card_) isPayment_Method() {}
+
+func (*Payment_Iban) isPayment_Method() {}
+
+func (*Payment_Cash) isPayment_Method() {}
+
+var _ isPayment_Method = (*Payment_Card_)(nil)
+var _ isPayment_Method = (*Payment_Iban)(nil)
+var _ isPayment_Method = (*Payment_Cash)(nil)
+
+type isPayment_Receipt interface {
+	isPayment_Receipt()
+}
+
+type Payment_Email struct {
+	Email string `protobuf:"bytes,4,opt,name=email,proto3,oneof"`
+}
+
+type Payment_Phone struct {
+	Phone string `protobuf:"bytes,5,opt,name=phone,proto3,oneof"`
+}
+
+func (*Payment_Email) isPayment_Receipt() {}
+
+func (*Payment_Phone) isPayment_Receipt() {}
+
+var _ isPayment_Receipt = (*Payment_Email)(nil)
+var _ isPayment_Receipt = (*Payment_Phone)(nil)
+
+type Payment_Card struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty" form:"number" uri:"number"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment_Card) Reset() {
+	*x = Payment_Card{}
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment_Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment_Card) ProtoMessage() {}
+
+func (x *Payment_Card) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment_Card.ProtoReflect.Descriptor instead.
+func (*Payment_Card) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Payment_Card) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/assertions/oneof/oneof.proto\x12\x1fgoproto.protoc.assertions.oneof\"\x81\x02\n" +
+	"\aPayment\x12C\n" +
+	"\x04card\x18\x01 \x01(\v2-.goproto.protoc.assertions.oneof.Payment.CardH\x00R\x04card\x12\x14\n" +
+	"\x04iban\x18\x02 \x01(\tH\x00R\x04iban\x12\x14\n" +
+	"\x04cash\x18\x03 \x01(\bH\x00R\x04cash\x12\x16\n" +
+	"\x05email\x18\x04 \x01(\tH\x01R\x05email\x12\x16\n" +
+	"\x05phone\x18\x05 \x01(\tH\x01R\x05phone\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x02R\x04note\x88\x01\x01\x1a\x1e\n" +
+	"\x04Card\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06numberB\b\n" +
+	"\x06methodB\t\n" +
+	"\areceiptB\a\n" +
+	"\x05_noteBHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/assertions/oneofb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_goTypes = []any{
+	(*Payment)(nil),      // 0: goproto.protoc.assertions.oneof.Payment
+	(*Payment_Card)(nil), // 1: goproto.protoc.assertions.oneof.Payment.Card
+}
+var file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.assertions.oneof.Payment.card:type_name -> goproto.protoc.assertions.oneof.Payment.Card
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_init() }
+func file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes[0].OneofWrappers = []any{
+		(*Payment_Card_)(nil),
+		(*Payment_Iban)(nil),
+		(*Payment_Cash)(nil),
+		(*Payment_Email)(nil),
+		(*Payment_Phone)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto = out.File
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_assertions_oneof_oneof_proto_depIdxs = nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/commonfield/commonfield.proto
+
+package commonfield
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Members       []*User                `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" form:"members" uri:"members"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetMembers() []*User {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Subject:
+	//
+	//	*Event_Id
+	//	*Event_Sequence
+	Subject       isEvent_Subject `protobuf_oneof:"subject"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Event) GetSubject() isEvent_Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		if x, ok := x.Subject.(*Event_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *Event) GetSequence() int64 {
+	if x != nil {
+		if x, ok := x.Subject.(*Event_Sequence); ok {
+			return x.Sequence
+		}
+	}
+	return 0
+}
+
+type isEvent_Subject interface {
+	isEvent_Subject()
+}
+
+type Event_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type Event_Sequence struct {
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3,oneof"`
+}
+
+func (*Event_Id) isEvent_Subject() {}
+
+func (*Event_Sequence) isEvent_Subject() {}
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty" form:"text" uri:"text"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Note) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// HasId is implemented by the messages with the field id.
+type HasId interface {
+	GetId() string
+}
+
+var (
+	_ HasId = (*User)(nil)
+	_ HasId = (*Group)(nil)
+	_ HasId = (*Event)(nil)
+)
+
+var File_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/commonfield/commonfield.proto\x12\x1agoproto.protoc.commonfield\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"*\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"S\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\amembers\x18\x02 \x03(\v2 .goproto.protoc.commonfield.UserR\amembers\"B\n" +
+	"\x05Event\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x1c\n" +
+	"\bsequence\x18\x02 \x01(\x03H\x00R\bsequenceB\t\n" +
+	"\asubject\"\x1a\n" +
+	"\x04Note\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04textBI\xca\xf3\x18\x02idZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/commonfieldb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_goTypes = []any{
+	(*User)(nil),  // 0: goproto.protoc.commonfield.User
+	(*Group)(nil), // 1: goproto.protoc.commonfield.Group
+	(*Event)(nil), // 2: goproto.protoc.commonfield.Event
+	(*Note)(nil),  // 3: goproto.protoc.commonfield.Note
+}
+var file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.commonfield.Group.members:type_name -> goproto.protoc.commonfield.User
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_init() }
+func file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes[2].OneofWrappers = []any{
+		(*Event_Id)(nil),
+		(*Event_Sequence)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto = out.File
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_commonfield_commonfield_proto_depIdxs = nil
+}
@@ -0,0 +1,432 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/commononeof/commononeof.proto
+
+package commononeof
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Request_Text
+	//	*Request_Attachment
+	Payload       isRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Request) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Request) GetPayload() isRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Request) GetText() string {
+	if x != nil {
+		if x, ok := x.Payload.(*Request_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Request) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*Request_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+type isRequest_Payload interface {
+	isRequest_Payload()
+}
+
+type Request_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type Request_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,3,opt,name=attachment,proto3,oneof"`
+}
+
+func (*Request_Text) isRequest_Payload() {}
+
+func (*Request_Attachment) isRequest_Payload() {}
+
+type Response struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty" form:"status" uri:"status"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Response_Text
+	//	*Response_Attachment
+	Payload isResponse_Payload `protobuf_oneof:"payload"`
+	// Types that are valid to be assigned to Trailer:
+	//
+	//	*Response_Checksum
+	Trailer       isResponse_Trailer `protobuf_oneof:"trailer"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Response) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Response) GetPayload() isResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Response) GetText() string {
+	if x != nil {
+		if x, ok := x.Payload.(*Response_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Response) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*Response_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetTrailer() isResponse_Trailer {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *Response) GetChecksum() string {
+	if x != nil {
+		if x, ok := x.Trailer.(*Response_Checksum); ok {
+			return x.Checksum
+		}
+	}
+	return ""
+}
+
+// isResponse_Payload is shared with Request, as the oneof payload is named
+// by the common_oneof option.
+type isResponse_Payload = isRequest_Payload
+
+type Response_Text = Request_Text
+
+type Response_Attachment = Request_Attachment
+
+type isResponse_Trailer interface {
+	isResponse_Trailer()
+}
+
+type Response_Checksum struct {
+	Checksum string `protobuf:"bytes,4,opt,name=checksum,proto3,oneof"`
+}
+
+func (*Response_Checksum) isResponse_Trailer() {}
+
+type Ping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Ping_Text
+	Body          isPing_Body `protobuf_oneof:"body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Ping) GetBody() isPing_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Ping) GetText() string {
+	if x != nil {
+		if x, ok := x.Body.(*Ping_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+type isPing_Body interface {
+	isPing_Body()
+}
+
+type Ping_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+func (*Ping_Text) isPing_Body() {}
+
+// HasPayload is implemented by the messages with the oneof payload.
+type HasPayload interface {
+	GetPayload() isRequest_Payload
+}
+
+var (
+	_ HasPayload = (*Request)(nil)
+	_ HasPayload = (*Response)(nil)
+)
+
+var File_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/commononeof/commononeof.proto\x12\x1agoproto.protoc.commononeof\x1a0cmd/protoc-gen-go/testdata/options/options.proto\" \n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x84\x01\n" +
+	"\aRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12H\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\v2&.goproto.protoc.commononeof.AttachmentH\x00R\n" +
+	"attachmentB\t\n" +
+	"\apayload\"\xb6\x01\n" +
+	"\bResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12H\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\v2&.goproto.protoc.commononeof.AttachmentH\x00R\n" +
+	"attachment\x12\x1c\n" +
+	"\bchecksum\x18\x04 \x01(\tH\x01R\bchecksumB\t\n" +
+	"\apayloadB\t\n" +
+	"\atrailer\"$\n" +
+	"\x04Ping\x12\x14\n" +
+	"\x04text\x18\x01 \x01(\tH\x00R\x04textB\x06\n" +
+	"\x04bodyBN\x82\xf4\x18\apayloadZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/commononeofb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_goTypes = []any{
+	(*Attachment)(nil), // 0: goproto.protoc.commononeof.Attachment
+	(*Request)(nil),    // 1: goproto.protoc.commononeof.Request
+	(*Response)(nil),   // 2: goproto.protoc.commononeof.Response
+	(*Ping)(nil),       // 3: goproto.protoc.commononeof.Ping
+}
+var file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.commononeof.Request.attachment:type_name -> goproto.protoc.commononeof.Attachment
+	0, // 1: goproto.protoc.commononeof.Response.attachment:type_name -> goproto.protoc.commononeof.Attachment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_init() }
+func file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[1].OneofWrappers = []any{
+		(*Request_Text)(nil),
+		(*Request_Attachment)(nil),
+	}
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[2].OneofWrappers = []any{
+		(*Response_Text)(nil),
+		(*Response_Attachment)(nil),
+		(*Response_Checksum)(nil),
+	}
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes[3].OneofWrappers = []any{
+		(*Ping_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto = out.File
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_commononeof_commononeof_proto_depIdxs = nil
+}
@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/computed/computed.proto
+
+//go:build protoopaque
+
+package computed
+
+import (
+	fmt "fmt"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Order struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Total       int64                  `protobuf:"varint,2,opt,name=total"`
+	xxx_hidden_Items       []string               `protobuf:"bytes,3,rep,name=items"`
+	xxx_hidden_Warnings    []string               `protobuf:"bytes,4,rep,name=warnings"`
+	xxx_hidden_Status      isOrder_Status         `protobuf_oneof:"status"`
+	xxx_hidden_Count       int32                  `protobuf:"varint,7,opt,name=count"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Order) GetTotal() int64 {
+	if x != nil {
+		return x.xxx_hidden_Total
+	}
+	return 0
+}
+
+func (x *Order) GetItems() []string {
+	if x != nil {
+		return x.xxx_hidden_Items
+	}
+	return nil
+}
+
+func (x *Order) GetWarnings() []string {
+	if x != nil {
+		return x.xxx_hidden_Warnings
+	}
+	return nil
+}
+
+func (x *Order) GetNote() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Status.(*order_Note); ok {
+			return x.Note
+		}
+	}
+	return ""
+}
+
+func (x *Order) GetShippedDay() int32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Status.(*order_ShippedDay); ok {
+			return x.ShippedDay
+		}
+	}
+	return 0
+}
+
+func (x *Order) GetCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *Order) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *Order) xxx_SetTotal(v int64) {
+	x.xxx_hidden_Total = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *Order) SetItems(v []string) {
+	x.xxx_hidden_Items = v
+}
+
+func (x *Order) xxx_SetWarnings(v []string) {
+	x.xxx_hidden_Warnings = v
+}
+
+func (x *Order) SetNote(v string) {
+	x.xxx_hidden_Status = &order_Note{v}
+}
+
+func (x *Order) xxx_SetShippedDay(v int32) {
+	x.xxx_hidden_Status = &order_ShippedDay{v}
+}
+
+func (x *Order) xxx_SetCount(v int32) {
+	x.xxx_hidden_Count = v
+}
+
+func (x *Order) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Order) HasTotal() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Order) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
+func (x *Order) HasNote() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Status.(*order_Note)
+	return ok
+}
+
+func (x *Order) HasShippedDay() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Status.(*order_ShippedDay)
+	return ok
+}
+
+func (x *Order) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Order) ClearTotal() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Total = 0
+}
+
+func (x *Order) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
+func (x *Order) ClearNote() {
+	if _, ok := x.xxx_hidden_Status.(*order_Note); ok {
+		x.xxx_hidden_Status = nil
+	}
+}
+
+func (x *Order) ClearShippedDay() {
+	if _, ok := x.xxx_hidden_Status.(*order_ShippedDay); ok {
+		x.xxx_hidden_Status = nil
+	}
+}
+
+const Order_Status_not_set_case case_Order_Status = 0
+const Order_Note_case case_Order_Status = 5
+const Order_ShippedDay_case case_Order_Status = 6
+
+func (x *Order) WhichStatus() case_Order_Status {
+	if x == nil {
+		return Order_Status_not_set_case
+	}
+	switch x.xxx_hidden_Status.(type) {
+	case *order_Note:
+		return Order_Note_case
+	case *order_ShippedDay:
+		return Order_ShippedDay_case
+	default:
+		return Order_Status_not_set_case
+	}
+}
+
+type Order_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id    *string
+	Items []string
+	// Fields of oneof xxx_hidden_Status:
+	Note *string
+	// -- end of xxx_hidden_Status
+}
+
+func (b0 Order_builder) Build() *Order {
+	m0 := &Order{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Items = b.Items
+	if b.Note != nil {
+		x.xxx_hidden_Status = &order_Note{*b.Note}
+	}
+	return m0
+}
+
+type case_Order_Status protoreflect.FieldNumber
+
+func (x case_Order_Status) String() string {
+	md := file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isOrder_Status interface {
+	isOrder_Status()
+}
+
+type order_Note struct {
+	Note string `protobuf:"bytes,5,opt,name=note,oneof"`
+}
+
+type order_ShippedDay struct {
+	ShippedDay int32 `protobuf:"varint,6,opt,name=shipped_day,json=shippedDay,oneof"`
+}
+
+func (*order_Note) isOrder_Status() {}
+
+func (*order_ShippedDay) isOrder_Status() {}
+
+// Validate reports an error if a computed field of x is set.
+// Computed fields are set by the server and must be left unset on input.
+func (x *Order) Validate() error {
+	if x == nil {
+		return nil
+	}
+	if x.HasTotal() {
+		return fmt.Errorf("computed field goproto.protoc.computed.Order.total must not be set")
+	}
+	if len(x.GetWarnings()) > 0 {
+		return fmt.Errorf("computed field goproto.protoc.computed.Order.warnings must not be set")
+	}
+	if x.HasShippedDay() {
+		return fmt.Errorf("computed field goproto.protoc.computed.Order.shipped_day must not be set")
+	}
+	if x.GetCount() != 0 {
+		return fmt.Errorf("computed field goproto.protoc.computed.Order.count must not be set")
+	}
+	return nil
+}
+
+type Item struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Item) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *Item) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Item) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+type Item_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name *string
+}
+
+func (b0 Item_builder) Build() *Item {
+	m0 := &Item{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+var File_cmd_protoc_gen_go_testdata_computed_computed_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_computed_computed_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/computed/computed.proto\x12\x17goproto.protoc.computed\x1a0cmd/protoc-gen-go/testdata/options/options.proto\x1a!google/protobuf/go_features.proto\"\xd5\x01\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\x05total\x18\x02 \x01(\x03B\x04\xf0\xf3\x18\x01R\x05total\x12\x14\n" +
+	"\x05items\x18\x03 \x03(\tR\x05items\x12 \n" +
+	"\bwarnings\x18\x04 \x03(\tB\x04\xf0\xf3\x18\x01R\bwarnings\x12\x14\n" +
+	"\x04note\x18\x05 \x01(\tH\x00R\x04note\x12'\n" +
+	"\vshipped_day\x18\x06 \x01(\x05B\x04\xf0\xf3\x18\x01H\x00R\n" +
+	"shippedDay\x12\x1f\n" +
+	"\x05count\x18\a \x01(\x05B\t\xf0\xf3\x18\x01\xaa\x01\x02\b\x02R\x05countB\b\n" +
+	"\x06status\"\x1a\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04nameBHZ>google.golang.org/protobuf/cmd/protoc-gen-go/testdata/computed\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_computed_computed_proto_goTypes = []any{
+	(*Order)(nil), // 0: goproto.protoc.computed.Order
+	(*Item)(nil),  // 1: goproto.protoc.computed.Item
+}
+var file_cmd_protoc_gen_go_testdata_computed_computed_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_computed_computed_proto_init() }
+func file_cmd_protoc_gen_go_testdata_computed_computed_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_computed_computed_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes[0].OneofWrappers = []any{
+		(*order_Note)(nil),
+		(*order_ShippedDay)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_computed_computed_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_computed_computed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_computed_computed_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_computed_computed_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_computed_computed_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_computed_computed_proto = out.File
+	file_cmd_protoc_gen_go_testdata_computed_computed_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_computed_computed_proto_depIdxs = nil
+}
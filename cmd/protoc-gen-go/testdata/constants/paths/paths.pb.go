@@ -0,0 +1,345 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/constants/paths/paths.proto
+
+package paths
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Order struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Customer          *Order_Customer        `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty" form:"customer" uri:"customer"`
+	PreviousCustomers []*Order_Customer      `protobuf:"bytes,3,rep,name=previous_customers,json=previousCustomers,proto3" json:"previous_customers,omitempty" form:"previous_customers" uri:"previous_customers"`
+	Labels            map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Payment:
+	//
+	//	*Order_Card
+	//	*Order_InvoiceAddress
+	Payment       isOrder_Payment `protobuf_oneof:"payment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetCustomer() *Order_Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *Order) GetPreviousCustomers() []*Order_Customer {
+	if x != nil {
+		return x.PreviousCustomers
+	}
+	return nil
+}
+
+func (x *Order) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Order) GetPayment() isOrder_Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Order) GetCard() string {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_Card); ok {
+			return x.Card
+		}
+	}
+	return ""
+}
+
+func (x *Order) GetInvoiceAddress() *OrderAddress {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_InvoiceAddress); ok {
+			return x.InvoiceAddress
+		}
+	}
+	return nil
+}
+
+type isOrder_Payment interface {
+	isOrder_Payment()
+}
+
+type Order_Card struct {
+	Card string `protobuf:"bytes,5,opt,name=card,proto3,oneof"`
+}
+
+type Order_InvoiceAddress struct {
+	InvoiceAddress *OrderAddress `protobuf:"bytes,6,opt,name=invoice_address,json=invoiceAddress,proto3,oneof"`
+}
+
+func (*Order_Card) isOrder_Payment() {}
+
+func (*Order_InvoiceAddress) isOrder_Payment() {}
+
+// Paths of the fields of Order.
+const (
+	Order_IdPath                    = "id"
+	Order_CustomerPath              = "customer"
+	Order_PreviousCustomersPath     = "previous_customers"
+	Order_LabelsPath                = "labels"
+	Order_CardPath                  = "card"
+	Order_InvoiceAddressPath        = "invoice_address"
+	Order_Customer_NamePath_        = "customer.name"
+	Order_Customer_AddressPath_     = "customer.address"
+	Order_InvoiceAddress_StreetPath = "invoice_address.street"
+	Order_InvoiceAddress_CityPath   = "invoice_address.city"
+)
+
+type OrderAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAddress) Reset() {
+	*x = OrderAddress{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAddress) ProtoMessage() {}
+
+func (x *OrderAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAddress.ProtoReflect.Descriptor instead.
+func (*OrderAddress) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// Paths of the fields of OrderAddress.
+const (
+	OrderAddress_StreetPath = "street"
+	OrderAddress_CityPath   = "city"
+)
+
+type Order_Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Address       *OrderAddress          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Customer) Reset() {
+	*x = Order_Customer{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Customer) ProtoMessage() {}
+
+func (x *Order_Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Customer.ProtoReflect.Descriptor instead.
+func (*Order_Customer) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Order_Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Order_Customer) GetAddress() *OrderAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Paths of the fields of Order_Customer.
+const (
+	Order_Customer_NamePath           = "name"
+	Order_Customer_AddressPath        = "address"
+	Order_Customer_Address_StreetPath = "address.street"
+	Order_Customer_Address_CityPath   = "address.city"
+)
+
+var File_cmd_protoc_gen_go_testdata_constants_paths_paths_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/constants/paths/paths.proto\x12\x1egoproto.protoc.constants.paths\"\xaa\x04\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12J\n" +
+	"\bcustomer\x18\x02 \x01(\v2..goproto.protoc.constants.paths.Order.CustomerR\bcustomer\x12]\n" +
+	"\x12previous_customers\x18\x03 \x03(\v2..goproto.protoc.constants.paths.Order.CustomerR\x11previousCustomers\x12I\n" +
+	"\x06labels\x18\x04 \x03(\v21.goproto.protoc.constants.paths.Order.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x04card\x18\x05 \x01(\tH\x00R\x04card\x12W\n" +
+	"\x0finvoice_address\x18\x06 \x01(\v2,.goproto.protoc.constants.paths.OrderAddressH\x00R\x0einvoiceAddress\x1af\n" +
+	"\bCustomer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12F\n" +
+	"\aaddress\x18\x02 \x01(\v2,.goproto.protoc.constants.paths.OrderAddressR\aaddress\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\apayment\":\n" +
+	"\fOrderAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04cityBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/pathsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_goTypes = []any{
+	(*Order)(nil),          // 0: goproto.protoc.constants.paths.Order
+	(*OrderAddress)(nil),   // 1: goproto.protoc.constants.paths.OrderAddress
+	(*Order_Customer)(nil), // 2: goproto.protoc.constants.paths.Order.Customer
+	nil,                    // 3: goproto.protoc.constants.paths.Order.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.constants.paths.Order.customer:type_name -> goproto.protoc.constants.paths.Order.Customer
+	2, // 1: goproto.protoc.constants.paths.Order.previous_customers:type_name -> goproto.protoc.constants.paths.Order.Customer
+	3, // 2: goproto.protoc.constants.paths.Order.labels:type_name -> goproto.protoc.constants.paths.Order.LabelsEntry
+	1, // 3: goproto.protoc.constants.paths.Order.invoice_address:type_name -> goproto.protoc.constants.paths.OrderAddress
+	1, // 4: goproto.protoc.constants.paths.Order.Customer.address:type_name -> goproto.protoc.constants.paths.OrderAddress
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_init() }
+func file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_constants_paths_paths_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes[0].OneofWrappers = []any{
+		(*Order_Card)(nil),
+		(*Order_InvoiceAddress)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_constants_paths_paths_proto = out.File
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_proto_depIdxs = nil
+}
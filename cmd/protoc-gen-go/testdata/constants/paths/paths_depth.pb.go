@@ -0,0 +1,347 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/constants/paths/paths_depth.proto
+
+package paths
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type DeepOrder struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Customer          *DeepOrder_Customer    `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty" form:"customer" uri:"customer"`
+	PreviousCustomers []*DeepOrder_Customer  `protobuf:"bytes,3,rep,name=previous_customers,json=previousCustomers,proto3" json:"previous_customers,omitempty" form:"previous_customers" uri:"previous_customers"`
+	Labels            map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Payment:
+	//
+	//	*DeepOrder_Card
+	//	*DeepOrder_InvoiceAddress
+	Payment       isDeepOrder_Payment `protobuf_oneof:"payment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeepOrder) Reset() {
+	*x = DeepOrder{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeepOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeepOrder) ProtoMessage() {}
+
+func (x *DeepOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeepOrder.ProtoReflect.Descriptor instead.
+func (*DeepOrder) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeepOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeepOrder) GetCustomer() *DeepOrder_Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *DeepOrder) GetPreviousCustomers() []*DeepOrder_Customer {
+	if x != nil {
+		return x.PreviousCustomers
+	}
+	return nil
+}
+
+func (x *DeepOrder) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DeepOrder) GetPayment() isDeepOrder_Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *DeepOrder) GetCard() string {
+	if x != nil {
+		if x, ok := x.Payment.(*DeepOrder_Card); ok {
+			return x.Card
+		}
+	}
+	return ""
+}
+
+func (x *DeepOrder) GetInvoiceAddress() *DeepOrderAddress {
+	if x != nil {
+		if x, ok := x.Payment.(*DeepOrder_InvoiceAddress); ok {
+			return x.InvoiceAddress
+		}
+	}
+	return nil
+}
+
+type isDeepOrder_Payment interface {
+	isDeepOrder_Payment()
+}
+
+type DeepOrder_Card struct {
+	Card string `protobuf:"bytes,5,opt,name=card,proto3,oneof"`
+}
+
+type DeepOrder_InvoiceAddress struct {
+	InvoiceAddress *DeepOrderAddress `protobuf:"bytes,6,opt,name=invoice_address,json=invoiceAddress,proto3,oneof"`
+}
+
+func (*DeepOrder_Card) isDeepOrder_Payment() {}
+
+func (*DeepOrder_InvoiceAddress) isDeepOrder_Payment() {}
+
+// Paths of the fields of DeepOrder.
+const (
+	DeepOrder_IdPath                       = "id"
+	DeepOrder_CustomerPath                 = "customer"
+	DeepOrder_PreviousCustomersPath        = "previous_customers"
+	DeepOrder_LabelsPath                   = "labels"
+	DeepOrder_CardPath                     = "card"
+	DeepOrder_InvoiceAddressPath           = "invoice_address"
+	DeepOrder_Customer_NamePath_           = "customer.name"
+	DeepOrder_Customer_AddressPath_        = "customer.address"
+	DeepOrder_InvoiceAddress_StreetPath    = "invoice_address.street"
+	DeepOrder_InvoiceAddress_CityPath      = "invoice_address.city"
+	DeepOrder_Customer_Address_StreetPath_ = "customer.address.street"
+	DeepOrder_Customer_Address_CityPath_   = "customer.address.city"
+)
+
+type DeepOrderAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeepOrderAddress) Reset() {
+	*x = DeepOrderAddress{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeepOrderAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeepOrderAddress) ProtoMessage() {}
+
+func (x *DeepOrderAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeepOrderAddress.ProtoReflect.Descriptor instead.
+func (*DeepOrderAddress) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeepOrderAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *DeepOrderAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// Paths of the fields of DeepOrderAddress.
+const (
+	DeepOrderAddress_StreetPath = "street"
+	DeepOrderAddress_CityPath   = "city"
+)
+
+type DeepOrder_Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Address       *DeepOrderAddress      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeepOrder_Customer) Reset() {
+	*x = DeepOrder_Customer{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeepOrder_Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeepOrder_Customer) ProtoMessage() {}
+
+func (x *DeepOrder_Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeepOrder_Customer.ProtoReflect.Descriptor instead.
+func (*DeepOrder_Customer) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *DeepOrder_Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeepOrder_Customer) GetAddress() *DeepOrderAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Paths of the fields of DeepOrder_Customer.
+const (
+	DeepOrder_Customer_NamePath           = "name"
+	DeepOrder_Customer_AddressPath        = "address"
+	DeepOrder_Customer_Address_StreetPath = "address.street"
+	DeepOrder_Customer_Address_CityPath   = "address.city"
+)
+
+var File_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/constants/paths/paths_depth.proto\x12\x1egoproto.protoc.constants.paths\"\xc2\x04\n" +
+	"\tDeepOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12N\n" +
+	"\bcustomer\x18\x02 \x01(\v22.goproto.protoc.constants.paths.DeepOrder.CustomerR\bcustomer\x12a\n" +
+	"\x12previous_customers\x18\x03 \x03(\v22.goproto.protoc.constants.paths.DeepOrder.CustomerR\x11previousCustomers\x12M\n" +
+	"\x06labels\x18\x04 \x03(\v25.goproto.protoc.constants.paths.DeepOrder.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x04card\x18\x05 \x01(\tH\x00R\x04card\x12[\n" +
+	"\x0finvoice_address\x18\x06 \x01(\v20.goproto.protoc.constants.paths.DeepOrderAddressH\x00R\x0einvoiceAddress\x1aj\n" +
+	"\bCustomer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
+	"\aaddress\x18\x02 \x01(\v20.goproto.protoc.constants.paths.DeepOrderAddressR\aaddress\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\apayment\">\n" +
+	"\x10DeepOrderAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04cityBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/pathsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_goTypes = []any{
+	(*DeepOrder)(nil),          // 0: goproto.protoc.constants.paths.DeepOrder
+	(*DeepOrderAddress)(nil),   // 1: goproto.protoc.constants.paths.DeepOrderAddress
+	(*DeepOrder_Customer)(nil), // 2: goproto.protoc.constants.paths.DeepOrder.Customer
+	nil,                        // 3: goproto.protoc.constants.paths.DeepOrder.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.constants.paths.DeepOrder.customer:type_name -> goproto.protoc.constants.paths.DeepOrder.Customer
+	2, // 1: goproto.protoc.constants.paths.DeepOrder.previous_customers:type_name -> goproto.protoc.constants.paths.DeepOrder.Customer
+	3, // 2: goproto.protoc.constants.paths.DeepOrder.labels:type_name -> goproto.protoc.constants.paths.DeepOrder.LabelsEntry
+	1, // 3: goproto.protoc.constants.paths.DeepOrder.invoice_address:type_name -> goproto.protoc.constants.paths.DeepOrderAddress
+	1, // 4: goproto.protoc.constants.paths.DeepOrder.Customer.address:type_name -> goproto.protoc.constants.paths.DeepOrderAddress
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_init() }
+func file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes[0].OneofWrappers = []any{
+		(*DeepOrder_Card)(nil),
+		(*DeepOrder_InvoiceAddress)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto = out.File
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_constants_paths_paths_depth_proto_depIdxs = nil
+}
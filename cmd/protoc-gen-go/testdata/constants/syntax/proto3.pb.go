@@ -0,0 +1,121 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/constants/syntax/proto3.proto
+
+package syntax
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Proto3Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proto3Message) Reset() {
+	*x = Proto3Message{}
+	mi := &file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proto3Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto3Message) ProtoMessage() {}
+
+func (x *Proto3Message) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto3Message.ProtoReflect.Descriptor instead.
+func (*Proto3Message) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Proto3Message) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_edition is the syntax of cmd/protoc-gen-go/testdata/constants/syntax/proto3.proto.
+const File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_edition = "proto3"
+
+var File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/constants/syntax/proto3.proto\x12&goproto.protoc.constants.syntax.proto3\"1\n" +
+	"\rProto3Message\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_nameBHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/syntaxb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_goTypes = []any{
+	(*Proto3Message)(nil), // 0: goproto.protoc.constants.syntax.proto3.Proto3Message
+}
+var file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_init() }
+func file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto = out.File
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_constants_syntax_proto3_proto_depIdxs = nil
+}
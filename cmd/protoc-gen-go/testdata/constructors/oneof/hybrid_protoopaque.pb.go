@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/constructors/oneof/hybrid.proto
+
+//go:build protoopaque
+
+package oneof
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type HybridEvent struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Payload isHybridEvent_Payload  `protobuf_oneof:"payload"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HybridEvent) Reset() {
+	*x = HybridEvent{}
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridEvent) ProtoMessage() {}
+
+func (x *HybridEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HybridEvent) GetClick() *Event_Click {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Payload.(*hybridEvent_Click); ok {
+			return x.Click
+		}
+	}
+	return nil
+}
+
+func (x *HybridEvent) GetText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Payload.(*hybridEvent_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *HybridEvent) SetClick(v *Event_Click) {
+	if v == nil {
+		x.xxx_hidden_Payload = nil
+		return
+	}
+	x.xxx_hidden_Payload = &hybridEvent_Click{v}
+}
+
+func (x *HybridEvent) SetText(v string) {
+	x.xxx_hidden_Payload = &hybridEvent_Text{v}
+}
+
+func (x *HybridEvent) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *HybridEvent) HasClick() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Payload.(*hybridEvent_Click)
+	return ok
+}
+
+func (x *HybridEvent) HasText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Payload.(*hybridEvent_Text)
+	return ok
+}
+
+func (x *HybridEvent) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *HybridEvent) ClearClick() {
+	if _, ok := x.xxx_hidden_Payload.(*hybridEvent_Click); ok {
+		x.xxx_hidden_Payload = nil
+	}
+}
+
+func (x *HybridEvent) ClearText() {
+	if _, ok := x.xxx_hidden_Payload.(*hybridEvent_Text); ok {
+		x.xxx_hidden_Payload = nil
+	}
+}
+
+const HybridEvent_Payload_not_set_case case_HybridEvent_Payload = 0
+const HybridEvent_Click_case case_HybridEvent_Payload = 1
+const HybridEvent_Text_case case_HybridEvent_Payload = 2
+
+func (x *HybridEvent) WhichPayload() case_HybridEvent_Payload {
+	if x == nil {
+		return HybridEvent_Payload_not_set_case
+	}
+	switch x.xxx_hidden_Payload.(type) {
+	case *hybridEvent_Click:
+		return HybridEvent_Click_case
+	case *hybridEvent_Text:
+		return HybridEvent_Text_case
+	default:
+		return HybridEvent_Payload_not_set_case
+	}
+}
+
+type HybridEvent_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Payload:
+	Click *Event_Click
+	Text  *string
+	// -- end of xxx_hidden_Payload
+}
+
+func (b0 HybridEvent_builder) Build() *HybridEvent {
+	m0 := &HybridEvent{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Click != nil {
+		x.xxx_hidden_Payload = &hybridEvent_Click{b.Click}
+	}
+	if b.Text != nil {
+		x.xxx_hidden_Payload = &hybridEvent_Text{*b.Text}
+	}
+	return m0
+}
+
+type case_HybridEvent_Payload protoreflect.FieldNumber
+
+func (x case_HybridEvent_Payload) String() string {
+	md := file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isHybridEvent_Payload interface {
+	isHybridEvent_Payload()
+}
+
+type hybridEvent_Click struct {
+	Click *Event_Click `protobuf:"bytes,1,opt,name=click,oneof"`
+}
+
+type hybridEvent_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,oneof"`
+}
+
+func (*hybridEvent_Click) isHybridEvent_Payload() {}
+
+func (*hybridEvent_Text) isHybridEvent_Payload() {}
+
+var File_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/constructors/oneof/hybrid.proto\x12!goproto.protoc.constructors.oneof\x1a9cmd/protoc-gen-go/testdata/constructors/oneof/oneof.proto\x1a!google/protobuf/go_features.proto\"v\n" +
+	"\vHybridEvent\x12F\n" +
+	"\x05click\x18\x01 \x01(\v2..goproto.protoc.constructors.oneof.Event.ClickH\x00R\x05click\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04textB\t\n" +
+	"\apayloadBRZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/constructors/oneof\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_goTypes = []any{
+	(*HybridEvent)(nil), // 0: goproto.protoc.constructors.oneof.HybridEvent
+	(*Event_Click)(nil), // 1: goproto.protoc.constructors.oneof.Event.Click
+}
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.constructors.oneof.HybridEvent.click:type_name -> goproto.protoc.constructors.oneof.Event.Click
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_init()
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*hybridEvent_Click)(nil),
+		(*hybridEvent_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/constructors/oneof/oneof.proto
+
+package oneof
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Event_KeyCode int32
+
+const (
+	Event_KEY_CODE_UNSPECIFIED Event_KeyCode = 0
+	Event_KEY_CODE_ENTER       Event_KeyCode = 1
+)
+
+// Enum value maps for Event_KeyCode.
+var (
+	Event_KeyCode_name = map[int32]string{
+		0: "KEY_CODE_UNSPECIFIED",
+		1: "KEY_CODE_ENTER",
+	}
+	Event_KeyCode_value = map[string]int32{
+		"KEY_CODE_UNSPECIFIED": 0,
+		"KEY_CODE_ENTER":       1,
+	}
+)
+
+func (x Event_KeyCode) Enum() *Event_KeyCode {
+	p := new(Event_KeyCode)
+	*p = x
+	return p
+}
+
+func (x Event_KeyCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_KeyCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_enumTypes[0].Descriptor()
+}
+
+func (Event_KeyCode) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_enumTypes[0]
+}
+
+func (x Event_KeyCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_KeyCode.Descriptor instead.
+func (Event_KeyCode) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_Click_
+	//	*Event_Text
+	//	*Event_Key
+	//	*Event_Raw
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	Source        *string         `protobuf:"bytes,5,opt,name=source,proto3,oneof" json:"source,omitempty" form:"source" uri:"source"` // synthetic oneof, so no constructor
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetClick() *Event_Click {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Click_); ok {
+			return x.Click
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetText() string {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Event) GetKey() Event_KeyCode {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Key); ok {
+			return x.Key
+		}
+	}
+	return Event_KEY_CODE_UNSPECIFIED
+}
+
+func (x *Event) GetRaw() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_Click_ struct {
+	Click *Event_Click `protobuf:"bytes,1,opt,name=click,proto3,oneof"` // the wrapper type is named Event_Click_
+}
+
+type Event_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type Event_Key struct {
+	Key Event_KeyCode `protobuf:"varint,3,opt,name=key,proto3,enum=goproto.protoc.constructors.oneof.Event_KeyCode,oneof"`
+}
+
+type Event_Raw struct {
+	Raw []byte `protobuf:"bytes,4,opt,name=raw,proto3,oneof"`
+}
+
+func (*Event_Click_) isEvent_Payload() {}
+
+func (*Event_Text) isEvent_Payload() {}
+
+func (*Event_Key) isEvent_Payload() {}
+
+func (*Event_Raw) isEvent_Payload() {}
+
+// NewEvent_Click_ returns a Event_Click_ holding v, which may be assigned
+// to the Payload field of Event to set the click field.
+func NewEvent_Click_(v *Event_Click) *Event_Click_ {
+	return &Event_Click_{Click: v}
+}
+
+// NewEvent_Text returns a Event_Text holding v, which may be assigned
+// to the Payload field of Event to set the text field.
+func NewEvent_Text(v string) *Event_Text {
+	return &Event_Text{Text: v}
+}
+
+// NewEvent_Key returns a Event_Key holding v, which may be assigned
+// to the Payload field of Event to set the key field.
+func NewEvent_Key(v Event_KeyCode) *Event_Key {
+	return &Event_Key{Key: v}
+}
+
+// NewEvent_Raw returns a Event_Raw holding v, which may be assigned
+// to the Payload field of Event to set the raw field.
+func NewEvent_Raw(v []byte) *Event_Raw {
+	return &Event_Raw{Raw: v}
+}
+
+type Event_Click struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty" form:"x" uri:"x"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty" form:"y" uri:"y"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Click) Reset() {
+	*x = Event_Click{}
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Click) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Click) ProtoMessage() {}
+
+func (x *Event_Click) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Click.ProtoReflect.Descriptor instead.
+func (*Event_Click) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Event_Click) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Event_Click) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+var File_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/constructors/oneof/oneof.proto\x12!goproto.protoc.constructors.oneof\"\xd0\x02\n" +
+	"\x05Event\x12F\n" +
+	"\x05click\x18\x01 \x01(\v2..goproto.protoc.constructors.oneof.Event.ClickH\x00R\x05click\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12D\n" +
+	"\x03key\x18\x03 \x01(\x0e20.goproto.protoc.constructors.oneof.Event.KeyCodeH\x00R\x03key\x12\x12\n" +
+	"\x03raw\x18\x04 \x01(\fH\x00R\x03raw\x12\x1b\n" +
+	"\x06source\x18\x05 \x01(\tH\x01R\x06source\x88\x01\x01\x1a#\n" +
+	"\x05Click\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"7\n" +
+	"\aKeyCode\x12\x18\n" +
+	"\x14KEY_CODE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eKEY_CODE_ENTER\x10\x01B\t\n" +
+	"\apayloadB\t\n" +
+	"\a_sourceBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/constructors/oneofb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_goTypes = []any{
+	(Event_KeyCode)(0),  // 0: goproto.protoc.constructors.oneof.Event.KeyCode
+	(*Event)(nil),       // 1: goproto.protoc.constructors.oneof.Event
+	(*Event_Click)(nil), // 2: goproto.protoc.constructors.oneof.Event.Click
+}
+var file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.constructors.oneof.Event.click:type_name -> goproto.protoc.constructors.oneof.Event.Click
+	0, // 1: goproto.protoc.constructors.oneof.Event.key:type_name -> goproto.protoc.constructors.oneof.Event.KeyCode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_init() }
+func file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes[0].OneofWrappers = []any{
+		(*Event_Click_)(nil),
+		(*Event_Text)(nil),
+		(*Event_Key)(nil),
+		(*Event_Raw)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto = out.File
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_constructors_oneof_oneof_proto_depIdxs = nil
+}
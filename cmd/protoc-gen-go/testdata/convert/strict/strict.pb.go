@@ -0,0 +1,548 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/convert/strict/strict.proto
+
+package strict
+
+import (
+	fmt "fmt"
+	v2 "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/v2"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_KIND_PERSONAL    Kind = 1
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_PERSONAL",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_PERSONAL":    1,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescGZIP(), []int{0}
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// ConvertToV2 returns a copy of x as a v2.Address, or nil if x is nil.
+// Fields are matched by name, or by number if no field has the same name,
+// and an error is reported if matched fields have different types.
+// Fields without a counterpart in either message are reported as an error.
+// Unknown fields and extensions are not converted.
+func (x *Address) ConvertToV2() (*v2.Address, error) {
+	if x == nil {
+		return nil, nil
+	}
+	y := new(v2.Address)
+	if err := file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage(x.ProtoReflect(), y.ProtoReflect(), true); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+type Person struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Age      int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Nickname *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Photo    []byte                 `protobuf:"bytes,4,opt,name=photo,proto3" json:"photo,omitempty" form:"photo" uri:"photo"`
+	Kind     Kind                   `protobuf:"varint,5,opt,name=kind,proto3,enum=goproto.protoc.convert.strict.Kind" json:"kind,omitempty" form:"kind" uri:"kind"`
+	Home     *Address               `protobuf:"bytes,6,opt,name=home,proto3" json:"home,omitempty" form:"home" uri:"home"`
+	Previous []*Address             `protobuf:"bytes,7,rep,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Offices  map[string]*Address    `protobuf:"bytes,8,rep,name=offices,proto3" json:"offices,omitempty" form:"offices" uri:"offices" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Scores   map[string]int64       `protobuf:"bytes,9,rep,name=scores,proto3" json:"scores,omitempty" form:"scores" uri:"scores" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Person_Phone
+	//	*Person_Mailing
+	Contact isPerson_Contact `protobuf_oneof:"contact"`
+	// Removed in v2.
+	Fax           string `protobuf:"bytes,13,opt,name=fax,proto3" json:"fax,omitempty" form:"fax" uri:"fax"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Person) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Person) GetPhoto() []byte {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *Person) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Person) GetHome() *Address {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *Person) GetPrevious() []*Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Person) GetOffices() map[string]*Address {
+	if x != nil {
+		return x.Offices
+	}
+	return nil
+}
+
+func (x *Person) GetScores() map[string]int64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Person) GetContact() isPerson_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Person) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Person_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Person) GetMailing() *Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Person_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+func (x *Person) GetFax() string {
+	if x != nil {
+		return x.Fax
+	}
+	return ""
+}
+
+type isPerson_Contact interface {
+	isPerson_Contact()
+}
+
+type Person_Phone struct {
+	Phone string `protobuf:"bytes,10,opt,name=phone,proto3,oneof"`
+}
+
+type Person_Mailing struct {
+	Mailing *Address `protobuf:"bytes,11,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Person_Phone) isPerson_Contact() {}
+
+func (*Person_Mailing) isPerson_Contact() {}
+
+// ConvertToV2 returns a copy of x as a v2.Person, or nil if x is nil.
+// Fields are matched by name, or by number if no field has the same name,
+// and an error is reported if matched fields have different types.
+// Fields without a counterpart in either message are reported as an error.
+// Unknown fields and extensions are not converted.
+func (x *Person) ConvertToV2() (*v2.Person, error) {
+	if x == nil {
+		return nil, nil
+	}
+	y := new(v2.Person)
+	if err := file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage(x.ProtoReflect(), y.ProtoReflect(), true); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+type Incompatible struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" form:"value" uri:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Incompatible) Reset() {
+	*x = Incompatible{}
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incompatible) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incompatible) ProtoMessage() {}
+
+func (x *Incompatible) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incompatible.ProtoReflect.Descriptor instead.
+func (*Incompatible) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Incompatible) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ConvertToV2 returns a copy of x as a v2.Incompatible, or nil if x is nil.
+// Fields are matched by name, or by number if no field has the same name,
+// and an error is reported if matched fields have different types.
+// Fields without a counterpart in either message are reported as an error.
+// Unknown fields and extensions are not converted.
+func (x *Incompatible) ConvertToV2() (*v2.Incompatible, error) {
+	if x == nil {
+		return nil, nil
+	}
+	y := new(v2.Incompatible)
+	if err := file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage(x.ProtoReflect(), y.ProtoReflect(), true); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+// file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage sets the fields of dst to the fields of src with the
+// same name or, failing that, the same number, converting message values
+// recursively. If strict is set, fields without a counterpart are an error.
+func file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage(src, dst protoreflect.Message, strict bool) error {
+	sfds, dfds := src.Descriptor().Fields(), dst.Descriptor().Fields()
+	for i := 0; i < sfds.Len(); i++ {
+		sfd := sfds.Get(i)
+		dfd := file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertCounterpart(sfd, dfds)
+		switch {
+		case dfd == nil:
+			if strict {
+				return fmt.Errorf("cannot convert %v to %v: field %v has no counterpart", src.Descriptor().FullName(), dst.Descriptor().FullName(), sfd.Name())
+			}
+		case sfd.IsList() != dfd.IsList() || sfd.IsMap() != dfd.IsMap() || sfd.Kind() != dfd.Kind(),
+			sfd.IsMap() && (sfd.MapKey().Kind() != dfd.MapKey().Kind() || sfd.MapValue().Kind() != dfd.MapValue().Kind()):
+			return fmt.Errorf("cannot convert field %v to %v: incompatible types", sfd.FullName(), dfd.FullName())
+		}
+	}
+	if strict {
+		for i := 0; i < dfds.Len(); i++ {
+			if dfd := dfds.Get(i); file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertCounterpart(dfd, sfds) == nil {
+				return fmt.Errorf("cannot convert %v to %v: field %v has no counterpart", src.Descriptor().FullName(), dst.Descriptor().FullName(), dfd.Name())
+			}
+		}
+	}
+
+	var err error
+	src.Range(func(sfd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if sfd.IsExtension() {
+			return true
+		}
+		dfd := file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertCounterpart(sfd, dfds)
+		if dfd == nil {
+			return true
+		}
+		switch {
+		case sfd.IsList():
+			sl, dl := v.List(), dst.NewField(dfd).List()
+			for i := 0; i < sl.Len() && err == nil; i++ {
+				var ev protoreflect.Value
+				ev, err = file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertValue(sfd, sl.Get(i), dl.NewElement, strict)
+				dl.Append(ev)
+			}
+			dst.Set(dfd, protoreflect.ValueOfList(dl))
+		case sfd.IsMap():
+			sm, dm := v.Map(), dst.NewField(dfd).Map()
+			sm.Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				mv, err = file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertValue(sfd.MapValue(), mv, dm.NewValue, strict)
+				dm.Set(k, mv)
+				return err == nil
+			})
+			dst.Set(dfd, protoreflect.ValueOfMap(dm))
+		default:
+			v, err = file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertValue(sfd, v, func() protoreflect.Value { return dst.NewField(dfd) }, strict)
+			dst.Set(dfd, v)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertValue returns a copy of the singular value v of a field
+// described by fd, using newValue to create message values.
+func file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, newValue func() protoreflect.Value, strict bool) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		dv := newValue()
+		return dv, file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertMessage(v.Message(), dv.Message(), strict)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte(nil), v.Bytes()...)), nil
+	}
+	return v, nil
+}
+
+// file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertCounterpart returns the field of fds with the name of fd or,
+// failing that, with the number of fd.
+func file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_convertCounterpart(fd protoreflect.FieldDescriptor, fds protoreflect.FieldDescriptors) protoreflect.FieldDescriptor {
+	if c := fds.ByName(fd.Name()); c != nil {
+		return c
+	}
+	return fds.ByNumber(fd.Number())
+}
+
+var File_cmd_protoc_gen_go_testdata_convert_strict_strict_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/convert/strict/strict.proto\x12\x1dgoproto.protoc.convert.strict\x1a.cmd/protoc-gen-go/testdata/convert/v2/v2.proto\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"D\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city:%\xda\xf3\x18!goproto.protoc.convert.v2.Address\"\x82\x06\n" +
+	"\x06Person\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x14\n" +
+	"\x05photo\x18\x04 \x01(\fR\x05photo\x127\n" +
+	"\x04kind\x18\x05 \x01(\x0e2#.goproto.protoc.convert.strict.KindR\x04kind\x12:\n" +
+	"\x04home\x18\x06 \x01(\v2&.goproto.protoc.convert.strict.AddressR\x04home\x12B\n" +
+	"\bprevious\x18\a \x03(\v2&.goproto.protoc.convert.strict.AddressR\bprevious\x12L\n" +
+	"\aoffices\x18\b \x03(\v22.goproto.protoc.convert.strict.Person.OfficesEntryR\aoffices\x12I\n" +
+	"\x06scores\x18\t \x03(\v21.goproto.protoc.convert.strict.Person.ScoresEntryR\x06scores\x12\x16\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tH\x00R\x05phone\x12B\n" +
+	"\amailing\x18\v \x01(\v2&.goproto.protoc.convert.strict.AddressH\x00R\amailing\x12\x10\n" +
+	"\x03fax\x18\r \x01(\tR\x03fax\x1ab\n" +
+	"\fOfficesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\x05value\x18\x02 \x01(\v2&.goproto.protoc.convert.strict.AddressR\x05value:\x028\x01\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01:$\xda\xf3\x18 goproto.protoc.convert.v2.PersonB\t\n" +
+	"\acontactB\v\n" +
+	"\t_nickname\"P\n" +
+	"\fIncompatible\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value:*\xda\xf3\x18&goproto.protoc.convert.v2.Incompatible*/\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rKIND_PERSONAL\x10\x01BFZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/strictb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_goTypes = []any{
+	(Kind)(0),            // 0: goproto.protoc.convert.strict.Kind
+	(*Address)(nil),      // 1: goproto.protoc.convert.strict.Address
+	(*Person)(nil),       // 2: goproto.protoc.convert.strict.Person
+	(*Incompatible)(nil), // 3: goproto.protoc.convert.strict.Incompatible
+	nil,                  // 4: goproto.protoc.convert.strict.Person.OfficesEntry
+	nil,                  // 5: goproto.protoc.convert.strict.Person.ScoresEntry
+}
+var file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.convert.strict.Person.kind:type_name -> goproto.protoc.convert.strict.Kind
+	1, // 1: goproto.protoc.convert.strict.Person.home:type_name -> goproto.protoc.convert.strict.Address
+	1, // 2: goproto.protoc.convert.strict.Person.previous:type_name -> goproto.protoc.convert.strict.Address
+	4, // 3: goproto.protoc.convert.strict.Person.offices:type_name -> goproto.protoc.convert.strict.Person.OfficesEntry
+	5, // 4: goproto.protoc.convert.strict.Person.scores:type_name -> goproto.protoc.convert.strict.Person.ScoresEntry
+	1, // 5: goproto.protoc.convert.strict.Person.mailing:type_name -> goproto.protoc.convert.strict.Address
+	1, // 6: goproto.protoc.convert.strict.Person.OfficesEntry.value:type_name -> goproto.protoc.convert.strict.Address
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_init() }
+func file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_convert_strict_strict_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes[1].OneofWrappers = []any{
+		(*Person_Phone)(nil),
+		(*Person_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_convert_strict_strict_proto = out.File
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_convert_strict_strict_proto_depIdxs = nil
+}
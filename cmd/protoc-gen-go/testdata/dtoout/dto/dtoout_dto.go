@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/dtoout.proto
+
+// Package dto declares data transfer objects for the messages of
+// package dtoout, which do not depend on the protobuf runtime.
+package dto
+
+import (
+	dto "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared/dto"
+)
+
+// Person is the data transfer object for the goproto.protoc.dtoout.Person message.
+type Person struct {
+	Name      string
+	Age       int32
+	Nickname  *string
+	Photo     []byte
+	Role      int32
+	Home      *Person_Address
+	Emails    []string
+	Previous  []*Person_Address
+	Roles     []int32
+	Scores    map[string]int64
+	Offices   map[int32]*Person_Address
+	Contact   Person_Contact
+	Created   *dto.Stamp
+	Thumbnail []byte
+	Visits    []*dto.Stamp
+	Stamps    map[string]*dto.Stamp
+}
+
+// Person_Contact holds the value of the contact oneof of Person.
+// Case is the number of the member which is set, or zero if none is set.
+type Person_Contact struct {
+	Case    int32
+	Phone   string
+	Mailing *Person_Address
+}
+
+// Person_Address is the data transfer object for the goproto.protoc.dtoout.Person.Address message.
+type Person_Address struct {
+	City string
+}
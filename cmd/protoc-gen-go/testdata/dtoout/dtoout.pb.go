@@ -0,0 +1,425 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/dtoout.proto
+
+package dtoout
+
+import (
+	shared "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_ADMIN       Role = 1
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_ADMIN":       1,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescGZIP(), []int{0}
+}
+
+type Person struct {
+	state    protoimpl.MessageState    `protogen:"open.v1"`
+	Name     string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Age      int32                     `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Nickname *string                   `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Photo    []byte                    `protobuf:"bytes,4,opt,name=photo,proto3" json:"photo,omitempty" form:"photo" uri:"photo"`
+	Role     Role                      `protobuf:"varint,5,opt,name=role,proto3,enum=goproto.protoc.dtoout.Role" json:"role,omitempty" form:"role" uri:"role"`
+	Home     *Person_Address           `protobuf:"bytes,6,opt,name=home,proto3" json:"home,omitempty" form:"home" uri:"home"`
+	Emails   []string                  `protobuf:"bytes,7,rep,name=emails,proto3" json:"emails,omitempty" form:"emails" uri:"emails"`
+	Previous []*Person_Address         `protobuf:"bytes,8,rep,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Roles    []Role                    `protobuf:"varint,9,rep,packed,name=roles,proto3,enum=goproto.protoc.dtoout.Role" json:"roles,omitempty" form:"roles" uri:"roles"`
+	Scores   map[string]int64          `protobuf:"bytes,10,rep,name=scores,proto3" json:"scores,omitempty" form:"scores" uri:"scores" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Offices  map[int32]*Person_Address `protobuf:"bytes,11,rep,name=offices,proto3" json:"offices,omitempty" form:"offices" uri:"offices" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Person_Phone
+	//	*Person_Mailing
+	Contact       isPerson_Contact         `protobuf_oneof:"contact"`
+	Created       *shared.Stamp            `protobuf:"bytes,14,opt,name=created,proto3" json:"created,omitempty" form:"created" uri:"created"`
+	Thumbnail     []byte                   `protobuf:"bytes,15,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty" form:"thumbnail" uri:"thumbnail"`
+	Visits        []*shared.Stamp          `protobuf:"bytes,16,rep,name=visits,proto3" json:"visits,omitempty" form:"visits" uri:"visits"`
+	Stamps        map[string]*shared.Stamp `protobuf:"bytes,17,rep,name=stamps,proto3" json:"stamps,omitempty" form:"stamps" uri:"stamps" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Person) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Person) GetPhoto() []byte {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *Person) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *Person) GetHome() *Person_Address {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *Person) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *Person) GetPrevious() []*Person_Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Person) GetRoles() []Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Person) GetScores() map[string]int64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Person) GetOffices() map[int32]*Person_Address {
+	if x != nil {
+		return x.Offices
+	}
+	return nil
+}
+
+func (x *Person) GetContact() isPerson_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Person) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Person_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Person) GetMailing() *Person_Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Person_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+func (x *Person) GetCreated() *shared.Stamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Person) GetThumbnail() []byte {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return nil
+}
+
+func (x *Person) GetVisits() []*shared.Stamp {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+func (x *Person) GetStamps() map[string]*shared.Stamp {
+	if x != nil {
+		return x.Stamps
+	}
+	return nil
+}
+
+type isPerson_Contact interface {
+	isPerson_Contact()
+}
+
+type Person_Phone struct {
+	Phone string `protobuf:"bytes,12,opt,name=phone,proto3,oneof"`
+}
+
+type Person_Mailing struct {
+	Mailing *Person_Address `protobuf:"bytes,13,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Person_Phone) isPerson_Contact() {}
+
+func (*Person_Mailing) isPerson_Contact() {}
+
+type Person_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person_Address) Reset() {
+	*x = Person_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person_Address) ProtoMessage() {}
+
+func (x *Person_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person_Address.ProtoReflect.Descriptor instead.
+func (*Person_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Person_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDesc = "" +
+	"\n" +
+	".cmd/protoc-gen-go/testdata/dtoout/dtoout.proto\x12\x15goproto.protoc.dtoout\x1a5cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto\"\xe8\b\n" +
+	"\x06Person\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x14\n" +
+	"\x05photo\x18\x04 \x01(\fR\x05photo\x12/\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x1b.goproto.protoc.dtoout.RoleR\x04role\x129\n" +
+	"\x04home\x18\x06 \x01(\v2%.goproto.protoc.dtoout.Person.AddressR\x04home\x12\x16\n" +
+	"\x06emails\x18\a \x03(\tR\x06emails\x12A\n" +
+	"\bprevious\x18\b \x03(\v2%.goproto.protoc.dtoout.Person.AddressR\bprevious\x121\n" +
+	"\x05roles\x18\t \x03(\x0e2\x1b.goproto.protoc.dtoout.RoleR\x05roles\x12A\n" +
+	"\x06scores\x18\n" +
+	" \x03(\v2).goproto.protoc.dtoout.Person.ScoresEntryR\x06scores\x12D\n" +
+	"\aoffices\x18\v \x03(\v2*.goproto.protoc.dtoout.Person.OfficesEntryR\aoffices\x12\x16\n" +
+	"\x05phone\x18\f \x01(\tH\x00R\x05phone\x12A\n" +
+	"\amailing\x18\r \x01(\v2%.goproto.protoc.dtoout.Person.AddressH\x00R\amailing\x12=\n" +
+	"\acreated\x18\x0e \x01(\v2#.goproto.protoc.dtoout.shared.StampR\acreated\x12!\n" +
+	"\tthumbnail\x18\x0f \x01(\fH\x02R\tthumbnail\x88\x01\x01\x12;\n" +
+	"\x06visits\x18\x10 \x03(\v2#.goproto.protoc.dtoout.shared.StampR\x06visits\x12A\n" +
+	"\x06stamps\x18\x11 \x03(\v2).goproto.protoc.dtoout.Person.StampsEntryR\x06stamps\x1a\x1d\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aa\n" +
+	"\fOfficesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12;\n" +
+	"\x05value\x18\x02 \x01(\v2%.goproto.protoc.dtoout.Person.AddressR\x05value:\x028\x01\x1a^\n" +
+	"\vStampsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.goproto.protoc.dtoout.shared.StampR\x05value:\x028\x01B\t\n" +
+	"\acontactB\v\n" +
+	"\t_nicknameB\f\n" +
+	"\n" +
+	"_thumbnail*,\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ROLE_ADMIN\x10\x01B>Z<google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtooutb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_goTypes = []any{
+	(Role)(0),              // 0: goproto.protoc.dtoout.Role
+	(*Person)(nil),         // 1: goproto.protoc.dtoout.Person
+	(*Person_Address)(nil), // 2: goproto.protoc.dtoout.Person.Address
+	nil,                    // 3: goproto.protoc.dtoout.Person.ScoresEntry
+	nil,                    // 4: goproto.protoc.dtoout.Person.OfficesEntry
+	nil,                    // 5: goproto.protoc.dtoout.Person.StampsEntry
+	(*shared.Stamp)(nil),   // 6: goproto.protoc.dtoout.shared.Stamp
+}
+var file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_depIdxs = []int32{
+	0,  // 0: goproto.protoc.dtoout.Person.role:type_name -> goproto.protoc.dtoout.Role
+	2,  // 1: goproto.protoc.dtoout.Person.home:type_name -> goproto.protoc.dtoout.Person.Address
+	2,  // 2: goproto.protoc.dtoout.Person.previous:type_name -> goproto.protoc.dtoout.Person.Address
+	0,  // 3: goproto.protoc.dtoout.Person.roles:type_name -> goproto.protoc.dtoout.Role
+	3,  // 4: goproto.protoc.dtoout.Person.scores:type_name -> goproto.protoc.dtoout.Person.ScoresEntry
+	4,  // 5: goproto.protoc.dtoout.Person.offices:type_name -> goproto.protoc.dtoout.Person.OfficesEntry
+	2,  // 6: goproto.protoc.dtoout.Person.mailing:type_name -> goproto.protoc.dtoout.Person.Address
+	6,  // 7: goproto.protoc.dtoout.Person.created:type_name -> goproto.protoc.dtoout.shared.Stamp
+	6,  // 8: goproto.protoc.dtoout.Person.visits:type_name -> goproto.protoc.dtoout.shared.Stamp
+	5,  // 9: goproto.protoc.dtoout.Person.stamps:type_name -> goproto.protoc.dtoout.Person.StampsEntry
+	2,  // 10: goproto.protoc.dtoout.Person.OfficesEntry.value:type_name -> goproto.protoc.dtoout.Person.Address
+	6,  // 11: goproto.protoc.dtoout.Person.StampsEntry.value:type_name -> goproto.protoc.dtoout.shared.Stamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_init() }
+func file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes[0].OneofWrappers = []any{
+		(*Person_Phone)(nil),
+		(*Person_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto = out.File
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_dtoout_dtoout_proto_depIdxs = nil
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/dtoout.proto
+
+package dtoout
+
+import (
+	dto "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/dto"
+	shared "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared"
+	dto1 "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared/dto"
+	proto "google.golang.org/protobuf/proto"
+)
+
+// ToDTO returns a copy of x as a data transfer object, or nil if x is nil.
+func (x *Person) ToDTO() *dto.Person {
+	if x == nil {
+		return nil
+	}
+	d := new(dto.Person)
+	d.Name = x.GetName()
+	d.Age = x.GetAge()
+	if x.Nickname != nil {
+		t := x.GetNickname()
+		d.Nickname = &t
+	}
+	d.Photo = append([]byte(nil), x.GetPhoto()...)
+	d.Role = int32(x.GetRole())
+	d.Home = x.GetHome().ToDTO()
+	if l := x.GetEmails(); len(l) > 0 {
+		d.Emails = make([]string, len(l))
+		for i, e := range l {
+			d.Emails[i] = e
+		}
+	}
+	if l := x.GetPrevious(); len(l) > 0 {
+		d.Previous = make([]*dto.Person_Address, len(l))
+		for i, e := range l {
+			d.Previous[i] = e.ToDTO()
+		}
+	}
+	if l := x.GetRoles(); len(l) > 0 {
+		d.Roles = make([]int32, len(l))
+		for i, e := range l {
+			d.Roles[i] = int32(e)
+		}
+	}
+	if mv := x.GetScores(); len(mv) > 0 {
+		d.Scores = make(map[string]int64, len(mv))
+		for k, e := range mv {
+			d.Scores[k] = e
+		}
+	}
+	if mv := x.GetOffices(); len(mv) > 0 {
+		d.Offices = make(map[int32]*dto.Person_Address, len(mv))
+		for k, e := range mv {
+			d.Offices[k] = e.ToDTO()
+		}
+	}
+	if v, ok := x.Contact.(*Person_Phone); ok {
+		d.Contact.Case = 12
+		d.Contact.Phone = v.Phone
+	}
+	if v, ok := x.Contact.(*Person_Mailing); ok {
+		d.Contact.Case = 13
+		d.Contact.Mailing = v.Mailing.ToDTO()
+	}
+	d.Created = x.GetCreated().ToDTO()
+	if x.Thumbnail != nil {
+		d.Thumbnail = append([]byte{}, x.Thumbnail...)
+	}
+	if l := x.GetVisits(); len(l) > 0 {
+		d.Visits = make([]*dto1.Stamp, len(l))
+		for i, e := range l {
+			d.Visits[i] = e.ToDTO()
+		}
+	}
+	if mv := x.GetStamps(); len(mv) > 0 {
+		d.Stamps = make(map[string]*dto1.Stamp, len(mv))
+		for k, e := range mv {
+			d.Stamps[k] = e.ToDTO()
+		}
+	}
+	return d
+}
+
+// FromDTO sets x to a copy of the data transfer object d and returns x.
+// If d is nil, x is reset.
+func (x *Person) FromDTO(d *dto.Person) *Person {
+	proto.Reset(x)
+	if d == nil {
+		return x
+	}
+	x.Name = d.Name
+	x.Age = d.Age
+	if d.Nickname != nil {
+		t := *d.Nickname
+		x.Nickname = &t
+	}
+	x.Photo = append([]byte(nil), d.Photo...)
+	x.Role = Role(d.Role)
+	if d.Home != nil {
+		x.Home = new(Person_Address).FromDTO(d.Home)
+	}
+	if len(d.Emails) > 0 {
+		l := make([]string, len(d.Emails))
+		for i, e := range d.Emails {
+			l[i] = e
+		}
+		x.Emails = l
+	}
+	if len(d.Previous) > 0 {
+		l := make([]*Person_Address, len(d.Previous))
+		for i, e := range d.Previous {
+			l[i] = new(Person_Address).FromDTO(e)
+		}
+		x.Previous = l
+	}
+	if len(d.Roles) > 0 {
+		l := make([]Role, len(d.Roles))
+		for i, e := range d.Roles {
+			l[i] = Role(e)
+		}
+		x.Roles = l
+	}
+	if len(d.Scores) > 0 {
+		mv := make(map[string]int64, len(d.Scores))
+		for k, e := range d.Scores {
+			mv[k] = e
+		}
+		x.Scores = mv
+	}
+	if len(d.Offices) > 0 {
+		mv := make(map[int32]*Person_Address, len(d.Offices))
+		for k, e := range d.Offices {
+			mv[k] = new(Person_Address).FromDTO(e)
+		}
+		x.Offices = mv
+	}
+	switch d.Contact.Case {
+	case 12:
+		x.Contact = &Person_Phone{Phone: d.Contact.Phone}
+	case 13:
+		x.Contact = &Person_Mailing{Mailing: new(Person_Address).FromDTO(d.Contact.Mailing)}
+	}
+	if d.Created != nil {
+		x.Created = new(shared.Stamp).FromDTO(d.Created)
+	}
+	if d.Thumbnail != nil {
+		x.Thumbnail = append([]byte{}, d.Thumbnail...)
+	}
+	if len(d.Visits) > 0 {
+		l := make([]*shared.Stamp, len(d.Visits))
+		for i, e := range d.Visits {
+			l[i] = new(shared.Stamp).FromDTO(e)
+		}
+		x.Visits = l
+	}
+	if len(d.Stamps) > 0 {
+		mv := make(map[string]*shared.Stamp, len(d.Stamps))
+		for k, e := range d.Stamps {
+			mv[k] = new(shared.Stamp).FromDTO(e)
+		}
+		x.Stamps = mv
+	}
+	return x
+}
+
+// ToDTO returns a copy of x as a data transfer object, or nil if x is nil.
+func (x *Person_Address) ToDTO() *dto.Person_Address {
+	if x == nil {
+		return nil
+	}
+	d := new(dto.Person_Address)
+	d.City = x.GetCity()
+	return d
+}
+
+// FromDTO sets x to a copy of the data transfer object d and returns x.
+// If d is nil, x is reset.
+func (x *Person_Address) FromDTO(d *dto.Person_Address) *Person_Address {
+	proto.Reset(x)
+	if d == nil {
+		return x
+	}
+	x.City = d.City
+	return x
+}
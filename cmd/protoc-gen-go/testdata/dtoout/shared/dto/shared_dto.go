@@ -0,0 +1,12 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto
+
+// Package dto declares data transfer objects for the messages of
+// package shared, which do not depend on the protobuf runtime.
+package dto
+
+// Stamp is the data transfer object for the goproto.protoc.dtoout.shared.Stamp message.
+type Stamp struct {
+	Seconds int64
+	Zone    string
+}
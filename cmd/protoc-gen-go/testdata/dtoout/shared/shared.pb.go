@@ -0,0 +1,125 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto
+
+package shared
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Stamp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seconds       int64                  `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty" form:"seconds" uri:"seconds"`
+	Zone          string                 `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty" form:"zone" uri:"zone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stamp) Reset() {
+	*x = Stamp{}
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stamp) ProtoMessage() {}
+
+func (x *Stamp) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stamp.ProtoReflect.Descriptor instead.
+func (*Stamp) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Stamp) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *Stamp) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDesc = "" +
+	"\n" +
+	"5cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto\x12\x1cgoproto.protoc.dtoout.shared\"5\n" +
+	"\x05Stamp\x12\x18\n" +
+	"\aseconds\x18\x01 \x01(\x03R\aseconds\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\tR\x04zoneBEZCgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/sharedb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_goTypes = []any{
+	(*Stamp)(nil), // 0: goproto.protoc.dtoout.shared.Stamp
+}
+var file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_init() }
+func file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto = out.File
+	file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_dtoout_shared_shared_proto_depIdxs = nil
+}
@@ -0,0 +1,32 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto
+
+package shared
+
+import (
+	dto "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared/dto"
+	proto "google.golang.org/protobuf/proto"
+)
+
+// ToDTO returns a copy of x as a data transfer object, or nil if x is nil.
+func (x *Stamp) ToDTO() *dto.Stamp {
+	if x == nil {
+		return nil
+	}
+	d := new(dto.Stamp)
+	d.Seconds = x.GetSeconds()
+	d.Zone = x.GetZone()
+	return d
+}
+
+// FromDTO sets x to a copy of the data transfer object d and returns x.
+// If d is nil, x is reset.
+func (x *Stamp) FromDTO(d *dto.Stamp) *Stamp {
+	proto.Reset(x)
+	if d == nil {
+		return x
+	}
+	x.Seconds = d.Seconds
+	x.Zone = d.Zone
+	return x
+}
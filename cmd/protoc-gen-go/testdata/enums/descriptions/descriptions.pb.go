@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/enums/descriptions/descriptions.proto
+
+package descriptions
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	// The request is waiting
+	// to be processed.
+	Status_STATUS_PENDING Status = 1
+	Status_STATUS_DONE    Status = 2 // The request has been processed.
+	// Leading comments take precedence.
+	Status_STATUS_FAILED Status = 3 // Trailing comment.
+	// An alias shares the description of the value it aliases.
+	Status_STATUS_ERROR Status = 3
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_DONE",
+		3: "STATUS_FAILED",
+		// Duplicate value: 3: "STATUS_ERROR",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_DONE":        2,
+		"STATUS_FAILED":      3,
+		"STATUS_ERROR":       3,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescGZIP(), []int{0}
+}
+
+// Description returns the description of x given by the comments of the
+// enum value in the .proto file, or the name of the value if it has none.
+func (x Status) Description() string {
+	switch x {
+	case Status_STATUS_PENDING:
+		return "The request is waiting to be processed."
+	case Status_STATUS_DONE:
+		return "The request has been processed."
+	case Status_STATUS_FAILED:
+		return "Leading comments take precedence."
+	}
+	return x.String()
+}
+
+var File_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/enums/descriptions/descriptions.proto\x12!goproto.protoc.enums.descriptions*n\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_PENDING\x10\x01\x12\x0f\n" +
+	"\vSTATUS_DONE\x10\x02\x12\x11\n" +
+	"\rSTATUS_FAILED\x10\x03\x12\x10\n" +
+	"\fSTATUS_ERROR\x10\x03\x1a\x02\x10\x01BJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/descriptionsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_goTypes = []any{
+	(Status)(0), // 0: goproto.protoc.enums.descriptions.Status
+}
+var file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_init() }
+func file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_enumTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto = out.File
+	file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_enums_descriptions_descriptions_proto_depIdxs = nil
+}
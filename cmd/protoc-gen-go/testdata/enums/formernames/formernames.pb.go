@@ -0,0 +1,225 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/enums/formernames/formernames.proto
+
+package formernames
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type State int32
+
+const (
+	State_STATE_UNSPECIFIED State = 0
+	State_STATE_ACTIVE      State = 1
+	State_STATE_INACTIVE    State = 2
+)
+
+// Former names for State enum values.
+const (
+	State_STATE_ENABLED State = State_STATE_ACTIVE
+	State_STATE_ON      State = State_STATE_ACTIVE
+)
+
+// Enum value maps for State.
+var (
+	State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_ACTIVE",
+		2: "STATE_INACTIVE",
+	}
+	State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_ACTIVE":      1,
+		"STATE_INACTIVE":    2,
+	}
+)
+
+func (x State) Enum() *State {
+	p := new(State)
+	*p = x
+	return p
+}
+
+func (x State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (State) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes[0].Descriptor()
+}
+
+func (State) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes[0]
+}
+
+func (x State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use State.Descriptor instead.
+func (State) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescGZIP(), []int{0}
+}
+
+type Job_Phase int32
+
+const (
+	Job_PHASE_UNSPECIFIED Job_Phase = 0
+	Job_PHASE_RUNNING     Job_Phase = 1
+)
+
+// Former names for Job_Phase enum values.
+const (
+	Job_PHASE_STARTED Job_Phase = Job_PHASE_RUNNING
+)
+
+// Enum value maps for Job_Phase.
+var (
+	Job_Phase_name = map[int32]string{
+		0: "PHASE_UNSPECIFIED",
+		1: "PHASE_RUNNING",
+	}
+	Job_Phase_value = map[string]int32{
+		"PHASE_UNSPECIFIED": 0,
+		"PHASE_RUNNING":     1,
+	}
+)
+
+func (x Job_Phase) Enum() *Job_Phase {
+	p := new(Job_Phase)
+	*p = x
+	return p
+}
+
+func (x Job_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes[1].Descriptor()
+}
+
+func (Job_Phase) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes[1]
+}
+
+func (x Job_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_Phase.Descriptor instead.
+func (Job_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescGZIP(), []int{0}
+}
+
+var File_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/enums/formernames/formernames.proto\x12 goproto.protoc.enums.formernames\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"K\n" +
+	"\x03Job\"D\n" +
+	"\x05Phase\x12\x15\n" +
+	"\x11PHASE_UNSPECIFIED\x10\x00\x12$\n" +
+	"\rPHASE_RUNNING\x10\x01\x1a\x11\xe2\xf3\x18\rPHASE_STARTED*c\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12/\n" +
+	"\fSTATE_ACTIVE\x10\x01\x1a\x1d\xe2\xf3\x18\rSTATE_ENABLED\xe2\xf3\x18\bSTATE_ON\x12\x12\n" +
+	"\x0eSTATE_INACTIVE\x10\x02BIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/formernamesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_goTypes = []any{
+	(State)(0),     // 0: goproto.protoc.enums.formernames.State
+	(Job_Phase)(0), // 1: goproto.protoc.enums.formernames.Job.Phase
+	(*Job)(nil),    // 2: goproto.protoc.enums.formernames.Job
+}
+var file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_init() }
+func file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto = out.File
+	file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_enums_formernames_formernames_proto_depIdxs = nil
+}
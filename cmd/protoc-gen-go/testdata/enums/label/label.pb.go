@@ -0,0 +1,234 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/enums/label/label.proto
+
+package label
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_GREEN       Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+		2: "COLOR_GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_GREEN":       2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescGZIP(), []int{0}
+}
+
+// Label returns the label of x given by lookup, which is called with the
+// full name of the enum and the number of x, or the name of the value if
+// lookup returns the empty string.
+func (x Color) Label(lookup func(protoreflect.FullName, protoreflect.EnumNumber) string) string {
+	if label := lookup("goproto.protoc.enums.label.Color", protoreflect.EnumNumber(x)); label != "" {
+		return label
+	}
+	return x.String()
+}
+
+type Palette_Shade int32
+
+const (
+	Palette_SHADE_UNSPECIFIED Palette_Shade = 0
+	Palette_SHADE_DARK        Palette_Shade = 1
+)
+
+// Enum value maps for Palette_Shade.
+var (
+	Palette_Shade_name = map[int32]string{
+		0: "SHADE_UNSPECIFIED",
+		1: "SHADE_DARK",
+	}
+	Palette_Shade_value = map[string]int32{
+		"SHADE_UNSPECIFIED": 0,
+		"SHADE_DARK":        1,
+	}
+)
+
+func (x Palette_Shade) Enum() *Palette_Shade {
+	p := new(Palette_Shade)
+	*p = x
+	return p
+}
+
+func (x Palette_Shade) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Palette_Shade) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes[1].Descriptor()
+}
+
+func (Palette_Shade) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes[1]
+}
+
+func (x Palette_Shade) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Palette_Shade.Descriptor instead.
+func (Palette_Shade) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Label returns the label of x given by lookup, which is called with the
+// full name of the enum and the number of x, or the name of the value if
+// lookup returns the empty string.
+func (x Palette_Shade) Label(lookup func(protoreflect.FullName, protoreflect.EnumNumber) string) string {
+	if label := lookup("goproto.protoc.enums.label.Palette.Shade", protoreflect.EnumNumber(x)); label != "" {
+		return label
+	}
+	return x.String()
+}
+
+type Palette struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Palette) Reset() {
+	*x = Palette{}
+	mi := &file_cmd_protoc_gen_go_testdata_enums_label_label_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Palette) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Palette) ProtoMessage() {}
+
+func (x *Palette) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_enums_label_label_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Palette.ProtoReflect.Descriptor instead.
+func (*Palette) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescGZIP(), []int{0}
+}
+
+var File_cmd_protoc_gen_go_testdata_enums_label_label_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/enums/label/label.proto\x12\x1agoproto.protoc.enums.label\"9\n" +
+	"\aPalette\".\n" +
+	"\x05Shade\x12\x15\n" +
+	"\x11SHADE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SHADE_DARK\x10\x01*>\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01\x12\x0f\n" +
+	"\vCOLOR_GREEN\x10\x02BCZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/labelb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cmd_protoc_gen_go_testdata_enums_label_label_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_enums_label_label_proto_goTypes = []any{
+	(Color)(0),         // 0: goproto.protoc.enums.label.Color
+	(Palette_Shade)(0), // 1: goproto.protoc.enums.label.Palette.Shade
+	(*Palette)(nil),    // 2: goproto.protoc.enums.label.Palette
+}
+var file_cmd_protoc_gen_go_testdata_enums_label_label_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_enums_label_label_proto_init() }
+func file_cmd_protoc_gen_go_testdata_enums_label_label_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_enums_label_label_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_label_label_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_enums_label_label_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_enums_label_label_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_enums_label_label_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_enums_label_label_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_enums_label_label_proto = out.File
+	file_cmd_protoc_gen_go_testdata_enums_label_label_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_enums_label_label_proto_depIdxs = nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/enums/switchstring/switchstring.proto
+
+package switchstring
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_UNSPECIFIED Level = 0
+	Level_LEVEL_LOW         Level = -1
+	Level_LEVEL_HIGH        Level = 1
+	Level_LEVEL_MAX         Level = 1
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0:  "LEVEL_UNSPECIFIED",
+		-1: "LEVEL_LOW",
+		1:  "LEVEL_HIGH",
+		// Duplicate value: 1: "LEVEL_MAX",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_LOW":         -1,
+		"LEVEL_HIGH":        1,
+		"LEVEL_MAX":         1,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	switch x {
+	case Level_LEVEL_UNSPECIFIED:
+		return "LEVEL_UNSPECIFIED"
+	case Level_LEVEL_LOW:
+		return "LEVEL_LOW"
+	case Level_LEVEL_HIGH:
+		return "LEVEL_HIGH"
+	}
+	return strconv.Itoa(int(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Level.Descriptor instead.
+func (Level) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescGZIP(), []int{0}
+}
+
+// Month has more values than the switchstring_max parameter, and its String
+// method looks up its descriptor.
+type Month int32
+
+const (
+	Month_MONTH_UNSPECIFIED Month = 0
+	Month_MONTH_JANUARY     Month = 1
+	Month_MONTH_FEBRUARY    Month = 2
+	Month_MONTH_MARCH       Month = 3
+	Month_MONTH_APRIL       Month = 4
+)
+
+// Enum value maps for Month.
+var (
+	Month_name = map[int32]string{
+		0: "MONTH_UNSPECIFIED",
+		1: "MONTH_JANUARY",
+		2: "MONTH_FEBRUARY",
+		3: "MONTH_MARCH",
+		4: "MONTH_APRIL",
+	}
+	Month_value = map[string]int32{
+		"MONTH_UNSPECIFIED": 0,
+		"MONTH_JANUARY":     1,
+		"MONTH_FEBRUARY":    2,
+		"MONTH_MARCH":       3,
+		"MONTH_APRIL":       4,
+	}
+)
+
+func (x Month) Enum() *Month {
+	p := new(Month)
+	*p = x
+	return p
+}
+
+func (x Month) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Month) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[1].Descriptor()
+}
+
+func (Month) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[1]
+}
+
+func (x Month) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Month.Descriptor instead.
+func (Month) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescGZIP(), []int{1}
+}
+
+type Reading_Unit int32
+
+const (
+	Reading_UNIT_UNSPECIFIED Reading_Unit = 0
+	Reading_UNIT_CELSIUS     Reading_Unit = 1
+)
+
+// Enum value maps for Reading_Unit.
+var (
+	Reading_Unit_name = map[int32]string{
+		0: "UNIT_UNSPECIFIED",
+		1: "UNIT_CELSIUS",
+	}
+	Reading_Unit_value = map[string]int32{
+		"UNIT_UNSPECIFIED": 0,
+		"UNIT_CELSIUS":     1,
+	}
+)
+
+func (x Reading_Unit) Enum() *Reading_Unit {
+	p := new(Reading_Unit)
+	*p = x
+	return p
+}
+
+func (x Reading_Unit) String() string {
+	switch x {
+	case Reading_UNIT_UNSPECIFIED:
+		return "UNIT_UNSPECIFIED"
+	case Reading_UNIT_CELSIUS:
+		return "UNIT_CELSIUS"
+	}
+	return strconv.Itoa(int(x))
+}
+
+func (Reading_Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[2].Descriptor()
+}
+
+func (Reading_Unit) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes[2]
+}
+
+func (x Reading_Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reading_Unit.Descriptor instead.
+func (Reading_Unit) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Reading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         Level                  `protobuf:"varint,1,opt,name=level,proto3,enum=goproto.protoc.enums.switchstring.Level" json:"level,omitempty" form:"level" uri:"level"`
+	Month         Month                  `protobuf:"varint,2,opt,name=month,proto3,enum=goproto.protoc.enums.switchstring.Month" json:"month,omitempty" form:"month" uri:"month"`
+	Unit          Reading_Unit           `protobuf:"varint,3,opt,name=unit,proto3,enum=goproto.protoc.enums.switchstring.Reading_Unit" json:"unit,omitempty" form:"unit" uri:"unit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reading) Reset() {
+	*x = Reading{}
+	mi := &file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reading) ProtoMessage() {}
+
+func (x *Reading) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reading.ProtoReflect.Descriptor instead.
+func (*Reading) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reading) GetLevel() Level {
+	if x != nil {
+		return x.Level
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *Reading) GetMonth() Month {
+	if x != nil {
+		return x.Month
+	}
+	return Month_MONTH_UNSPECIFIED
+}
+
+func (x *Reading) GetUnit() Reading_Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return Reading_UNIT_UNSPECIFIED
+}
+
+var File_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/enums/switchstring/switchstring.proto\x12!goproto.protoc.enums.switchstring\"\xfe\x01\n" +
+	"\aReading\x12>\n" +
+	"\x05level\x18\x01 \x01(\x0e2(.goproto.protoc.enums.switchstring.LevelR\x05level\x12>\n" +
+	"\x05month\x18\x02 \x01(\x0e2(.goproto.protoc.enums.switchstring.MonthR\x05month\x12C\n" +
+	"\x04unit\x18\x03 \x01(\x0e2/.goproto.protoc.enums.switchstring.Reading.UnitR\x04unit\".\n" +
+	"\x04Unit\x12\x14\n" +
+	"\x10UNIT_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fUNIT_CELSIUS\x10\x01*Y\n" +
+	"\x05Level\x12\x15\n" +
+	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\tLEVEL_LOW\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\x01\x12\r\n" +
+	"\tLEVEL_MAX\x10\x01\x1a\x02\x10\x01*g\n" +
+	"\x05Month\x12\x15\n" +
+	"\x11MONTH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rMONTH_JANUARY\x10\x01\x12\x12\n" +
+	"\x0eMONTH_FEBRUARY\x10\x02\x12\x0f\n" +
+	"\vMONTH_MARCH\x10\x03\x12\x0f\n" +
+	"\vMONTH_APRIL\x10\x04BJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/switchstringb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_goTypes = []any{
+	(Level)(0),        // 0: goproto.protoc.enums.switchstring.Level
+	(Month)(0),        // 1: goproto.protoc.enums.switchstring.Month
+	(Reading_Unit)(0), // 2: goproto.protoc.enums.switchstring.Reading.Unit
+	(*Reading)(nil),   // 3: goproto.protoc.enums.switchstring.Reading
+}
+var file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.enums.switchstring.Reading.level:type_name -> goproto.protoc.enums.switchstring.Level
+	1, // 1: goproto.protoc.enums.switchstring.Reading.month:type_name -> goproto.protoc.enums.switchstring.Month
+	2, // 2: goproto.protoc.enums.switchstring.Reading.unit:type_name -> goproto.protoc.enums.switchstring.Reading.Unit
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_init() }
+func file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto = out.File
+	file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_enums_switchstring_switchstring_proto_depIdxs = nil
+}
@@ -0,0 +1,526 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/fixtures/fixtures.proto
+
+package fixtures
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Order_Status int32
+
+const (
+	Order_STATUS_UNSPECIFIED Order_Status = 0
+	Order_STATUS_OPEN        Order_Status = 1
+	Order_STATUS_CLOSED      Order_Status = 2
+)
+
+// Enum value maps for Order_Status.
+var (
+	Order_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_OPEN",
+		2: "STATUS_CLOSED",
+	}
+	Order_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_OPEN":        1,
+		"STATUS_CLOSED":      2,
+	}
+)
+
+func (x Order_Status) Enum() *Order_Status {
+	p := new(Order_Status)
+	*p = x
+	return p
+}
+
+func (x Order_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_enumTypes[0].Descriptor()
+}
+
+func (Order_Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_enumTypes[0]
+}
+
+func (x Order_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Order_Status) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Order_Status(num)
+	return nil
+}
+
+// Deprecated: Use Order_Status.Descriptor instead.
+func (Order_Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Order struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Number     *int64                 `protobuf:"varint,2,opt,name=number" json:"number,omitempty" form:"number" uri:"number"`
+	Status     *Order_Status          `protobuf:"varint,3,opt,name=status,enum=goproto.protoc.fixtures.Order_Status" json:"status,omitempty" form:"status" uri:"status"`
+	Paid       *bool                  `protobuf:"varint,4,opt,name=paid" json:"paid,omitempty" form:"paid" uri:"paid"`
+	Total      *float64               `protobuf:"fixed64,5,opt,name=total" json:"total,omitempty" form:"total" uri:"total"`
+	Weight     *float32               `protobuf:"fixed32,6,opt,name=weight" json:"weight,omitempty" form:"weight" uri:"weight"`
+	Token      []byte                 `protobuf:"bytes,7,opt,name=token" json:"token,omitempty" form:"token" uri:"token"`
+	Lines      []*Order_Line          `protobuf:"bytes,8,rep,name=lines" json:"lines,omitempty" form:"lines" uri:"lines"`
+	LinesBySku map[string]*Order_Line `protobuf:"bytes,9,rep,name=lines_by_sku,json=linesBySku" json:"lines_by_sku,omitempty" form:"lines_by_sku" uri:"lines_by_sku" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Notes      map[int32]string       `protobuf:"bytes,10,rep,name=notes" json:"notes,omitempty" form:"notes" uri:"notes" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	History    []Order_Status         `protobuf:"varint,11,rep,packed,name=history,enum=goproto.protoc.fixtures.Order_Status" json:"history,omitempty" form:"history" uri:"history"`
+	Parent     *Order                 `protobuf:"bytes,12,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created" json:"created,omitempty" form:"created" uri:"created"`
+	// Types that are valid to be assigned to Payment:
+	//
+	//	*Order_Card
+	//	*Order_Voucher
+	Payment       isOrder_Payment `protobuf_oneof:"payment"`
+	Shipping      *Order_Shipping `protobuf:"group,16,opt,name=Shipping,json=shipping" json:"shipping,omitempty" form:"shipping" uri:"shipping"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetNumber() int64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() Order_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return Order_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetPaid() bool {
+	if x != nil && x.Paid != nil {
+		return *x.Paid
+	}
+	return false
+}
+
+func (x *Order) GetTotal() float64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *Order) GetWeight() float32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *Order) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Order) GetLines() []*Order_Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Order) GetLinesBySku() map[string]*Order_Line {
+	if x != nil {
+		return x.LinesBySku
+	}
+	return nil
+}
+
+func (x *Order) GetNotes() map[int32]string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Order) GetHistory() []Order_Status {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Order) GetParent() *Order {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Order) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Order) GetPayment() isOrder_Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Order) GetCard() string {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_Card); ok {
+			return x.Card
+		}
+	}
+	return ""
+}
+
+func (x *Order) GetVoucher() *Order_Line {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_Voucher); ok {
+			return x.Voucher
+		}
+	}
+	return nil
+}
+
+func (x *Order) GetShipping() *Order_Shipping {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+type isOrder_Payment interface {
+	isOrder_Payment()
+}
+
+type Order_Card struct {
+	Card string `protobuf:"bytes,14,opt,name=card,oneof"`
+}
+
+type Order_Voucher struct {
+	Voucher *Order_Line `protobuf:"bytes,15,opt,name=voucher,oneof"`
+}
+
+func (*Order_Card) isOrder_Payment() {}
+
+func (*Order_Voucher) isOrder_Payment() {}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP(), []int{1}
+}
+
+type Order_Line struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *string                `protobuf:"bytes,1,opt,name=sku" json:"sku,omitempty" form:"sku" uri:"sku"`
+	Quantity      *uint32                `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty" form:"quantity" uri:"quantity"`
+	Cents         *int64                 `protobuf:"fixed64,3,opt,name=cents" json:"cents,omitempty" form:"cents" uri:"cents"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Line) Reset() {
+	*x = Order_Line{}
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Line) ProtoMessage() {}
+
+func (x *Order_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Line.ProtoReflect.Descriptor instead.
+func (*Order_Line) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Order_Line) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *Order_Line) GetQuantity() uint32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+func (x *Order_Line) GetCents() int64 {
+	if x != nil && x.Cents != nil {
+		return *x.Cents
+	}
+	return 0
+}
+
+type Order_Shipping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *string                `protobuf:"bytes,17,opt,name=address" json:"address,omitempty" form:"address" uri:"address"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Shipping) Reset() {
+	*x = Order_Shipping{}
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Shipping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Shipping) ProtoMessage() {}
+
+func (x *Order_Shipping) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Shipping.ProtoReflect.Descriptor instead.
+func (*Order_Shipping) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Order_Shipping) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/fixtures/fixtures.proto\x12\x17goproto.protoc.fixtures\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\b\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x03R\x06number\x12=\n" +
+	"\x06status\x18\x03 \x01(\x0e2%.goproto.protoc.fixtures.Order.StatusR\x06status\x12\x12\n" +
+	"\x04paid\x18\x04 \x01(\bR\x04paid\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x02R\x06weight\x12\x14\n" +
+	"\x05token\x18\a \x01(\fR\x05token\x129\n" +
+	"\x05lines\x18\b \x03(\v2#.goproto.protoc.fixtures.Order.LineR\x05lines\x12P\n" +
+	"\flines_by_sku\x18\t \x03(\v2..goproto.protoc.fixtures.Order.LinesBySkuEntryR\n" +
+	"linesBySku\x12?\n" +
+	"\x05notes\x18\n" +
+	" \x03(\v2).goproto.protoc.fixtures.Order.NotesEntryR\x05notes\x12C\n" +
+	"\ahistory\x18\v \x03(\x0e2%.goproto.protoc.fixtures.Order.StatusB\x02\x10\x01R\ahistory\x126\n" +
+	"\x06parent\x18\f \x01(\v2\x1e.goproto.protoc.fixtures.OrderR\x06parent\x124\n" +
+	"\acreated\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x14\n" +
+	"\x04card\x18\x0e \x01(\tH\x00R\x04card\x12?\n" +
+	"\avoucher\x18\x0f \x01(\v2#.goproto.protoc.fixtures.Order.LineH\x00R\avoucher\x12C\n" +
+	"\bshipping\x18\x10 \x01(\n" +
+	"2'.goproto.protoc.fixtures.Order.ShippingR\bshipping\x1aJ\n" +
+	"\x04Line\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\x12\x14\n" +
+	"\x05cents\x18\x03 \x01(\x10R\x05cents\x1ab\n" +
+	"\x0fLinesBySkuEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.goproto.protoc.fixtures.Order.LineR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"NotesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a$\n" +
+	"\bShipping\x12\x18\n" +
+	"\aaddress\x18\x11 \x01(\tR\aaddress\"D\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSTATUS_OPEN\x10\x01\x12\x11\n" +
+	"\rSTATUS_CLOSED\x10\x02B\t\n" +
+	"\apayment\"\a\n" +
+	"\x05EmptyB@Z>google.golang.org/protobuf/cmd/protoc-gen-go/testdata/fixtures"
+
+var (
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_goTypes = []any{
+	(Order_Status)(0),             // 0: goproto.protoc.fixtures.Order.Status
+	(*Order)(nil),                 // 1: goproto.protoc.fixtures.Order
+	(*Empty)(nil),                 // 2: goproto.protoc.fixtures.Empty
+	(*Order_Line)(nil),            // 3: goproto.protoc.fixtures.Order.Line
+	nil,                           // 4: goproto.protoc.fixtures.Order.LinesBySkuEntry
+	nil,                           // 5: goproto.protoc.fixtures.Order.NotesEntry
+	(*Order_Shipping)(nil),        // 6: goproto.protoc.fixtures.Order.Shipping
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_depIdxs = []int32{
+	0,  // 0: goproto.protoc.fixtures.Order.status:type_name -> goproto.protoc.fixtures.Order.Status
+	3,  // 1: goproto.protoc.fixtures.Order.lines:type_name -> goproto.protoc.fixtures.Order.Line
+	4,  // 2: goproto.protoc.fixtures.Order.lines_by_sku:type_name -> goproto.protoc.fixtures.Order.LinesBySkuEntry
+	5,  // 3: goproto.protoc.fixtures.Order.notes:type_name -> goproto.protoc.fixtures.Order.NotesEntry
+	0,  // 4: goproto.protoc.fixtures.Order.history:type_name -> goproto.protoc.fixtures.Order.Status
+	1,  // 5: goproto.protoc.fixtures.Order.parent:type_name -> goproto.protoc.fixtures.Order
+	7,  // 6: goproto.protoc.fixtures.Order.created:type_name -> google.protobuf.Timestamp
+	3,  // 7: goproto.protoc.fixtures.Order.voucher:type_name -> goproto.protoc.fixtures.Order.Line
+	6,  // 8: goproto.protoc.fixtures.Order.shipping:type_name -> goproto.protoc.fixtures.Order.Shipping
+	3,  // 9: goproto.protoc.fixtures.Order.LinesBySkuEntry.value:type_name -> goproto.protoc.fixtures.Order.Line
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_init() }
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes[0].OneofWrappers = []any{
+		(*Order_Card)(nil),
+		(*Order_Voucher)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto = out.File
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_depIdxs = nil
+}
@@ -0,0 +1,73 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/fixtures/fixtures.proto
+
+package fixtures
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Order_Fixture returns a new Order with every field set to a sample
+// value, for use in tests. Only the first member of each oneof is set, and
+// message fields are populated up to 3 levels of nesting.
+func Order_Fixture() *Order {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_fixture(3)
+}
+
+// Empty_Fixture returns a new Empty with every field set to a sample
+// value, for use in tests. Only the first member of each oneof is set, and
+// message fields are populated up to 3 levels of nesting.
+func Empty_Fixture() *Empty {
+	return file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Empty_fixture(3)
+}
+
+// file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_fixture returns a new
+// Order populated with sample values, with depth levels of nested messages.
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_fixture(depth int) *Order {
+	x := &Order{}
+	x.Id = proto.String("id")
+	x.Number = proto.Int64(2)
+	x.Status = Order_STATUS_OPEN.Enum()
+	x.Paid = proto.Bool(true)
+	x.Total = proto.Float64(5)
+	x.Weight = proto.Float32(6)
+	x.Token = []byte("token")
+	x.Notes = map[int32]string{1: "value"}
+	x.History = []Order_Status{Order_STATUS_OPEN}
+	x.Payment = &Order_Card{Card: "card"}
+	if depth <= 0 {
+		return x
+	}
+	x.Lines = []*Order_Line{file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Line_fixture(depth - 1)}
+	x.LinesBySku = map[string]*Order_Line{"key": file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Line_fixture(depth - 1)}
+	x.Parent = file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_fixture(depth - 1)
+	x.Created = &timestamppb.Timestamp{}
+	x.Shipping = file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Shipping_fixture(depth - 1)
+	return x
+}
+
+// file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Empty_fixture returns a new
+// Empty populated with sample values, with depth levels of nested messages.
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Empty_fixture(depth int) *Empty {
+	x := &Empty{}
+	return x
+}
+
+// file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Line_fixture returns a new
+// Order_Line populated with sample values, with depth levels of nested messages.
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Line_fixture(depth int) *Order_Line {
+	x := &Order_Line{}
+	x.Sku = proto.String("sku")
+	x.Quantity = proto.Uint32(2)
+	x.Cents = proto.Int64(3)
+	return x
+}
+
+// file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Shipping_fixture returns a new
+// Order_Shipping populated with sample values, with depth levels of nested messages.
+func file_cmd_protoc_gen_go_testdata_fixtures_fixtures_proto_Order_Shipping_fixture(depth int) *Order_Shipping {
+	x := &Order_Shipping{}
+	x.Address = proto.String("address")
+	return x
+}
@@ -0,0 +1,27 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/fixtures/hybrid.proto
+
+package fixtures
+
+// Job_Fixture returns a new Job with every field set to a sample
+// value, for use in tests. Only the first member of each oneof is set, and
+// message fields are populated up to 3 levels of nesting.
+func Job_Fixture() *Job {
+	return file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_Job_fixture(3)
+}
+
+// file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_Job_fixture returns a new
+// Job populated with sample values, with depth levels of nested messages.
+func file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_Job_fixture(depth int) *Job {
+	x := &Job{}
+	x.SetId("id")
+	x.SetRetries(2)
+	x.SetPriority(Job_PRIORITY_HIGH)
+	x.SetTags([]string{"tags"})
+	x.SetCron("cron")
+	if depth <= 0 {
+		return x
+	}
+	x.SetChildren(map[string]*Job{"key": file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_Job_fixture(depth - 1)})
+	return x
+}
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/fixtures/hybrid.proto
+
+//go:build protoopaque
+
+package fixtures
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Job_Priority int32
+
+const (
+	Job_PRIORITY_UNSPECIFIED Job_Priority = 0
+	Job_PRIORITY_HIGH        Job_Priority = 1
+)
+
+// Enum value maps for Job_Priority.
+var (
+	Job_Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_HIGH",
+	}
+	Job_Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_HIGH":        1,
+	}
+)
+
+func (x Job_Priority) Enum() *Job_Priority {
+	p := new(Job_Priority)
+	*p = x
+	return p
+}
+
+func (x Job_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_enumTypes[0].Descriptor()
+}
+
+func (Job_Priority) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_enumTypes[0]
+}
+
+func (x Job_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type Job struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Retries     int32                  `protobuf:"varint,2,opt,name=retries"`
+	xxx_hidden_Priority    Job_Priority           `protobuf:"varint,3,opt,name=priority,enum=goproto.protoc.fixtures.Job_Priority"`
+	xxx_hidden_Tags        []string               `protobuf:"bytes,4,rep,name=tags"`
+	xxx_hidden_Children    map[string]*Job        `protobuf:"bytes,5,rep,name=children" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Schedule    isJob_Schedule         `protobuf_oneof:"schedule"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Job) GetRetries() int32 {
+	if x != nil {
+		return x.xxx_hidden_Retries
+	}
+	return 0
+}
+
+func (x *Job) GetPriority() Job_Priority {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Priority
+		}
+	}
+	return Job_PRIORITY_UNSPECIFIED
+}
+
+func (x *Job) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *Job) GetChildren() map[string]*Job {
+	if x != nil {
+		return x.xxx_hidden_Children
+	}
+	return nil
+}
+
+func (x *Job) GetCron() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Schedule.(*job_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+func (x *Job) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *Job) SetRetries(v int32) {
+	x.xxx_hidden_Retries = v
+}
+
+func (x *Job) SetPriority(v Job_Priority) {
+	x.xxx_hidden_Priority = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *Job) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *Job) SetChildren(v map[string]*Job) {
+	x.xxx_hidden_Children = v
+}
+
+func (x *Job) SetCron(v string) {
+	x.xxx_hidden_Schedule = &job_Cron{v}
+}
+
+func (x *Job) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Job) HasPriority() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Job) HasSchedule() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Schedule != nil
+}
+
+func (x *Job) HasCron() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Schedule.(*job_Cron)
+	return ok
+}
+
+func (x *Job) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Job) ClearPriority() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Priority = Job_PRIORITY_UNSPECIFIED
+}
+
+func (x *Job) ClearSchedule() {
+	x.xxx_hidden_Schedule = nil
+}
+
+func (x *Job) ClearCron() {
+	if _, ok := x.xxx_hidden_Schedule.(*job_Cron); ok {
+		x.xxx_hidden_Schedule = nil
+	}
+}
+
+const Job_Schedule_not_set_case case_Job_Schedule = 0
+const Job_Cron_case case_Job_Schedule = 6
+
+func (x *Job) WhichSchedule() case_Job_Schedule {
+	if x == nil {
+		return Job_Schedule_not_set_case
+	}
+	switch x.xxx_hidden_Schedule.(type) {
+	case *job_Cron:
+		return Job_Cron_case
+	default:
+		return Job_Schedule_not_set_case
+	}
+}
+
+type Job_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       *string
+	Retries  int32
+	Priority *Job_Priority
+	Tags     []string
+	Children map[string]*Job
+	// Fields of oneof xxx_hidden_Schedule:
+	Cron *string
+	// -- end of xxx_hidden_Schedule
+}
+
+func (b0 Job_builder) Build() *Job {
+	m0 := &Job{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Retries = b.Retries
+	if b.Priority != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Priority = *b.Priority
+	}
+	x.xxx_hidden_Tags = b.Tags
+	x.xxx_hidden_Children = b.Children
+	if b.Cron != nil {
+		x.xxx_hidden_Schedule = &job_Cron{*b.Cron}
+	}
+	return m0
+}
+
+type case_Job_Schedule protoreflect.FieldNumber
+
+func (x case_Job_Schedule) String() string {
+	md := file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isJob_Schedule interface {
+	isJob_Schedule()
+}
+
+type job_Cron struct {
+	Cron string `protobuf:"bytes,6,opt,name=cron,oneof"`
+}
+
+func (*job_Cron) isJob_Schedule() {}
+
+var File_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"0cmd/protoc-gen-go/testdata/fixtures/hybrid.proto\x12\x17goproto.protoc.fixtures\x1a!google/protobuf/go_features.proto\"\x8b\x03\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\aretries\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\aretries\x12A\n" +
+	"\bpriority\x18\x03 \x01(\x0e2%.goproto.protoc.fixtures.Job.PriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12F\n" +
+	"\bchildren\x18\x05 \x03(\v2*.goproto.protoc.fixtures.Job.ChildrenEntryR\bchildren\x12\x14\n" +
+	"\x04cron\x18\x06 \x01(\tH\x00R\x04cron\x1aY\n" +
+	"\rChildrenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.goproto.protoc.fixtures.JobR\x05value:\x028\x01\"7\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x01B\n" +
+	"\n" +
+	"\bscheduleBHZ>google.golang.org/protobuf/cmd/protoc-gen-go/testdata/fixtures\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_goTypes = []any{
+	(Job_Priority)(0), // 0: goproto.protoc.fixtures.Job.Priority
+	(*Job)(nil),       // 1: goproto.protoc.fixtures.Job
+	nil,               // 2: goproto.protoc.fixtures.Job.ChildrenEntry
+}
+var file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.fixtures.Job.priority:type_name -> goproto.protoc.fixtures.Job.Priority
+	2, // 1: goproto.protoc.fixtures.Job.children:type_name -> goproto.protoc.fixtures.Job.ChildrenEntry
+	1, // 2: goproto.protoc.fixtures.Job.ChildrenEntry.value:type_name -> goproto.protoc.fixtures.Job
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*job_Cron)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_fixtures_hybrid_proto_depIdxs = nil
+}
@@ -8,13 +8,38 @@ package main
 
 import (
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/annotations"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/assertions/oneof"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/comments"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/commonfield"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/commononeof"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/computed"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/paths"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constants/syntax"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/constructors/oneof"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/strict"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/v1"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/convert/v2"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/dtoout/shared"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enumprefix"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/descriptions"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/formernames"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/label"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/enums/switchstring"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/extensions/base"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/extensions/ext"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/extensions/extra"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/extensions/proto3"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/fieldnames"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/fixtures"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/getters/copymap"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/at"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/eachmsg"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/filter"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/int64string"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/iter"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/joined"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/mergeunique"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/import_public"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/import_public/sub"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/import_public/sub2"
@@ -23,11 +48,90 @@ import (
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/imports/test_a_1"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/imports/test_a_2"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/imports/test_b_1"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/indexkey"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/issue780_oneof_conflict"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/methods"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/numeric"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/rules"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/strict"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/layout/pack"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/maps/jsonnames"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/applydefaults"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/batch"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/byjsonname"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/bytelen"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cachekey/raw"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cachekey/sha256"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearkind"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearpaths"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/coalesce"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cyclecheck"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/defaultjson"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/depth"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/descindex"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/detectunknown"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/diffcount"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/eachext"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/enumdefault"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/envoverride"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/equalignore"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/extnums"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fastclone"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fdlookup"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldbytes"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldsizes"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fingerprint"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/framewriter"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freeze"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freezemaps"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv/unsupported"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/iszerofast"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/jsonpatch"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/kindlookup"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/lenientunmarshal"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/limit"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/logstring"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maptext"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalexcept"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpath"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpresent"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maxsize"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/mergereport"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/metriclabels"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/msgcount"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/oneoftagged"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/patchmerge"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/project"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/requiredcheck"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/setbynum"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/sizetable"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/snapshot"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/streamrepeated"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/stripunknown"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/templatemap"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/text"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/tomap"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/typedeps"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unknownpreserve"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unmarshallimit"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues/unknown"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/utf8check"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wirehex"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wireorder"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/nameclash"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/nopackage"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/normalize/presence"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/omitstring"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/oneofs/value"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/pooling/sync"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/proto2"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/proto3"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/protoeditions"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/reflection/exportmsgtypes"
 	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/retention"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/tracking/callback"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/tracking/touched"
 )
@@ -0,0 +1,173 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/getters/copymap/copymap.proto
+
+package copymap
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	maps "maps"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Registry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Counts   map[string]int32       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Children map[int64]*Registry    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Names    []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" form:"names" uri:"names"`
+	// Deprecated: Marked as deprecated in cmd/protoc-gen-go/testdata/getters/copymap/copymap.proto.
+	OldLabels     map[string]string `protobuf:"bytes,4,rep,name=old_labels,json=oldLabels,proto3" json:"old_labels,omitempty" form:"old_labels" uri:"old_labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry) Reset() {
+	*x = Registry{}
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry) ProtoMessage() {}
+
+func (x *Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
+func (*Registry) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescGZIP(), []int{0}
+}
+
+// GetCounts returns a shallow copy of the counts map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+func (x *Registry) GetCounts() map[string]int32 {
+	if x != nil {
+		return maps.Clone(x.Counts)
+	}
+	return nil
+}
+
+// GetChildren returns a shallow copy of the children map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+func (x *Registry) GetChildren() map[int64]*Registry {
+	if x != nil {
+		return maps.Clone(x.Children)
+	}
+	return nil
+}
+
+func (x *Registry) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// GetOldLabels returns a shallow copy of the old_labels map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+//
+// Deprecated: Marked as deprecated in cmd/protoc-gen-go/testdata/getters/copymap/copymap.proto.
+func (x *Registry) GetOldLabels() map[string]string {
+	if x != nil {
+		return maps.Clone(x.OldLabels)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/getters/copymap/copymap.proto\x12\x1egoproto.protoc.getters.copymap\"\xfe\x03\n" +
+	"\bRegistry\x12L\n" +
+	"\x06counts\x18\x01 \x03(\v24.goproto.protoc.getters.copymap.Registry.CountsEntryR\x06counts\x12R\n" +
+	"\bchildren\x18\x02 \x03(\v26.goproto.protoc.getters.copymap.Registry.ChildrenEntryR\bchildren\x12\x14\n" +
+	"\x05names\x18\x03 \x03(\tR\x05names\x12Z\n" +
+	"\n" +
+	"old_labels\x18\x04 \x03(\v27.goproto.protoc.getters.copymap.Registry.OldLabelsEntryB\x02\x18\x01R\toldLabels\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1ae\n" +
+	"\rChildrenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.goproto.protoc.getters.copymap.RegistryR\x05value:\x028\x01\x1a<\n" +
+	"\x0eOldLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/getters/copymapb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_goTypes = []any{
+	(*Registry)(nil), // 0: goproto.protoc.getters.copymap.Registry
+	nil,              // 1: goproto.protoc.getters.copymap.Registry.CountsEntry
+	nil,              // 2: goproto.protoc.getters.copymap.Registry.ChildrenEntry
+	nil,              // 3: goproto.protoc.getters.copymap.Registry.OldLabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.getters.copymap.Registry.counts:type_name -> goproto.protoc.getters.copymap.Registry.CountsEntry
+	2, // 1: goproto.protoc.getters.copymap.Registry.children:type_name -> goproto.protoc.getters.copymap.Registry.ChildrenEntry
+	3, // 2: goproto.protoc.getters.copymap.Registry.old_labels:type_name -> goproto.protoc.getters.copymap.Registry.OldLabelsEntry
+	0, // 3: goproto.protoc.getters.copymap.Registry.ChildrenEntry.value:type_name -> goproto.protoc.getters.copymap.Registry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_init() }
+func file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto = out.File
+	file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_getters_copymap_copymap_proto_depIdxs = nil
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/getters/copymap/hybrid.proto
+
+//go:build !protoopaque
+
+package copymap
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	maps "maps"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Index struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Offsets       map[string]int64       `protobuf:"bytes,1,rep,name=offsets" json:"offsets,omitempty" form:"offsets" uri:"offsets" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// GetOffsets returns a shallow copy of the offsets map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+func (x *Index) GetOffsets() map[string]int64 {
+	if x != nil {
+		return maps.Clone(x.Offsets)
+	}
+	return nil
+}
+
+func (x *Index) SetOffsets(v map[string]int64) {
+	x.Offsets = v
+}
+
+type Index_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Offsets map[string]int64
+}
+
+func (b0 Index_builder) Build() *Index {
+	m0 := &Index{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Offsets = b.Offsets
+	return m0
+}
+
+var File_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/getters/copymap/hybrid.proto\x12\x1egoproto.protoc.getters.copymap\x1a!google/protobuf/go_features.proto\"\x91\x01\n" +
+	"\x05Index\x12L\n" +
+	"\aoffsets\x18\x01 \x03(\v22.goproto.protoc.getters.copymap.Index.OffsetsEntryR\aoffsets\x1a:\n" +
+	"\fOffsetsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01BOZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/getters/copymap\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_goTypes = []any{
+	(*Index)(nil), // 0: goproto.protoc.getters.copymap.Index
+	nil,           // 1: goproto.protoc.getters.copymap.Index.OffsetsEntry
+}
+var file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.getters.copymap.Index.offsets:type_name -> goproto.protoc.getters.copymap.Index.OffsetsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_getters_copymap_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/getters/copymap/mutators.proto
+
+//go:build !protoopaque
+
+package copymap
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	maps "maps"
+	reflect "reflect"
+	sort "sort"
+	unsafe "unsafe"
+)
+
+// Catalog is generated with methods modifying map fields in place, which
+// store them back through the setters since the getters return copies.
+type Catalog struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Labels        map[string]string      `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Counts        map[int32]int64        `protobuf:"bytes,2,rep,name=counts" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Catalog) Reset() {
+	*x = Catalog{}
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog) ProtoMessage() {}
+
+func (x *Catalog) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// GetLabels returns a shallow copy of the labels map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+func (x *Catalog) GetLabels() map[string]string {
+	if x != nil {
+		return maps.Clone(x.Labels)
+	}
+	return nil
+}
+
+// GetCounts returns a shallow copy of the counts map field, so that
+// modifying the returned map does not modify x. The values of the copy are
+// those of the field, so messages held by the map are shared with x.
+func (x *Catalog) GetCounts() map[int32]int64 {
+	if x != nil {
+		return maps.Clone(x.Counts)
+	}
+	return nil
+}
+
+func (x *Catalog) SetLabels(v map[string]string) {
+	x.Labels = v
+}
+
+func (x *Catalog) SetCounts(v map[int32]int64) {
+	x.Counts = v
+}
+
+type Catalog_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Labels map[string]string
+	Counts map[int32]int64
+}
+
+func (b0 Catalog_builder) Build() *Catalog {
+	m0 := &Catalog{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Labels = b.Labels
+	x.Counts = b.Counts
+	return m0
+}
+
+// LimitCollections truncates every repeated field of x and trims every map
+// field of x to at most max entries, recursing into message values.
+// Map entries are retained in ascending key order.
+func (x *Catalog) LimitCollections(max int) {
+	if x == nil {
+		return
+	}
+	if max < 0 {
+		max = 0
+	}
+	if mv := x.GetLabels(); len(mv) > max {
+		keys := make([]string, 0, len(mv))
+		for k := range mv {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys[max:] {
+			delete(mv, k)
+		}
+		x.SetLabels(mv)
+	}
+	if mv := x.GetCounts(); len(mv) > max {
+		keys := make([]int32, 0, len(mv))
+		for k := range mv {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys[max:] {
+			delete(mv, k)
+		}
+		x.SetCounts(mv)
+	}
+}
+
+// PatchFrom merges the fields of src which are populated into x, leaving the
+// other fields of x untouched. Unlike proto.Merge, a field of src which
+// is set to its zero value without explicit presence is not copied.
+//
+// Singular message fields are patched recursively, repeated fields are
+// replaced by a copy of the field of src, and the entries of map fields are
+// copied into the map of x. The values copied from src are deep copies.
+func (x *Catalog) PatchFrom(src *Catalog) {
+	if src == nil || x == src {
+		return
+	}
+	if len(src.GetLabels()) > 0 {
+		mv := x.GetLabels()
+		if mv == nil {
+			mv = make(map[string]string, len(src.GetLabels()))
+		}
+		for k, e := range src.GetLabels() {
+			mv[k] = e
+		}
+		x.SetLabels(mv)
+	}
+	if len(src.GetCounts()) > 0 {
+		mv := x.GetCounts()
+		if mv == nil {
+			mv = make(map[int32]int64, len(src.GetCounts()))
+		}
+		for k, e := range src.GetCounts() {
+			mv[k] = e
+		}
+		x.SetCounts(mv)
+	}
+}
+
+// MergeReport merges src into x as by proto.Merge, except that a singular
+// field populated in both x and src with different values keeps the value
+// of x, and its name is reported in conflicts, in the order in which the
+// fields are declared. Setting a different member of a oneof than the one
+// set in x is also a conflict.
+//
+// Singular message fields are merged recursively, and reported if merging
+// them reports a conflict; messages declared in other files are compared
+// as a whole instead. Repeated fields are appended to, and the entries of
+// map fields are copied into x. Unknown fields and extensions are not merged.
+func (x *Catalog) MergeReport(src *Catalog) (conflicts []protoreflect.Name, err error) {
+	if src == nil || x == src {
+		return nil, nil
+	}
+	if x == nil {
+		return nil, fmt.Errorf("cannot merge into nil *Catalog")
+	}
+	if len(src.GetLabels()) > 0 {
+		mv := x.GetLabels()
+		if mv == nil {
+			mv = make(map[string]string, len(src.GetLabels()))
+		}
+		for k, e := range src.GetLabels() {
+			mv[k] = e
+		}
+		x.SetLabels(mv)
+	}
+	if len(src.GetCounts()) > 0 {
+		mv := x.GetCounts()
+		if mv == nil {
+			mv = make(map[int32]int64, len(src.GetCounts()))
+		}
+		for k, e := range src.GetCounts() {
+			mv[k] = e
+		}
+		x.SetCounts(mv)
+	}
+	return conflicts, nil
+}
+
+// MapStrings replaces the value of every populated string field of x and of
+// its nested messages with the result of calling f with the name of the field
+// and its value. Each element of a repeated string field and each string
+// value of a map field is replaced likewise. Map keys are left unchanged.
+func (x *Catalog) MapStrings(f func(fieldName protoreflect.Name, s string) string) {
+	if x == nil {
+		return
+	}
+	if mv := x.GetLabels(); len(mv) > 0 {
+		for k, s := range mv {
+			mv[k] = f("labels", s)
+		}
+		x.SetLabels(mv)
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/getters/copymap/mutators.proto\x12\x1egoproto.protoc.getters.copymap\x1a!google/protobuf/go_features.proto\"\x99\x02\n" +
+	"\aCatalog\x12K\n" +
+	"\x06labels\x18\x01 \x03(\v23.goproto.protoc.getters.copymap.Catalog.LabelsEntryR\x06labels\x12K\n" +
+	"\x06counts\x18\x02 \x03(\v23.goproto.protoc.getters.copymap.Catalog.CountsEntryR\x06counts\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01BOZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/getters/copymap\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_goTypes = []any{
+	(*Catalog)(nil), // 0: goproto.protoc.getters.copymap.Catalog
+	nil,             // 1: goproto.protoc.getters.copymap.Catalog.LabelsEntry
+	nil,             // 2: goproto.protoc.getters.copymap.Catalog.CountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.getters.copymap.Catalog.labels:type_name -> goproto.protoc.getters.copymap.Catalog.LabelsEntry
+	2, // 1: goproto.protoc.getters.copymap.Catalog.counts:type_name -> goproto.protoc.getters.copymap.Catalog.CountsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_init() }
+func file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto = out.File
+	file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_getters_copymap_mutators_proto_depIdxs = nil
+}
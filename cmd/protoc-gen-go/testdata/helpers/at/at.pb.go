@@ -0,0 +1,194 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/at/at.proto
+
+package at
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []int64                `protobuf:"varint,1,rep,packed,name=points,proto3" json:"points,omitempty" form:"points" uri:"points"`
+	Labels        []string               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels"`
+	Blobs         [][]byte               `protobuf:"bytes,3,rep,name=blobs,proto3" json:"blobs,omitempty" form:"blobs" uri:"blobs"`
+	Children      []*Series              `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children"`
+	Counts        map[string]int32       `protobuf:"bytes,5,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Series) GetPoints() []int64 {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *Series) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Series) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *Series) GetChildren() []*Series {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Series) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// PointsAt returns the element of the points field at index i
+// and true, or the zero value and false if i is out of range.
+func (x *Series) PointsAt(i int) (v int64, ok bool) {
+	if l := x.GetPoints(); i >= 0 && i < len(l) {
+		return l[i], true
+	}
+	return v, false
+}
+
+// LabelsAt returns the element of the labels field at index i
+// and true, or the zero value and false if i is out of range.
+func (x *Series) LabelsAt(i int) (v string, ok bool) {
+	if l := x.GetLabels(); i >= 0 && i < len(l) {
+		return l[i], true
+	}
+	return v, false
+}
+
+// BlobsAt returns the element of the blobs field at index i
+// and true, or the zero value and false if i is out of range.
+func (x *Series) BlobsAt(i int) (v []byte, ok bool) {
+	if l := x.GetBlobs(); i >= 0 && i < len(l) {
+		return l[i], true
+	}
+	return v, false
+}
+
+// ChildrenAt returns the element of the children field at index i
+// and true, or the zero value and false if i is out of range.
+func (x *Series) ChildrenAt(i int) (v *Series, ok bool) {
+	if l := x.GetChildren(); i >= 0 && i < len(l) {
+		return l[i], true
+	}
+	return v, false
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_at_at_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDesc = "" +
+	"\n" +
+	".cmd/protoc-gen-go/testdata/helpers/at/at.proto\x12\x19goproto.protoc.helpers.at\"\x8f\x02\n" +
+	"\x06Series\x12\x16\n" +
+	"\x06points\x18\x01 \x03(\x03R\x06points\x12\x16\n" +
+	"\x06labels\x18\x02 \x03(\tR\x06labels\x12\x14\n" +
+	"\x05blobs\x18\x03 \x03(\fR\x05blobs\x12=\n" +
+	"\bchildren\x18\x04 \x03(\v2!.goproto.protoc.helpers.at.SeriesR\bchildren\x12E\n" +
+	"\x06counts\x18\x05 \x03(\v2-.goproto.protoc.helpers.at.Series.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01BBZ@google.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/atb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_goTypes = []any{
+	(*Series)(nil), // 0: goproto.protoc.helpers.at.Series
+	nil,            // 1: goproto.protoc.helpers.at.Series.CountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.helpers.at.Series.children:type_name -> goproto.protoc.helpers.at.Series
+	1, // 1: goproto.protoc.helpers.at.Series.counts:type_name -> goproto.protoc.helpers.at.Series.CountsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_at_at_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_at_at_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_at_at_proto_depIdxs = nil
+}
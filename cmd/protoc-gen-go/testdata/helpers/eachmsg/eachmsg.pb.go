@@ -0,0 +1,224 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/eachmsg/eachmsg.proto
+
+package eachmsg
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Registry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       map[string]*Entry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" form:"entries" uri:"entries" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ById          map[int32]*Entry       `protobuf:"bytes,2,rep,name=by_id,json=byId,proto3" json:"by_id,omitempty" form:"by_id" uri:"by_id" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // not message-valued, so no EachLabels
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry) Reset() {
+	*x = Registry{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry) ProtoMessage() {}
+
+func (x *Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
+func (*Registry) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Registry) GetEntries() map[string]*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Registry) GetById() map[int32]*Entry {
+	if x != nil {
+		return x.ById
+	}
+	return nil
+}
+
+func (x *Registry) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// EachEntries calls f for each entry of the entries field with a non-nil
+// value, in unspecified order, until f returns false.
+func (x *Registry) EachEntries(f func(k string, v *Entry) bool) {
+	for k, v := range x.GetEntries() {
+		if v == nil {
+			continue
+		}
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// EachById calls f for each entry of the by_id field with a non-nil
+// value, in unspecified order, until f returns false.
+func (x *Registry) EachById(f func(k int32, v *Entry) bool) {
+	for k, v := range x.GetById() {
+		if v == nil {
+			continue
+		}
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+type Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Entry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/helpers/eachmsg/eachmsg.proto\x12\x1egoproto.protoc.helpers.eachmsg\"\xf0\x03\n" +
+	"\bRegistry\x12O\n" +
+	"\aentries\x18\x01 \x03(\v25.goproto.protoc.helpers.eachmsg.Registry.EntriesEntryR\aentries\x12G\n" +
+	"\x05by_id\x18\x02 \x03(\v22.goproto.protoc.helpers.eachmsg.Registry.ByIdEntryR\x04byId\x12L\n" +
+	"\x06labels\x18\x03 \x03(\v24.goproto.protoc.helpers.eachmsg.Registry.LabelsEntryR\x06labels\x1aa\n" +
+	"\fEntriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12;\n" +
+	"\x05value\x18\x02 \x01(\v2%.goproto.protoc.helpers.eachmsg.EntryR\x05value:\x028\x01\x1a^\n" +
+	"\tByIdEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12;\n" +
+	"\x05value\x18\x02 \x01(\v2%.goproto.protoc.helpers.eachmsg.EntryR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1b\n" +
+	"\x05Entry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04nameBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/eachmsgb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_goTypes = []any{
+	(*Registry)(nil), // 0: goproto.protoc.helpers.eachmsg.Registry
+	(*Entry)(nil),    // 1: goproto.protoc.helpers.eachmsg.Entry
+	nil,              // 2: goproto.protoc.helpers.eachmsg.Registry.EntriesEntry
+	nil,              // 3: goproto.protoc.helpers.eachmsg.Registry.ByIdEntry
+	nil,              // 4: goproto.protoc.helpers.eachmsg.Registry.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.helpers.eachmsg.Registry.entries:type_name -> goproto.protoc.helpers.eachmsg.Registry.EntriesEntry
+	3, // 1: goproto.protoc.helpers.eachmsg.Registry.by_id:type_name -> goproto.protoc.helpers.eachmsg.Registry.ByIdEntry
+	4, // 2: goproto.protoc.helpers.eachmsg.Registry.labels:type_name -> goproto.protoc.helpers.eachmsg.Registry.LabelsEntry
+	1, // 3: goproto.protoc.helpers.eachmsg.Registry.EntriesEntry.value:type_name -> goproto.protoc.helpers.eachmsg.Entry
+	1, // 4: goproto.protoc.helpers.eachmsg.Registry.ByIdEntry.value:type_name -> goproto.protoc.helpers.eachmsg.Entry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_eachmsg_eachmsg_proto_depIdxs = nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/filter/filter.proto
+
+package filter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Dashboard struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Panels        []*Dashboard_Panel          `protobuf:"bytes,1,rep,name=panels,proto3" json:"panels,omitempty" form:"panels" uri:"panels"`
+	Tags          []string                    `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`                                                                               // not a message, so no FilterTags
+	Named         map[string]*Dashboard_Panel `protobuf:"bytes,3,rep,name=named,proto3" json:"named,omitempty" form:"named" uri:"named" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // map, so no FilterNamed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dashboard) Reset() {
+	*x = Dashboard{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard) ProtoMessage() {}
+
+func (x *Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Dashboard) GetPanels() []*Dashboard_Panel {
+	if x != nil {
+		return x.Panels
+	}
+	return nil
+}
+
+func (x *Dashboard) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Dashboard) GetNamed() map[string]*Dashboard_Panel {
+	if x != nil {
+		return x.Named
+	}
+	return nil
+}
+
+// FilterPanels removes from the panels field the elements for which
+// keep returns false, preserving the order of the remaining elements.
+// The elements are moved within the backing array of the field.
+func (x *Dashboard) FilterPanels(keep func(*Dashboard_Panel) bool) {
+	l := x.Panels
+	n := 0
+	for _, v := range l {
+		if keep(v) {
+			l[n] = v
+			n++
+		}
+	}
+	clear(l[n:])
+	x.Panels = l[:n]
+}
+
+type Dashboard_Panel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" form:"title" uri:"title"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty" form:"hidden" uri:"hidden"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dashboard_Panel) Reset() {
+	*x = Dashboard_Panel{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard_Panel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard_Panel) ProtoMessage() {}
+
+func (x *Dashboard_Panel) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard_Panel.ProtoReflect.Descriptor instead.
+func (*Dashboard_Panel) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Dashboard_Panel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Dashboard_Panel) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/helpers/filter/filter.proto\x12\x1dgoproto.protoc.helpers.filter\"\xd3\x02\n" +
+	"\tDashboard\x12F\n" +
+	"\x06panels\x18\x01 \x03(\v2..goproto.protoc.helpers.filter.Dashboard.PanelR\x06panels\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12I\n" +
+	"\x05named\x18\x03 \x03(\v23.goproto.protoc.helpers.filter.Dashboard.NamedEntryR\x05named\x1a5\n" +
+	"\x05Panel\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06hidden\x18\x02 \x01(\bR\x06hidden\x1ah\n" +
+	"\n" +
+	"NamedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..goproto.protoc.helpers.filter.Dashboard.PanelR\x05value:\x028\x01BFZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/filterb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_goTypes = []any{
+	(*Dashboard)(nil),       // 0: goproto.protoc.helpers.filter.Dashboard
+	(*Dashboard_Panel)(nil), // 1: goproto.protoc.helpers.filter.Dashboard.Panel
+	nil,                     // 2: goproto.protoc.helpers.filter.Dashboard.NamedEntry
+}
+var file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.helpers.filter.Dashboard.panels:type_name -> goproto.protoc.helpers.filter.Dashboard.Panel
+	2, // 1: goproto.protoc.helpers.filter.Dashboard.named:type_name -> goproto.protoc.helpers.filter.Dashboard.NamedEntry
+	1, // 2: goproto.protoc.helpers.filter.Dashboard.NamedEntry.value:type_name -> goproto.protoc.helpers.filter.Dashboard.Panel
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_filter_filter_proto_depIdxs = nil
+}
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/filter/hybrid.proto
+
+//go:build !protoopaque
+
+package filter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Rows          []*Report_Row          `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty" form:"rows" uri:"rows"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Report) GetRows() []*Report_Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *Report) SetRows(v []*Report_Row) {
+	x.Rows = v
+}
+
+type Report_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rows []*Report_Row
+}
+
+func (b0 Report_builder) Build() *Report {
+	m0 := &Report{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Rows = b.Rows
+	return m0
+}
+
+// FilterRows removes from the rows field the elements for which
+// keep returns false, preserving the order of the remaining elements.
+// The elements are moved within the backing array of the field.
+func (x *Report) FilterRows(keep func(*Report_Row) bool) {
+	l := x.GetRows()
+	n := 0
+	for _, v := range l {
+		if keep(v) {
+			l[n] = v
+			n++
+		}
+	}
+	clear(l[n:])
+	x.SetRows(l[:n])
+}
+
+type Report_Row struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Value         *int32                 `protobuf:"varint,1,opt,name=value" json:"value,omitempty" form:"value" uri:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report_Row) Reset() {
+	*x = Report_Row{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report_Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Row) ProtoMessage() {}
+
+func (x *Report_Row) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Report_Row) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Report_Row) SetValue(v int32) {
+	x.Value = &v
+}
+
+func (x *Report_Row) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.Value != nil
+}
+
+func (x *Report_Row) ClearValue() {
+	x.Value = nil
+}
+
+type Report_Row_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Value *int32
+}
+
+func (b0 Report_Row_builder) Build() *Report_Row {
+	m0 := &Report_Row{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Value = b.Value
+	return m0
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/helpers/filter/hybrid.proto\x12\x1dgoproto.protoc.helpers.filter\x1a!google/protobuf/go_features.proto\"d\n" +
+	"\x06Report\x12=\n" +
+	"\x04rows\x18\x01 \x03(\v2).goproto.protoc.helpers.filter.Report.RowR\x04rows\x1a\x1b\n" +
+	"\x03Row\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05valueBNZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/filter\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_goTypes = []any{
+	(*Report)(nil),     // 0: goproto.protoc.helpers.filter.Report
+	(*Report_Row)(nil), // 1: goproto.protoc.helpers.filter.Report.Row
+}
+var file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.helpers.filter.Report.rows:type_name -> goproto.protoc.helpers.filter.Report.Row
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_filter_hybrid_proto_depIdxs = nil
+}
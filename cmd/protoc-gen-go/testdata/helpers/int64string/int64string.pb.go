@@ -0,0 +1,347 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/int64string/int64string.proto
+
+package int64string
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Account struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Balance  int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty" form:"balance" uri:"balance"`
+	Id       uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Offset   int64                  `protobuf:"fixed64,3,opt,name=offset,proto3" json:"offset,omitempty" form:"offset" uri:"offset"`
+	Checksum uint64                 `protobuf:"fixed64,4,opt,name=checksum,proto3" json:"checksum,omitempty" form:"checksum" uri:"checksum"`
+	Delta    int64                  `protobuf:"zigzag64,5,opt,name=delta,proto3" json:"delta,omitempty" form:"delta" uri:"delta"`
+	Limit    *int64                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty" form:"limit" uri:"limit"`
+	// Types that are valid to be assigned to Ref:
+	//
+	//	*Account_RefId
+	//	*Account_RefName
+	Ref           isAccount_Ref `protobuf_oneof:"ref"`
+	History       []int64       `protobuf:"varint,9,rep,packed,name=history,proto3" json:"history,omitempty" form:"history" uri:"history"` // repeated, so no string accessors
+	Version       int32         `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty" form:"version" uri:"version"`       // not 64-bit, so no string accessors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Account) GetChecksum() uint64 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
+func (x *Account) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *Account) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *Account) GetRef() isAccount_Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *Account) GetRefId() int64 {
+	if x != nil {
+		if x, ok := x.Ref.(*Account_RefId); ok {
+			return x.RefId
+		}
+	}
+	return 0
+}
+
+func (x *Account) GetRefName() string {
+	if x != nil {
+		if x, ok := x.Ref.(*Account_RefName); ok {
+			return x.RefName
+		}
+	}
+	return ""
+}
+
+func (x *Account) GetHistory() []int64 {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Account) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type isAccount_Ref interface {
+	isAccount_Ref()
+}
+
+type Account_RefId struct {
+	RefId int64 `protobuf:"varint,7,opt,name=ref_id,json=refId,proto3,oneof"`
+}
+
+type Account_RefName struct {
+	RefName string `protobuf:"bytes,8,opt,name=ref_name,json=refName,proto3,oneof"`
+}
+
+func (*Account_RefId) isAccount_Ref() {}
+
+func (*Account_RefName) isAccount_Ref() {}
+
+// GetBalanceString returns the value of the balance field as a decimal string.
+func (x *Account) GetBalanceString() string {
+	return strconv.FormatInt(x.GetBalance(), 10)
+}
+
+// SetBalanceString sets the balance field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetBalanceString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Balance = v
+	return nil
+}
+
+// GetIdString returns the value of the id field as a decimal string.
+func (x *Account) GetIdString() string {
+	return strconv.FormatUint(x.GetId(), 10)
+}
+
+// SetIdString sets the id field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetIdString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Id = v
+	return nil
+}
+
+// GetOffsetString returns the value of the offset field as a decimal string.
+func (x *Account) GetOffsetString() string {
+	return strconv.FormatInt(x.GetOffset(), 10)
+}
+
+// SetOffsetString sets the offset field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetOffsetString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Offset = v
+	return nil
+}
+
+// GetChecksumString returns the value of the checksum field as a decimal string.
+func (x *Account) GetChecksumString() string {
+	return strconv.FormatUint(x.GetChecksum(), 10)
+}
+
+// SetChecksumString sets the checksum field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetChecksumString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Checksum = v
+	return nil
+}
+
+// GetDeltaString returns the value of the delta field as a decimal string.
+func (x *Account) GetDeltaString() string {
+	return strconv.FormatInt(x.GetDelta(), 10)
+}
+
+// SetDeltaString sets the delta field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetDeltaString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Delta = v
+	return nil
+}
+
+// GetLimitString returns the value of the limit field as a decimal string.
+func (x *Account) GetLimitString() string {
+	return strconv.FormatInt(x.GetLimit(), 10)
+}
+
+// SetLimitString sets the limit field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetLimitString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Limit = &v
+	return nil
+}
+
+// GetRefIdString returns the value of the ref_id field as a decimal string.
+func (x *Account) GetRefIdString() string {
+	return strconv.FormatInt(x.GetRefId(), 10)
+}
+
+// SetRefIdString sets the ref_id field to the value of the decimal
+// string s. It reports an error and leaves x unchanged if s is not a valid value.
+func (x *Account) SetRefIdString(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	x.Ref = &Account_RefId{RefId: v}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/helpers/int64string/int64string.proto\x12\"goproto.protoc.helpers.int64string\"\x93\x02\n" +
+	"\aAccount\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x03R\abalance\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x10R\x06offset\x12\x1a\n" +
+	"\bchecksum\x18\x04 \x01(\x06R\bchecksum\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x12R\x05delta\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x03H\x01R\x05limit\x88\x01\x01\x12\x17\n" +
+	"\x06ref_id\x18\a \x01(\x03H\x00R\x05refId\x12\x1b\n" +
+	"\bref_name\x18\b \x01(\tH\x00R\arefName\x12\x18\n" +
+	"\ahistory\x18\t \x03(\x03R\ahistory\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversionB\x05\n" +
+	"\x03refB\b\n" +
+	"\x06_limitBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/int64stringb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_goTypes = []any{
+	(*Account)(nil), // 0: goproto.protoc.helpers.int64string.Account
+}
+var file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_RefId)(nil),
+		(*Account_RefName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_int64string_int64string_proto_depIdxs = nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/iter/iter.proto
+
+package iter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Inventory struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Tags          []string                  `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Items         []*Inventory_Item         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	Counts        map[string]int32          `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ById          map[int64]*Inventory_Item `protobuf:"bytes,4,rep,name=by_id,json=byId,proto3" json:"by_id,omitempty" form:"by_id" uri:"by_id" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Owner         string                    `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty" form:"owner" uri:"owner"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory) Reset() {
+	*x = Inventory{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory) ProtoMessage() {}
+
+func (x *Inventory) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Inventory) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Inventory) GetItems() []*Inventory_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Inventory) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Inventory) GetById() map[int64]*Inventory_Item {
+	if x != nil {
+		return x.ById
+	}
+	return nil
+}
+
+func (x *Inventory) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type Inventory_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory_Item) Reset() {
+	*x = Inventory_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory_Item) ProtoMessage() {}
+
+func (x *Inventory_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory_Item.ProtoReflect.Descriptor instead.
+func (*Inventory_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Inventory_Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/helpers/iter/iter.proto\x12\x1bgoproto.protoc.helpers.iter\"\xc8\x03\n" +
+	"\tInventory\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12A\n" +
+	"\x05items\x18\x02 \x03(\v2+.goproto.protoc.helpers.iter.Inventory.ItemR\x05items\x12J\n" +
+	"\x06counts\x18\x03 \x03(\v22.goproto.protoc.helpers.iter.Inventory.CountsEntryR\x06counts\x12E\n" +
+	"\x05by_id\x18\x04 \x03(\v20.goproto.protoc.helpers.iter.Inventory.ByIdEntryR\x04byId\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x1a\x1a\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1ad\n" +
+	"\tByIdEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12A\n" +
+	"\x05value\x18\x02 \x01(\v2+.goproto.protoc.helpers.iter.Inventory.ItemR\x05value:\x028\x01BDZBgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/iterb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_goTypes = []any{
+	(*Inventory)(nil),      // 0: goproto.protoc.helpers.iter.Inventory
+	(*Inventory_Item)(nil), // 1: goproto.protoc.helpers.iter.Inventory.Item
+	nil,                    // 2: goproto.protoc.helpers.iter.Inventory.CountsEntry
+	nil,                    // 3: goproto.protoc.helpers.iter.Inventory.ByIdEntry
+}
+var file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.helpers.iter.Inventory.items:type_name -> goproto.protoc.helpers.iter.Inventory.Item
+	2, // 1: goproto.protoc.helpers.iter.Inventory.counts:type_name -> goproto.protoc.helpers.iter.Inventory.CountsEntry
+	3, // 2: goproto.protoc.helpers.iter.Inventory.by_id:type_name -> goproto.protoc.helpers.iter.Inventory.ByIdEntry
+	1, // 3: goproto.protoc.helpers.iter.Inventory.ByIdEntry.value:type_name -> goproto.protoc.helpers.iter.Inventory.Item
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_iter_iter_proto_depIdxs = nil
+}
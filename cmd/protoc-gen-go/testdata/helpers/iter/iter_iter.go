@@ -0,0 +1,56 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/iter/iter.proto
+
+//go:build go1.23
+
+package iter
+
+import (
+	iter "iter"
+)
+
+// AllTags returns an iterator over the elements of the tags field.
+func (x *Inventory) AllTags() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, v := range x.GetTags() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// AllItems returns an iterator over the elements of the items field.
+func (x *Inventory) AllItems() iter.Seq[*Inventory_Item] {
+	return func(yield func(*Inventory_Item) bool) {
+		for _, v := range x.GetItems() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// AllCountsEntries returns an iterator over the entries of the counts field,
+// in unspecified order.
+func (x *Inventory) AllCountsEntries() iter.Seq2[string, int32] {
+	return func(yield func(string, int32) bool) {
+		for k, v := range x.GetCounts() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// AllByIdEntries returns an iterator over the entries of the by_id field,
+// in unspecified order.
+func (x *Inventory) AllByIdEntries() iter.Seq2[int64, *Inventory_Item] {
+	return func(yield func(int64, *Inventory_Item) bool) {
+		for k, v := range x.GetById() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/joined/joined.proto
+
+package joined
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Report_Level int32
+
+const (
+	Report_LEVEL_UNSPECIFIED Report_Level = 0
+	Report_LEVEL_LOW         Report_Level = 1
+	Report_LEVEL_HIGH        Report_Level = 2
+)
+
+// Enum value maps for Report_Level.
+var (
+	Report_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_LOW",
+		2: "LEVEL_HIGH",
+	}
+	Report_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_LOW":         1,
+		"LEVEL_HIGH":        2,
+	}
+)
+
+func (x Report_Level) Enum() *Report_Level {
+	p := new(Report_Level)
+	*p = x
+	return p
+}
+
+func (x Report_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Report_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_enumTypes[0].Descriptor()
+}
+
+func (Report_Level) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_enumTypes[0]
+}
+
+func (x Report_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Report_Level.Descriptor instead.
+func (Report_Level) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []int32                `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts"`
+	Levels        []Report_Level         `protobuf:"varint,2,rep,packed,name=levels,proto3,enum=goproto.protoc.helpers.joined.Report_Level" json:"levels,omitempty" form:"levels" uri:"levels"`
+	Names         []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" form:"names" uri:"names"`
+	Ids           []uint64               `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty" form:"ids" uri:"ids"`
+	Flags         []bool                 `protobuf:"varint,5,rep,packed,name=flags,proto3" json:"flags,omitempty" form:"flags" uri:"flags"`
+	Ratios        []float64              `protobuf:"fixed64,6,rep,packed,name=ratios,proto3" json:"ratios,omitempty" form:"ratios" uri:"ratios"`
+	Weights       []float32              `protobuf:"fixed32,7,rep,packed,name=weights,proto3" json:"weights,omitempty" form:"weights" uri:"weights"`
+	Blobs         [][]byte               `protobuf:"bytes,8,rep,name=blobs,proto3" json:"blobs,omitempty" form:"blobs" uri:"blobs"` // bytes, so no BlobsJoined
+	Parts         []*Report              `protobuf:"bytes,9,rep,name=parts,proto3" json:"parts,omitempty" form:"parts" uri:"parts"` // message, so no PartsJoined
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Report) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Report) GetLevels() []Report_Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *Report) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Report) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Report) GetFlags() []bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Report) GetRatios() []float64 {
+	if x != nil {
+		return x.Ratios
+	}
+	return nil
+}
+
+func (x *Report) GetWeights() []float32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Report) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *Report) GetParts() []*Report {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// CountsJoined returns the elements of the counts field joined by sep.
+// Each element is formatted by strconv.FormatInt.
+func (x *Report) CountsJoined(sep string) string {
+	l := x.GetCounts()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = strconv.FormatInt(int64(v), 10)
+	}
+	return strings.Join(s, sep)
+}
+
+// LevelsJoined returns the elements of the levels field joined by sep.
+// Each element is given by the name of its enum value.
+func (x *Report) LevelsJoined(sep string) string {
+	l := x.GetLevels()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = v.String()
+	}
+	return strings.Join(s, sep)
+}
+
+// NamesJoined returns the elements of the names field joined by sep.
+func (x *Report) NamesJoined(sep string) string {
+	return strings.Join(x.GetNames(), sep)
+}
+
+// IdsJoined returns the elements of the ids field joined by sep.
+// Each element is formatted by strconv.FormatUint.
+func (x *Report) IdsJoined(sep string) string {
+	l := x.GetIds()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+	return strings.Join(s, sep)
+}
+
+// FlagsJoined returns the elements of the flags field joined by sep.
+// Each element is formatted by strconv.FormatBool.
+func (x *Report) FlagsJoined(sep string) string {
+	l := x.GetFlags()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = strconv.FormatBool(v)
+	}
+	return strings.Join(s, sep)
+}
+
+// RatiosJoined returns the elements of the ratios field joined by sep.
+// Each element is formatted by strconv.FormatFloat.
+func (x *Report) RatiosJoined(sep string) string {
+	l := x.GetRatios()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return strings.Join(s, sep)
+}
+
+// WeightsJoined returns the elements of the weights field joined by sep.
+// Each element is formatted by strconv.FormatFloat.
+func (x *Report) WeightsJoined(sep string) string {
+	l := x.GetWeights()
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = strconv.FormatFloat(float64(v), 'g', -1, 32)
+	}
+	return strings.Join(s, sep)
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/helpers/joined/joined.proto\x12\x1dgoproto.protoc.helpers.joined\"\xe7\x02\n" +
+	"\x06Report\x12\x16\n" +
+	"\x06counts\x18\x01 \x03(\x05R\x06counts\x12C\n" +
+	"\x06levels\x18\x02 \x03(\x0e2+.goproto.protoc.helpers.joined.Report.LevelR\x06levels\x12\x14\n" +
+	"\x05names\x18\x03 \x03(\tR\x05names\x12\x10\n" +
+	"\x03ids\x18\x04 \x03(\x04R\x03ids\x12\x14\n" +
+	"\x05flags\x18\x05 \x03(\bR\x05flags\x12\x16\n" +
+	"\x06ratios\x18\x06 \x03(\x01R\x06ratios\x12\x18\n" +
+	"\aweights\x18\a \x03(\x02R\aweights\x12\x14\n" +
+	"\x05blobs\x18\b \x03(\fR\x05blobs\x12;\n" +
+	"\x05parts\x18\t \x03(\v2%.goproto.protoc.helpers.joined.ReportR\x05parts\"=\n" +
+	"\x05Level\x12\x15\n" +
+	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tLEVEL_LOW\x10\x01\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\x02BFZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/joinedb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_goTypes = []any{
+	(Report_Level)(0), // 0: goproto.protoc.helpers.joined.Report.Level
+	(*Report)(nil),    // 1: goproto.protoc.helpers.joined.Report
+}
+var file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.helpers.joined.Report.levels:type_name -> goproto.protoc.helpers.joined.Report.Level
+	1, // 1: goproto.protoc.helpers.joined.Report.parts:type_name -> goproto.protoc.helpers.joined.Report
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_joined_joined_proto_depIdxs = nil
+}
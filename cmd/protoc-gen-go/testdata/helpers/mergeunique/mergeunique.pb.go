@@ -0,0 +1,263 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/helpers/mergeunique/mergeunique.proto
+
+package mergeunique
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_KIND_A           Kind = 1
+	Kind_KIND_B           Kind = 2
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_A",
+		2: "KIND_B",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_A":           1,
+		"KIND_B":           2,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescGZIP(), []int{0}
+}
+
+type Aggregate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty" form:"ids" uri:"ids"`
+	Kinds         []Kind                 `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=goproto.protoc.helpers.mergeunique.Kind" json:"kinds,omitempty" form:"kinds" uri:"kinds"`
+	Blobs         [][]byte               `protobuf:"bytes,4,rep,name=blobs,proto3" json:"blobs,omitempty" form:"blobs" uri:"blobs"` // not comparable, so no MergeUniqueBlobs
+	Parts         []*Aggregate           `protobuf:"bytes,5,rep,name=parts,proto3" json:"parts,omitempty" form:"parts" uri:"parts"` // message, so no MergeUniqueParts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregate) Reset() {
+	*x = Aggregate{}
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate) ProtoMessage() {}
+
+func (x *Aggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate.ProtoReflect.Descriptor instead.
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Aggregate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Aggregate) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Aggregate) GetKinds() []Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *Aggregate) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *Aggregate) GetParts() []*Aggregate {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// MergeUniqueTags appends to the tags field each of vals which is not
+// already present in the field or earlier in vals, preserving their order.
+func (x *Aggregate) MergeUniqueTags(vals ...string) {
+	l := x.Tags
+	seen := make(map[string]struct{}, len(l)+len(vals))
+	for _, v := range l {
+		seen[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			l = append(l, v)
+		}
+	}
+	x.Tags = l
+}
+
+// MergeUniqueIds appends to the ids field each of vals which is not
+// already present in the field or earlier in vals, preserving their order.
+func (x *Aggregate) MergeUniqueIds(vals ...int64) {
+	l := x.Ids
+	seen := make(map[int64]struct{}, len(l)+len(vals))
+	for _, v := range l {
+		seen[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			l = append(l, v)
+		}
+	}
+	x.Ids = l
+}
+
+// MergeUniqueKinds appends to the kinds field each of vals which is not
+// already present in the field or earlier in vals, preserving their order.
+func (x *Aggregate) MergeUniqueKinds(vals ...Kind) {
+	l := x.Kinds
+	seen := make(map[Kind]struct{}, len(l)+len(vals))
+	for _, v := range l {
+		seen[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			l = append(l, v)
+		}
+	}
+	x.Kinds = l
+}
+
+var File_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/helpers/mergeunique/mergeunique.proto\x12\"goproto.protoc.helpers.mergeunique\"\xcc\x01\n" +
+	"\tAggregate\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\x12>\n" +
+	"\x05kinds\x18\x03 \x03(\x0e2(.goproto.protoc.helpers.mergeunique.KindR\x05kinds\x12\x14\n" +
+	"\x05blobs\x18\x04 \x03(\fR\x05blobs\x12C\n" +
+	"\x05parts\x18\x05 \x03(\v2-.goproto.protoc.helpers.mergeunique.AggregateR\x05parts*4\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06KIND_A\x10\x01\x12\n" +
+	"\n" +
+	"\x06KIND_B\x10\x02BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/helpers/mergeuniqueb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_goTypes = []any{
+	(Kind)(0),         // 0: goproto.protoc.helpers.mergeunique.Kind
+	(*Aggregate)(nil), // 1: goproto.protoc.helpers.mergeunique.Aggregate
+}
+var file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.helpers.mergeunique.Aggregate.kinds:type_name -> goproto.protoc.helpers.mergeunique.Kind
+	1, // 1: goproto.protoc.helpers.mergeunique.Aggregate.parts:type_name -> goproto.protoc.helpers.mergeunique.Aggregate
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_init() }
+func file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto = out.File
+	file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_helpers_mergeunique_mergeunique_proto_depIdxs = nil
+}
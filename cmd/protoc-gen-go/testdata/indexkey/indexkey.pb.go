@@ -0,0 +1,336 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/indexkey/indexkey.proto
+
+package indexkey
+
+import (
+	fmt "fmt"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty" form:"sku" uri:"sku"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty" form:"title" uri:"title"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type Catalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty" form:"products" uri:"products"`
+	Discontinued  []*Product             `protobuf:"bytes,2,rep,name=discontinued,proto3" json:"discontinued,omitempty" form:"discontinued" uri:"discontinued"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Catalog) Reset() {
+	*x = Catalog{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog) ProtoMessage() {}
+
+func (x *Catalog) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog.ProtoReflect.Descriptor instead.
+func (*Catalog) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Catalog) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *Catalog) GetDiscontinued() []*Product {
+	if x != nil {
+		return x.Discontinued
+	}
+	return nil
+}
+
+type Catalog_Shelf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bins          []*Catalog_Shelf_Bin   `protobuf:"bytes,1,rep,name=bins,proto3" json:"bins,omitempty" form:"bins" uri:"bins"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Catalog_Shelf) Reset() {
+	*x = Catalog_Shelf{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog_Shelf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog_Shelf) ProtoMessage() {}
+
+func (x *Catalog_Shelf) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog_Shelf.ProtoReflect.Descriptor instead.
+func (*Catalog_Shelf) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Catalog_Shelf) GetBins() []*Catalog_Shelf_Bin {
+	if x != nil {
+		return x.Bins
+	}
+	return nil
+}
+
+type Catalog_Shelf_Bin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty" form:"number" uri:"number"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Catalog_Shelf_Bin) Reset() {
+	*x = Catalog_Shelf_Bin{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog_Shelf_Bin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog_Shelf_Bin) ProtoMessage() {}
+
+func (x *Catalog_Shelf_Bin) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog_Shelf_Bin.ProtoReflect.Descriptor instead.
+func (*Catalog_Shelf_Bin) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *Catalog_Shelf_Bin) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+// BuildProductIndex returns the messages in items indexed by their sku field.
+// Nil messages are skipped, and messages whose sku field is not set
+// are indexed by its default value.
+// It reports an error if several messages have the same sku.
+func BuildProductIndex(items []*Product) (map[string]*Product, error) {
+	index := make(map[string]*Product, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		k := item.GetSku()
+		if _, ok := index[k]; ok {
+			return nil, fmt.Errorf("goproto.protoc.indexkey.Product: duplicate sku %q", k)
+		}
+		index[k] = item
+	}
+	return index, nil
+}
+
+// ProductsIndex returns the messages of the products field indexed by
+// their sku field, as built by BuildProductIndex.
+func (x *Catalog) ProductsIndex() (map[string]*Product, error) {
+	return BuildProductIndex(x.GetProducts())
+}
+
+// DiscontinuedIndex returns the messages of the discontinued field indexed by
+// their sku field, as built by BuildProductIndex.
+func (x *Catalog) DiscontinuedIndex() (map[string]*Product, error) {
+	return BuildProductIndex(x.GetDiscontinued())
+}
+
+// BuildCatalog_Shelf_BinIndex returns the messages in items indexed by their number field.
+// Nil messages are skipped, and messages whose number field is not set
+// are indexed by its default value.
+// It reports an error if several messages have the same number.
+func BuildCatalog_Shelf_BinIndex(items []*Catalog_Shelf_Bin) (map[int32]*Catalog_Shelf_Bin, error) {
+	index := make(map[int32]*Catalog_Shelf_Bin, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		k := item.GetNumber()
+		if _, ok := index[k]; ok {
+			return nil, fmt.Errorf("goproto.protoc.indexkey.Catalog.Shelf.Bin: duplicate number %v", k)
+		}
+		index[k] = item
+	}
+	return index, nil
+}
+
+// BinsIndex returns the messages of the bins field indexed by
+// their number field, as built by BuildCatalog_Shelf_BinIndex.
+func (x *Catalog_Shelf) BinsIndex() (map[int32]*Catalog_Shelf_Bin, error) {
+	return BuildCatalog_Shelf_BinIndex(x.GetBins())
+}
+
+var File_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/indexkey/indexkey.proto\x12\x17goproto.protoc.indexkey\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"1\n" +
+	"\aProduct\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\xf5\x01\n" +
+	"\aCatalog\x12<\n" +
+	"\bproducts\x18\x01 \x03(\v2 .goproto.protoc.indexkey.ProductR\bproducts\x12D\n" +
+	"\fdiscontinued\x18\x02 \x03(\v2 .goproto.protoc.indexkey.ProductR\fdiscontinued\x1af\n" +
+	"\x05Shelf\x12>\n" +
+	"\x04bins\x18\x01 \x03(\v2*.goproto.protoc.indexkey.Catalog.Shelf.BinR\x04bins\x1a\x1d\n" +
+	"\x03Bin\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06numberB\x91\x01\x9a\xf4\x18\x14Catalog.products:sku\x9a\xf4\x18\x18Catalog.discontinued:sku\x9a\xf4\x18\x19Catalog.Shelf.bins:numberZ>google.golang.org/protobuf/cmd/protoc-gen-go/testdata/indexkeyb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_goTypes = []any{
+	(*Product)(nil),           // 0: goproto.protoc.indexkey.Product
+	(*Catalog)(nil),           // 1: goproto.protoc.indexkey.Catalog
+	(*Catalog_Shelf)(nil),     // 2: goproto.protoc.indexkey.Catalog.Shelf
+	(*Catalog_Shelf_Bin)(nil), // 3: goproto.protoc.indexkey.Catalog.Shelf.Bin
+}
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.indexkey.Catalog.products:type_name -> goproto.protoc.indexkey.Product
+	0, // 1: goproto.protoc.indexkey.Catalog.discontinued:type_name -> goproto.protoc.indexkey.Product
+	3, // 2: goproto.protoc.indexkey.Catalog.Shelf.bins:type_name -> goproto.protoc.indexkey.Catalog.Shelf.Bin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_init() }
+func file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto = out.File
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_proto_depIdxs = nil
+}
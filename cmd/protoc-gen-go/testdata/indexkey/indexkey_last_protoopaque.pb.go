@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/indexkey/indexkey_last.proto
+
+//go:build protoopaque
+
+package indexkey
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Vehicle struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Plate       *string                `protobuf:"bytes,1,opt,name=plate"`
+	xxx_hidden_Seats       int32                  `protobuf:"varint,2,opt,name=seats"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Vehicle) GetPlate() string {
+	if x != nil {
+		if x.xxx_hidden_Plate != nil {
+			return *x.xxx_hidden_Plate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Vehicle) GetSeats() int32 {
+	if x != nil {
+		return x.xxx_hidden_Seats
+	}
+	return 0
+}
+
+func (x *Vehicle) SetPlate(v string) {
+	x.xxx_hidden_Plate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *Vehicle) SetSeats(v int32) {
+	x.xxx_hidden_Seats = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *Vehicle) HasPlate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Vehicle) HasSeats() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Vehicle) ClearPlate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Plate = nil
+}
+
+func (x *Vehicle) ClearSeats() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Seats = 0
+}
+
+type Vehicle_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Plate *string
+	Seats *int32
+}
+
+func (b0 Vehicle_builder) Build() *Vehicle {
+	m0 := &Vehicle{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Plate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Plate = b.Plate
+	}
+	if b.Seats != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Seats = *b.Seats
+	}
+	return m0
+}
+
+type Fleet struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Vehicles *[]*Vehicle            `protobuf:"bytes,1,rep,name=vehicles"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Fleet) Reset() {
+	*x = Fleet{}
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fleet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fleet) ProtoMessage() {}
+
+func (x *Fleet) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Fleet) GetVehicles() []*Vehicle {
+	if x != nil {
+		if x.xxx_hidden_Vehicles != nil {
+			return *x.xxx_hidden_Vehicles
+		}
+	}
+	return nil
+}
+
+func (x *Fleet) SetVehicles(v []*Vehicle) {
+	x.xxx_hidden_Vehicles = &v
+}
+
+type Fleet_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Vehicles []*Vehicle
+}
+
+func (b0 Fleet_builder) Build() *Fleet {
+	m0 := &Fleet{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Vehicles = &b.Vehicles
+	return m0
+}
+
+// BuildVehicleIndex returns the messages in items indexed by their plate field.
+// Nil messages are skipped, and messages whose plate field is not set
+// are indexed by its default value.
+// If several messages have the same plate, the last of them is
+// kept, and no error is reported.
+func BuildVehicleIndex(items []*Vehicle) (map[string]*Vehicle, error) {
+	index := make(map[string]*Vehicle, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		index[item.GetPlate()] = item
+	}
+	return index, nil
+}
+
+// VehiclesIndex returns the messages of the vehicles field indexed by
+// their plate field, as built by BuildVehicleIndex.
+func (x *Fleet) VehiclesIndex() (map[string]*Vehicle, error) {
+	return BuildVehicleIndex(x.GetVehicles())
+}
+
+var File_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/indexkey/indexkey_last.proto\x12\x17goproto.protoc.indexkey\x1a0cmd/protoc-gen-go/testdata/options/options.proto\x1a!google/protobuf/go_features.proto\"5\n" +
+	"\aVehicle\x12\x14\n" +
+	"\x05plate\x18\x01 \x01(\tR\x05plate\x12\x14\n" +
+	"\x05seats\x18\x02 \x01(\x05R\x05seats\"E\n" +
+	"\x05Fleet\x12<\n" +
+	"\bvehicles\x18\x01 \x03(\v2 .goproto.protoc.indexkey.VehicleR\bvehiclesB`\x9a\xf4\x18\x14Fleet.vehicles:plateZ>google.golang.org/protobuf/cmd/protoc-gen-go/testdata/indexkey\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_goTypes = []any{
+	(*Vehicle)(nil), // 0: goproto.protoc.indexkey.Vehicle
+	(*Fleet)(nil),   // 1: goproto.protoc.indexkey.Fleet
+}
+var file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.indexkey.Fleet.vehicles:type_name -> goproto.protoc.indexkey.Vehicle
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_init() }
+func file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto = out.File
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_indexkey_indexkey_last_proto_depIdxs = nil
+}
@@ -0,0 +1,385 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/json/numeric/numeric.proto
+
+package numeric
+
+import (
+	json "encoding/json"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type LegacyStatus int32
+
+const (
+	LegacyStatus_LEGACY_STATUS_UNKNOWN LegacyStatus = 0
+	LegacyStatus_LEGACY_STATUS_ACTIVE  LegacyStatus = 1
+	LegacyStatus_LEGACY_STATUS_CLOSED  LegacyStatus = 2
+)
+
+// Enum value maps for LegacyStatus.
+var (
+	LegacyStatus_name = map[int32]string{
+		0: "LEGACY_STATUS_UNKNOWN",
+		1: "LEGACY_STATUS_ACTIVE",
+		2: "LEGACY_STATUS_CLOSED",
+	}
+	LegacyStatus_value = map[string]int32{
+		"LEGACY_STATUS_UNKNOWN": 0,
+		"LEGACY_STATUS_ACTIVE":  1,
+		"LEGACY_STATUS_CLOSED":  2,
+	}
+)
+
+func (x LegacyStatus) Enum() *LegacyStatus {
+	p := new(LegacyStatus)
+	*p = x
+	return p
+}
+
+func (x LegacyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LegacyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes[0].Descriptor()
+}
+
+func (LegacyStatus) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes[0]
+}
+
+func (x LegacyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LegacyStatus.Descriptor instead.
+func (LegacyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescGZIP(), []int{0}
+}
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes[1].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes[1]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescGZIP(), []int{1}
+}
+
+type Account struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Status        LegacyStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=goproto.protoc.json.numeric.LegacyStatus" json:"status,omitempty" form:"status" uri:"status"`
+	Color         Color                   `protobuf:"varint,3,opt,name=color,proto3,enum=goproto.protoc.json.numeric.Color" json:"color,omitempty" form:"color" uri:"color"`
+	History       []LegacyStatus          `protobuf:"varint,4,rep,packed,name=history,proto3,enum=goproto.protoc.json.numeric.LegacyStatus" json:"history,omitempty" form:"history" uri:"history"`
+	ByRegion      map[string]LegacyStatus `protobuf:"bytes,5,rep,name=by_region,json=byRegion,proto3" json:"by_region,omitempty" form:"by_region" uri:"by_region" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.protoc.json.numeric.LegacyStatus"`
+	Parent        *Account                `protobuf:"bytes,6,opt,name=parent,proto3" json:"parent,omitempty" form:"parent" uri:"parent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() LegacyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LegacyStatus_LEGACY_STATUS_UNKNOWN
+}
+
+func (x *Account) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *Account) GetHistory() []LegacyStatus {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Account) GetByRegion() map[string]LegacyStatus {
+	if x != nil {
+		return x.ByRegion
+	}
+	return nil
+}
+
+func (x *Account) GetParent() *Account {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler by marshaling x with protojson.Marshal,
+// except that:
+//   - the values of enums with the json_numeric option are marshaled as
+//     numbers rather than names.
+//
+// This applies to the fields of x only, and not to the fields of its nested
+// messages.
+func (x *Account) MarshalJSON() ([]byte, error) {
+	b, err := protojson.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	{
+		b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		var override map[string]json.RawMessage
+		if err := json.Unmarshal(b, &override); err != nil {
+			return nil, err
+		}
+		for _, name := range []string{
+			"status",
+			"history",
+			"byRegion",
+		} {
+			if v, ok := override[name]; ok {
+				fields[name] = v
+			}
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with
+// the protobuf JSON mapping.
+// Unknown fields in b are discarded.
+func (x *Account) UnmarshalJSON(b []byte) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, x)
+}
+
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         Color                  `protobuf:"varint,1,opt,name=color,proto3,enum=goproto.protoc.json.numeric.Color" json:"color,omitempty" form:"color" uri:"color"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Label) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+// MarshalJSON implements json.Marshaler by marshaling x with protojson.Marshal.
+func (x *Label) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with
+// the protobuf JSON mapping.
+// Unknown fields in b are discarded.
+func (x *Label) UnmarshalJSON(b []byte) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, x)
+}
+
+var File_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDesc = "" +
+	"\n" +
+	"5cmd/protoc-gen-go/testdata/json/numeric/numeric.proto\x12\x1bgoproto.protoc.json.numeric\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"\xd6\x03\n" +
+	"\aAccount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
+	"\x06status\x18\x02 \x01(\x0e2).goproto.protoc.json.numeric.LegacyStatusR\x06status\x128\n" +
+	"\x05color\x18\x03 \x01(\x0e2\".goproto.protoc.json.numeric.ColorR\x05color\x12C\n" +
+	"\ahistory\x18\x04 \x03(\x0e2).goproto.protoc.json.numeric.LegacyStatusR\ahistory\x12O\n" +
+	"\tby_region\x18\x05 \x03(\v22.goproto.protoc.json.numeric.Account.ByRegionEntryR\bbyRegion\x12<\n" +
+	"\x06parent\x18\x06 \x01(\v2$.goproto.protoc.json.numeric.AccountR\x06parent\x1af\n" +
+	"\rByRegionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\x0e2).goproto.protoc.json.numeric.LegacyStatusR\x05value:\x028\x01\"A\n" +
+	"\x05Label\x128\n" +
+	"\x05color\x18\x01 \x01(\x0e2\".goproto.protoc.json.numeric.ColorR\x05color*c\n" +
+	"\fLegacyStatus\x12\x19\n" +
+	"\x15LEGACY_STATUS_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14LEGACY_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14LEGACY_STATUS_CLOSED\x10\x02\x1a\x04\xf8\xf3\x18\x01*-\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01BDZBgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/numericb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_goTypes = []any{
+	(LegacyStatus)(0), // 0: goproto.protoc.json.numeric.LegacyStatus
+	(Color)(0),        // 1: goproto.protoc.json.numeric.Color
+	(*Account)(nil),   // 2: goproto.protoc.json.numeric.Account
+	(*Label)(nil),     // 3: goproto.protoc.json.numeric.Label
+	nil,               // 4: goproto.protoc.json.numeric.Account.ByRegionEntry
+}
+var file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.json.numeric.Account.status:type_name -> goproto.protoc.json.numeric.LegacyStatus
+	1, // 1: goproto.protoc.json.numeric.Account.color:type_name -> goproto.protoc.json.numeric.Color
+	0, // 2: goproto.protoc.json.numeric.Account.history:type_name -> goproto.protoc.json.numeric.LegacyStatus
+	4, // 3: goproto.protoc.json.numeric.Account.by_region:type_name -> goproto.protoc.json.numeric.Account.ByRegionEntry
+	2, // 4: goproto.protoc.json.numeric.Account.parent:type_name -> goproto.protoc.json.numeric.Account
+	1, // 5: goproto.protoc.json.numeric.Label.color:type_name -> goproto.protoc.json.numeric.Color
+	0, // 6: goproto.protoc.json.numeric.Account.ByRegionEntry.value:type_name -> goproto.protoc.json.numeric.LegacyStatus
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_init() }
+func file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto = out.File
+	file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_json_numeric_numeric_proto_depIdxs = nil
+}
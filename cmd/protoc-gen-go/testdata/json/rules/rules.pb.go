@@ -0,0 +1,412 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/json/rules/rules.proto
+
+package rules
+
+import (
+	json "encoding/json"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_UNSPECIFIED Level = 0
+	Level_LEVEL_HIGH        Level = 1
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_HIGH":        1,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Level.Descriptor instead.
+func (Level) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescGZIP(), []int{0}
+}
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_KIND_USER        Kind = 1
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_USER",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_USER":        1,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes[1].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes[1]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescGZIP(), []int{1}
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Retries       int32                  `protobuf:"varint,2,opt,name=retries,proto3" json:"retries,omitempty" form:"retries" uri:"retries"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty" form:"password" uri:"password"`
+	Level         Level                  `protobuf:"varint,4,opt,name=level,proto3,enum=goproto.protoc.json.rules.Level" json:"level,omitempty" form:"level" uri:"level"`
+	Kind          Kind                   `protobuf:"varint,5,opt,name=kind,proto3,enum=goproto.protoc.json.rules.Kind" json:"kind,omitempty" form:"kind" uri:"kind"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Nickname      *string                `protobuf:"bytes,7,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Manager       *User                  `protobuf:"bytes,8,opt,name=manager,proto3" json:"manager,omitempty" form:"manager" uri:"manager"`
+	Priority      Level                  `protobuf:"varint,9,opt,name=priority,proto3,enum=goproto.protoc.json.rules.Level" json:"priority,omitempty" form:"priority" uri:"priority"`
+	Internal      *User                  `protobuf:"bytes,10,opt,name=internal,proto3" json:"internal,omitempty" form:"internal" uri:"internal"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *User) GetLevel() Level {
+	if x != nil {
+		return x.Level
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *User) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *User) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *User) GetManager() *User {
+	if x != nil {
+		return x.Manager
+	}
+	return nil
+}
+
+func (x *User) GetPriority() Level {
+	if x != nil {
+		return x.Priority
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *User) GetInternal() *User {
+	if x != nil {
+		return x.Internal
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler by marshaling x with protojson.Marshal,
+// except that:
+//   - the values of enums with the json_numeric option are marshaled as
+//     numbers rather than names,
+//   - the fields with the json_always option are emitted even if they are
+//     not populated, as null for fields with explicit presence,
+//   - the fields with the json_never option are never emitted.
+//
+// This applies to the fields of x only, and not to the fields of its nested
+// messages.
+func (x *User) MarshalJSON() ([]byte, error) {
+	b, err := protojson.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	{
+		b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		var override map[string]json.RawMessage
+		if err := json.Unmarshal(b, &override); err != nil {
+			return nil, err
+		}
+		for _, name := range []string{
+			"level",
+			"priority",
+		} {
+			if v, ok := override[name]; ok {
+				fields[name] = v
+			}
+		}
+	}
+	{
+		b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		var override map[string]json.RawMessage
+		if err := json.Unmarshal(b, &override); err != nil {
+			return nil, err
+		}
+		for _, name := range []string{
+			"retries",
+			"kind",
+			"tags",
+			"nickname",
+			"manager",
+		} {
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			if v, ok := override[name]; ok {
+				fields[name] = v
+			}
+		}
+	}
+	{
+		b, err := protojson.MarshalOptions{EmitUnpopulated: true, UseEnumNumbers: true}.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		var override map[string]json.RawMessage
+		if err := json.Unmarshal(b, &override); err != nil {
+			return nil, err
+		}
+		for _, name := range []string{
+			"level",
+		} {
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			if v, ok := override[name]; ok {
+				fields[name] = v
+			}
+		}
+	}
+	for _, name := range []string{
+		"nickname",
+		"manager",
+	} {
+		if _, ok := fields[name]; !ok {
+			fields[name] = json.RawMessage("null")
+		}
+	}
+	delete(fields, "password")
+	delete(fields, "internal")
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with
+// the protobuf JSON mapping.
+// Unknown fields in b are discarded.
+func (x *User) UnmarshalJSON(b []byte) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, x)
+}
+
+var File_cmd_protoc_gen_go_testdata_json_rules_rules_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDesc = "" +
+	"\n" +
+	"1cmd/protoc-gen-go/testdata/json/rules/rules.proto\x12\x19goproto.protoc.json.rules\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"\xe5\x03\n" +
+	"\x04User\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\aretries\x18\x02 \x01(\x05B\x04\x88\xf4\x18\x01R\aretries\x12 \n" +
+	"\bpassword\x18\x03 \x01(\tB\x04\x90\xf4\x18\x01R\bpassword\x12<\n" +
+	"\x05level\x18\x04 \x01(\x0e2 .goproto.protoc.json.rules.LevelB\x04\x88\xf4\x18\x01R\x05level\x129\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1f.goproto.protoc.json.rules.KindB\x04\x88\xf4\x18\x01R\x04kind\x12\x18\n" +
+	"\x04tags\x18\x06 \x03(\tB\x04\x88\xf4\x18\x01R\x04tags\x12%\n" +
+	"\bnickname\x18\a \x01(\tB\x04\x88\xf4\x18\x01H\x00R\bnickname\x88\x01\x01\x12?\n" +
+	"\amanager\x18\b \x01(\v2\x1f.goproto.protoc.json.rules.UserB\x04\x88\xf4\x18\x01R\amanager\x12<\n" +
+	"\bpriority\x18\t \x01(\x0e2 .goproto.protoc.json.rules.LevelR\bpriority\x12A\n" +
+	"\binternal\x18\n" +
+	" \x01(\v2\x1f.goproto.protoc.json.rules.UserB\x04\x90\xf4\x18\x01R\binternalB\v\n" +
+	"\t_nickname*4\n" +
+	"\x05Level\x12\x15\n" +
+	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\x01\x1a\x04\xf8\xf3\x18\x01*+\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tKIND_USER\x10\x01BBZ@google.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/rulesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_goTypes = []any{
+	(Level)(0),   // 0: goproto.protoc.json.rules.Level
+	(Kind)(0),    // 1: goproto.protoc.json.rules.Kind
+	(*User)(nil), // 2: goproto.protoc.json.rules.User
+}
+var file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.json.rules.User.level:type_name -> goproto.protoc.json.rules.Level
+	1, // 1: goproto.protoc.json.rules.User.kind:type_name -> goproto.protoc.json.rules.Kind
+	2, // 2: goproto.protoc.json.rules.User.manager:type_name -> goproto.protoc.json.rules.User
+	0, // 3: goproto.protoc.json.rules.User.priority:type_name -> goproto.protoc.json.rules.Level
+	2, // 4: goproto.protoc.json.rules.User.internal:type_name -> goproto.protoc.json.rules.User
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_init() }
+func file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_json_rules_rules_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_json_rules_rules_proto = out.File
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_json_rules_rules_proto_depIdxs = nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/json/strict/strict.proto
+
+package strict
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserName      string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" form:"user_name" uri:"user_name"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty" form:"options" uri:"options"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Request) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler by marshaling x with protojson.Marshal.
+func (x *Request) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with
+// the protobuf JSON mapping.
+// Unknown fields in b are reported as an error.
+func (x *Request) UnmarshalJSON(b []byte) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: false}.Unmarshal(b, x)
+}
+
+type Options struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verbose       bool                   `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty" form:"verbose" uri:"verbose"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Options) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+// MarshalJSON implements json.Marshaler by marshaling x with protojson.Marshal.
+func (x *Options) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by unmarshaling b into x with
+// the protobuf JSON mapping.
+// Unknown fields in b are reported as an error.
+func (x *Options) UnmarshalJSON(b []byte) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: false}.Unmarshal(b, x)
+}
+
+var File_cmd_protoc_gen_go_testdata_json_strict_strict_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDesc = "" +
+	"\n" +
+	"3cmd/protoc-gen-go/testdata/json/strict/strict.proto\x12\x1agoproto.protoc.json.strict\"e\n" +
+	"\aRequest\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12=\n" +
+	"\aoptions\x18\x02 \x01(\v2#.goproto.protoc.json.strict.OptionsR\aoptions\"#\n" +
+	"\aOptions\x12\x18\n" +
+	"\averbose\x18\x01 \x01(\bR\averboseBCZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/json/strictb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_goTypes = []any{
+	(*Request)(nil), // 0: goproto.protoc.json.strict.Request
+	(*Options)(nil), // 1: goproto.protoc.json.strict.Options
+}
+var file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.json.strict.Request.options:type_name -> goproto.protoc.json.strict.Options
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_init() }
+func file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_json_strict_strict_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_json_strict_strict_proto = out.File
+	file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_json_strict_strict_proto_depIdxs = nil
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/layout/pack/pack.proto
+
+package pack
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Packed interleaves small and large fields, which wastes space on padding
+// unless the struct fields are reordered.
+type Packed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	J     []int32                `protobuf:"varint,10,rep,packed,name=j,proto3" json:"j,omitempty" form:"j" uri:"j"`
+	F     string                 `protobuf:"bytes,6,opt,name=f,proto3" json:"f,omitempty" form:"f" uri:"f"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Packed_L
+	//	*Packed_M
+	Choice        isPacked_Choice `protobuf_oneof:"choice"`
+	B             int64           `protobuf:"varint,2,opt,name=b,proto3" json:"b,omitempty" form:"b" uri:"b"`
+	D             *Packed         `protobuf:"bytes,4,opt,name=d,proto3" json:"d,omitempty" form:"d" uri:"d"`
+	H             float64         `protobuf:"fixed64,8,opt,name=h,proto3" json:"h,omitempty" form:"h" uri:"h"`
+	G             int32           `protobuf:"varint,7,opt,name=g,proto3" json:"g,omitempty" form:"g" uri:"g"`
+	A             bool            `protobuf:"varint,1,opt,name=a,proto3" json:"a,omitempty" form:"a" uri:"a"`
+	C             bool            `protobuf:"varint,3,opt,name=c,proto3" json:"c,omitempty" form:"c" uri:"c"`
+	E             bool            `protobuf:"varint,5,opt,name=e,proto3" json:"e,omitempty" form:"e" uri:"e"`
+	I             bool            `protobuf:"varint,9,opt,name=i,proto3" json:"i,omitempty" form:"i" uri:"i"`
+	K             bool            `protobuf:"varint,11,opt,name=k,proto3" json:"k,omitempty" form:"k" uri:"k"`
+	N             bool            `protobuf:"varint,14,opt,name=n,proto3" json:"n,omitempty" form:"n" uri:"n"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packed) Reset() {
+	*x = Packed{}
+	mi := &file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packed) ProtoMessage() {}
+
+func (x *Packed) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packed.ProtoReflect.Descriptor instead.
+func (*Packed) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Packed) GetA() bool {
+	if x != nil {
+		return x.A
+	}
+	return false
+}
+
+func (x *Packed) GetB() int64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Packed) GetC() bool {
+	if x != nil {
+		return x.C
+	}
+	return false
+}
+
+func (x *Packed) GetD() *Packed {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+func (x *Packed) GetE() bool {
+	if x != nil {
+		return x.E
+	}
+	return false
+}
+
+func (x *Packed) GetF() string {
+	if x != nil {
+		return x.F
+	}
+	return ""
+}
+
+func (x *Packed) GetG() int32 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *Packed) GetH() float64 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *Packed) GetI() bool {
+	if x != nil {
+		return x.I
+	}
+	return false
+}
+
+func (x *Packed) GetJ() []int32 {
+	if x != nil {
+		return x.J
+	}
+	return nil
+}
+
+func (x *Packed) GetK() bool {
+	if x != nil {
+		return x.K
+	}
+	return false
+}
+
+func (x *Packed) GetChoice() isPacked_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Packed) GetL() int32 {
+	if x != nil {
+		if x, ok := x.Choice.(*Packed_L); ok {
+			return x.L
+		}
+	}
+	return 0
+}
+
+func (x *Packed) GetM() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Packed_M); ok {
+			return x.M
+		}
+	}
+	return ""
+}
+
+func (x *Packed) GetN() bool {
+	if x != nil {
+		return x.N
+	}
+	return false
+}
+
+type isPacked_Choice interface {
+	isPacked_Choice()
+}
+
+type Packed_L struct {
+	L int32 `protobuf:"varint,12,opt,name=l,proto3,oneof"`
+}
+
+type Packed_M struct {
+	M string `protobuf:"bytes,13,opt,name=m,proto3,oneof"`
+}
+
+func (*Packed_L) isPacked_Choice() {}
+
+func (*Packed_M) isPacked_Choice() {}
+
+var File_cmd_protoc_gen_go_testdata_layout_pack_pack_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDesc = "" +
+	"\n" +
+	"1cmd/protoc-gen-go/testdata/layout/pack/pack.proto\x12\x1agoproto.protoc.layout.pack\"\xfe\x01\n" +
+	"\x06Packed\x12\f\n" +
+	"\x01a\x18\x01 \x01(\bR\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\x03R\x01b\x12\f\n" +
+	"\x01c\x18\x03 \x01(\bR\x01c\x120\n" +
+	"\x01d\x18\x04 \x01(\v2\".goproto.protoc.layout.pack.PackedR\x01d\x12\f\n" +
+	"\x01e\x18\x05 \x01(\bR\x01e\x12\f\n" +
+	"\x01f\x18\x06 \x01(\tR\x01f\x12\f\n" +
+	"\x01g\x18\a \x01(\x05R\x01g\x12\f\n" +
+	"\x01h\x18\b \x01(\x01R\x01h\x12\f\n" +
+	"\x01i\x18\t \x01(\bR\x01i\x12\f\n" +
+	"\x01j\x18\n" +
+	" \x03(\x05R\x01j\x12\f\n" +
+	"\x01k\x18\v \x01(\bR\x01k\x12\x0e\n" +
+	"\x01l\x18\f \x01(\x05H\x00R\x01l\x12\x0e\n" +
+	"\x01m\x18\r \x01(\tH\x00R\x01m\x12\f\n" +
+	"\x01n\x18\x0e \x01(\bR\x01nB\b\n" +
+	"\x06choiceBCZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/layout/packb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_goTypes = []any{
+	(*Packed)(nil), // 0: goproto.protoc.layout.pack.Packed
+}
+var file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.layout.pack.Packed.d:type_name -> goproto.protoc.layout.pack.Packed
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_init() }
+func file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_layout_pack_pack_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_msgTypes[0].OneofWrappers = []any{
+		(*Packed_L)(nil),
+		(*Packed_M)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_layout_pack_pack_proto = out.File
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_layout_pack_pack_proto_depIdxs = nil
+}
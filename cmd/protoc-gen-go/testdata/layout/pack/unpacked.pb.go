@@ -0,0 +1,272 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/layout/pack/unpacked.proto
+
+package pack
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Unpacked interleaves small and large fields, which wastes space on padding
+// unless the struct fields are reordered.
+type Unpacked struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	A     bool                   `protobuf:"varint,1,opt,name=a,proto3" json:"a,omitempty" form:"a" uri:"a"`
+	B     int64                  `protobuf:"varint,2,opt,name=b,proto3" json:"b,omitempty" form:"b" uri:"b"`
+	C     bool                   `protobuf:"varint,3,opt,name=c,proto3" json:"c,omitempty" form:"c" uri:"c"`
+	D     *Unpacked              `protobuf:"bytes,4,opt,name=d,proto3" json:"d,omitempty" form:"d" uri:"d"`
+	E     bool                   `protobuf:"varint,5,opt,name=e,proto3" json:"e,omitempty" form:"e" uri:"e"`
+	F     string                 `protobuf:"bytes,6,opt,name=f,proto3" json:"f,omitempty" form:"f" uri:"f"`
+	G     int32                  `protobuf:"varint,7,opt,name=g,proto3" json:"g,omitempty" form:"g" uri:"g"`
+	H     float64                `protobuf:"fixed64,8,opt,name=h,proto3" json:"h,omitempty" form:"h" uri:"h"`
+	I     bool                   `protobuf:"varint,9,opt,name=i,proto3" json:"i,omitempty" form:"i" uri:"i"`
+	J     []int32                `protobuf:"varint,10,rep,packed,name=j,proto3" json:"j,omitempty" form:"j" uri:"j"`
+	K     bool                   `protobuf:"varint,11,opt,name=k,proto3" json:"k,omitempty" form:"k" uri:"k"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Unpacked_L
+	//	*Unpacked_M
+	Choice        isUnpacked_Choice `protobuf_oneof:"choice"`
+	N             bool              `protobuf:"varint,14,opt,name=n,proto3" json:"n,omitempty" form:"n" uri:"n"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unpacked) Reset() {
+	*x = Unpacked{}
+	mi := &file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unpacked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unpacked) ProtoMessage() {}
+
+func (x *Unpacked) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unpacked.ProtoReflect.Descriptor instead.
+func (*Unpacked) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Unpacked) GetA() bool {
+	if x != nil {
+		return x.A
+	}
+	return false
+}
+
+func (x *Unpacked) GetB() int64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Unpacked) GetC() bool {
+	if x != nil {
+		return x.C
+	}
+	return false
+}
+
+func (x *Unpacked) GetD() *Unpacked {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+func (x *Unpacked) GetE() bool {
+	if x != nil {
+		return x.E
+	}
+	return false
+}
+
+func (x *Unpacked) GetF() string {
+	if x != nil {
+		return x.F
+	}
+	return ""
+}
+
+func (x *Unpacked) GetG() int32 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *Unpacked) GetH() float64 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *Unpacked) GetI() bool {
+	if x != nil {
+		return x.I
+	}
+	return false
+}
+
+func (x *Unpacked) GetJ() []int32 {
+	if x != nil {
+		return x.J
+	}
+	return nil
+}
+
+func (x *Unpacked) GetK() bool {
+	if x != nil {
+		return x.K
+	}
+	return false
+}
+
+func (x *Unpacked) GetChoice() isUnpacked_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Unpacked) GetL() int32 {
+	if x != nil {
+		if x, ok := x.Choice.(*Unpacked_L); ok {
+			return x.L
+		}
+	}
+	return 0
+}
+
+func (x *Unpacked) GetM() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Unpacked_M); ok {
+			return x.M
+		}
+	}
+	return ""
+}
+
+func (x *Unpacked) GetN() bool {
+	if x != nil {
+		return x.N
+	}
+	return false
+}
+
+type isUnpacked_Choice interface {
+	isUnpacked_Choice()
+}
+
+type Unpacked_L struct {
+	L int32 `protobuf:"varint,12,opt,name=l,proto3,oneof"`
+}
+
+type Unpacked_M struct {
+	M string `protobuf:"bytes,13,opt,name=m,proto3,oneof"`
+}
+
+func (*Unpacked_L) isUnpacked_Choice() {}
+
+func (*Unpacked_M) isUnpacked_Choice() {}
+
+var File_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDesc = "" +
+	"\n" +
+	"5cmd/protoc-gen-go/testdata/layout/pack/unpacked.proto\x12\x1agoproto.protoc.layout.pack\"\x82\x02\n" +
+	"\bUnpacked\x12\f\n" +
+	"\x01a\x18\x01 \x01(\bR\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\x03R\x01b\x12\f\n" +
+	"\x01c\x18\x03 \x01(\bR\x01c\x122\n" +
+	"\x01d\x18\x04 \x01(\v2$.goproto.protoc.layout.pack.UnpackedR\x01d\x12\f\n" +
+	"\x01e\x18\x05 \x01(\bR\x01e\x12\f\n" +
+	"\x01f\x18\x06 \x01(\tR\x01f\x12\f\n" +
+	"\x01g\x18\a \x01(\x05R\x01g\x12\f\n" +
+	"\x01h\x18\b \x01(\x01R\x01h\x12\f\n" +
+	"\x01i\x18\t \x01(\bR\x01i\x12\f\n" +
+	"\x01j\x18\n" +
+	" \x03(\x05R\x01j\x12\f\n" +
+	"\x01k\x18\v \x01(\bR\x01k\x12\x0e\n" +
+	"\x01l\x18\f \x01(\x05H\x00R\x01l\x12\x0e\n" +
+	"\x01m\x18\r \x01(\tH\x00R\x01m\x12\f\n" +
+	"\x01n\x18\x0e \x01(\bR\x01nB\b\n" +
+	"\x06choiceBCZAgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/layout/packb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_goTypes = []any{
+	(*Unpacked)(nil), // 0: goproto.protoc.layout.pack.Unpacked
+}
+var file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.layout.pack.Unpacked.d:type_name -> goproto.protoc.layout.pack.Unpacked
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_init() }
+func file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_msgTypes[0].OneofWrappers = []any{
+		(*Unpacked_L)(nil),
+		(*Unpacked_M)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto = out.File
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_layout_pack_unpacked_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/maps/jsonnames/jsonnames.proto
+
+package jsonnames
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Account struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName    string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty" form:"display_name" uri:"display_name"`
+	AccountId      int64                  `protobuf:"varint,2,opt,name=account_id,json=id,proto3" json:"account_id,omitempty" form:"account_id" uri:"account_id"`
+	EmailAddresses []string               `protobuf:"bytes,3,rep,name=email_addresses,json=emailAddresses,proto3" json:"email_addresses,omitempty" form:"email_addresses" uri:"email_addresses"`
+	// Types that are valid to be assigned to Login:
+	//
+	//	*Account_UserName
+	//	*Account_SsoToken
+	Login         isAccount_Login `protobuf_oneof:"login"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Account) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Account) GetEmailAddresses() []string {
+	if x != nil {
+		return x.EmailAddresses
+	}
+	return nil
+}
+
+func (x *Account) GetLogin() isAccount_Login {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+func (x *Account) GetUserName() string {
+	if x != nil {
+		if x, ok := x.Login.(*Account_UserName); ok {
+			return x.UserName
+		}
+	}
+	return ""
+}
+
+func (x *Account) GetSsoToken() string {
+	if x != nil {
+		if x, ok := x.Login.(*Account_SsoToken); ok {
+			return x.SsoToken
+		}
+	}
+	return ""
+}
+
+type isAccount_Login interface {
+	isAccount_Login()
+}
+
+type Account_UserName struct {
+	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof"`
+}
+
+type Account_SsoToken struct {
+	SsoToken string `protobuf:"bytes,5,opt,name=sso_token,json=SSO,proto3,oneof"`
+}
+
+func (*Account_UserName) isAccount_Login() {}
+
+func (*Account_SsoToken) isAccount_Login() {}
+
+// Account_jsonNames maps the name of each field of Account to its JSON name.
+var Account_jsonNames = map[protoreflect.Name]string{
+	"display_name":    "displayName",
+	"account_id":      "id",
+	"email_addresses": "emailAddresses",
+	"user_name":       "userName",
+	"sso_token":       "SSO",
+}
+
+var File_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/maps/jsonnames/jsonnames.proto\x12\x1dgoproto.protoc.maps.jsonnames\"\xaf\x01\n" +
+	"\aAccount\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\x02id\x12'\n" +
+	"\x0femail_addresses\x18\x03 \x03(\tR\x0eemailAddresses\x12\x1d\n" +
+	"\tuser_name\x18\x04 \x01(\tH\x00R\buserName\x12\x18\n" +
+	"\tsso_token\x18\x05 \x01(\tH\x00R\x03SSOB\a\n" +
+	"\x05loginBFZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/maps/jsonnamesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_goTypes = []any{
+	(*Account)(nil), // 0: goproto.protoc.maps.jsonnames.Account
+}
+var file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_init() }
+func file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_UserName)(nil),
+		(*Account_SsoToken)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto = out.File
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_maps_jsonnames_jsonnames_proto_depIdxs = nil
+}
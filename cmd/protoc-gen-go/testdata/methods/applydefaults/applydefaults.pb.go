@@ -0,0 +1,290 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/applydefaults/applydefaults.proto
+
+package applydefaults
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config_Mode int32
+
+const (
+	Config_MODE_UNKNOWN Config_Mode = 0
+	Config_MODE_FAST    Config_Mode = 1
+	Config_MODE_SAFE    Config_Mode = 2
+)
+
+// Enum value maps for Config_Mode.
+var (
+	Config_Mode_name = map[int32]string{
+		0: "MODE_UNKNOWN",
+		1: "MODE_FAST",
+		2: "MODE_SAFE",
+	}
+	Config_Mode_value = map[string]int32{
+		"MODE_UNKNOWN": 0,
+		"MODE_FAST":    1,
+		"MODE_SAFE":    2,
+	}
+)
+
+func (x Config_Mode) Enum() *Config_Mode {
+	p := new(Config_Mode)
+	*p = x
+	return p
+}
+
+func (x Config_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Config_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_enumTypes[0].Descriptor()
+}
+
+func (Config_Mode) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_enumTypes[0]
+}
+
+func (x Config_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Config_Mode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Config_Mode(num)
+	return nil
+}
+
+// Deprecated: Use Config_Mode.Descriptor instead.
+func (Config_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Config struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    *string                `protobuf:"bytes,1,opt,name=name,def=default" json:"name,omitempty" form:"name" uri:"name"`
+	Mode    *Config_Mode           `protobuf:"varint,2,opt,name=mode,enum=goproto.protoc.methods.applydefaults.Config_Mode,def=2" json:"mode,omitempty" form:"mode" uri:"mode"`
+	Salt    []byte                 `protobuf:"bytes,3,opt,name=salt,def=\\001\\002" json:"salt,omitempty" form:"salt" uri:"salt"`
+	Retries *int32                 `protobuf:"varint,4,opt,name=retries,def=3" json:"retries,omitempty" form:"retries" uri:"retries"`
+	Comment *string                `protobuf:"bytes,5,opt,name=comment" json:"comment,omitempty" form:"comment" uri:"comment"` // no default, so left unset
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Config_Label
+	Choice        isConfig_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for Config fields.
+const (
+	Default_Config_Name    = string("default")
+	Default_Config_Mode    = Config_MODE_SAFE
+	Default_Config_Retries = int32(3)
+	Default_Config_Label   = string("label")
+)
+
+// Default values for Config fields.
+var (
+	Default_Config_Salt = []byte("\x01\x02")
+)
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return Default_Config_Name
+}
+
+func (x *Config) GetMode() Config_Mode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return Default_Config_Mode
+}
+
+func (x *Config) GetSalt() []byte {
+	if x != nil && x.Salt != nil {
+		return x.Salt
+	}
+	return append([]byte(nil), Default_Config_Salt...)
+}
+
+func (x *Config) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return Default_Config_Retries
+}
+
+func (x *Config) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *Config) GetChoice() isConfig_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Config) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Config_Label); ok {
+			return x.Label
+		}
+	}
+	return Default_Config_Label
+}
+
+type isConfig_Choice interface {
+	isConfig_Choice()
+}
+
+type Config_Label struct {
+	Label string `protobuf:"bytes,6,opt,name=label,oneof,def=label"`
+}
+
+func (*Config_Label) isConfig_Choice() {}
+
+// ApplyDefaults sets each unset field of x with a default value declared in
+// the .proto file to that value. Members of oneofs are left unset.
+func (x *Config) ApplyDefaults() {
+	if x == nil {
+		return
+	}
+	if x.Name == nil {
+		v := Default_Config_Name
+		x.Name = &v
+	}
+	if x.Mode == nil {
+		v := Default_Config_Mode
+		x.Mode = &v
+	}
+	if x.Salt == nil {
+		x.Salt = append([]byte(nil), Default_Config_Salt...)
+	}
+	if x.Retries == nil {
+		v := Default_Config_Retries
+		x.Retries = &v
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDesc = "" +
+	"\n" +
+	"Dcmd/protoc-gen-go/testdata/methods/applydefaults/applydefaults.proto\x12$goproto.protoc.methods.applydefaults\"\xad\x02\n" +
+	"\x06Config\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\t:\adefaultR\x04name\x12P\n" +
+	"\x04mode\x18\x02 \x01(\x0e21.goproto.protoc.methods.applydefaults.Config.Mode:\tMODE_SAFER\x04mode\x12\x1c\n" +
+	"\x04salt\x18\x03 \x01(\f:\b\\001\\002R\x04salt\x12\x1b\n" +
+	"\aretries\x18\x04 \x01(\x05:\x013R\aretries\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1d\n" +
+	"\x05label\x18\x06 \x01(\t:\x05labelH\x00R\x05label\"6\n" +
+	"\x04Mode\x12\x10\n" +
+	"\fMODE_UNKNOWN\x10\x00\x12\r\n" +
+	"\tMODE_FAST\x10\x01\x12\r\n" +
+	"\tMODE_SAFE\x10\x02B\b\n" +
+	"\x06choiceBMZKgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/applydefaults"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_goTypes = []any{
+	(Config_Mode)(0), // 0: goproto.protoc.methods.applydefaults.Config.Mode
+	(*Config)(nil),   // 1: goproto.protoc.methods.applydefaults.Config
+}
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.applydefaults.Config.mode:type_name -> goproto.protoc.methods.applydefaults.Config.Mode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_msgTypes[0].OneofWrappers = []any{
+		(*Config_Label)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_depIdxs = nil
+}
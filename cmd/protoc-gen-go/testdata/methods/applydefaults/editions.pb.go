@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/applydefaults/editions.proto
+
+//go:build !protoopaque
+
+package applydefaults
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type EditionsConfig struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,def=default" json:"name,omitempty" form:"name" uri:"name"`
+	Mode          *Config_Mode           `protobuf:"varint,2,opt,name=mode,enum=goproto.protoc.methods.applydefaults.Config_Mode,def=2" json:"mode,omitempty" form:"mode" uri:"mode"`
+	Salt          []byte                 `protobuf:"bytes,3,opt,name=salt,def=\\001\\002" json:"salt,omitempty" form:"salt" uri:"salt"`
+	Retries       *int32                 `protobuf:"varint,4,opt,name=retries" json:"retries,omitempty" form:"retries" uri:"retries"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for EditionsConfig fields.
+const (
+	Default_EditionsConfig_Name = string("default")
+	Default_EditionsConfig_Mode = Config_MODE_SAFE
+)
+
+// Default values for EditionsConfig fields.
+var (
+	Default_EditionsConfig_Salt = []byte("\x01\x02")
+)
+
+func (x *EditionsConfig) Reset() {
+	*x = EditionsConfig{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditionsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionsConfig) ProtoMessage() {}
+
+func (x *EditionsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EditionsConfig) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return Default_EditionsConfig_Name
+}
+
+func (x *EditionsConfig) GetMode() Config_Mode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return Default_EditionsConfig_Mode
+}
+
+func (x *EditionsConfig) GetSalt() []byte {
+	if x != nil && x.Salt != nil {
+		return x.Salt
+	}
+	return append([]byte(nil), Default_EditionsConfig_Salt...)
+}
+
+func (x *EditionsConfig) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *EditionsConfig) SetName(v string) {
+	x.Name = &v
+}
+
+func (x *EditionsConfig) SetMode(v Config_Mode) {
+	x.Mode = &v
+}
+
+func (x *EditionsConfig) SetSalt(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Salt = v
+}
+
+func (x *EditionsConfig) SetRetries(v int32) {
+	x.Retries = &v
+}
+
+func (x *EditionsConfig) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return x.Name != nil
+}
+
+func (x *EditionsConfig) HasMode() bool {
+	if x == nil {
+		return false
+	}
+	return x.Mode != nil
+}
+
+func (x *EditionsConfig) HasSalt() bool {
+	if x == nil {
+		return false
+	}
+	return x.Salt != nil
+}
+
+func (x *EditionsConfig) HasRetries() bool {
+	if x == nil {
+		return false
+	}
+	return x.Retries != nil
+}
+
+func (x *EditionsConfig) ClearName() {
+	x.Name = nil
+}
+
+func (x *EditionsConfig) ClearMode() {
+	x.Mode = nil
+}
+
+func (x *EditionsConfig) ClearSalt() {
+	x.Salt = nil
+}
+
+func (x *EditionsConfig) ClearRetries() {
+	x.Retries = nil
+}
+
+type EditionsConfig_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name    *string
+	Mode    *Config_Mode
+	Salt    []byte
+	Retries *int32
+}
+
+func (b0 EditionsConfig_builder) Build() *EditionsConfig {
+	m0 := &EditionsConfig{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Mode = b.Mode
+	x.Salt = b.Salt
+	x.Retries = b.Retries
+	return m0
+}
+
+// ApplyDefaults sets each unset field of x with a default value declared in
+// the .proto file to that value. Members of oneofs are left unset.
+func (x *EditionsConfig) ApplyDefaults() {
+	if x == nil {
+		return
+	}
+	if !x.HasName() {
+		x.SetName(Default_EditionsConfig_Name)
+	}
+	if !x.HasMode() {
+		x.SetMode(Default_EditionsConfig_Mode)
+	}
+	if !x.HasSalt() {
+		x.SetSalt(append([]byte(nil), Default_EditionsConfig_Salt...))
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_rawDesc = "" +
+	"\n" +
+	"?cmd/protoc-gen-go/testdata/methods/applydefaults/editions.proto\x12$goproto.protoc.methods.applydefaults\x1aDcmd/protoc-gen-go/testdata/methods/applydefaults/applydefaults.proto\x1a!google/protobuf/go_features.proto\"\xb7\x01\n" +
+	"\x0eEditionsConfig\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\t:\adefaultR\x04name\x12P\n" +
+	"\x04mode\x18\x02 \x01(\x0e21.goproto.protoc.methods.applydefaults.Config.Mode:\tMODE_SAFER\x04mode\x12\x1c\n" +
+	"\x04salt\x18\x03 \x01(\f:\b\\001\\002R\x04salt\x12\x18\n" +
+	"\aretries\x18\x04 \x01(\x05R\aretriesBUZKgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/applydefaults\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_goTypes = []any{
+	(*EditionsConfig)(nil), // 0: goproto.protoc.methods.applydefaults.EditionsConfig
+	(Config_Mode)(0),       // 1: goproto.protoc.methods.applydefaults.Config.Mode
+}
+var file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.applydefaults.EditionsConfig.mode:type_name -> goproto.protoc.methods.applydefaults.Config.Mode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_applydefaults_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_applydefaults_editions_proto_depIdxs = nil
+}
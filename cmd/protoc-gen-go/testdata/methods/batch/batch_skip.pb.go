@@ -0,0 +1,187 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/batch/batch_skip.proto
+
+package batch
+
+import (
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type SkippedRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty" form:"payload" uri:"payload"`
+	Values        []int32                `protobuf:"varint,3,rep,packed,name=values,proto3" json:"values,omitempty" form:"values" uri:"values"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedRecord) Reset() {
+	*x = SkippedRecord{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedRecord) ProtoMessage() {}
+
+func (x *SkippedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedRecord.ProtoReflect.Descriptor instead.
+func (*SkippedRecord) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SkippedRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkippedRecord) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SkippedRecord) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// MarshalSkippedRecordSlice returns the wire-format encoding of each message in xs.
+// The encodings share a single buffer and must not be appended to.
+// Nil elements of xs are skipped.
+func MarshalSkippedRecordSlice(xs []*SkippedRecord) ([][]byte, error) {
+	n := 0
+	for _, x := range xs {
+		if x == nil {
+			continue
+		}
+		n += proto.Size(x)
+	}
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	buf := make([]byte, 0, n)
+	out := make([][]byte, 0, len(xs))
+	for _, x := range xs {
+		if x == nil {
+			continue
+		}
+		start := len(buf)
+		var err error
+		if buf, err = opts.MarshalAppend(buf, x); err != nil {
+			return nil, err
+		}
+		out = append(out, buf[start:len(buf):len(buf)])
+	}
+	return out, nil
+}
+
+// MarshalSkippedRecordStream writes each message in xs to w, prefixed by its size
+// encoded as a varint. The output can be read back with protodelim.UnmarshalFrom.
+// Nil elements of xs are skipped.
+func MarshalSkippedRecordStream(w io.Writer, xs []*SkippedRecord) error {
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	var buf []byte
+	for _, x := range xs {
+		if x == nil {
+			continue
+		}
+		buf = protowire.AppendVarint(buf[:0], uint64(proto.Size(x)))
+		var err error
+		if buf, err = opts.MarshalAppend(buf, x); err != nil {
+			return err
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/methods/batch/batch_skip.proto\x12\x1cgoproto.protoc.methods.batch\"Q\n" +
+	"\rSkippedRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\x05R\x06valuesBEZCgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/batchb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_goTypes = []any{
+	(*SkippedRecord)(nil), // 0: goproto.protoc.methods.batch.SkippedRecord
+}
+var file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_batch_batch_skip_proto_depIdxs = nil
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/byjsonname/byjsonname.proto
+
+package byjsonname
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Signup struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserName  string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" form:"user_name" uri:"user_name"`
+	MaxItems  int32                  `protobuf:"varint,2,opt,name=max_items,json=limit,proto3" json:"max_items,omitempty" form:"max_items" uri:"max_items"`
+	Subscribe *bool                  `protobuf:"varint,3,opt,name=subscribe,proto3,oneof" json:"subscribe,omitempty" form:"subscribe" uri:"subscribe"`
+	Interests []string               `protobuf:"bytes,4,rep,name=interests,proto3" json:"interests,omitempty" form:"interests" uri:"interests"`
+	Referrer  *Signup                `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty" form:"referrer" uri:"referrer"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Signup_EmailAddress
+	//	*Signup_Phone
+	Contact       isSignup_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signup) Reset() {
+	*x = Signup{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signup) ProtoMessage() {}
+
+func (x *Signup) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signup.ProtoReflect.Descriptor instead.
+func (*Signup) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Signup) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Signup) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *Signup) GetSubscribe() bool {
+	if x != nil && x.Subscribe != nil {
+		return *x.Subscribe
+	}
+	return false
+}
+
+func (x *Signup) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+func (x *Signup) GetReferrer() *Signup {
+	if x != nil {
+		return x.Referrer
+	}
+	return nil
+}
+
+func (x *Signup) GetContact() isSignup_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Signup) GetEmailAddress() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Signup_EmailAddress); ok {
+			return x.EmailAddress
+		}
+	}
+	return ""
+}
+
+func (x *Signup) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Signup_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+type isSignup_Contact interface {
+	isSignup_Contact()
+}
+
+type Signup_EmailAddress struct {
+	EmailAddress string `protobuf:"bytes,6,opt,name=email_address,json=emailAddress,proto3,oneof"`
+}
+
+type Signup_Phone struct {
+	Phone string `protobuf:"bytes,7,opt,name=phone,proto3,oneof"`
+}
+
+func (*Signup_EmailAddress) isSignup_Contact() {}
+
+func (*Signup_Phone) isSignup_Contact() {}
+
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_Signup_fieldsByJSONName = map[string]protoreflect.FieldNumber{
+	"userName":     1,
+	"limit":        2,
+	"subscribe":    3,
+	"interests":    4,
+	"referrer":     5,
+	"emailAddress": 6,
+	"phone":        7,
+}
+
+// GetByJSONName returns the value of the field of x with the given JSON
+// name, as returned by its getter. For fields with explicit presence, the
+// value is that of the field, and not a pointer to it.
+func (x *Signup) GetByJSONName(name string) (any, error) {
+	switch file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_Signup_fieldsByJSONName[name] {
+	case 1:
+		return x.GetUserName(), nil
+	case 2:
+		return x.GetMaxItems(), nil
+	case 3:
+		return x.GetSubscribe(), nil
+	case 4:
+		return x.GetInterests(), nil
+	case 5:
+		return x.GetReferrer(), nil
+	case 6:
+		return x.GetEmailAddress(), nil
+	case 7:
+		return x.GetPhone(), nil
+	}
+	return nil, fmt.Errorf("unknown JSON name %q for message goproto.protoc.methods.byjsonname.Signup", name)
+}
+
+// SetByJSONName sets the field of x with the given JSON name to v, which must
+// have the Go type of the field. For fields with explicit presence, v is the
+// value of the field, and not a pointer to it. Setting a oneof member clears
+// the other members of the oneof.
+func (x *Signup) SetByJSONName(name string, v any) error {
+	switch file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_Signup_fieldsByJSONName[name] {
+	case 1:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.user_name", v)
+		}
+		x.UserName = t
+	case 2:
+		t, ok := v.(int32)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.max_items", v)
+		}
+		x.MaxItems = t
+	case 3:
+		t, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.subscribe", v)
+		}
+		x.Subscribe = &t
+	case 4:
+		t, ok := v.([]string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.interests", v)
+		}
+		x.Interests = t
+	case 5:
+		t, ok := v.(*Signup)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.referrer", v)
+		}
+		x.Referrer = t
+	case 6:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.email_address", v)
+		}
+		x.Contact = &Signup_EmailAddress{EmailAddress: t}
+	case 7:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Signup.phone", v)
+		}
+		x.Contact = &Signup_Phone{Phone: t}
+	default:
+		return fmt.Errorf("unknown JSON name %q for message goproto.protoc.methods.byjsonname.Signup", name)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/byjsonname/byjsonname.proto\x12!goproto.protoc.methods.byjsonname\"\x9f\x02\n" +
+	"\x06Signup\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x18\n" +
+	"\tmax_items\x18\x02 \x01(\x05R\x05limit\x12!\n" +
+	"\tsubscribe\x18\x03 \x01(\bH\x01R\tsubscribe\x88\x01\x01\x12\x1c\n" +
+	"\tinterests\x18\x04 \x03(\tR\tinterests\x12E\n" +
+	"\breferrer\x18\x05 \x01(\v2).goproto.protoc.methods.byjsonname.SignupR\breferrer\x12%\n" +
+	"\remail_address\x18\x06 \x01(\tH\x00R\femailAddress\x12\x16\n" +
+	"\x05phone\x18\a \x01(\tH\x00R\x05phoneB\t\n" +
+	"\acontactB\f\n" +
+	"\n" +
+	"_subscribeBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/byjsonnameb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_goTypes = []any{
+	(*Signup)(nil), // 0: goproto.protoc.methods.byjsonname.Signup
+}
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.byjsonname.Signup.referrer:type_name -> goproto.protoc.methods.byjsonname.Signup
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_msgTypes[0].OneofWrappers = []any{
+		(*Signup_EmailAddress)(nil),
+		(*Signup_Phone)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_byjsonname_proto_depIdxs = nil
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/byjsonname/hybrid.proto
+
+//go:build !protoopaque
+
+package byjsonname
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Profile struct {
+	state       protoimpl.MessageState `protogen:"hybrid.v1"`
+	DisplayName *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName" json:"display_name,omitempty" form:"display_name" uri:"display_name"`
+	AgeYears    *int64                 `protobuf:"varint,2,opt,name=age_years,json=age" json:"age_years,omitempty" form:"age_years" uri:"age_years"`
+	// Types that are valid to be assigned to Avatar:
+	//
+	//	*Profile_AvatarUrl
+	//	*Profile_AvatarPng
+	Avatar        isProfile_Avatar `protobuf_oneof:"avatar"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetAgeYears() int64 {
+	if x != nil && x.AgeYears != nil {
+		return *x.AgeYears
+	}
+	return 0
+}
+
+func (x *Profile) GetAvatar() isProfile_Avatar {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		if x, ok := x.Avatar.(*Profile_AvatarUrl); ok {
+			return x.AvatarUrl
+		}
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarPng() []byte {
+	if x != nil {
+		if x, ok := x.Avatar.(*Profile_AvatarPng); ok {
+			return x.AvatarPng
+		}
+	}
+	return nil
+}
+
+func (x *Profile) SetDisplayName(v string) {
+	x.DisplayName = &v
+}
+
+func (x *Profile) SetAgeYears(v int64) {
+	x.AgeYears = &v
+}
+
+func (x *Profile) SetAvatarUrl(v string) {
+	x.Avatar = &Profile_AvatarUrl{v}
+}
+
+func (x *Profile) SetAvatarPng(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Avatar = &Profile_AvatarPng{v}
+}
+
+func (x *Profile) HasDisplayName() bool {
+	if x == nil {
+		return false
+	}
+	return x.DisplayName != nil
+}
+
+func (x *Profile) HasAgeYears() bool {
+	if x == nil {
+		return false
+	}
+	return x.AgeYears != nil
+}
+
+func (x *Profile) HasAvatar() bool {
+	if x == nil {
+		return false
+	}
+	return x.Avatar != nil
+}
+
+func (x *Profile) HasAvatarUrl() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Avatar.(*Profile_AvatarUrl)
+	return ok
+}
+
+func (x *Profile) HasAvatarPng() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Avatar.(*Profile_AvatarPng)
+	return ok
+}
+
+func (x *Profile) ClearDisplayName() {
+	x.DisplayName = nil
+}
+
+func (x *Profile) ClearAgeYears() {
+	x.AgeYears = nil
+}
+
+func (x *Profile) ClearAvatar() {
+	x.Avatar = nil
+}
+
+func (x *Profile) ClearAvatarUrl() {
+	if _, ok := x.Avatar.(*Profile_AvatarUrl); ok {
+		x.Avatar = nil
+	}
+}
+
+func (x *Profile) ClearAvatarPng() {
+	if _, ok := x.Avatar.(*Profile_AvatarPng); ok {
+		x.Avatar = nil
+	}
+}
+
+const Profile_Avatar_not_set_case case_Profile_Avatar = 0
+const Profile_AvatarUrl_case case_Profile_Avatar = 3
+const Profile_AvatarPng_case case_Profile_Avatar = 4
+
+func (x *Profile) WhichAvatar() case_Profile_Avatar {
+	if x == nil {
+		return Profile_Avatar_not_set_case
+	}
+	switch x.Avatar.(type) {
+	case *Profile_AvatarUrl:
+		return Profile_AvatarUrl_case
+	case *Profile_AvatarPng:
+		return Profile_AvatarPng_case
+	default:
+		return Profile_Avatar_not_set_case
+	}
+}
+
+type Profile_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DisplayName *string
+	AgeYears    *int64
+	// Fields of oneof Avatar:
+	AvatarUrl *string
+	AvatarPng []byte
+	// -- end of Avatar
+}
+
+func (b0 Profile_builder) Build() *Profile {
+	m0 := &Profile{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DisplayName = b.DisplayName
+	x.AgeYears = b.AgeYears
+	if b.AvatarUrl != nil {
+		x.Avatar = &Profile_AvatarUrl{*b.AvatarUrl}
+	}
+	if b.AvatarPng != nil {
+		x.Avatar = &Profile_AvatarPng{b.AvatarPng}
+	}
+	return m0
+}
+
+type case_Profile_Avatar protoreflect.FieldNumber
+
+func (x case_Profile_Avatar) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isProfile_Avatar interface {
+	isProfile_Avatar()
+}
+
+type Profile_AvatarUrl struct {
+	AvatarUrl string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,oneof"`
+}
+
+type Profile_AvatarPng struct {
+	AvatarPng []byte `protobuf:"bytes,4,opt,name=avatar_png,json=avatarPng,oneof"`
+}
+
+func (*Profile_AvatarUrl) isProfile_Avatar() {}
+
+func (*Profile_AvatarPng) isProfile_Avatar() {}
+
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_Profile_fieldsByJSONName = map[string]protoreflect.FieldNumber{
+	"displayName": 1,
+	"age":         2,
+	"avatarUrl":   3,
+	"avatarPng":   4,
+}
+
+// GetByJSONName returns the value of the field of x with the given JSON
+// name, as returned by its getter. For fields with explicit presence, the
+// value is that of the field, and not a pointer to it.
+func (x *Profile) GetByJSONName(name string) (any, error) {
+	switch file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_Profile_fieldsByJSONName[name] {
+	case 1:
+		return x.GetDisplayName(), nil
+	case 2:
+		return x.GetAgeYears(), nil
+	case 3:
+		return x.GetAvatarUrl(), nil
+	case 4:
+		return x.GetAvatarPng(), nil
+	}
+	return nil, fmt.Errorf("unknown JSON name %q for message goproto.protoc.methods.byjsonname.Profile", name)
+}
+
+// SetByJSONName sets the field of x with the given JSON name to v, which must
+// have the Go type of the field. For fields with explicit presence, v is the
+// value of the field, and not a pointer to it. Setting a oneof member clears
+// the other members of the oneof.
+func (x *Profile) SetByJSONName(name string, v any) error {
+	switch file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_Profile_fieldsByJSONName[name] {
+	case 1:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Profile.display_name", v)
+		}
+		x.SetDisplayName(t)
+	case 2:
+		t, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Profile.age_years", v)
+		}
+		x.SetAgeYears(t)
+	case 3:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Profile.avatar_url", v)
+		}
+		x.SetAvatarUrl(t)
+	case 4:
+		t, ok := v.([]byte)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.byjsonname.Profile.avatar_png", v)
+		}
+		x.SetAvatarPng(t)
+	default:
+		return fmt.Errorf("unknown JSON name %q for message goproto.protoc.methods.byjsonname.Profile", name)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/byjsonname/hybrid.proto\x12!goproto.protoc.methods.byjsonname\x1a!google/protobuf/go_features.proto\"\x90\x01\n" +
+	"\aProfile\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\tage_years\x18\x02 \x01(\x03R\x03age\x12\x1f\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tH\x00R\tavatarUrl\x12\x1f\n" +
+	"\n" +
+	"avatar_png\x18\x04 \x01(\fH\x00R\tavatarPngB\b\n" +
+	"\x06avatarBRZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/byjsonname\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_goTypes = []any{
+	(*Profile)(nil), // 0: goproto.protoc.methods.byjsonname.Profile
+}
+var file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_AvatarUrl)(nil),
+		(*Profile_AvatarPng)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_byjsonname_hybrid_proto_depIdxs = nil
+}
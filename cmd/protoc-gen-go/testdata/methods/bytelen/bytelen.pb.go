@@ -0,0 +1,370 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/bytelen/bytelen.proto
+
+package bytelen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Upload struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	Name     string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Tags     []string                `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	First    *Upload_Chunk           `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty" form:"first" uri:"first"`
+	Chunks   []*Upload_Chunk         `protobuf:"bytes,4,rep,name=chunks,proto3" json:"chunks,omitempty" form:"chunks" uri:"chunks"`
+	Metadata map[string]string       `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" form:"metadata" uri:"metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ByIndex  map[int32]*Upload_Chunk `protobuf:"bytes,6,rep,name=by_index,json=byIndex,proto3" json:"by_index,omitempty" form:"by_index" uri:"by_index" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sizes    map[int32]int64         `protobuf:"bytes,7,rep,name=sizes,proto3" json:"sizes,omitempty" form:"sizes" uri:"sizes" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // no string or bytes values
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Upload_Url
+	//	*Upload_Inline
+	Source        isUpload_Source `protobuf_oneof:"source"`
+	Size          int64           `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty" form:"size" uri:"size"`
+	Title         *string         `protobuf:"bytes,11,opt,name=title,proto3,oneof" json:"title,omitempty" form:"title" uri:"title"`
+	Checksum      []byte          `protobuf:"bytes,12,opt,name=checksum,proto3,oneof" json:"checksum,omitempty" form:"checksum" uri:"checksum"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upload) Reset() {
+	*x = Upload{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upload) ProtoMessage() {}
+
+func (x *Upload) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upload.ProtoReflect.Descriptor instead.
+func (*Upload) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Upload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Upload) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Upload) GetFirst() *Upload_Chunk {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *Upload) GetChunks() []*Upload_Chunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *Upload) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Upload) GetByIndex() map[int32]*Upload_Chunk {
+	if x != nil {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (x *Upload) GetSizes() map[int32]int64 {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *Upload) GetSource() isUpload_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Upload) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*Upload_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *Upload) GetInline() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*Upload_Inline); ok {
+			return x.Inline
+		}
+	}
+	return nil
+}
+
+func (x *Upload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Upload) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Upload) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+type isUpload_Source interface {
+	isUpload_Source()
+}
+
+type Upload_Url struct {
+	Url string `protobuf:"bytes,8,opt,name=url,proto3,oneof"`
+}
+
+type Upload_Inline struct {
+	Inline []byte `protobuf:"bytes,9,opt,name=inline,proto3,oneof"`
+}
+
+func (*Upload_Url) isUpload_Source() {}
+
+func (*Upload_Inline) isUpload_Source() {}
+
+// TotalStringBytes returns the total length in bytes of the values of the
+// string and bytes fields of x, including the elements of repeated fields,
+// the keys and values of map fields, and the fields of nested messages.
+func (x *Upload) TotalStringBytes() int {
+	if x == nil {
+		return 0
+	}
+	n := 0
+	n += len(x.GetName())
+	for _, v := range x.Tags {
+		n += len(v)
+	}
+	n += x.First.TotalStringBytes()
+	for _, v := range x.Chunks {
+		n += v.TotalStringBytes()
+	}
+	for k, v := range x.Metadata {
+		n += len(k)
+		n += len(v)
+	}
+	for _, v := range x.ByIndex {
+		n += v.TotalStringBytes()
+	}
+	n += len(x.GetUrl())
+	n += len(x.GetInline())
+	n += len(x.GetTitle())
+	n += len(x.GetChecksum())
+	return n
+}
+
+type Upload_Chunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty" form:"data" uri:"data"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty" form:"offset" uri:"offset"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upload_Chunk) Reset() {
+	*x = Upload_Chunk{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upload_Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upload_Chunk) ProtoMessage() {}
+
+func (x *Upload_Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upload_Chunk.ProtoReflect.Descriptor instead.
+func (*Upload_Chunk) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Upload_Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Upload_Chunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// TotalStringBytes returns the total length in bytes of the values of the
+// string and bytes fields of x, including the elements of repeated fields,
+// the keys and values of map fields, and the fields of nested messages.
+func (x *Upload_Chunk) TotalStringBytes() int {
+	if x == nil {
+		return 0
+	}
+	n := 0
+	n += len(x.GetData())
+	return n
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/bytelen/bytelen.proto\x12\x1egoproto.protoc.methods.bytelen\"\xda\x06\n" +
+	"\x06Upload\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12B\n" +
+	"\x05first\x18\x03 \x01(\v2,.goproto.protoc.methods.bytelen.Upload.ChunkR\x05first\x12D\n" +
+	"\x06chunks\x18\x04 \x03(\v2,.goproto.protoc.methods.bytelen.Upload.ChunkR\x06chunks\x12P\n" +
+	"\bmetadata\x18\x05 \x03(\v24.goproto.protoc.methods.bytelen.Upload.MetadataEntryR\bmetadata\x12N\n" +
+	"\bby_index\x18\x06 \x03(\v23.goproto.protoc.methods.bytelen.Upload.ByIndexEntryR\abyIndex\x12G\n" +
+	"\x05sizes\x18\a \x03(\v21.goproto.protoc.methods.bytelen.Upload.SizesEntryR\x05sizes\x12\x12\n" +
+	"\x03url\x18\b \x01(\tH\x00R\x03url\x12\x18\n" +
+	"\x06inline\x18\t \x01(\fH\x00R\x06inline\x12\x12\n" +
+	"\x04size\x18\n" +
+	" \x01(\x03R\x04size\x12\x19\n" +
+	"\x05title\x18\v \x01(\tH\x01R\x05title\x88\x01\x01\x12\x1f\n" +
+	"\bchecksum\x18\f \x01(\fH\x02R\bchecksum\x88\x01\x01\x1a3\n" +
+	"\x05Chunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ah\n" +
+	"\fByIndexEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.goproto.protoc.methods.bytelen.Upload.ChunkR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"SizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\b\n" +
+	"\x06sourceB\b\n" +
+	"\x06_titleB\v\n" +
+	"\t_checksumBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/bytelenb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_goTypes = []any{
+	(*Upload)(nil),       // 0: goproto.protoc.methods.bytelen.Upload
+	(*Upload_Chunk)(nil), // 1: goproto.protoc.methods.bytelen.Upload.Chunk
+	nil,                  // 2: goproto.protoc.methods.bytelen.Upload.MetadataEntry
+	nil,                  // 3: goproto.protoc.methods.bytelen.Upload.ByIndexEntry
+	nil,                  // 4: goproto.protoc.methods.bytelen.Upload.SizesEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.bytelen.Upload.first:type_name -> goproto.protoc.methods.bytelen.Upload.Chunk
+	1, // 1: goproto.protoc.methods.bytelen.Upload.chunks:type_name -> goproto.protoc.methods.bytelen.Upload.Chunk
+	2, // 2: goproto.protoc.methods.bytelen.Upload.metadata:type_name -> goproto.protoc.methods.bytelen.Upload.MetadataEntry
+	3, // 3: goproto.protoc.methods.bytelen.Upload.by_index:type_name -> goproto.protoc.methods.bytelen.Upload.ByIndexEntry
+	4, // 4: goproto.protoc.methods.bytelen.Upload.sizes:type_name -> goproto.protoc.methods.bytelen.Upload.SizesEntry
+	1, // 5: goproto.protoc.methods.bytelen.Upload.ByIndexEntry.value:type_name -> goproto.protoc.methods.bytelen.Upload.Chunk
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes[0].OneofWrappers = []any{
+		(*Upload_Url)(nil),
+		(*Upload_Inline)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_bytelen_bytelen_proto_depIdxs = nil
+}
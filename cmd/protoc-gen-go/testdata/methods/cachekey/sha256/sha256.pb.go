@@ -0,0 +1,165 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/cachekey/sha256/sha256.proto
+
+package sha256
+
+import (
+	sha256 "crypto/sha256"
+	base64 "encoding/base64"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Query struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty" form:"table" uri:"table"`
+	Filters       map[string]string      `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" form:"filters" uri:"filters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty" form:"columns" uri:"columns"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty" form:"limit" uri:"limit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Query) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Query) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *Query) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Query) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// CacheKey returns the unpadded URL-safe base64 encoding of the SHA-256 hash
+// of the deterministic wire-format encoding of x.
+// Equal messages have the same key when generated by the same binary, even
+// if their map fields were populated in different orders. It returns the
+// empty string if x cannot be marshaled.
+func (x *Query) CacheKey() string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDesc = "" +
+	"\n" +
+	"?cmd/protoc-gen-go/testdata/methods/cachekey/sha256/sha256.proto\x12&goproto.protoc.methods.cachekey.sha256\"\xdf\x01\n" +
+	"\x05Query\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12T\n" +
+	"\afilters\x18\x02 \x03(\v2:.goproto.protoc.methods.cachekey.sha256.Query.FiltersEntryR\afilters\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BOZMgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cachekey/sha256b\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_goTypes = []any{
+	(*Query)(nil), // 0: goproto.protoc.methods.cachekey.sha256.Query
+	nil,           // 1: goproto.protoc.methods.cachekey.sha256.Query.FiltersEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.cachekey.sha256.Query.filters:type_name -> goproto.protoc.methods.cachekey.sha256.Query.FiltersEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_cachekey_sha256_sha256_proto_depIdxs = nil
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/clearkind/clearkind.proto
+
+package clearkind
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Profile struct {
+	state    protoimpl.MessageState     `protogen:"open.v1"`
+	Name     string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Id       int64                      `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Aliases  []string                   `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty" form:"aliases" uri:"aliases"`
+	Home     *Profile_Address           `protobuf:"bytes,4,opt,name=home,proto3" json:"home,omitempty" form:"home" uri:"home"`
+	Previous []*Profile_Address         `protobuf:"bytes,5,rep,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Labels   map[string]string          `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ByFloor  map[int32]*Profile_Address `protobuf:"bytes,7,rep,name=by_floor,json=byFloor,proto3" json:"by_floor,omitempty" form:"by_floor" uri:"by_floor" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Profile_Email
+	//	*Profile_Mailing
+	Contact       isProfile_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Profile) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Profile) GetHome() *Profile_Address {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *Profile) GetPrevious() []*Profile_Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Profile) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Profile) GetByFloor() map[int32]*Profile_Address {
+	if x != nil {
+		return x.ByFloor
+	}
+	return nil
+}
+
+func (x *Profile) GetContact() isProfile_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Profile) GetMailing() *Profile_Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+type isProfile_Contact interface {
+	isProfile_Contact()
+}
+
+type Profile_Email struct {
+	Email string `protobuf:"bytes,8,opt,name=email,proto3,oneof"`
+}
+
+type Profile_Mailing struct {
+	Mailing *Profile_Address `protobuf:"bytes,9,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Profile_Email) isProfile_Contact() {}
+
+func (*Profile_Mailing) isProfile_Contact() {}
+
+// ClearKind clears every field of x with values of kind k, recursing into the
+// message values of the remaining fields. A repeated field is cleared if
+// its elements have kind k, and a map field if its values have kind k.
+func (x *Profile) ClearKind(k protoreflect.Kind) {
+	if x == nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(x.ProtoReflect(), k)
+}
+
+type Profile_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty" form:"number" uri:"number"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile_Address) Reset() {
+	*x = Profile_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile_Address) ProtoMessage() {}
+
+func (x *Profile_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile_Address.ProtoReflect.Descriptor instead.
+func (*Profile_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Profile_Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Profile_Address) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+// ClearKind clears every field of x with values of kind k, recursing into the
+// message values of the remaining fields. A repeated field is cleared if
+// its elements have kind k, and a map field if its values have kind k.
+func (x *Profile_Address) ClearKind(k protoreflect.Kind) {
+	if x == nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(x.ProtoReflect(), k)
+}
+
+func file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(m protoreflect.Message, k protoreflect.Kind) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		kind := fd.Kind()
+		if fd.IsMap() {
+			kind = fd.MapValue().Kind()
+		}
+		switch {
+		case kind == k:
+			m.Clear(fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(v.Message(), k)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i, l := 0, v.List(); i < l.Len(); i++ {
+					file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(l.Get(i).Message(), k)
+				}
+			}
+		case fd.Message() != nil:
+			file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_clearKind(v.Message(), k)
+		}
+		return true
+	})
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/clearkind/clearkind.proto\x12 goproto.protoc.methods.clearkind\"\xd6\x05\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12E\n" +
+	"\x04home\x18\x04 \x01(\v21.goproto.protoc.methods.clearkind.Profile.AddressR\x04home\x12M\n" +
+	"\bprevious\x18\x05 \x03(\v21.goproto.protoc.methods.clearkind.Profile.AddressR\bprevious\x12M\n" +
+	"\x06labels\x18\x06 \x03(\v25.goproto.protoc.methods.clearkind.Profile.LabelsEntryR\x06labels\x12Q\n" +
+	"\bby_floor\x18\a \x03(\v26.goproto.protoc.methods.clearkind.Profile.ByFloorEntryR\abyFloor\x12\x16\n" +
+	"\x05email\x18\b \x01(\tH\x00R\x05email\x12M\n" +
+	"\amailing\x18\t \x01(\v21.goproto.protoc.methods.clearkind.Profile.AddressH\x00R\amailing\x1a9\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1am\n" +
+	"\fByFloorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12G\n" +
+	"\x05value\x18\x02 \x01(\v21.goproto.protoc.methods.clearkind.Profile.AddressR\x05value:\x028\x01B\t\n" +
+	"\acontactBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearkindb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_goTypes = []any{
+	(*Profile)(nil),         // 0: goproto.protoc.methods.clearkind.Profile
+	(*Profile_Address)(nil), // 1: goproto.protoc.methods.clearkind.Profile.Address
+	nil,                     // 2: goproto.protoc.methods.clearkind.Profile.LabelsEntry
+	nil,                     // 3: goproto.protoc.methods.clearkind.Profile.ByFloorEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.clearkind.Profile.home:type_name -> goproto.protoc.methods.clearkind.Profile.Address
+	1, // 1: goproto.protoc.methods.clearkind.Profile.previous:type_name -> goproto.protoc.methods.clearkind.Profile.Address
+	2, // 2: goproto.protoc.methods.clearkind.Profile.labels:type_name -> goproto.protoc.methods.clearkind.Profile.LabelsEntry
+	3, // 3: goproto.protoc.methods.clearkind.Profile.by_floor:type_name -> goproto.protoc.methods.clearkind.Profile.ByFloorEntry
+	1, // 4: goproto.protoc.methods.clearkind.Profile.mailing:type_name -> goproto.protoc.methods.clearkind.Profile.Address
+	1, // 5: goproto.protoc.methods.clearkind.Profile.ByFloorEntry.value:type_name -> goproto.protoc.methods.clearkind.Profile.Address
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_Email)(nil),
+		(*Profile_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_clearkind_clearkind_proto_depIdxs = nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/clearpaths/clearpaths.proto
+
+package clearpaths
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strings "strings"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type User struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty" form:"email" uri:"email"`
+	Address           *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	Phones            []string               `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty" form:"phones" uri:"phones"`
+	Labels            map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PreviousAddresses []*Address             `protobuf:"bytes,6,rep,name=previous_addresses,json=previousAddresses,proto3" json:"previous_addresses,omitempty" form:"previous_addresses" uri:"previous_addresses"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *User) GetPhones() []string {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *User) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *User) GetPreviousAddresses() []*Address {
+	if x != nil {
+		return x.PreviousAddresses
+	}
+	return nil
+}
+
+// ClearPaths clears the fields of x addressed by each of the paths, which are
+// dot-separated lists of field names. Every component but the last must name a
+// singular message field, and a path ending at a repeated or map field clears
+// the whole field. ClearPaths reports an error and leaves x unmodified if any
+// of the paths is invalid.
+func (x *User) ClearPaths(paths ...string) error {
+	return file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_clearPaths(x.ProtoReflect(), paths)
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// ClearPaths clears the fields of x addressed by each of the paths, which are
+// dot-separated lists of field names. Every component but the last must name a
+// singular message field, and a path ending at a repeated or map field clears
+// the whole field. ClearPaths reports an error and leaves x unmodified if any
+// of the paths is invalid.
+func (x *Address) ClearPaths(paths ...string) error {
+	return file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_clearPaths(x.ProtoReflect(), paths)
+}
+
+func file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_clearPaths(m protoreflect.Message, paths []string) error {
+	// Resolve all of the paths before clearing any of the fields.
+	md := m.Descriptor()
+	resolved := make([][]protoreflect.FieldDescriptor, len(paths))
+	for i, path := range paths {
+		var parent protoreflect.FieldDescriptor
+		d := md
+		for _, name := range strings.Split(path, ".") {
+			if d == nil {
+				return fmt.Errorf("invalid path %q for message %v: %v is not a singular message field", path, md.FullName(), parent.FullName())
+			}
+			fd := d.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return fmt.Errorf("invalid path %q for message %v: no field %q in %v", path, md.FullName(), name, d.FullName())
+			}
+			resolved[i] = append(resolved[i], fd)
+			parent, d = fd, nil
+			if fd.Message() != nil && fd.Cardinality() != protoreflect.Repeated {
+				d = fd.Message()
+			}
+		}
+	}
+
+next:
+	for _, fds := range resolved {
+		m := m
+		for _, fd := range fds[:len(fds)-1] {
+			if !m.Has(fd) {
+				continue next // nothing to clear
+			}
+			m = m.Get(fd).Message()
+		}
+		m.Clear(fds[len(fds)-1])
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/clearpaths/clearpaths.proto\x12!goproto.protoc.methods.clearpaths\"\xf1\x02\n" +
+	"\x04User\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12D\n" +
+	"\aaddress\x18\x03 \x01(\v2*.goproto.protoc.methods.clearpaths.AddressR\aaddress\x12\x16\n" +
+	"\x06phones\x18\x04 \x03(\tR\x06phones\x12K\n" +
+	"\x06labels\x18\x05 \x03(\v23.goproto.protoc.methods.clearpaths.User.LabelsEntryR\x06labels\x12Y\n" +
+	"\x12previous_addresses\x18\x06 \x03(\v2*.goproto.protoc.methods.clearpaths.AddressR\x11previousAddresses\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04cityBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/clearpathsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_goTypes = []any{
+	(*User)(nil),    // 0: goproto.protoc.methods.clearpaths.User
+	(*Address)(nil), // 1: goproto.protoc.methods.clearpaths.Address
+	nil,             // 2: goproto.protoc.methods.clearpaths.User.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.clearpaths.User.address:type_name -> goproto.protoc.methods.clearpaths.Address
+	2, // 1: goproto.protoc.methods.clearpaths.User.labels:type_name -> goproto.protoc.methods.clearpaths.User.LabelsEntry
+	1, // 2: goproto.protoc.methods.clearpaths.User.previous_addresses:type_name -> goproto.protoc.methods.clearpaths.Address
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_clearpaths_clearpaths_proto_depIdxs = nil
+}
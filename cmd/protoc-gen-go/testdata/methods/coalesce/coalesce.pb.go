@@ -0,0 +1,399 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/coalesce/coalesce.proto
+
+package coalesce
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Workers int32                  `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty" form:"workers" uri:"workers"`
+	Verbose *bool                  `protobuf:"varint,3,opt,name=verbose,proto3,oneof" json:"verbose,omitempty" form:"verbose" uri:"verbose"`
+	Secret  []byte                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty" form:"secret" uri:"secret"`
+	Hosts   []string               `protobuf:"bytes,5,rep,name=hosts,proto3" json:"hosts,omitempty" form:"hosts" uri:"hosts"`
+	Env     map[string]string      `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" form:"env" uri:"env" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Limits  *Config_Limits         `protobuf:"bytes,7,opt,name=limits,proto3" json:"limits,omitempty" form:"limits" uri:"limits"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty" form:"timeout" uri:"timeout"`
+	// Types that are valid to be assigned to Storage:
+	//
+	//	*Config_Path
+	//	*Config_Quota
+	Storage       isConfig_Storage `protobuf_oneof:"storage"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Config) GetVerbose() bool {
+	if x != nil && x.Verbose != nil {
+		return *x.Verbose
+	}
+	return false
+}
+
+func (x *Config) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *Config) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *Config) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Config) GetLimits() *Config_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Config) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Config) GetStorage() isConfig_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *Config) GetPath() string {
+	if x != nil {
+		if x, ok := x.Storage.(*Config_Path); ok {
+			return x.Path
+		}
+	}
+	return ""
+}
+
+func (x *Config) GetQuota() *Config_Limits {
+	if x != nil {
+		if x, ok := x.Storage.(*Config_Quota); ok {
+			return x.Quota
+		}
+	}
+	return nil
+}
+
+type isConfig_Storage interface {
+	isConfig_Storage()
+}
+
+type Config_Path struct {
+	Path string `protobuf:"bytes,9,opt,name=path,proto3,oneof"`
+}
+
+type Config_Quota struct {
+	Quota *Config_Limits `protobuf:"bytes,10,opt,name=quota,proto3,oneof"`
+}
+
+func (*Config_Path) isConfig_Storage() {}
+
+func (*Config_Quota) isConfig_Storage() {}
+
+// CoalesceWith sets each field of x which is not populated to a deep copy of
+// the same field of fallback, so that fallback provides defaults for x. A
+// field without explicit presence is not populated if it has its zero value,
+// and a repeated or map field if it is empty.
+//
+// Singular message fields set in both messages are coalesced recursively
+// if their message is declared in the same file, and are otherwise kept as
+// they are in x. A oneof is taken from fallback only if none of its members
+// is set in x.
+func (x *Config) CoalesceWith(fallback *Config) {
+	if x == nil || fallback == nil || x == fallback {
+		return
+	}
+	if x.Storage == nil {
+		if v, ok := fallback.Storage.(*Config_Path); ok {
+			x.Storage = &Config_Path{Path: v.Path}
+		}
+		if v, ok := fallback.Storage.(*Config_Quota); ok {
+			x.Storage = &Config_Quota{Quota: proto.CloneOf(v.Quota)}
+		}
+	}
+	if x.Name == "" {
+		if fallback.Name != "" {
+			x.Name = fallback.Name
+		}
+	}
+	if x.Workers == 0 {
+		if fallback.Workers != 0 {
+			x.Workers = fallback.Workers
+		}
+	}
+	if x.Verbose == nil {
+		if fallback.Verbose != nil {
+			t := fallback.GetVerbose()
+			x.Verbose = &t
+		}
+	}
+	if len(x.Secret) == 0 {
+		if len(fallback.Secret) > 0 {
+			x.Secret = append([]byte{}, fallback.Secret...)
+		}
+	}
+	if len(x.Hosts) == 0 {
+		if len(fallback.Hosts) > 0 {
+			l := make([]string, len(fallback.Hosts))
+			copy(l, fallback.Hosts)
+			x.Hosts = l
+		}
+	}
+	if len(x.Env) == 0 {
+		if len(fallback.Env) > 0 {
+			mv := make(map[string]string, len(fallback.Env))
+			for k, e := range fallback.Env {
+				mv[k] = e
+			}
+			x.Env = mv
+		}
+	}
+	if x.Limits == nil {
+		if fallback.Limits != nil {
+			x.Limits = proto.CloneOf(fallback.Limits)
+		}
+	} else {
+		x.Limits.CoalesceWith(fallback.Limits)
+	}
+	if x.Timeout == nil {
+		if fallback.Timeout != nil {
+			x.Timeout = proto.CloneOf(fallback.Timeout)
+		}
+	}
+}
+
+type Config_Limits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        int64                  `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty" form:"memory" uri:"memory"`
+	Cpu           int64                  `protobuf:"varint,2,opt,name=cpu,proto3" json:"cpu,omitempty" form:"cpu" uri:"cpu"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Limits) Reset() {
+	*x = Config_Limits{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Limits) ProtoMessage() {}
+
+func (x *Config_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Limits.ProtoReflect.Descriptor instead.
+func (*Config_Limits) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Config_Limits) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Config_Limits) GetCpu() int64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+// CoalesceWith sets each field of x which is not populated to a deep copy of
+// the same field of fallback, so that fallback provides defaults for x. A
+// field without explicit presence is not populated if it has its zero value,
+// and a repeated or map field if it is empty.
+//
+// Singular message fields set in both messages are coalesced recursively
+// if their message is declared in the same file, and are otherwise kept as
+// they are in x. A oneof is taken from fallback only if none of its members
+// is set in x.
+func (x *Config_Limits) CoalesceWith(fallback *Config_Limits) {
+	if x == nil || fallback == nil || x == fallback {
+		return
+	}
+	if x.Memory == 0 {
+		if fallback.Memory != 0 {
+			x.Memory = fallback.Memory
+		}
+	}
+	if x.Cpu == 0 {
+		if fallback.Cpu != 0 {
+			x.Cpu = fallback.Cpu
+		}
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/coalesce/coalesce.proto\x12\x1fgoproto.protoc.methods.coalesce\x1a\x1egoogle/protobuf/duration.proto\"\xa5\x04\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aworkers\x18\x02 \x01(\x05R\aworkers\x12\x1d\n" +
+	"\averbose\x18\x03 \x01(\bH\x01R\averbose\x88\x01\x01\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\fR\x06secret\x12\x14\n" +
+	"\x05hosts\x18\x05 \x03(\tR\x05hosts\x12B\n" +
+	"\x03env\x18\x06 \x03(\v20.goproto.protoc.methods.coalesce.Config.EnvEntryR\x03env\x12F\n" +
+	"\x06limits\x18\a \x01(\v2..goproto.protoc.methods.coalesce.Config.LimitsR\x06limits\x123\n" +
+	"\atimeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x14\n" +
+	"\x04path\x18\t \x01(\tH\x00R\x04path\x12F\n" +
+	"\x05quota\x18\n" +
+	" \x01(\v2..goproto.protoc.methods.coalesce.Config.LimitsH\x00R\x05quota\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a2\n" +
+	"\x06Limits\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\x03R\x06memory\x12\x10\n" +
+	"\x03cpu\x18\x02 \x01(\x03R\x03cpuB\t\n" +
+	"\astorageB\n" +
+	"\n" +
+	"\b_verboseBHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/coalesceb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_goTypes = []any{
+	(*Config)(nil),              // 0: goproto.protoc.methods.coalesce.Config
+	nil,                         // 1: goproto.protoc.methods.coalesce.Config.EnvEntry
+	(*Config_Limits)(nil),       // 2: goproto.protoc.methods.coalesce.Config.Limits
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+}
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.coalesce.Config.env:type_name -> goproto.protoc.methods.coalesce.Config.EnvEntry
+	2, // 1: goproto.protoc.methods.coalesce.Config.limits:type_name -> goproto.protoc.methods.coalesce.Config.Limits
+	3, // 2: goproto.protoc.methods.coalesce.Config.timeout:type_name -> google.protobuf.Duration
+	2, // 3: goproto.protoc.methods.coalesce.Config.quota:type_name -> goproto.protoc.methods.coalesce.Config.Limits
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes[0].OneofWrappers = []any{
+		(*Config_Path)(nil),
+		(*Config_Quota)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_coalesce_proto_depIdxs = nil
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/coalesce/hybrid.proto
+
+//go:build protoopaque
+
+package coalesce
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Layer struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Level       int32                  `protobuf:"varint,2,opt,name=level"`
+	xxx_hidden_Base        *Layer                 `protobuf:"bytes,3,opt,name=base"`
+	xxx_hidden_Ports       []int32                `protobuf:"varint,4,rep,packed,name=ports"`
+	xxx_hidden_Source      isLayer_Source         `protobuf_oneof:"source"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Layer) Reset() {
+	*x = Layer{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Layer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Layer) ProtoMessage() {}
+
+func (x *Layer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Layer) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Layer) GetLevel() int32 {
+	if x != nil {
+		return x.xxx_hidden_Level
+	}
+	return 0
+}
+
+func (x *Layer) GetBase() *Layer {
+	if x != nil {
+		return x.xxx_hidden_Base
+	}
+	return nil
+}
+
+func (x *Layer) GetPorts() []int32 {
+	if x != nil {
+		return x.xxx_hidden_Ports
+	}
+	return nil
+}
+
+func (x *Layer) GetUrl() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Source.(*layer_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *Layer) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *Layer) SetLevel(v int32) {
+	x.xxx_hidden_Level = v
+}
+
+func (x *Layer) SetBase(v *Layer) {
+	x.xxx_hidden_Base = v
+}
+
+func (x *Layer) SetPorts(v []int32) {
+	x.xxx_hidden_Ports = v
+}
+
+func (x *Layer) SetUrl(v string) {
+	x.xxx_hidden_Source = &layer_Url{v}
+}
+
+func (x *Layer) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Layer) HasBase() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Base != nil
+}
+
+func (x *Layer) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *Layer) HasUrl() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Source.(*layer_Url)
+	return ok
+}
+
+func (x *Layer) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Layer) ClearBase() {
+	x.xxx_hidden_Base = nil
+}
+
+func (x *Layer) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *Layer) ClearUrl() {
+	if _, ok := x.xxx_hidden_Source.(*layer_Url); ok {
+		x.xxx_hidden_Source = nil
+	}
+}
+
+const Layer_Source_not_set_case case_Layer_Source = 0
+const Layer_Url_case case_Layer_Source = 5
+
+func (x *Layer) WhichSource() case_Layer_Source {
+	if x == nil {
+		return Layer_Source_not_set_case
+	}
+	switch x.xxx_hidden_Source.(type) {
+	case *layer_Url:
+		return Layer_Url_case
+	default:
+		return Layer_Source_not_set_case
+	}
+}
+
+type Layer_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name  *string
+	Level int32
+	Base  *Layer
+	Ports []int32
+	// Fields of oneof xxx_hidden_Source:
+	Url *string
+	// -- end of xxx_hidden_Source
+}
+
+func (b0 Layer_builder) Build() *Layer {
+	m0 := &Layer{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Level = b.Level
+	x.xxx_hidden_Base = b.Base
+	x.xxx_hidden_Ports = b.Ports
+	if b.Url != nil {
+		x.xxx_hidden_Source = &layer_Url{*b.Url}
+	}
+	return m0
+}
+
+type case_Layer_Source protoreflect.FieldNumber
+
+func (x case_Layer_Source) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isLayer_Source interface {
+	isLayer_Source()
+}
+
+type layer_Url struct {
+	Url string `protobuf:"bytes,5,opt,name=url,oneof"`
+}
+
+func (*layer_Url) isLayer_Source() {}
+
+// CoalesceWith sets each field of x which is not populated to a deep copy of
+// the same field of fallback, so that fallback provides defaults for x. A
+// field without explicit presence is not populated if it has its zero value,
+// and a repeated or map field if it is empty.
+//
+// Singular message fields set in both messages are coalesced recursively
+// if their message is declared in the same file, and are otherwise kept as
+// they are in x. A oneof is taken from fallback only if none of its members
+// is set in x.
+func (x *Layer) CoalesceWith(fallback *Layer) {
+	if x == nil || fallback == nil || x == fallback {
+		return
+	}
+	if x.xxx_hidden_Source == nil {
+		if fallback.HasUrl() {
+			x.SetUrl(fallback.GetUrl())
+		}
+	}
+	if !x.HasName() {
+		if fallback.HasName() {
+			x.SetName(fallback.GetName())
+		}
+	}
+	if x.GetLevel() == 0 {
+		if fallback.GetLevel() != 0 {
+			x.SetLevel(fallback.GetLevel())
+		}
+	}
+	if !x.HasBase() {
+		if fallback.HasBase() {
+			x.SetBase(proto.CloneOf(fallback.GetBase()))
+		}
+	} else {
+		x.GetBase().CoalesceWith(fallback.GetBase())
+	}
+	if len(x.GetPorts()) == 0 {
+		if len(fallback.GetPorts()) > 0 {
+			l := make([]int32, len(fallback.GetPorts()))
+			copy(l, fallback.GetPorts())
+			x.SetPorts(l)
+		}
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/coalesce/hybrid.proto\x12\x1fgoproto.protoc.methods.coalesce\x1a!google/protobuf/go_features.proto\"\xa8\x01\n" +
+	"\x05Layer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\x05level\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\x05level\x12:\n" +
+	"\x04base\x18\x03 \x01(\v2&.goproto.protoc.methods.coalesce.LayerR\x04base\x12\x14\n" +
+	"\x05ports\x18\x04 \x03(\x05R\x05ports\x12\x12\n" +
+	"\x03url\x18\x05 \x01(\tH\x00R\x03urlB\b\n" +
+	"\x06sourceBPZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/coalesce\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_goTypes = []any{
+	(*Layer)(nil), // 0: goproto.protoc.methods.coalesce.Layer
+}
+var file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.coalesce.Layer.base:type_name -> goproto.protoc.methods.coalesce.Layer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*layer_Url)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_coalesce_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,336 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/cyclecheck/cyclecheck.proto
+
+package cyclecheck
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Node struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	Next     *Node                  `protobuf:"bytes,2,opt,name=next" json:"next,omitempty" form:"next" uri:"next"`
+	Children []*Node                `protobuf:"bytes,3,rep,name=children" json:"children,omitempty" form:"children" uri:"children"`
+	Links    map[string]*Node       `protobuf:"bytes,4,rep,name=links" json:"links,omitempty" form:"links" uri:"links" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data     *structpb.Struct       `protobuf:"bytes,5,opt,name=data" json:"data,omitempty" form:"data" uri:"data"`
+	// Types that are valid to be assigned to Owner:
+	//
+	//	*Node_Parent
+	//	*Node_OwnerName
+	Owner           isNode_Owner `protobuf_oneof:"owner"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Node) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetNext() *Node {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *Node) GetChildren() []*Node {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Node) GetLinks() map[string]*Node {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Node) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Node) GetOwner() isNode_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Node) GetParent() *Node {
+	if x != nil {
+		if x, ok := x.Owner.(*Node_Parent); ok {
+			return x.Parent
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetOwnerName() string {
+	if x != nil {
+		if x, ok := x.Owner.(*Node_OwnerName); ok {
+			return x.OwnerName
+		}
+	}
+	return ""
+}
+
+type isNode_Owner interface {
+	isNode_Owner()
+}
+
+type Node_Parent struct {
+	Parent *Node `protobuf:"bytes,6,opt,name=parent,oneof"`
+}
+
+type Node_OwnerName struct {
+	OwnerName string `protobuf:"bytes,7,opt,name=owner_name,json=ownerName,oneof"`
+}
+
+func (*Node_Parent) isNode_Owner() {}
+
+func (*Node_OwnerName) isNode_Owner() {}
+
+// HasCycle reports whether x, or a message nested in x, is held by one of
+// its own fields or by the fields of a message nested in it, in which case
+// marshaling x does not terminate. Such cycles may be created in memory,
+// but not by unmarshaling. A message held more than once without being its
+// own descendant is not a cycle.
+func (x *Node) HasCycle() bool {
+	return x.hasCycle(make(map[any]bool))
+}
+
+// hasCycle reports whether x, or a message nested in x, is one of the
+// messages in path, or is held by one of its own descendants.
+func (x *Node) hasCycle(path map[any]bool) bool {
+	if x == nil {
+		return false
+	}
+	if path[x] {
+		return true
+	}
+	path[x] = true
+	if x.GetNext().hasCycle(path) {
+		return true
+	}
+	for _, v := range x.GetChildren() {
+		if v.hasCycle(path) {
+			return true
+		}
+	}
+	for _, v := range x.GetLinks() {
+		if v.hasCycle(path) {
+			return true
+		}
+	}
+	if file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle(x.GetData().ProtoReflect(), path) {
+		return true
+	}
+	if x.GetParent().hasCycle(path) {
+		return true
+	}
+	cycle := false
+	x.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() {
+			cycle = file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycleValue(fd, v, path)
+		}
+		return !cycle
+	})
+	if cycle {
+		return true
+	}
+	delete(path, x)
+	return false
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle reports whether m, or a message nested in m, is one
+// of the messages in path, or is held by one of its own descendants.
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle(m protoreflect.Message, path map[any]bool) (cycle bool) {
+	if !m.IsValid() {
+		return false
+	}
+	k := m.Interface()
+	if path[k] {
+		return true
+	}
+	path[k] = true
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		cycle = file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycleValue(fd, v, path)
+		return !cycle
+	})
+	delete(path, k)
+	return cycle
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycleValue reports whether a message held in the value v
+// of the field fd has a cycle.
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycleValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path map[any]bool) bool {
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		l := v.List()
+		for i := 0; i < l.Len(); i++ {
+			if file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle(l.Get(i).Message(), path) {
+				return true
+			}
+		}
+	case fd.IsMap() && fd.MapValue().Message() != nil:
+		cycle := false
+		v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			cycle = file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle(v.Message(), path)
+			return !cycle
+		})
+		return cycle
+	case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+		return file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_hasCycle(v.Message(), path)
+	}
+	return false
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Node)(nil),
+		ExtensionType: (*Node)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.cyclecheck.alias",
+		Tag:           "bytes,100,opt,name=alias",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/cyclecheck/cyclecheck.proto",
+	},
+}
+
+// Extension fields to Node.
+var (
+	// optional goproto.protoc.methods.cyclecheck.Node alias = 100;
+	E_Alias = &file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/cyclecheck/cyclecheck.proto\x12!goproto.protoc.methods.cyclecheck\x1a\x1cgoogle/protobuf/struct.proto\"\xea\x03\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\x04next\x18\x02 \x01(\v2'.goproto.protoc.methods.cyclecheck.NodeR\x04next\x12C\n" +
+	"\bchildren\x18\x03 \x03(\v2'.goproto.protoc.methods.cyclecheck.NodeR\bchildren\x12H\n" +
+	"\x05links\x18\x04 \x03(\v22.goproto.protoc.methods.cyclecheck.Node.LinksEntryR\x05links\x12+\n" +
+	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\x12A\n" +
+	"\x06parent\x18\x06 \x01(\v2'.goproto.protoc.methods.cyclecheck.NodeH\x00R\x06parent\x12\x1f\n" +
+	"\n" +
+	"owner_name\x18\a \x01(\tH\x00R\townerName\x1aa\n" +
+	"\n" +
+	"LinksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\x05value\x18\x02 \x01(\v2'.goproto.protoc.methods.cyclecheck.NodeR\x05value:\x028\x01*\x05\bd\x10\xc8\x01B\a\n" +
+	"\x05owner:f\n" +
+	"\x05alias\x12'.goproto.protoc.methods.cyclecheck.Node\x18d \x01(\v2'.goproto.protoc.methods.cyclecheck.NodeR\x05aliasBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cyclecheck"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_goTypes = []any{
+	(*Node)(nil),            // 0: goproto.protoc.methods.cyclecheck.Node
+	nil,                     // 1: goproto.protoc.methods.cyclecheck.Node.LinksEntry
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+}
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.cyclecheck.Node.next:type_name -> goproto.protoc.methods.cyclecheck.Node
+	0, // 1: goproto.protoc.methods.cyclecheck.Node.children:type_name -> goproto.protoc.methods.cyclecheck.Node
+	1, // 2: goproto.protoc.methods.cyclecheck.Node.links:type_name -> goproto.protoc.methods.cyclecheck.Node.LinksEntry
+	2, // 3: goproto.protoc.methods.cyclecheck.Node.data:type_name -> google.protobuf.Struct
+	0, // 4: goproto.protoc.methods.cyclecheck.Node.parent:type_name -> goproto.protoc.methods.cyclecheck.Node
+	0, // 5: goproto.protoc.methods.cyclecheck.Node.LinksEntry.value:type_name -> goproto.protoc.methods.cyclecheck.Node
+	0, // 6: goproto.protoc.methods.cyclecheck.alias:extendee -> goproto.protoc.methods.cyclecheck.Node
+	0, // 7: goproto.protoc.methods.cyclecheck.alias:type_name -> goproto.protoc.methods.cyclecheck.Node
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	7, // [7:8] is the sub-list for extension type_name
+	6, // [6:7] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_msgTypes[0].OneofWrappers = []any{
+		(*Node_Parent)(nil),
+		(*Node_OwnerName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_cyclecheck_proto_depIdxs = nil
+}
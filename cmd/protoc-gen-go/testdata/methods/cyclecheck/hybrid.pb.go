@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/cyclecheck/hybrid.proto
+
+//go:build !protoopaque
+
+package cyclecheck
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Link struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Target        *Link                  `protobuf:"bytes,1,opt,name=target" json:"target,omitempty" form:"target" uri:"target"`
+	Mirrors       []*Link                `protobuf:"bytes,2,rep,name=mirrors" json:"mirrors,omitempty" form:"mirrors" uri:"mirrors"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Link) GetTarget() *Link {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Link) GetMirrors() []*Link {
+	if x != nil {
+		return x.Mirrors
+	}
+	return nil
+}
+
+func (x *Link) SetTarget(v *Link) {
+	x.Target = v
+}
+
+func (x *Link) SetMirrors(v []*Link) {
+	x.Mirrors = v
+}
+
+func (x *Link) HasTarget() bool {
+	if x == nil {
+		return false
+	}
+	return x.Target != nil
+}
+
+func (x *Link) ClearTarget() {
+	x.Target = nil
+}
+
+type Link_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Target  *Link
+	Mirrors []*Link
+}
+
+func (b0 Link_builder) Build() *Link {
+	m0 := &Link{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Target = b.Target
+	x.Mirrors = b.Mirrors
+	return m0
+}
+
+// HasCycle reports whether x, or a message nested in x, is held by one of
+// its own fields or by the fields of a message nested in it, in which case
+// marshaling x does not terminate. Such cycles may be created in memory,
+// but not by unmarshaling. A message held more than once without being its
+// own descendant is not a cycle.
+func (x *Link) HasCycle() bool {
+	return x.hasCycle(make(map[any]bool))
+}
+
+// hasCycle reports whether x, or a message nested in x, is one of the
+// messages in path, or is held by one of its own descendants.
+func (x *Link) hasCycle(path map[any]bool) bool {
+	if x == nil {
+		return false
+	}
+	if path[x] {
+		return true
+	}
+	path[x] = true
+	if x.GetTarget().hasCycle(path) {
+		return true
+	}
+	for _, v := range x.GetMirrors() {
+		if v.hasCycle(path) {
+			return true
+		}
+	}
+	delete(path, x)
+	return false
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycle reports whether m, or a message nested in m, is one
+// of the messages in path, or is held by one of its own descendants.
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycle(m protoreflect.Message, path map[any]bool) (cycle bool) {
+	if !m.IsValid() {
+		return false
+	}
+	k := m.Interface()
+	if path[k] {
+		return true
+	}
+	path[k] = true
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		cycle = file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycleValue(fd, v, path)
+		return !cycle
+	})
+	delete(path, k)
+	return cycle
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycleValue reports whether a message held in the value v
+// of the field fd has a cycle.
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycleValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path map[any]bool) bool {
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		l := v.List()
+		for i := 0; i < l.Len(); i++ {
+			if file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycle(l.Get(i).Message(), path) {
+				return true
+			}
+		}
+	case fd.IsMap() && fd.MapValue().Message() != nil:
+		cycle := false
+		v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			cycle = file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycle(v.Message(), path)
+			return !cycle
+		})
+		return cycle
+	case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+		return file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_hasCycle(v.Message(), path)
+	}
+	return false
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/cyclecheck/hybrid.proto\x12!goproto.protoc.methods.cyclecheck\x1a!google/protobuf/go_features.proto\"\x8a\x01\n" +
+	"\x04Link\x12?\n" +
+	"\x06target\x18\x01 \x01(\v2'.goproto.protoc.methods.cyclecheck.LinkR\x06target\x12A\n" +
+	"\amirrors\x18\x02 \x03(\v2'.goproto.protoc.methods.cyclecheck.LinkR\amirrorsBRZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/cyclecheck\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_goTypes = []any{
+	(*Link)(nil), // 0: goproto.protoc.methods.cyclecheck.Link
+}
+var file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.cyclecheck.Link.target:type_name -> goproto.protoc.methods.cyclecheck.Link
+	0, // 1: goproto.protoc.methods.cyclecheck.Link.mirrors:type_name -> goproto.protoc.methods.cyclecheck.Link
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_cyclecheck_hybrid_proto_depIdxs = nil
+}
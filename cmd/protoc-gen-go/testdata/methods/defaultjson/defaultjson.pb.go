@@ -0,0 +1,331 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/defaultjson/defaultjson.proto
+
+package defaultjson
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Form_Plan int32
+
+const (
+	Form_PLAN_UNSPECIFIED Form_Plan = 0
+	Form_PLAN_PRO         Form_Plan = 1
+)
+
+// Enum value maps for Form_Plan.
+var (
+	Form_Plan_name = map[int32]string{
+		0: "PLAN_UNSPECIFIED",
+		1: "PLAN_PRO",
+	}
+	Form_Plan_value = map[string]int32{
+		"PLAN_UNSPECIFIED": 0,
+		"PLAN_PRO":         1,
+	}
+)
+
+func (x Form_Plan) Enum() *Form_Plan {
+	p := new(Form_Plan)
+	*p = x
+	return p
+}
+
+func (x Form_Plan) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Form_Plan) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_enumTypes[0].Descriptor()
+}
+
+func (Form_Plan) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_enumTypes[0]
+}
+
+func (x Form_Plan) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Form_Plan.Descriptor instead.
+func (Form_Plan) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Form struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" form:"full_name" uri:"full_name"`
+	Age           int64                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Subscribed    bool                   `protobuf:"varint,3,opt,name=subscribed,proto3" json:"subscribed,omitempty" form:"subscribed" uri:"subscribed"`
+	Plan          Form_Plan              `protobuf:"varint,4,opt,name=plan,proto3,enum=goproto.protoc.methods.defaultjson.Form_Plan" json:"plan,omitempty" form:"plan" uri:"plan"`
+	Interests     []string               `protobuf:"bytes,5,rep,name=interests,proto3" json:"interests,omitempty" form:"interests" uri:"interests"`
+	Extra         map[string]string      `protobuf:"bytes,6,rep,name=extra,proto3" json:"extra,omitempty" form:"extra" uri:"extra" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Address       *Form_Address          `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	Avatar        []byte                 `protobuf:"bytes,8,opt,name=avatar,proto3" json:"avatar,omitempty" form:"avatar" uri:"avatar"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Form) Reset() {
+	*x = Form{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Form) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Form) ProtoMessage() {}
+
+func (x *Form) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Form.ProtoReflect.Descriptor instead.
+func (*Form) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Form) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Form) GetAge() int64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Form) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+func (x *Form) GetPlan() Form_Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Form_PLAN_UNSPECIFIED
+}
+
+func (x *Form) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+func (x *Form) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *Form) GetAddress() *Form_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Form) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_defaultJSONOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_defaultJSON     []byte
+)
+
+// DefaultJSON returns the JSON encoding of an empty Form in which every field
+// is present with its zero value, as marshaled by protojson.Marshal with
+// EmitUnpopulated set. Required fields are not checked, since none of them
+// are set. The encoding is computed once, and a copy of it is returned by
+// each call.
+func (*Form) DefaultJSON() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_defaultJSONOnce.Do(func() {
+		b, err := protojson.MarshalOptions{AllowPartial: true, EmitUnpopulated: true}.Marshal(new(Form))
+		if err != nil {
+			panic(err)
+		}
+		file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_defaultJSON = b
+	})
+	return append([]byte(nil), file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_defaultJSON...)
+}
+
+type Form_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Form_Address) Reset() {
+	*x = Form_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Form_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Form_Address) ProtoMessage() {}
+
+func (x *Form_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Form_Address.ProtoReflect.Descriptor instead.
+func (*Form_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Form_Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_Address_defaultJSONOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_Address_defaultJSON     []byte
+)
+
+// DefaultJSON returns the JSON encoding of an empty Form_Address in which every field
+// is present with its zero value, as marshaled by protojson.Marshal with
+// EmitUnpopulated set. Required fields are not checked, since none of them
+// are set. The encoding is computed once, and a copy of it is returned by
+// each call.
+func (*Form_Address) DefaultJSON() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_Address_defaultJSONOnce.Do(func() {
+		b, err := protojson.MarshalOptions{AllowPartial: true, EmitUnpopulated: true}.Marshal(new(Form_Address))
+		if err != nil {
+			panic(err)
+		}
+		file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_Address_defaultJSON = b
+	})
+	return append([]byte(nil), file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_Form_Address_defaultJSON...)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/defaultjson/defaultjson.proto\x12\"goproto.protoc.methods.defaultjson\"\xee\x03\n" +
+	"\x04Form\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x03R\x03age\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x03 \x01(\bR\n" +
+	"subscribed\x12A\n" +
+	"\x04plan\x18\x04 \x01(\x0e2-.goproto.protoc.methods.defaultjson.Form.PlanR\x04plan\x12\x1c\n" +
+	"\tinterests\x18\x05 \x03(\tR\tinterests\x12I\n" +
+	"\x05extra\x18\x06 \x03(\v23.goproto.protoc.methods.defaultjson.Form.ExtraEntryR\x05extra\x12J\n" +
+	"\aaddress\x18\a \x01(\v20.goproto.protoc.methods.defaultjson.Form.AddressR\aaddress\x12\x16\n" +
+	"\x06avatar\x18\b \x01(\fR\x06avatar\x1a!\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"*\n" +
+	"\x04Plan\x12\x14\n" +
+	"\x10PLAN_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bPLAN_PRO\x10\x01BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/defaultjsonb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_goTypes = []any{
+	(Form_Plan)(0),       // 0: goproto.protoc.methods.defaultjson.Form.Plan
+	(*Form)(nil),         // 1: goproto.protoc.methods.defaultjson.Form
+	(*Form_Address)(nil), // 2: goproto.protoc.methods.defaultjson.Form.Address
+	nil,                  // 3: goproto.protoc.methods.defaultjson.Form.ExtraEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.defaultjson.Form.plan:type_name -> goproto.protoc.methods.defaultjson.Form.Plan
+	3, // 1: goproto.protoc.methods.defaultjson.Form.extra:type_name -> goproto.protoc.methods.defaultjson.Form.ExtraEntry
+	2, // 2: goproto.protoc.methods.defaultjson.Form.address:type_name -> goproto.protoc.methods.defaultjson.Form.Address
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_defaultjson_proto_depIdxs = nil
+}
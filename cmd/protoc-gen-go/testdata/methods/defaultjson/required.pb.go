@@ -0,0 +1,147 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/defaultjson/required.proto
+
+package defaultjson
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Level         *int32                 `protobuf:"varint,2,opt,name=level" json:"level,omitempty" form:"level" uri:"level"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_Account_defaultJSONOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_Account_defaultJSON     []byte
+)
+
+// DefaultJSON returns the JSON encoding of an empty Account in which every field
+// is present with its zero value, as marshaled by protojson.Marshal with
+// EmitUnpopulated set. Required fields are not checked, since none of them
+// are set. The encoding is computed once, and a copy of it is returned by
+// each call.
+func (*Account) DefaultJSON() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_Account_defaultJSONOnce.Do(func() {
+		b, err := protojson.MarshalOptions{AllowPartial: true, EmitUnpopulated: true}.Marshal(new(Account))
+		if err != nil {
+			panic(err)
+		}
+		file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_Account_defaultJSON = b
+	})
+	return append([]byte(nil), file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_Account_defaultJSON...)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDesc = "" +
+	"\n" +
+	"=cmd/protoc-gen-go/testdata/methods/defaultjson/required.proto\x12\"goproto.protoc.methods.defaultjson\"/\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\tR\x02id\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05levelBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/defaultjson"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_goTypes = []any{
+	(*Account)(nil), // 0: goproto.protoc.methods.defaultjson.Account
+}
+var file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_defaultjson_required_proto_depIdxs = nil
+}
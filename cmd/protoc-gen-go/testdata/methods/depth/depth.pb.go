@@ -0,0 +1,268 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/depth/depth.proto
+
+package depth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Node struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Child    *Node                  `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty" form:"child" uri:"child"`
+	Children []*Node                `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children"`
+	Named    map[string]*Node       `protobuf:"bytes,4,rep,name=named,proto3" json:"named,omitempty" form:"named" uri:"named" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Weights  map[string]int32       `protobuf:"bytes,5,rep,name=weights,proto3" json:"weights,omitempty" form:"weights" uri:"weights" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Created  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty" form:"created" uri:"created"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Node_Chosen
+	//	*Node_Label
+	Choice        isNode_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetChild() *Node {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Node) GetChildren() []*Node {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Node) GetNamed() map[string]*Node {
+	if x != nil {
+		return x.Named
+	}
+	return nil
+}
+
+func (x *Node) GetWeights() map[string]int32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Node) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Node) GetChoice() isNode_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Node) GetChosen() *Node {
+	if x != nil {
+		if x, ok := x.Choice.(*Node_Chosen); ok {
+			return x.Chosen
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Node_Label); ok {
+			return x.Label
+		}
+	}
+	return ""
+}
+
+type isNode_Choice interface {
+	isNode_Choice()
+}
+
+type Node_Chosen struct {
+	Chosen *Node `protobuf:"bytes,7,opt,name=chosen,proto3,oneof"`
+}
+
+type Node_Label struct {
+	Label string `protobuf:"bytes,8,opt,name=label,proto3,oneof"`
+}
+
+func (*Node_Chosen) isNode_Choice() {}
+
+func (*Node_Label) isNode_Choice() {}
+
+// MaxDepth returns the length of the longest chain of nested messages
+// starting at x, including x itself, or zero if x is nil.
+// A message without any populated message fields has a depth of one.
+func (x *Node) MaxDepth() int {
+	if x == nil {
+		return 0
+	}
+	d := 0
+	if c := x.Child.MaxDepth(); c > d {
+		d = c
+	}
+	for _, v := range x.Children {
+		if c := v.MaxDepth(); c > d {
+			d = c
+		}
+	}
+	for _, v := range x.Named {
+		if c := v.MaxDepth(); c > d {
+			d = c
+		}
+	}
+	if x.Created != nil {
+		c := 1
+		if m, ok := any(x.Created).(interface{ MaxDepth() int }); ok {
+			c = m.MaxDepth()
+		}
+		if c > d {
+			d = c
+		}
+	}
+	if c := x.GetChosen().MaxDepth(); c > d {
+		d = c
+	}
+	return 1 + d
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_depth_depth_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDesc = "" +
+	"\n" +
+	"4cmd/protoc-gen-go/testdata/methods/depth/depth.proto\x12\x1cgoproto.protoc.methods.depth\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x04\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x128\n" +
+	"\x05child\x18\x02 \x01(\v2\".goproto.protoc.methods.depth.NodeR\x05child\x12>\n" +
+	"\bchildren\x18\x03 \x03(\v2\".goproto.protoc.methods.depth.NodeR\bchildren\x12C\n" +
+	"\x05named\x18\x04 \x03(\v2-.goproto.protoc.methods.depth.Node.NamedEntryR\x05named\x12I\n" +
+	"\aweights\x18\x05 \x03(\v2/.goproto.protoc.methods.depth.Node.WeightsEntryR\aweights\x124\n" +
+	"\acreated\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12<\n" +
+	"\x06chosen\x18\a \x01(\v2\".goproto.protoc.methods.depth.NodeH\x00R\x06chosen\x12\x16\n" +
+	"\x05label\x18\b \x01(\tH\x00R\x05label\x1a\\\n" +
+	"\n" +
+	"NamedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".goproto.protoc.methods.depth.NodeR\x05value:\x028\x01\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\b\n" +
+	"\x06choiceBEZCgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/depthb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_goTypes = []any{
+	(*Node)(nil),                  // 0: goproto.protoc.methods.depth.Node
+	nil,                           // 1: goproto.protoc.methods.depth.Node.NamedEntry
+	nil,                           // 2: goproto.protoc.methods.depth.Node.WeightsEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.depth.Node.child:type_name -> goproto.protoc.methods.depth.Node
+	0, // 1: goproto.protoc.methods.depth.Node.children:type_name -> goproto.protoc.methods.depth.Node
+	1, // 2: goproto.protoc.methods.depth.Node.named:type_name -> goproto.protoc.methods.depth.Node.NamedEntry
+	2, // 3: goproto.protoc.methods.depth.Node.weights:type_name -> goproto.protoc.methods.depth.Node.WeightsEntry
+	3, // 4: goproto.protoc.methods.depth.Node.created:type_name -> google.protobuf.Timestamp
+	0, // 5: goproto.protoc.methods.depth.Node.chosen:type_name -> goproto.protoc.methods.depth.Node
+	0, // 6: goproto.protoc.methods.depth.Node.NamedEntry.value:type_name -> goproto.protoc.methods.depth.Node
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_depth_depth_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_msgTypes[0].OneofWrappers = []any{
+		(*Node_Chosen)(nil),
+		(*Node_Label)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_depth_depth_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_depth_depth_proto_depIdxs = nil
+}
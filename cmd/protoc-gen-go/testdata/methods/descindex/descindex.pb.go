@@ -0,0 +1,501 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/descindex/descindex.proto
+
+package descindex
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{0}
+}
+
+// DescriptorIndexPath returns the path of indexes locating Color in the
+// descriptor of its file: the indexes of the messages enclosing it, if any,
+// followed by the index of the enum.
+func (Color) DescriptorIndexPath() []int {
+	return []int{0}
+}
+
+type Order_Status int32
+
+const (
+	Order_STATUS_UNSPECIFIED Order_Status = 0
+	Order_STATUS_SHIPPED     Order_Status = 1
+)
+
+// Enum value maps for Order_Status.
+var (
+	Order_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_SHIPPED",
+	}
+	Order_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_SHIPPED":     1,
+	}
+)
+
+func (x Order_Status) Enum() *Order_Status {
+	p := new(Order_Status)
+	*p = x
+	return p
+}
+
+func (x Order_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[1].Descriptor()
+}
+
+func (Order_Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[1]
+}
+
+func (x Order_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order_Status.Descriptor instead.
+func (Order_Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// DescriptorIndexPath returns the path of indexes locating Order_Status in the
+// descriptor of its file: the indexes of the messages enclosing it, if any,
+// followed by the index of the enum.
+func (Order_Status) DescriptorIndexPath() []int {
+	return []int{1, 0}
+}
+
+type Order_Item_Option_Kind int32
+
+const (
+	Order_Item_Option_KIND_UNSPECIFIED Order_Item_Option_Kind = 0
+)
+
+// Enum value maps for Order_Item_Option_Kind.
+var (
+	Order_Item_Option_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+	}
+	Order_Item_Option_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+	}
+)
+
+func (x Order_Item_Option_Kind) Enum() *Order_Item_Option_Kind {
+	p := new(Order_Item_Option_Kind)
+	*p = x
+	return p
+}
+
+func (x Order_Item_Option_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order_Item_Option_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[2].Descriptor()
+}
+
+func (Order_Item_Option_Kind) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes[2]
+}
+
+func (x Order_Item_Option_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order_Item_Option_Kind.Descriptor instead.
+func (Order_Item_Option_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{1, 0, 0, 0}
+}
+
+// DescriptorIndexPath returns the path of indexes locating Order_Item_Option_Kind in the
+// descriptor of its file: the indexes of the messages enclosing it, if any,
+// followed by the index of the enum.
+func (Order_Item_Option_Kind) DescriptorIndexPath() []int {
+	return []int{1, 0, 0, 0}
+}
+
+type Catalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Catalog) Reset() {
+	*x = Catalog{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog) ProtoMessage() {}
+
+func (x *Catalog) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog.ProtoReflect.Descriptor instead.
+func (*Catalog) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Catalog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DescriptorIndexPath returns the path of indexes locating Catalog in the
+// descriptor of its file: the index of the top-level message, followed by
+// the index of each nested message.
+func (*Catalog) DescriptorIndexPath() []int {
+	return []int{0}
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        Order_Status           `protobuf:"varint,1,opt,name=status,proto3,enum=goproto.protoc.methods.descindex.Order_Status" json:"status,omitempty" form:"status" uri:"status"`
+	Items         []*Order_Item          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	BySku         map[string]*Order_Item `protobuf:"bytes,3,rep,name=by_sku,json=bySku,proto3" json:"by_sku,omitempty" form:"by_sku" uri:"by_sku" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Order) GetStatus() Order_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Order_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetItems() []*Order_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetBySku() map[string]*Order_Item {
+	if x != nil {
+		return x.BySku
+	}
+	return nil
+}
+
+// DescriptorIndexPath returns the path of indexes locating Order in the
+// descriptor of its file: the index of the top-level message, followed by
+// the index of each nested message.
+func (*Order) DescriptorIndexPath() []int {
+	return []int{1}
+}
+
+type Order_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty" form:"sku" uri:"sku"`
+	Options       []*Order_Item_Option   `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty" form:"options" uri:"options"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Item) Reset() {
+	*x = Order_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Item) ProtoMessage() {}
+
+func (x *Order_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Item.ProtoReflect.Descriptor instead.
+func (*Order_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Order_Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Order_Item) GetOptions() []*Order_Item_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// DescriptorIndexPath returns the path of indexes locating Order_Item in the
+// descriptor of its file: the index of the top-level message, followed by
+// the index of each nested message.
+func (*Order_Item) DescriptorIndexPath() []int {
+	return []int{1, 0}
+}
+
+type Order_Item_Option struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" form:"value" uri:"value"`
+	Kind          Order_Item_Option_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=goproto.protoc.methods.descindex.Order_Item_Option_Kind" json:"kind,omitempty" form:"kind" uri:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Item_Option) Reset() {
+	*x = Order_Item_Option{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Item_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Item_Option) ProtoMessage() {}
+
+func (x *Order_Item_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Item_Option.ProtoReflect.Descriptor instead.
+func (*Order_Item_Option) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *Order_Item_Option) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Order_Item_Option) GetKind() Order_Item_Option_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Order_Item_Option_KIND_UNSPECIFIED
+}
+
+// DescriptorIndexPath returns the path of indexes locating Order_Item_Option in the
+// descriptor of its file: the index of the top-level message, followed by
+// the index of each nested message.
+func (*Order_Item_Option) DescriptorIndexPath() []int {
+	return []int{1, 0, 0}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/descindex/descindex.proto\x12 goproto.protoc.methods.descindex\"\x1d\n" +
+	"\aCatalog\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xf3\x04\n" +
+	"\x05Order\x12F\n" +
+	"\x06status\x18\x01 \x01(\x0e2..goproto.protoc.methods.descindex.Order.StatusR\x06status\x12B\n" +
+	"\x05items\x18\x02 \x03(\v2,.goproto.protoc.methods.descindex.Order.ItemR\x05items\x12I\n" +
+	"\x06by_sku\x18\x03 \x03(\v22.goproto.protoc.methods.descindex.Order.BySkuEntryR\x05bySku\x1a\xf4\x01\n" +
+	"\x04Item\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12M\n" +
+	"\aoptions\x18\x02 \x03(\v23.goproto.protoc.methods.descindex.Order.Item.OptionR\aoptions\x1a\x8a\x01\n" +
+	"\x06Option\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12L\n" +
+	"\x04kind\x18\x02 \x01(\x0e28.goproto.protoc.methods.descindex.Order.Item.Option.KindR\x04kind\"\x1c\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x1af\n" +
+	"\n" +
+	"BySkuEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.goproto.protoc.methods.descindex.Order.ItemR\x05value:\x028\x01\"4\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_SHIPPED\x10\x01*-\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01BIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/descindexb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_goTypes = []any{
+	(Color)(0),                  // 0: goproto.protoc.methods.descindex.Color
+	(Order_Status)(0),           // 1: goproto.protoc.methods.descindex.Order.Status
+	(Order_Item_Option_Kind)(0), // 2: goproto.protoc.methods.descindex.Order.Item.Option.Kind
+	(*Catalog)(nil),             // 3: goproto.protoc.methods.descindex.Catalog
+	(*Order)(nil),               // 4: goproto.protoc.methods.descindex.Order
+	(*Order_Item)(nil),          // 5: goproto.protoc.methods.descindex.Order.Item
+	nil,                         // 6: goproto.protoc.methods.descindex.Order.BySkuEntry
+	(*Order_Item_Option)(nil),   // 7: goproto.protoc.methods.descindex.Order.Item.Option
+}
+var file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.descindex.Order.status:type_name -> goproto.protoc.methods.descindex.Order.Status
+	5, // 1: goproto.protoc.methods.descindex.Order.items:type_name -> goproto.protoc.methods.descindex.Order.Item
+	6, // 2: goproto.protoc.methods.descindex.Order.by_sku:type_name -> goproto.protoc.methods.descindex.Order.BySkuEntry
+	7, // 3: goproto.protoc.methods.descindex.Order.Item.options:type_name -> goproto.protoc.methods.descindex.Order.Item.Option
+	5, // 4: goproto.protoc.methods.descindex.Order.BySkuEntry.value:type_name -> goproto.protoc.methods.descindex.Order.Item
+	2, // 5: goproto.protoc.methods.descindex.Order.Item.Option.kind:type_name -> goproto.protoc.methods.descindex.Order.Item.Option.Kind
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_descindex_descindex_proto_depIdxs = nil
+}
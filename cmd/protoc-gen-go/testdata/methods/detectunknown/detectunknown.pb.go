@@ -0,0 +1,161 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/detectunknown/detectunknown.proto
+
+package detectunknown
+
+import (
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Parent        *Event                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty" form:"parent" uri:"parent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetParent() *Event {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+// UnknownFieldNumbers returns the distinct numbers of the unknown fields
+// retained by x, in ascending order. Only the top-level fields of the unknown
+// data are reported: the contents of groups and of length-delimited fields
+// are not inspected. Parsing stops at the first malformed field.
+func (x *Event) UnknownFieldNumbers() []protoreflect.FieldNumber {
+	if x == nil {
+		return nil
+	}
+	return file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_unknownFieldNumbers(x.ProtoReflect().GetUnknown())
+}
+
+func file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_unknownFieldNumbers(b []byte) []protoreflect.FieldNumber {
+	var nums []protoreflect.FieldNumber
+	seen := make(map[protoreflect.FieldNumber]bool)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			break
+		}
+		b = b[n+m:]
+		if !seen[num] {
+			seen[num] = true
+			nums = append(nums, num)
+		}
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+	return nums
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDesc = "" +
+	"\n" +
+	"Dcmd/protoc-gen-go/testdata/methods/detectunknown/detectunknown.proto\x12$goproto.protoc.methods.detectunknown\"`\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\x06parent\x18\x02 \x01(\v2+.goproto.protoc.methods.detectunknown.EventR\x06parentBMZKgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/detectunknownb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_goTypes = []any{
+	(*Event)(nil), // 0: goproto.protoc.methods.detectunknown.Event
+}
+var file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.detectunknown.Event.parent:type_name -> goproto.protoc.methods.detectunknown.Event
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_detectunknown_detectunknown_proto_depIdxs = nil
+}
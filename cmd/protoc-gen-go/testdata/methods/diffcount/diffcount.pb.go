@@ -0,0 +1,328 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/diffcount/diffcount.proto
+
+package diffcount
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Server struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Cores         *int32                  `protobuf:"varint,2,opt,name=cores,proto3,oneof" json:"cores,omitempty" form:"cores" uri:"cores"`
+	Key           []byte                  `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty" form:"key" uri:"key"`
+	Tags          []string                `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Labels        map[string]string       `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Root          *Server_Disk            `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty" form:"root" uri:"root"`
+	Disks         []*Server_Disk          `protobuf:"bytes,7,rep,name=disks,proto3" json:"disks,omitempty" form:"disks" uri:"disks"`
+	Mounts        map[string]*Server_Disk `protobuf:"bytes,8,rep,name=mounts,proto3" json:"mounts,omitempty" form:"mounts" uri:"mounts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Server) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Server) GetCores() int32 {
+	if x != nil && x.Cores != nil {
+		return *x.Cores
+	}
+	return 0
+}
+
+func (x *Server) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Server) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Server) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Server) GetRoot() *Server_Disk {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *Server) GetDisks() []*Server_Disk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *Server) GetMounts() map[string]*Server_Disk {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+// DiffCount returns the number of fields which differ between x and y,
+// counting the fields of nested messages rather than the messages themselves.
+// Repeated and map fields are compared element-wise: each element or entry
+// which differs, or which is present in only one of the messages, counts as
+// one difference, or as the differences of its fields for message values
+// present in both. Singular fields differ if they have different presence
+// or values. Extensions and unknown fields are not compared.
+func (x *Server) DiffCount(y *Server) int {
+	return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount(x.ProtoReflect(), y.ProtoReflect())
+}
+
+type Server_Disk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" form:"path" uri:"path"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty" form:"size" uri:"size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Disk) Reset() {
+	*x = Server_Disk{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Disk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Disk) ProtoMessage() {}
+
+func (x *Server_Disk) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Disk.ProtoReflect.Descriptor instead.
+func (*Server_Disk) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Server_Disk) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Server_Disk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// DiffCount returns the number of fields which differ between x and y,
+// counting the fields of nested messages rather than the messages themselves.
+// Repeated and map fields are compared element-wise: each element or entry
+// which differs, or which is present in only one of the messages, counts as
+// one difference, or as the differences of its fields for message values
+// present in both. Singular fields differ if they have different presence
+// or values. Extensions and unknown fields are not compared.
+func (x *Server_Disk) DiffCount(y *Server_Disk) int {
+	return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount(x.ProtoReflect(), y.ProtoReflect())
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount returns the number of leaf fields which differ between x
+// and y, which have the same descriptor.
+func file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount(x, y protoreflect.Message) int {
+	n := 0
+	fds := x.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		switch {
+		case fd.IsList():
+			lx, ly := x.Get(fd).List(), y.Get(fd).List()
+			if lx.Len() < ly.Len() {
+				lx, ly = ly, lx
+			}
+			n += lx.Len() - ly.Len()
+			for j := 0; j < ly.Len(); j++ {
+				n += file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCountValue(fd, lx.Get(j), ly.Get(j))
+			}
+		case fd.IsMap():
+			mx, my := x.Get(fd).Map(), y.Get(fd).Map()
+			mx.Range(func(k protoreflect.MapKey, vx protoreflect.Value) bool {
+				if !my.Has(k) {
+					n++
+				} else {
+					n += file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCountValue(fd.MapValue(), vx, my.Get(k))
+				}
+				return true
+			})
+			my.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				if !mx.Has(k) {
+					n++
+				}
+				return true
+			})
+		case fd.Message() != nil:
+			if x.Has(fd) || y.Has(fd) {
+				n += file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount(x.Get(fd).Message(), y.Get(fd).Message())
+			}
+		case x.Has(fd) != y.Has(fd) || !x.Get(fd).Equal(y.Get(fd)):
+			n++
+		}
+	}
+	return n
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCountValue returns the number of leaf fields which differ between
+// the values x and y of an element of fd, or 1 if they are scalars which differ.
+func file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCountValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value) int {
+	if fd.Message() != nil {
+		return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_diffCount(x.Message(), y.Message())
+	}
+	if !x.Equal(y) {
+		return 1
+	}
+	return 0
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/diffcount/diffcount.proto\x12 goproto.protoc.methods.diffcount\"\xe0\x04\n" +
+	"\x06Server\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05cores\x18\x02 \x01(\x05H\x00R\x05cores\x88\x01\x01\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\fR\x03key\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12L\n" +
+	"\x06labels\x18\x05 \x03(\v24.goproto.protoc.methods.diffcount.Server.LabelsEntryR\x06labels\x12A\n" +
+	"\x04root\x18\x06 \x01(\v2-.goproto.protoc.methods.diffcount.Server.DiskR\x04root\x12C\n" +
+	"\x05disks\x18\a \x03(\v2-.goproto.protoc.methods.diffcount.Server.DiskR\x05disks\x12L\n" +
+	"\x06mounts\x18\b \x03(\v24.goproto.protoc.methods.diffcount.Server.MountsEntryR\x06mounts\x1a.\n" +
+	"\x04Disk\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ah\n" +
+	"\vMountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12C\n" +
+	"\x05value\x18\x02 \x01(\v2-.goproto.protoc.methods.diffcount.Server.DiskR\x05value:\x028\x01B\b\n" +
+	"\x06_coresBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/diffcountb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_goTypes = []any{
+	(*Server)(nil),      // 0: goproto.protoc.methods.diffcount.Server
+	(*Server_Disk)(nil), // 1: goproto.protoc.methods.diffcount.Server.Disk
+	nil,                 // 2: goproto.protoc.methods.diffcount.Server.LabelsEntry
+	nil,                 // 3: goproto.protoc.methods.diffcount.Server.MountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.diffcount.Server.labels:type_name -> goproto.protoc.methods.diffcount.Server.LabelsEntry
+	1, // 1: goproto.protoc.methods.diffcount.Server.root:type_name -> goproto.protoc.methods.diffcount.Server.Disk
+	1, // 2: goproto.protoc.methods.diffcount.Server.disks:type_name -> goproto.protoc.methods.diffcount.Server.Disk
+	3, // 3: goproto.protoc.methods.diffcount.Server.mounts:type_name -> goproto.protoc.methods.diffcount.Server.MountsEntry
+	1, // 4: goproto.protoc.methods.diffcount.Server.MountsEntry.value:type_name -> goproto.protoc.methods.diffcount.Server.Disk
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_diffcount_diffcount_proto_depIdxs = nil
+}
@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/enumdefault/enumdefault.proto
+
+package enumdefault
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Level is closed, so its first value is its default even though it is not zero.
+type Level int32
+
+const (
+	Level_LEVEL_LOW  Level = 3
+	Level_LEVEL_NONE Level = 0
+	Level_LEVEL_HIGH Level = 7
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		3: "LEVEL_LOW",
+		0: "LEVEL_NONE",
+		7: "LEVEL_HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_LOW":  3,
+		"LEVEL_NONE": 0,
+		"LEVEL_HIGH": 7,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Level.Descriptor instead.
+func (Level) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescGZIP(), []int{0}
+}
+
+// Default returns the default value of Level, Level_LEVEL_LOW.
+// Enum fields with an explicit default value use that value instead.
+func (Level) Default() Level {
+	return Level_LEVEL_LOW
+}
+
+type Mode int32
+
+const (
+	Mode_MODE_UNSPECIFIED Mode = 0
+	Mode_MODE_ON          Mode = 1
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_ON",
+	}
+	Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_ON":          1,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes[1].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes[1]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescGZIP(), []int{1}
+}
+
+// Default returns the default value of Mode, Mode_MODE_UNSPECIFIED.
+// Enum fields with an explicit default value use that value instead.
+func (Mode) Default() Mode {
+	return Mode_MODE_UNSPECIFIED
+}
+
+type Settings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         *Level                 `protobuf:"varint,1,opt,name=level,enum=goproto.protoc.methods.enumdefault.Level" json:"level,omitempty" form:"level" uri:"level"`
+	Strict        *Level                 `protobuf:"varint,2,opt,name=strict,enum=goproto.protoc.methods.enumdefault.Level,def=7" json:"strict,omitempty" form:"strict" uri:"strict"`
+	Mode          *Mode                  `protobuf:"varint,3,opt,name=mode,enum=goproto.protoc.methods.enumdefault.Mode" json:"mode,omitempty" form:"mode" uri:"mode"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for Settings fields.
+const (
+	Default_Settings_Strict = Level_LEVEL_HIGH
+)
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Settings) GetLevel() Level {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return Level_LEVEL_LOW
+}
+
+func (x *Settings) GetStrict() Level {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return Default_Settings_Strict
+}
+
+func (x *Settings) GetMode() Mode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/enumdefault/enumdefault.proto\x12\"goproto.protoc.methods.enumdefault\"\xd8\x01\n" +
+	"\bSettings\x12?\n" +
+	"\x05level\x18\x01 \x01(\x0e2).goproto.protoc.methods.enumdefault.LevelR\x05level\x12M\n" +
+	"\x06strict\x18\x02 \x01(\x0e2).goproto.protoc.methods.enumdefault.Level:\n" +
+	"LEVEL_HIGHR\x06strict\x12<\n" +
+	"\x04mode\x18\x03 \x01(\x0e2(.goproto.protoc.methods.enumdefault.ModeR\x04mode*<\n" +
+	"\x05Level\x12\r\n" +
+	"\tLEVEL_LOW\x10\x03\x12\x0e\n" +
+	"\n" +
+	"LEVEL_NONE\x10\x00\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\a\x1a\x04:\x02\x10\x02*)\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aMODE_ON\x10\x01BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/enumdefaultb\beditionsp\xe8\a"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_goTypes = []any{
+	(Level)(0),       // 0: goproto.protoc.methods.enumdefault.Level
+	(Mode)(0),        // 1: goproto.protoc.methods.enumdefault.Mode
+	(*Settings)(nil), // 2: goproto.protoc.methods.enumdefault.Settings
+}
+var file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.enumdefault.Settings.level:type_name -> goproto.protoc.methods.enumdefault.Level
+	0, // 1: goproto.protoc.methods.enumdefault.Settings.strict:type_name -> goproto.protoc.methods.enumdefault.Level
+	1, // 2: goproto.protoc.methods.enumdefault.Settings.mode:type_name -> goproto.protoc.methods.enumdefault.Mode
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_enumdefault_enumdefault_proto_depIdxs = nil
+}
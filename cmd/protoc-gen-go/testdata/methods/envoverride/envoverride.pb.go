@@ -0,0 +1,392 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/envoverride/envoverride.proto
+
+package envoverride
+
+import (
+	base64 "encoding/base64"
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	os "os"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config_Mode int32
+
+const (
+	Config_MODE_UNSPECIFIED Config_Mode = 0
+	Config_MODE_FAST        Config_Mode = 1
+	Config_MODE_SAFE        Config_Mode = 2
+)
+
+// Enum value maps for Config_Mode.
+var (
+	Config_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_FAST",
+		2: "MODE_SAFE",
+	}
+	Config_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_FAST":        1,
+		"MODE_SAFE":        2,
+	}
+)
+
+func (x Config_Mode) Enum() *Config_Mode {
+	p := new(Config_Mode)
+	*p = x
+	return p
+}
+
+func (x Config_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Config_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_enumTypes[0].Descriptor()
+}
+
+func (Config_Mode) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_enumTypes[0]
+}
+
+func (x Config_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Config_Mode.Descriptor instead.
+func (Config_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Config struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Workers  int32                  `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty" form:"workers" uri:"workers"`
+	Mode     Config_Mode            `protobuf:"varint,3,opt,name=mode,proto3,enum=goproto.protoc.methods.envoverride.Config_Mode" json:"mode,omitempty" form:"mode" uri:"mode"`
+	Budget   *uint64                `protobuf:"varint,4,opt,name=budget,proto3,oneof" json:"budget,omitempty" form:"budget" uri:"budget"`
+	Verbose  bool                   `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty" form:"verbose" uri:"verbose"`
+	Ratio    float64                `protobuf:"fixed64,6,opt,name=ratio,proto3" json:"ratio,omitempty" form:"ratio" uri:"ratio"`
+	Token    []byte                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty" form:"token" uri:"token"`
+	MaxConns int32                  `protobuf:"varint,8,opt,name=maxConns,proto3" json:"maxConns,omitempty" form:"maxConns" uri:"maxConns"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Config_Host
+	//	*Config_Port
+	Target        isConfig_Target `protobuf_oneof:"target"`
+	Tags          []string        `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Parent        *Config         `protobuf:"bytes,12,opt,name=parent,proto3" json:"parent,omitempty" form:"parent" uri:"parent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Config) GetMode() Config_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Config_MODE_UNSPECIFIED
+}
+
+func (x *Config) GetBudget() uint64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *Config) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *Config) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Config) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Config) GetMaxConns() int32 {
+	if x != nil {
+		return x.MaxConns
+	}
+	return 0
+}
+
+func (x *Config) GetTarget() isConfig_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Config) GetHost() string {
+	if x != nil {
+		if x, ok := x.Target.(*Config_Host); ok {
+			return x.Host
+		}
+	}
+	return ""
+}
+
+func (x *Config) GetPort() uint32 {
+	if x != nil {
+		if x, ok := x.Target.(*Config_Port); ok {
+			return x.Port
+		}
+	}
+	return 0
+}
+
+func (x *Config) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Config) GetParent() *Config {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+type isConfig_Target interface {
+	isConfig_Target()
+}
+
+type Config_Host struct {
+	Host string `protobuf:"bytes,9,opt,name=host,proto3,oneof"`
+}
+
+type Config_Port struct {
+	Port uint32 `protobuf:"varint,10,opt,name=port,proto3,oneof"`
+}
+
+func (*Config_Host) isConfig_Target() {}
+
+func (*Config_Port) isConfig_Target() {}
+
+// ApplyEnvOverrides sets each singular scalar field of x from the environment
+// variable named by prefix followed by the proto name of the field in upper
+// snake case, if it is set. Enums are given by name or number, and bytes in
+// standard base64. Fields whose variable is not set are unchanged.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Config) ApplyEnvOverrides(prefix string) error {
+	y := proto.CloneOf(x)
+	if s, ok := os.LookupEnv(prefix + "NAME"); ok {
+		y.Name = s
+	}
+	if s, ok := os.LookupEnv(prefix + "WORKERS"); ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"WORKERS", err)
+		}
+		y.Workers = int32(n)
+	}
+	if s, ok := os.LookupEnv(prefix + "MODE"); ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if e, ok := Config_Mode_value[s]; ok {
+			n, err = int64(e), nil
+		}
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"MODE", err)
+		}
+		y.Mode = Config_Mode(n)
+	}
+	if s, ok := os.LookupEnv(prefix + "BUDGET"); ok {
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"BUDGET", err)
+		}
+		y.Budget = &v
+	}
+	if s, ok := os.LookupEnv(prefix + "VERBOSE"); ok {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"VERBOSE", err)
+		}
+		y.Verbose = v
+	}
+	if s, ok := os.LookupEnv(prefix + "RATIO"); ok {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"RATIO", err)
+		}
+		y.Ratio = v
+	}
+	if s, ok := os.LookupEnv(prefix + "TOKEN"); ok {
+		v, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"TOKEN", err)
+		}
+		y.Token = v
+	}
+	if s, ok := os.LookupEnv(prefix + "MAX_CONNS"); ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"MAX_CONNS", err)
+		}
+		y.MaxConns = int32(n)
+	}
+	if s, ok := os.LookupEnv(prefix + "HOST"); ok {
+		y.Target = &Config_Host{Host: s}
+	}
+	if s, ok := os.LookupEnv(prefix + "PORT"); ok {
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"PORT", err)
+		}
+		y.Target = &Config_Port{Port: uint32(n)}
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/envoverride/envoverride.proto\x12\"goproto.protoc.methods.envoverride\"\xcf\x03\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aworkers\x18\x02 \x01(\x05R\aworkers\x12C\n" +
+	"\x04mode\x18\x03 \x01(\x0e2/.goproto.protoc.methods.envoverride.Config.ModeR\x04mode\x12\x1b\n" +
+	"\x06budget\x18\x04 \x01(\x04H\x01R\x06budget\x88\x01\x01\x12\x18\n" +
+	"\averbose\x18\x05 \x01(\bR\averbose\x12\x14\n" +
+	"\x05ratio\x18\x06 \x01(\x01R\x05ratio\x12\x14\n" +
+	"\x05token\x18\a \x01(\fR\x05token\x12\x1a\n" +
+	"\bmaxConns\x18\b \x01(\x05R\bmaxConns\x12\x14\n" +
+	"\x04host\x18\t \x01(\tH\x00R\x04host\x12\x14\n" +
+	"\x04port\x18\n" +
+	" \x01(\rH\x00R\x04port\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12B\n" +
+	"\x06parent\x18\f \x01(\v2*.goproto.protoc.methods.envoverride.ConfigR\x06parent\":\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tMODE_FAST\x10\x01\x12\r\n" +
+	"\tMODE_SAFE\x10\x02B\b\n" +
+	"\x06targetB\t\n" +
+	"\a_budgetBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/envoverrideb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_goTypes = []any{
+	(Config_Mode)(0), // 0: goproto.protoc.methods.envoverride.Config.Mode
+	(*Config)(nil),   // 1: goproto.protoc.methods.envoverride.Config
+}
+var file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.envoverride.Config.mode:type_name -> goproto.protoc.methods.envoverride.Config.Mode
+	1, // 1: goproto.protoc.methods.envoverride.Config.parent:type_name -> goproto.protoc.methods.envoverride.Config
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_msgTypes[0].OneofWrappers = []any{
+		(*Config_Host)(nil),
+		(*Config_Port)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_envoverride_envoverride_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/equalignore/equalignore.proto
+
+package equalignore
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty" form:"payload" uri:"payload"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty" form:"received_at" uri:"received_at"`
+	Attempt       int64                  `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty" form:"attempt" uri:"attempt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Request) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Request) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *Request) GetAttempt() int64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// EqualIgnoring reports whether x and y are equal according to proto.Equal,
+// ignoring the fields with the given numbers, which are cleared in copies of
+// x and y before they are compared. It panics if a number is not that of a
+// field of Request.
+func (x *Request) EqualIgnoring(y *Request, ignore ...protoreflect.FieldNumber) bool {
+	fds := x.ProtoReflect().Descriptor().Fields()
+	for _, num := range ignore {
+		if fds.ByNumber(num) == nil {
+			panic(fmt.Sprintf("goproto.protoc.methods.equalignore.Request has no field number %d", num))
+		}
+	}
+	if x == nil || y == nil || len(ignore) == 0 {
+		return proto.Equal(x, y)
+	}
+	mx, my := proto.CloneOf(x).ProtoReflect(), proto.CloneOf(y).ProtoReflect()
+	for _, num := range ignore {
+		mx.Clear(fds.ByNumber(num))
+		my.Clear(fds.ByNumber(num))
+	}
+	return proto.Equal(mx.Interface(), my.Interface())
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/equalignore/equalignore.proto\x12\"goproto.protoc.methods.equalignore\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x01\n" +
+	"\aRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12;\n" +
+	"\vreceived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x03R\aattemptBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/equalignoreb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_goTypes = []any{
+	(*Request)(nil),               // 0: goproto.protoc.methods.equalignore.Request
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.equalignore.Request.received_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_equalignore_equalignore_proto_depIdxs = nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto
+
+package extnums
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Extendable struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Extendable) Reset() {
+	*x = Extendable{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Extendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extendable) ProtoMessage() {}
+
+func (x *Extendable) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extendable.ProtoReflect.Descriptor instead.
+func (*Extendable) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Extendable) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// SetExtensionNumbers returns the field numbers of the extensions set on x
+// in ascending order.
+func (x *Extendable) SetExtensionNumbers() []protoreflect.FieldNumber {
+	var nums []protoreflect.FieldNumber
+	x.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			nums = append(nums, fd.Number())
+		}
+		return true
+	})
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+	return nums
+}
+
+// Plain has no extension ranges, so no SetExtensionNumbers method.
+type Plain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plain) Reset() {
+	*x = Plain{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plain) ProtoMessage() {}
+
+func (x *Plain) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plain.ProtoReflect.Descriptor instead.
+func (*Plain) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Plain) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.extnums.first",
+		Tag:           "varint,100,opt,name=first",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         150,
+		Name:          "goproto.protoc.methods.extnums.second",
+		Tag:           "bytes,150,opt,name=second",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]int64)(nil),
+		Field:         199,
+		Name:          "goproto.protoc.methods.extnums.third",
+		Tag:           "varint,199,rep,name=third",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto",
+	},
+}
+
+// Extension fields to Extendable.
+var (
+	// optional int32 first = 100;
+	E_First = &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_extTypes[0]
+	// optional string second = 150;
+	E_Second = &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_extTypes[1]
+	// repeated int64 third = 199;
+	E_Third = &file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_extTypes[2]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto\x12\x1egoproto.protoc.methods.extnums\"'\n" +
+	"\n" +
+	"Extendable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name*\x05\bd\x10\xc8\x01\"\x1b\n" +
+	"\x05Plain\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name:@\n" +
+	"\x05first\x12*.goproto.protoc.methods.extnums.Extendable\x18d \x01(\x05R\x05first:C\n" +
+	"\x06second\x12*.goproto.protoc.methods.extnums.Extendable\x18\x96\x01 \x01(\tR\x06second:A\n" +
+	"\x05third\x12*.goproto.protoc.methods.extnums.Extendable\x18\xc7\x01 \x03(\x03R\x05thirdBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/extnums"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_goTypes = []any{
+	(*Extendable)(nil), // 0: goproto.protoc.methods.extnums.Extendable
+	(*Plain)(nil),      // 1: goproto.protoc.methods.extnums.Plain
+}
+var file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.extnums.first:extendee -> goproto.protoc.methods.extnums.Extendable
+	0, // 1: goproto.protoc.methods.extnums.second:extendee -> goproto.protoc.methods.extnums.Extendable
+	0, // 2: goproto.protoc.methods.extnums.third:extendee -> goproto.protoc.methods.extnums.Extendable
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	0, // [0:3] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_extnums_extnums_proto_depIdxs = nil
+}
@@ -0,0 +1,572 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fastclone/fastclone.proto
+
+package fastclone
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Document_Kind int32
+
+const (
+	Document_KIND_UNSPECIFIED Document_Kind = 0
+	Document_KIND_REPORT      Document_Kind = 1
+)
+
+// Enum value maps for Document_Kind.
+var (
+	Document_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_REPORT",
+	}
+	Document_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_REPORT":      1,
+	}
+)
+
+func (x Document_Kind) Enum() *Document_Kind {
+	p := new(Document_Kind)
+	*p = x
+	return p
+}
+
+func (x Document_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Document_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_enumTypes[0].Descriptor()
+}
+
+func (Document_Kind) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_enumTypes[0]
+}
+
+func (x Document_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Document_Kind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Document_Kind(num)
+	return nil
+}
+
+// Deprecated: Use Document_Kind.Descriptor instead.
+func (Document_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Document struct {
+	state    protoimpl.MessageState       `protogen:"open.v1"`
+	Title    *string                      `protobuf:"bytes,1,opt,name=title" json:"title,omitempty" form:"title" uri:"title"`
+	Version  *int64                       `protobuf:"varint,2,opt,name=version,def=1" json:"version,omitempty" form:"version" uri:"version"`
+	Digest   []byte                       `protobuf:"bytes,3,opt,name=digest" json:"digest,omitempty" form:"digest" uri:"digest"`
+	Kind     *Document_Kind               `protobuf:"varint,4,opt,name=kind,enum=goproto.protoc.methods.fastclone.Document_Kind" json:"kind,omitempty" form:"kind" uri:"kind"`
+	Tags     []string                     `protobuf:"bytes,5,rep,name=tags" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Blobs    [][]byte                     `protobuf:"bytes,6,rep,name=blobs" json:"blobs,omitempty" form:"blobs" uri:"blobs"`
+	Sections []*Document_Section          `protobuf:"bytes,7,rep,name=sections" json:"sections,omitempty" form:"sections" uri:"sections"`
+	ByName   map[string]*Document_Section `protobuf:"bytes,8,rep,name=by_name,json=byName" json:"by_name,omitempty" form:"by_name" uri:"by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Chunks   map[int32][]byte             `protobuf:"bytes,9,rep,name=chunks" json:"chunks,omitempty" form:"chunks" uri:"chunks" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Summary  *Document_Section            `protobuf:"bytes,10,opt,name=summary" json:"summary,omitempty" form:"summary" uri:"summary"`
+	Created  *timestamppb.Timestamp       `protobuf:"bytes,11,opt,name=created" json:"created,omitempty" form:"created" uri:"created"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Document_Url
+	//	*Document_Inline
+	//	*Document_Raw
+	Source          isDocument_Source `protobuf_oneof:"source"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+// Default values for Document fields.
+const (
+	Default_Document_Version = int64(1)
+)
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Document) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return Default_Document_Version
+}
+
+func (x *Document) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Document) GetKind() Document_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return Document_KIND_UNSPECIFIED
+}
+
+func (x *Document) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Document) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *Document) GetSections() []*Document_Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Document) GetByName() map[string]*Document_Section {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Document) GetChunks() map[int32][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *Document) GetSummary() *Document_Section {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Document) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Document) GetSource() isDocument_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Document) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*Document_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *Document) GetInline() *Document_Section {
+	if x != nil {
+		if x, ok := x.Source.(*Document_Inline); ok {
+			return x.Inline
+		}
+	}
+	return nil
+}
+
+func (x *Document) GetRaw() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*Document_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+type isDocument_Source interface {
+	isDocument_Source()
+}
+
+type Document_Url struct {
+	Url string `protobuf:"bytes,12,opt,name=url,oneof"`
+}
+
+type Document_Inline struct {
+	Inline *Document_Section `protobuf:"bytes,13,opt,name=inline,oneof"`
+}
+
+type Document_Raw struct {
+	Raw []byte `protobuf:"bytes,14,opt,name=raw,oneof"`
+}
+
+func (*Document_Url) isDocument_Source() {}
+
+func (*Document_Inline) isDocument_Source() {}
+
+func (*Document_Raw) isDocument_Source() {}
+
+// CloneVT returns a deep copy of x, or nil if x is nil. Unlike proto.Clone,
+// the fields of x are copied directly, and messages declared in the same
+// file are copied by their own CloneVT method. Other messages are copied
+// with proto.Clone. Retained unknown fields are copied as well.
+// Extension fields are copied with proto.Merge.
+func (x *Document) CloneVT() *Document {
+	if x == nil {
+		return nil
+	}
+	y := new(Document)
+	if x.Title != nil {
+		t := x.GetTitle()
+		y.Title = &t
+	}
+	if x.Version != nil {
+		t := x.GetVersion()
+		y.Version = &t
+	}
+	if x.Digest != nil {
+		y.Digest = append([]byte{}, x.Digest...)
+	}
+	if x.Kind != nil {
+		t := x.GetKind()
+		y.Kind = &t
+	}
+	if len(x.Tags) > 0 {
+		l := make([]string, len(x.Tags))
+		copy(l, x.Tags)
+		y.Tags = l
+	}
+	if len(x.Blobs) > 0 {
+		l := make([][]byte, len(x.Blobs))
+		for i, e := range x.Blobs {
+			l[i] = append([]byte{}, e...)
+		}
+		y.Blobs = l
+	}
+	if len(x.Sections) > 0 {
+		l := make([]*Document_Section, len(x.Sections))
+		for i, e := range x.Sections {
+			l[i] = e.CloneVT()
+		}
+		y.Sections = l
+	}
+	if len(x.ByName) > 0 {
+		mv := make(map[string]*Document_Section, len(x.ByName))
+		for k, e := range x.ByName {
+			mv[k] = e.CloneVT()
+		}
+		y.ByName = mv
+	}
+	if len(x.Chunks) > 0 {
+		mv := make(map[int32][]byte, len(x.Chunks))
+		for k, e := range x.Chunks {
+			mv[k] = append([]byte{}, e...)
+		}
+		y.Chunks = mv
+	}
+	if x.Summary != nil {
+		y.Summary = x.Summary.CloneVT()
+	}
+	if x.Created != nil {
+		y.Created = proto.CloneOf(x.Created)
+	}
+	if v, ok := x.Source.(*Document_Url); ok {
+		y.Source = &Document_Url{Url: v.Url}
+	}
+	if v, ok := x.Source.(*Document_Inline); ok {
+		y.Source = &Document_Inline{Inline: v.Inline.CloneVT()}
+	}
+	if v, ok := x.Source.(*Document_Raw); ok {
+		y.Source = &Document_Raw{Raw: append([]byte{}, v.Raw...)}
+	}
+	if len(x.extensionFields) > 0 {
+		ext := new(Document).ProtoReflect()
+		x.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.IsExtension() {
+				ext.Set(fd, v)
+			}
+			return true
+		})
+		proto.Merge(y, ext.Interface())
+	}
+	if len(x.unknownFields) > 0 {
+		y.unknownFields = append(protoimpl.UnknownFields(nil), x.unknownFields...)
+	}
+	return y
+}
+
+type Document_Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         *string                `protobuf:"bytes,1,opt,name=title" json:"title,omitempty" form:"title" uri:"title"`
+	Children      []*Document_Section    `protobuf:"bytes,2,rep,name=children" json:"children,omitempty" form:"children" uri:"children"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document_Section) Reset() {
+	*x = Document_Section{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document_Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document_Section) ProtoMessage() {}
+
+func (x *Document_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document_Section.ProtoReflect.Descriptor instead.
+func (*Document_Section) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Document_Section) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Document_Section) GetChildren() []*Document_Section {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// CloneVT returns a deep copy of x, or nil if x is nil. Unlike proto.Clone,
+// the fields of x are copied directly, and messages declared in the same
+// file are copied by their own CloneVT method. Other messages are copied
+// with proto.Clone. Retained unknown fields are copied as well.
+func (x *Document_Section) CloneVT() *Document_Section {
+	if x == nil {
+		return nil
+	}
+	y := new(Document_Section)
+	if x.Title != nil {
+		t := x.GetTitle()
+		y.Title = &t
+	}
+	if len(x.Children) > 0 {
+		l := make([]*Document_Section, len(x.Children))
+		for i, e := range x.Children {
+			l[i] = e.CloneVT()
+		}
+		y.Children = l
+	}
+	if len(x.unknownFields) > 0 {
+		y.unknownFields = append(protoimpl.UnknownFields(nil), x.unknownFields...)
+	}
+	return y
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Document)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.fastclone.owner",
+		Tag:           "bytes,100,opt,name=owner",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/fastclone/fastclone.proto",
+	},
+	{
+		ExtendedType:  (*Document)(nil),
+		ExtensionType: ([]*Document_Section)(nil),
+		Field:         101,
+		Name:          "goproto.protoc.methods.fastclone.appendices",
+		Tag:           "bytes,101,rep,name=appendices",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/fastclone/fastclone.proto",
+	},
+}
+
+// Extension fields to Document.
+var (
+	// optional string owner = 100;
+	E_Owner = &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_extTypes[0]
+	// repeated goproto.protoc.methods.fastclone.Document.Section appendices = 101;
+	E_Appendices = &file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_extTypes[1]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/fastclone/fastclone.proto\x12 goproto.protoc.methods.fastclone\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\b\n" +
+	"\bDocument\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
+	"\aversion\x18\x02 \x01(\x03:\x011R\aversion\x12\x16\n" +
+	"\x06digest\x18\x03 \x01(\fR\x06digest\x12C\n" +
+	"\x04kind\x18\x04 \x01(\x0e2/.goproto.protoc.methods.fastclone.Document.KindR\x04kind\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05blobs\x18\x06 \x03(\fR\x05blobs\x12N\n" +
+	"\bsections\x18\a \x03(\v22.goproto.protoc.methods.fastclone.Document.SectionR\bsections\x12O\n" +
+	"\aby_name\x18\b \x03(\v26.goproto.protoc.methods.fastclone.Document.ByNameEntryR\x06byName\x12N\n" +
+	"\x06chunks\x18\t \x03(\v26.goproto.protoc.methods.fastclone.Document.ChunksEntryR\x06chunks\x12L\n" +
+	"\asummary\x18\n" +
+	" \x01(\v22.goproto.protoc.methods.fastclone.Document.SectionR\asummary\x124\n" +
+	"\acreated\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x12\n" +
+	"\x03url\x18\f \x01(\tH\x00R\x03url\x12L\n" +
+	"\x06inline\x18\r \x01(\v22.goproto.protoc.methods.fastclone.Document.SectionH\x00R\x06inline\x12\x12\n" +
+	"\x03raw\x18\x0e \x01(\fH\x00R\x03raw\x1ao\n" +
+	"\aSection\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12N\n" +
+	"\bchildren\x18\x02 \x03(\v22.goproto.protoc.methods.fastclone.Document.SectionR\bchildren\x1am\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\x05value\x18\x02 \x01(\v22.goproto.protoc.methods.fastclone.Document.SectionR\x05value:\x028\x01\x1a9\n" +
+	"\vChunksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"-\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vKIND_REPORT\x10\x01*\b\bd\x10\x80\x80\x80\x80\x02B\b\n" +
+	"\x06source:@\n" +
+	"\x05owner\x12*.goproto.protoc.methods.fastclone.Document\x18d \x01(\tR\x05owner:~\n" +
+	"\n" +
+	"appendices\x12*.goproto.protoc.methods.fastclone.Document\x18e \x03(\v22.goproto.protoc.methods.fastclone.Document.SectionR\n" +
+	"appendicesBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fastclone"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_goTypes = []any{
+	(Document_Kind)(0),            // 0: goproto.protoc.methods.fastclone.Document.Kind
+	(*Document)(nil),              // 1: goproto.protoc.methods.fastclone.Document
+	(*Document_Section)(nil),      // 2: goproto.protoc.methods.fastclone.Document.Section
+	nil,                           // 3: goproto.protoc.methods.fastclone.Document.ByNameEntry
+	nil,                           // 4: goproto.protoc.methods.fastclone.Document.ChunksEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_depIdxs = []int32{
+	0,  // 0: goproto.protoc.methods.fastclone.Document.kind:type_name -> goproto.protoc.methods.fastclone.Document.Kind
+	2,  // 1: goproto.protoc.methods.fastclone.Document.sections:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	3,  // 2: goproto.protoc.methods.fastclone.Document.by_name:type_name -> goproto.protoc.methods.fastclone.Document.ByNameEntry
+	4,  // 3: goproto.protoc.methods.fastclone.Document.chunks:type_name -> goproto.protoc.methods.fastclone.Document.ChunksEntry
+	2,  // 4: goproto.protoc.methods.fastclone.Document.summary:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	5,  // 5: goproto.protoc.methods.fastclone.Document.created:type_name -> google.protobuf.Timestamp
+	2,  // 6: goproto.protoc.methods.fastclone.Document.inline:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	2,  // 7: goproto.protoc.methods.fastclone.Document.Section.children:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	2,  // 8: goproto.protoc.methods.fastclone.Document.ByNameEntry.value:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	1,  // 9: goproto.protoc.methods.fastclone.owner:extendee -> goproto.protoc.methods.fastclone.Document
+	1,  // 10: goproto.protoc.methods.fastclone.appendices:extendee -> goproto.protoc.methods.fastclone.Document
+	2,  // 11: goproto.protoc.methods.fastclone.appendices:type_name -> goproto.protoc.methods.fastclone.Document.Section
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	11, // [11:12] is the sub-list for extension type_name
+	9,  // [9:11] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes[0].OneofWrappers = []any{
+		(*Document_Url)(nil),
+		(*Document_Inline)(nil),
+		(*Document_Raw)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_fastclone_proto_depIdxs = nil
+}
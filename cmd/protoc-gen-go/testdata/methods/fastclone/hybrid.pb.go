@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fastclone/hybrid.proto
+
+//go:build !protoopaque
+
+package fastclone
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Note struct {
+	state      protoimpl.MessageState `protogen:"hybrid.v1"`
+	Text       *string                `protobuf:"bytes,1,opt,name=text" json:"text,omitempty" form:"text" uri:"text"`
+	Priority   int32                  `protobuf:"varint,2,opt,name=priority" json:"priority,omitempty" form:"priority" uri:"priority"`
+	Attachment []byte                 `protobuf:"bytes,3,opt,name=attachment" json:"attachment,omitempty" form:"attachment" uri:"attachment"`
+	Replies    []*Note                `protobuf:"bytes,4,rep,name=replies" json:"replies,omitempty" form:"replies" uri:"replies"`
+	Counts     map[string]int64       `protobuf:"bytes,5,rep,name=counts" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Parent     *Note                  `protobuf:"bytes,6,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Note_User
+	//	*Note_Thread
+	Target        isNote_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Note) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *Note) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Note) GetAttachment() []byte {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Note) GetReplies() []*Note {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Note) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Note) GetParent() *Note {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Note) GetTarget() isNote_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Note) GetUser() string {
+	if x != nil {
+		if x, ok := x.Target.(*Note_User); ok {
+			return x.User
+		}
+	}
+	return ""
+}
+
+func (x *Note) GetThread() *Note {
+	if x != nil {
+		if x, ok := x.Target.(*Note_Thread); ok {
+			return x.Thread
+		}
+	}
+	return nil
+}
+
+func (x *Note) SetText(v string) {
+	x.Text = &v
+}
+
+func (x *Note) SetPriority(v int32) {
+	x.Priority = v
+}
+
+func (x *Note) SetAttachment(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Attachment = v
+}
+
+func (x *Note) SetReplies(v []*Note) {
+	x.Replies = v
+}
+
+func (x *Note) SetCounts(v map[string]int64) {
+	x.Counts = v
+}
+
+func (x *Note) SetParent(v *Note) {
+	x.Parent = v
+}
+
+func (x *Note) SetUser(v string) {
+	x.Target = &Note_User{v}
+}
+
+func (x *Note) SetThread(v *Note) {
+	if v == nil {
+		x.Target = nil
+		return
+	}
+	x.Target = &Note_Thread{v}
+}
+
+func (x *Note) HasText() bool {
+	if x == nil {
+		return false
+	}
+	return x.Text != nil
+}
+
+func (x *Note) HasAttachment() bool {
+	if x == nil {
+		return false
+	}
+	return x.Attachment != nil
+}
+
+func (x *Note) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Parent != nil
+}
+
+func (x *Note) HasTarget() bool {
+	if x == nil {
+		return false
+	}
+	return x.Target != nil
+}
+
+func (x *Note) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Target.(*Note_User)
+	return ok
+}
+
+func (x *Note) HasThread() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Target.(*Note_Thread)
+	return ok
+}
+
+func (x *Note) ClearText() {
+	x.Text = nil
+}
+
+func (x *Note) ClearAttachment() {
+	x.Attachment = nil
+}
+
+func (x *Note) ClearParent() {
+	x.Parent = nil
+}
+
+func (x *Note) ClearTarget() {
+	x.Target = nil
+}
+
+func (x *Note) ClearUser() {
+	if _, ok := x.Target.(*Note_User); ok {
+		x.Target = nil
+	}
+}
+
+func (x *Note) ClearThread() {
+	if _, ok := x.Target.(*Note_Thread); ok {
+		x.Target = nil
+	}
+}
+
+const Note_Target_not_set_case case_Note_Target = 0
+const Note_User_case case_Note_Target = 7
+const Note_Thread_case case_Note_Target = 8
+
+func (x *Note) WhichTarget() case_Note_Target {
+	if x == nil {
+		return Note_Target_not_set_case
+	}
+	switch x.Target.(type) {
+	case *Note_User:
+		return Note_User_case
+	case *Note_Thread:
+		return Note_Thread_case
+	default:
+		return Note_Target_not_set_case
+	}
+}
+
+type Note_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Text       *string
+	Priority   int32
+	Attachment []byte
+	Replies    []*Note
+	Counts     map[string]int64
+	Parent     *Note
+	// Fields of oneof Target:
+	User   *string
+	Thread *Note
+	// -- end of Target
+}
+
+func (b0 Note_builder) Build() *Note {
+	m0 := &Note{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Text = b.Text
+	x.Priority = b.Priority
+	x.Attachment = b.Attachment
+	x.Replies = b.Replies
+	x.Counts = b.Counts
+	x.Parent = b.Parent
+	if b.User != nil {
+		x.Target = &Note_User{*b.User}
+	}
+	if b.Thread != nil {
+		x.Target = &Note_Thread{b.Thread}
+	}
+	return m0
+}
+
+type case_Note_Target protoreflect.FieldNumber
+
+func (x case_Note_Target) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isNote_Target interface {
+	isNote_Target()
+}
+
+type Note_User struct {
+	User string `protobuf:"bytes,7,opt,name=user,oneof"`
+}
+
+type Note_Thread struct {
+	Thread *Note `protobuf:"bytes,8,opt,name=thread,oneof"`
+}
+
+func (*Note_User) isNote_Target() {}
+
+func (*Note_Thread) isNote_Target() {}
+
+// CloneVT returns a deep copy of x, or nil if x is nil. Unlike proto.Clone,
+// the fields of x are copied directly, and messages declared in the same
+// file are copied by their own CloneVT method. Other messages are copied
+// with proto.Clone. Retained unknown fields are copied as well.
+func (x *Note) CloneVT() *Note {
+	if x == nil {
+		return nil
+	}
+	y := new(Note)
+	if x.HasText() {
+		y.SetText(x.GetText())
+	}
+	if x.GetPriority() != 0 {
+		y.SetPriority(x.GetPriority())
+	}
+	if x.HasAttachment() {
+		y.SetAttachment(append([]byte{}, x.GetAttachment()...))
+	}
+	if len(x.GetReplies()) > 0 {
+		l := make([]*Note, len(x.GetReplies()))
+		for i, e := range x.GetReplies() {
+			l[i] = e.CloneVT()
+		}
+		y.SetReplies(l)
+	}
+	if len(x.GetCounts()) > 0 {
+		mv := make(map[string]int64, len(x.GetCounts()))
+		for k, e := range x.GetCounts() {
+			mv[k] = e
+		}
+		y.SetCounts(mv)
+	}
+	if x.HasParent() {
+		y.SetParent(x.GetParent().CloneVT())
+	}
+	if x.HasUser() {
+		y.SetUser(x.GetUser())
+	}
+	if x.HasThread() {
+		y.SetThread(x.GetThread().CloneVT())
+	}
+	if len(x.unknownFields) > 0 {
+		y.unknownFields = append(protoimpl.UnknownFields(nil), x.unknownFields...)
+	}
+	return y
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/methods/fastclone/hybrid.proto\x12 goproto.protoc.methods.fastclone\x1a!google/protobuf/go_features.proto\"\xcc\x03\n" +
+	"\x04Note\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12!\n" +
+	"\bpriority\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\bpriority\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\fR\n" +
+	"attachment\x12@\n" +
+	"\areplies\x18\x04 \x03(\v2&.goproto.protoc.methods.fastclone.NoteR\areplies\x12J\n" +
+	"\x06counts\x18\x05 \x03(\v22.goproto.protoc.methods.fastclone.Note.CountsEntryR\x06counts\x12B\n" +
+	"\x06parent\x18\x06 \x01(\v2&.goproto.protoc.methods.fastclone.NoteB\x02(\x01R\x06parent\x12\x14\n" +
+	"\x04user\x18\a \x01(\tH\x00R\x04user\x12@\n" +
+	"\x06thread\x18\b \x01(\v2&.goproto.protoc.methods.fastclone.NoteH\x00R\x06thread\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\b\n" +
+	"\x06targetBQZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fastclone\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_goTypes = []any{
+	(*Note)(nil), // 0: goproto.protoc.methods.fastclone.Note
+	nil,          // 1: goproto.protoc.methods.fastclone.Note.CountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.fastclone.Note.replies:type_name -> goproto.protoc.methods.fastclone.Note
+	1, // 1: goproto.protoc.methods.fastclone.Note.counts:type_name -> goproto.protoc.methods.fastclone.Note.CountsEntry
+	0, // 2: goproto.protoc.methods.fastclone.Note.parent:type_name -> goproto.protoc.methods.fastclone.Note
+	0, // 3: goproto.protoc.methods.fastclone.Note.thread:type_name -> goproto.protoc.methods.fastclone.Note
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Note_User)(nil),
+		(*Note_Thread)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fastclone_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fdlookup/fdlookup.proto
+
+package fdlookup
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Lookup struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" form:"user_id" uri:"user_id"`
+	Tags   []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Lookup_Email
+	//	*Lookup_Nested
+	Target        isLookup_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lookup) Reset() {
+	*x = Lookup{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lookup) ProtoMessage() {}
+
+func (x *Lookup) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lookup.ProtoReflect.Descriptor instead.
+func (*Lookup) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Lookup) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Lookup) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Lookup) GetTarget() isLookup_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Lookup) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Target.(*Lookup_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Lookup) GetNested() *Lookup {
+	if x != nil {
+		if x, ok := x.Target.(*Lookup_Nested); ok {
+			return x.Nested
+		}
+	}
+	return nil
+}
+
+type isLookup_Target interface {
+	isLookup_Target()
+}
+
+type Lookup_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"`
+}
+
+type Lookup_Nested struct {
+	Nested *Lookup `protobuf:"bytes,4,opt,name=nested,proto3,oneof"`
+}
+
+func (*Lookup_Email) isLookup_Target() {}
+
+func (*Lookup_Nested) isLookup_Target() {}
+
+var file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_Lookup_fieldNumbersByGoName = map[string]protoreflect.FieldNumber{
+	"UserId": 1,
+	"Tags":   2,
+	"Email":  3,
+	"Nested": 4,
+}
+
+// FieldDescriptorByGoName returns the descriptor of the field of Lookup with
+// the given Go field name, or nil if there is no such field. Members of a
+// oneof are identified by the field name within their wrapper type.
+func (*Lookup) FieldDescriptorByGoName(name string) protoreflect.FieldDescriptor {
+	num, ok := file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_Lookup_fieldNumbersByGoName[name]
+	if !ok {
+		return nil
+	}
+	return file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes[0].Descriptor().Fields().ByNumber(num)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/fdlookup/fdlookup.proto\x12\x1fgoproto.protoc.methods.fdlookup\"\x9a\x01\n" +
+	"\x06Lookup\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x16\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x12A\n" +
+	"\x06nested\x18\x04 \x01(\v2'.goproto.protoc.methods.fdlookup.LookupH\x00R\x06nestedB\b\n" +
+	"\x06targetBHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fdlookupb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_goTypes = []any{
+	(*Lookup)(nil), // 0: goproto.protoc.methods.fdlookup.Lookup
+}
+var file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.fdlookup.Lookup.nested:type_name -> goproto.protoc.methods.fdlookup.Lookup
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes[0].OneofWrappers = []any{
+		(*Lookup_Email)(nil),
+		(*Lookup_Nested)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fdlookup_fdlookup_proto_depIdxs = nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fieldbytes/fieldbytes.proto
+
+package fieldbytes
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Source        *Event_Source          `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty" form:"source" uri:"source"`
+	Codes         []int32                `protobuf:"varint,4,rep,packed,name=codes,proto3" json:"codes,omitempty" form:"codes" uri:"codes"`
+	Counts        map[string]int32       `protobuf:"bytes,5,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetSource() *Event_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Event) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Event) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// FieldBytes returns the wire-format encoding of the field of x with the
+// given number, including its tag, as it would appear in the encoding of x.
+// Every element of a repeated or map field is encoded, with map entries in
+// deterministic order, and an unpopulated field is encoded as no bytes.
+// Required fields are not checked, since the encoding holds only the one
+// field. An error is reported if the message has no field with the number.
+func (x *Event) FieldBytes(num protoreflect.FieldNumber) ([]byte, error) {
+	m := x.ProtoReflect()
+	fd := m.Descriptor().Fields().ByNumber(num)
+	if fd == nil {
+		return nil, fmt.Errorf("message %v has no field with number %d", m.Descriptor().FullName(), num)
+	}
+	field := m.New()
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	}
+	return proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(field.Interface())
+}
+
+type Event_Source struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" form:"host" uri:"host"`
+	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty" form:"port" uri:"port"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Source) Reset() {
+	*x = Event_Source{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Source) ProtoMessage() {}
+
+func (x *Event_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Source.ProtoReflect.Descriptor instead.
+func (*Event_Source) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Event_Source) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Event_Source) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// FieldBytes returns the wire-format encoding of the field of x with the
+// given number, including its tag, as it would appear in the encoding of x.
+// Every element of a repeated or map field is encoded, with map entries in
+// deterministic order, and an unpopulated field is encoded as no bytes.
+// Required fields are not checked, since the encoding holds only the one
+// field. An error is reported if the message has no field with the number.
+func (x *Event_Source) FieldBytes(num protoreflect.FieldNumber) ([]byte, error) {
+	m := x.ProtoReflect()
+	fd := m.Descriptor().Fields().ByNumber(num)
+	if fd == nil {
+		return nil, fmt.Errorf("message %v has no field with number %d", m.Descriptor().FullName(), num)
+	}
+	field := m.New()
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	}
+	return proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(field.Interface())
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/fieldbytes/fieldbytes.proto\x12!goproto.protoc.methods.fieldbytes\"\xc5\x02\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12G\n" +
+	"\x06source\x18\x03 \x01(\v2/.goproto.protoc.methods.fieldbytes.Event.SourceR\x06source\x12\x14\n" +
+	"\x05codes\x18\x04 \x03(\x05R\x05codes\x12L\n" +
+	"\x06counts\x18\x05 \x03(\v24.goproto.protoc.methods.fieldbytes.Event.CountsEntryR\x06counts\x1a0\n" +
+	"\x06Source\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01BJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldbytesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_goTypes = []any{
+	(*Event)(nil),        // 0: goproto.protoc.methods.fieldbytes.Event
+	(*Event_Source)(nil), // 1: goproto.protoc.methods.fieldbytes.Event.Source
+	nil,                  // 2: goproto.protoc.methods.fieldbytes.Event.CountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.fieldbytes.Event.source:type_name -> goproto.protoc.methods.fieldbytes.Event.Source
+	2, // 1: goproto.protoc.methods.fieldbytes.Event.counts:type_name -> goproto.protoc.methods.fieldbytes.Event.CountsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_fieldbytes_proto_depIdxs = nil
+}
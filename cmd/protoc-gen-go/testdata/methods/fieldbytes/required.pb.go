@@ -0,0 +1,146 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fieldbytes/required.proto
+
+package fieldbytes
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *string                `protobuf:"bytes,1,req,name=key" json:"key,omitempty" form:"key" uri:"key"`
+	Value         *int32                 `protobuf:"varint,2,opt,name=value" json:"value,omitempty" form:"value" uri:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *Record) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+// FieldBytes returns the wire-format encoding of the field of x with the
+// given number, including its tag, as it would appear in the encoding of x.
+// Every element of a repeated or map field is encoded, with map entries in
+// deterministic order, and an unpopulated field is encoded as no bytes.
+// Required fields are not checked, since the encoding holds only the one
+// field. An error is reported if the message has no field with the number.
+func (x *Record) FieldBytes(num protoreflect.FieldNumber) ([]byte, error) {
+	m := x.ProtoReflect()
+	fd := m.Descriptor().Fields().ByNumber(num)
+	if fd == nil {
+		return nil, fmt.Errorf("message %v has no field with number %d", m.Descriptor().FullName(), num)
+	}
+	field := m.New()
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	}
+	return proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(field.Interface())
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/fieldbytes/required.proto\x12!goproto.protoc.methods.fieldbytes\"0\n" +
+	"\x06Record\x12\x10\n" +
+	"\x03key\x18\x01 \x02(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05valueBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldbytes"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_goTypes = []any{
+	(*Record)(nil), // 0: goproto.protoc.methods.fieldbytes.Record
+}
+var file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fieldbytes_required_proto_depIdxs = nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fieldsizes/fieldsizes.proto
+
+package fieldsizes
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Sample struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *int32                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Label           *string                `protobuf:"bytes,2,opt,name=label" json:"label,omitempty" form:"label" uri:"label"`
+	Values          []int64                `protobuf:"varint,3,rep,packed,name=values" json:"values,omitempty" form:"values" uri:"values"`
+	Tags            []string               `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Counts          map[string]int32       `protobuf:"bytes,5,rep,name=counts" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Child           *Sample                `protobuf:"bytes,6,opt,name=child" json:"child,omitempty" form:"child" uri:"child"`
+	Extra           *Sample_Extra          `protobuf:"group,7,opt,name=Extra,json=extra" json:"extra,omitempty" form:"extra" uri:"extra"`
+	Big             *uint32                `protobuf:"varint,2000,opt,name=big" json:"big,omitempty" form:"big" uri:"big"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Sample) Reset() {
+	*x = Sample{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample) ProtoMessage() {}
+
+func (x *Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample.ProtoReflect.Descriptor instead.
+func (*Sample) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Sample) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Sample) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *Sample) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Sample) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Sample) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Sample) GetChild() *Sample {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Sample) GetExtra() *Sample_Extra {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *Sample) GetBig() uint32 {
+	if x != nil && x.Big != nil {
+		return *x.Big
+	}
+	return 0
+}
+
+// FieldSizes returns the size in bytes of the wire-format encoding of each
+// populated field of x, including extensions, by field number. The size of
+// a field includes its tags and every element of a repeated or map field.
+// The sizes add up to proto.Size(x), less the size of its unknown fields.
+func (x *Sample) FieldSizes() map[protoreflect.FieldNumber]int {
+	m := x.ProtoReflect()
+	sizes := make(map[protoreflect.FieldNumber]int)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		field := m.New()
+		field.Set(fd, v)
+		sizes[fd.Number()] = proto.Size(field.Interface())
+		return true
+	})
+	return sizes
+}
+
+type Sample_Extra struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *bool                  `protobuf:"varint,8,opt,name=flag" json:"flag,omitempty" form:"flag" uri:"flag"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sample_Extra) Reset() {
+	*x = Sample_Extra{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sample_Extra) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample_Extra) ProtoMessage() {}
+
+func (x *Sample_Extra) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample_Extra.ProtoReflect.Descriptor instead.
+func (*Sample_Extra) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Sample_Extra) GetFlag() bool {
+	if x != nil && x.Flag != nil {
+		return *x.Flag
+	}
+	return false
+}
+
+// FieldSizes returns the size in bytes of the wire-format encoding of each
+// populated field of x, including extensions, by field number. The size of
+// a field includes its tags and every element of a repeated or map field.
+// The sizes add up to proto.Size(x), less the size of its unknown fields.
+func (x *Sample_Extra) FieldSizes() map[protoreflect.FieldNumber]int {
+	m := x.ProtoReflect()
+	sizes := make(map[protoreflect.FieldNumber]int)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		field := m.New()
+		field.Set(fd, v)
+		sizes[fd.Number()] = proto.Size(field.Interface())
+		return true
+	})
+	return sizes
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Sample)(nil),
+		ExtensionType: (*uint64)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.fieldsizes.stamp",
+		Tag:           "fixed64,100,opt,name=stamp",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/fieldsizes/fieldsizes.proto",
+	},
+}
+
+// Extension fields to Sample.
+var (
+	// optional fixed64 stamp = 100;
+	E_Stamp = &file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/fieldsizes/fieldsizes.proto\x12!goproto.protoc.methods.fieldsizes\"\xa7\x03\n" +
+	"\x06Sample\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\x06values\x18\x03 \x03(\x03B\x02\x10\x01R\x06values\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12M\n" +
+	"\x06counts\x18\x05 \x03(\v25.goproto.protoc.methods.fieldsizes.Sample.CountsEntryR\x06counts\x12?\n" +
+	"\x05child\x18\x06 \x01(\v2).goproto.protoc.methods.fieldsizes.SampleR\x05child\x12E\n" +
+	"\x05extra\x18\a \x01(\n" +
+	"2/.goproto.protoc.methods.fieldsizes.Sample.ExtraR\x05extra\x12\x11\n" +
+	"\x03big\x18\xd0\x0f \x01(\rR\x03big\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a\x1b\n" +
+	"\x05Extra\x12\x12\n" +
+	"\x04flag\x18\b \x01(\bR\x04flag*\x05\bd\x10\xc8\x01:?\n" +
+	"\x05stamp\x12).goproto.protoc.methods.fieldsizes.Sample\x18d \x01(\x06R\x05stampBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fieldsizes"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_goTypes = []any{
+	(*Sample)(nil),       // 0: goproto.protoc.methods.fieldsizes.Sample
+	nil,                  // 1: goproto.protoc.methods.fieldsizes.Sample.CountsEntry
+	(*Sample_Extra)(nil), // 2: goproto.protoc.methods.fieldsizes.Sample.Extra
+}
+var file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.fieldsizes.Sample.counts:type_name -> goproto.protoc.methods.fieldsizes.Sample.CountsEntry
+	0, // 1: goproto.protoc.methods.fieldsizes.Sample.child:type_name -> goproto.protoc.methods.fieldsizes.Sample
+	2, // 2: goproto.protoc.methods.fieldsizes.Sample.extra:type_name -> goproto.protoc.methods.fieldsizes.Sample.Extra
+	0, // 3: goproto.protoc.methods.fieldsizes.stamp:extendee -> goproto.protoc.methods.fieldsizes.Sample
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	3, // [3:4] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fieldsizes_fieldsizes_proto_depIdxs = nil
+}
@@ -0,0 +1,365 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fingerprint/fingerprint.proto
+
+package fingerprint
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	fnv "hash/fnv"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Response struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	Query       string                    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty" form:"query" uri:"query"`
+	ServedAt    *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=served_at,json=servedAt,proto3" json:"served_at,omitempty" form:"served_at" uri:"served_at"`
+	RequestId   string                    `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty" form:"request_id" uri:"request_id"`
+	Items       []*Response_Item          `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	ItemsByName map[string]*Response_Item `protobuf:"bytes,5,rep,name=items_by_name,json=itemsByName,proto3" json:"items_by_name,omitempty" form:"items_by_name" uri:"items_by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Best        *Response_Item            `protobuf:"bytes,6,opt,name=best,proto3" json:"best,omitempty" form:"best" uri:"best"`
+	Trace       []string                  `protobuf:"bytes,7,rep,name=trace,proto3" json:"trace,omitempty" form:"trace" uri:"trace"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Response_Cache
+	//	*Response_Origin
+	Source        isResponse_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Response) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Response) GetServedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServedAt
+	}
+	return nil
+}
+
+func (x *Response) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Response) GetItems() []*Response_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Response) GetItemsByName() map[string]*Response_Item {
+	if x != nil {
+		return x.ItemsByName
+	}
+	return nil
+}
+
+func (x *Response) GetBest() *Response_Item {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+func (x *Response) GetTrace() []string {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *Response) GetSource() isResponse_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Response) GetCache() string {
+	if x != nil {
+		if x, ok := x.Source.(*Response_Cache); ok {
+			return x.Cache
+		}
+	}
+	return ""
+}
+
+func (x *Response) GetOrigin() string {
+	if x != nil {
+		if x, ok := x.Source.(*Response_Origin); ok {
+			return x.Origin
+		}
+	}
+	return ""
+}
+
+type isResponse_Source interface {
+	isResponse_Source()
+}
+
+type Response_Cache struct {
+	Cache string `protobuf:"bytes,8,opt,name=cache,proto3,oneof"`
+}
+
+type Response_Origin struct {
+	Origin string `protobuf:"bytes,9,opt,name=origin,proto3,oneof"`
+}
+
+func (*Response_Cache) isResponse_Source() {}
+
+func (*Response_Origin) isResponse_Source() {}
+
+// Fingerprint returns the 64-bit FNV-1a hash of the deterministic wire-format
+// encoding of x, ignoring the fields with the volatile option, as cleared by
+// ClearVolatileFields. Messages which differ only in volatile fields have the
+// same fingerprint when generated by the same binary. It returns 0 if x
+// cannot be marshaled.
+func (x *Response) Fingerprint() uint64 {
+	y := proto.CloneOf(x)
+	y.ClearVolatileFields()
+	b, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(y)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// ClearVolatileFields clears the fields of x and of its nested messages which
+// have the volatile option. Volatile message fields are cleared entirely.
+// Messages declared in other files are only cleared if they were also
+// generated with the method.
+func (x *Response) ClearVolatileFields() {
+	if x == nil {
+		return
+	}
+	m := x.ProtoReflect()
+	fds := m.Descriptor().Fields()
+	m.Clear(fds.ByNumber(2)) // served_at
+	m.Clear(fds.ByNumber(3)) // request_id
+	m.Clear(fds.ByNumber(7)) // trace
+	m.Clear(fds.ByNumber(8)) // cache
+	for _, v := range x.Items {
+		v.ClearVolatileFields()
+	}
+	for _, v := range x.ItemsByName {
+		v.ClearVolatileFields()
+	}
+	x.Best.ClearVolatileFields()
+}
+
+type Response_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	FetchedAt     int64                  `protobuf:"varint,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty" form:"fetched_at" uri:"fetched_at"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response_Item) Reset() {
+	*x = Response_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response_Item) ProtoMessage() {}
+
+func (x *Response_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response_Item.ProtoReflect.Descriptor instead.
+func (*Response_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Response_Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Response_Item) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return 0
+}
+
+// Fingerprint returns the 64-bit FNV-1a hash of the deterministic wire-format
+// encoding of x, ignoring the fields with the volatile option, as cleared by
+// ClearVolatileFields. Messages which differ only in volatile fields have the
+// same fingerprint when generated by the same binary. It returns 0 if x
+// cannot be marshaled.
+func (x *Response_Item) Fingerprint() uint64 {
+	y := proto.CloneOf(x)
+	y.ClearVolatileFields()
+	b, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(y)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// ClearVolatileFields clears the fields of x and of its nested messages which
+// have the volatile option. Volatile message fields are cleared entirely.
+// Messages declared in other files are only cleared if they were also
+// generated with the method.
+func (x *Response_Item) ClearVolatileFields() {
+	if x == nil {
+		return
+	}
+	m := x.ProtoReflect()
+	fds := m.Descriptor().Fields()
+	m.Clear(fds.ByNumber(2)) // fetched_at
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/fingerprint/fingerprint.proto\x12\"goproto.protoc.methods.fingerprint\x1a0cmd/protoc-gen-go/testdata/options/options.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x05\n" +
+	"\bResponse\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12=\n" +
+	"\tserved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xa0\xf4\x18\x01R\bservedAt\x12#\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tB\x04\xa0\xf4\x18\x01R\trequestId\x12G\n" +
+	"\x05items\x18\x04 \x03(\v21.goproto.protoc.methods.fingerprint.Response.ItemR\x05items\x12a\n" +
+	"\ritems_by_name\x18\x05 \x03(\v2=.goproto.protoc.methods.fingerprint.Response.ItemsByNameEntryR\vitemsByName\x12E\n" +
+	"\x04best\x18\x06 \x01(\v21.goproto.protoc.methods.fingerprint.Response.ItemR\x04best\x12\x1a\n" +
+	"\x05trace\x18\a \x03(\tB\x04\xa0\xf4\x18\x01R\x05trace\x12\x1c\n" +
+	"\x05cache\x18\b \x01(\tB\x04\xa0\xf4\x18\x01H\x00R\x05cache\x12\x18\n" +
+	"\x06origin\x18\t \x01(\tH\x00R\x06origin\x1a?\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\n" +
+	"fetched_at\x18\x02 \x01(\x03B\x04\xa0\xf4\x18\x01R\tfetchedAt\x1aq\n" +
+	"\x10ItemsByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12G\n" +
+	"\x05value\x18\x02 \x01(\v21.goproto.protoc.methods.fingerprint.Response.ItemR\x05value:\x028\x01B\b\n" +
+	"\x06sourceBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fingerprintb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_goTypes = []any{
+	(*Response)(nil),              // 0: goproto.protoc.methods.fingerprint.Response
+	(*Response_Item)(nil),         // 1: goproto.protoc.methods.fingerprint.Response.Item
+	nil,                           // 2: goproto.protoc.methods.fingerprint.Response.ItemsByNameEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_depIdxs = []int32{
+	3, // 0: goproto.protoc.methods.fingerprint.Response.served_at:type_name -> google.protobuf.Timestamp
+	1, // 1: goproto.protoc.methods.fingerprint.Response.items:type_name -> goproto.protoc.methods.fingerprint.Response.Item
+	2, // 2: goproto.protoc.methods.fingerprint.Response.items_by_name:type_name -> goproto.protoc.methods.fingerprint.Response.ItemsByNameEntry
+	1, // 3: goproto.protoc.methods.fingerprint.Response.best:type_name -> goproto.protoc.methods.fingerprint.Response.Item
+	1, // 4: goproto.protoc.methods.fingerprint.Response.ItemsByNameEntry.value:type_name -> goproto.protoc.methods.fingerprint.Response.Item
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes[0].OneofWrappers = []any{
+		(*Response_Cache)(nil),
+		(*Response_Origin)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_depIdxs = nil
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fingerprint/hybrid.proto
+
+//go:build !protoopaque
+
+package fingerprint
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	fnv "hash/fnv"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Snapshot struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	TakenAt       *int64                 `protobuf:"varint,2,opt,name=taken_at,json=takenAt" json:"taken_at,omitempty" form:"taken_at" uri:"taken_at"`
+	Response      *Response              `protobuf:"bytes,3,opt,name=response" json:"response,omitempty" form:"response" uri:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetTakenAt() int64 {
+	if x != nil && x.TakenAt != nil {
+		return *x.TakenAt
+	}
+	return 0
+}
+
+func (x *Snapshot) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *Snapshot) SetId(v string) {
+	x.Id = &v
+}
+
+func (x *Snapshot) SetTakenAt(v int64) {
+	x.TakenAt = &v
+}
+
+func (x *Snapshot) SetResponse(v *Response) {
+	x.Response = v
+}
+
+func (x *Snapshot) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return x.Id != nil
+}
+
+func (x *Snapshot) HasTakenAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.TakenAt != nil
+}
+
+func (x *Snapshot) HasResponse() bool {
+	if x == nil {
+		return false
+	}
+	return x.Response != nil
+}
+
+func (x *Snapshot) ClearId() {
+	x.Id = nil
+}
+
+func (x *Snapshot) ClearTakenAt() {
+	x.TakenAt = nil
+}
+
+func (x *Snapshot) ClearResponse() {
+	x.Response = nil
+}
+
+type Snapshot_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       *string
+	TakenAt  *int64
+	Response *Response
+}
+
+func (b0 Snapshot_builder) Build() *Snapshot {
+	m0 := &Snapshot{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.TakenAt = b.TakenAt
+	x.Response = b.Response
+	return m0
+}
+
+// Fingerprint returns the 64-bit FNV-1a hash of the deterministic wire-format
+// encoding of x, ignoring the fields with the volatile option, as cleared by
+// ClearVolatileFields. Messages which differ only in volatile fields have the
+// same fingerprint when generated by the same binary. It returns 0 if x
+// cannot be marshaled.
+func (x *Snapshot) Fingerprint() uint64 {
+	y := proto.CloneOf(x)
+	y.ClearVolatileFields()
+	b, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(y)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// ClearVolatileFields clears the fields of x and of its nested messages which
+// have the volatile option. Volatile message fields are cleared entirely.
+// Messages declared in other files are only cleared if they were also
+// generated with the method.
+func (x *Snapshot) ClearVolatileFields() {
+	if x == nil {
+		return
+	}
+	m := x.ProtoReflect()
+	fds := m.Descriptor().Fields()
+	m.Clear(fds.ByNumber(2)) // taken_at
+	if c, ok := any(x.GetResponse()).(interface{ ClearVolatileFields() }); ok {
+		c.ClearVolatileFields()
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_rawDesc = "" +
+	"\n" +
+	";cmd/protoc-gen-go/testdata/methods/fingerprint/hybrid.proto\x12\"goproto.protoc.methods.fingerprint\x1a@cmd/protoc-gen-go/testdata/methods/fingerprint/fingerprint.proto\x1a0cmd/protoc-gen-go/testdata/options/options.proto\x1a!google/protobuf/go_features.proto\"\x85\x01\n" +
+	"\bSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\btaken_at\x18\x02 \x01(\x03B\x04\xa0\xf4\x18\x01R\atakenAt\x12H\n" +
+	"\bresponse\x18\x03 \x01(\v2,.goproto.protoc.methods.fingerprint.ResponseR\bresponseBSZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fingerprint\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_goTypes = []any{
+	(*Snapshot)(nil), // 0: goproto.protoc.methods.fingerprint.Snapshot
+	(*Response)(nil), // 1: goproto.protoc.methods.fingerprint.Response
+}
+var file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.fingerprint.Snapshot.response:type_name -> goproto.protoc.methods.fingerprint.Response
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_fingerprint_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fingerprint_hybrid_proto_depIdxs = nil
+}
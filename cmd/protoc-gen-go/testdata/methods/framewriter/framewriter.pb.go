@@ -0,0 +1,162 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/framewriter/framewriter.proto
+
+package framewriter
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty" form:"message" uri:"message"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty" form:"timestamp" uri:"timestamp"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Record) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Record) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// WriteFramed marshals x and writes it to w as a frame consisting of the
+// length of the wire-format encoding as a 4-byte big-endian integer,
+// followed by the encoding itself.
+// It reports an error if the encoding exceeds math.MaxUint32 bytes.
+func (x *Record) WriteFramed(w io.Writer) error {
+	b, err := proto.Marshal(x)
+	if err != nil {
+		return err
+	}
+	if uint64(len(b)) > math.MaxUint32 {
+		return fmt.Errorf("goproto.protoc.methods.framewriter.Record: frame size %d exceeds %d bytes", len(b), uint64(math.MaxUint32))
+	}
+	frame := make([]byte, 4, 4+len(b))
+	binary.BigEndian.PutUint32(frame, uint32(len(b)))
+	_, err = w.Write(append(frame, b...))
+	return err
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/framewriter/framewriter.proto\x12\"goproto.protoc.methods.framewriter\"\xcb\x01\n" +
+	"\x06Record\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12N\n" +
+	"\x06labels\x18\x03 \x03(\v26.goproto.protoc.methods.framewriter.Record.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/framewriterb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_goTypes = []any{
+	(*Record)(nil), // 0: goproto.protoc.methods.framewriter.Record
+	nil,            // 1: goproto.protoc.methods.framewriter.Record.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.framewriter.Record.labels:type_name -> goproto.protoc.methods.framewriter.Record.LabelsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_framewriter_framewriter_proto_depIdxs = nil
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/freeze/freeze.proto
+
+//go:build protoopaque
+
+package freeze
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Config struct {
+	state                  protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                     `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Tags        []string                    `protobuf:"bytes,2,rep,name=tags"`
+	xxx_hidden_Primary     *Config_Endpoint            `protobuf:"bytes,3,opt,name=primary"`
+	xxx_hidden_Replicas    *[]*Config_Endpoint         `protobuf:"bytes,4,rep,name=replicas"`
+	xxx_hidden_Named       map[string]*Config_Endpoint `protobuf:"bytes,5,rep,name=named" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Auth        isConfig_Auth               `protobuf_oneof:"auth"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	xxx_frozen             bool
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	if x.xxx_frozen {
+		panic("Reset called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Config) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *Config) GetPrimary() *Config_Endpoint {
+	if x != nil {
+		return x.xxx_hidden_Primary
+	}
+	return nil
+}
+
+func (x *Config) GetReplicas() []*Config_Endpoint {
+	if x != nil {
+		if x.xxx_hidden_Replicas != nil {
+			return *x.xxx_hidden_Replicas
+		}
+	}
+	return nil
+}
+
+func (x *Config) GetNamed() map[string]*Config_Endpoint {
+	if x != nil {
+		return x.xxx_hidden_Named
+	}
+	return nil
+}
+
+func (x *Config) GetToken() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Auth.(*config_Token); ok {
+			return x.Token
+		}
+	}
+	return ""
+}
+
+func (x *Config) GetIssuer() *Config_Endpoint {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Auth.(*config_Issuer); ok {
+			return x.Issuer
+		}
+	}
+	return nil
+}
+
+func (x *Config) SetName(v string) {
+	if x.xxx_frozen {
+		panic("SetName called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *Config) SetTags(v []string) {
+	if x.xxx_frozen {
+		panic("SetTags called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Tags = v
+}
+
+func (x *Config) SetPrimary(v *Config_Endpoint) {
+	if x.xxx_frozen {
+		panic("SetPrimary called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Primary = v
+}
+
+func (x *Config) SetReplicas(v []*Config_Endpoint) {
+	if x.xxx_frozen {
+		panic("SetReplicas called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Replicas = &v
+}
+
+func (x *Config) SetNamed(v map[string]*Config_Endpoint) {
+	if x.xxx_frozen {
+		panic("SetNamed called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Named = v
+}
+
+func (x *Config) SetToken(v string) {
+	if x.xxx_frozen {
+		panic("SetToken called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Auth = &config_Token{v}
+}
+
+func (x *Config) SetIssuer(v *Config_Endpoint) {
+	if x.xxx_frozen {
+		panic("SetIssuer called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	if v == nil {
+		x.xxx_hidden_Auth = nil
+		return
+	}
+	x.xxx_hidden_Auth = &config_Issuer{v}
+}
+
+func (x *Config) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Config) HasPrimary() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Primary != nil
+}
+
+func (x *Config) HasAuth() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Auth != nil
+}
+
+func (x *Config) HasToken() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Auth.(*config_Token)
+	return ok
+}
+
+func (x *Config) HasIssuer() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Auth.(*config_Issuer)
+	return ok
+}
+
+func (x *Config) ClearName() {
+	if x.xxx_frozen {
+		panic("ClearName called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Config) ClearPrimary() {
+	if x.xxx_frozen {
+		panic("ClearPrimary called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Primary = nil
+}
+
+func (x *Config) ClearAuth() {
+	if x.xxx_frozen {
+		panic("ClearAuth called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	x.xxx_hidden_Auth = nil
+}
+
+func (x *Config) ClearToken() {
+	if x.xxx_frozen {
+		panic("ClearToken called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	if _, ok := x.xxx_hidden_Auth.(*config_Token); ok {
+		x.xxx_hidden_Auth = nil
+	}
+}
+
+func (x *Config) ClearIssuer() {
+	if x.xxx_frozen {
+		panic("ClearIssuer called on frozen message goproto.protoc.methods.freeze.Config")
+	}
+	if _, ok := x.xxx_hidden_Auth.(*config_Issuer); ok {
+		x.xxx_hidden_Auth = nil
+	}
+}
+
+const Config_Auth_not_set_case case_Config_Auth = 0
+const Config_Token_case case_Config_Auth = 6
+const Config_Issuer_case case_Config_Auth = 7
+
+func (x *Config) WhichAuth() case_Config_Auth {
+	if x == nil {
+		return Config_Auth_not_set_case
+	}
+	switch x.xxx_hidden_Auth.(type) {
+	case *config_Token:
+		return Config_Token_case
+	case *config_Issuer:
+		return Config_Issuer_case
+	default:
+		return Config_Auth_not_set_case
+	}
+}
+
+type Config_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name     *string
+	Tags     []string
+	Primary  *Config_Endpoint
+	Replicas []*Config_Endpoint
+	Named    map[string]*Config_Endpoint
+	// Fields of oneof xxx_hidden_Auth:
+	Token  *string
+	Issuer *Config_Endpoint
+	// -- end of xxx_hidden_Auth
+}
+
+func (b0 Config_builder) Build() *Config {
+	m0 := &Config{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Tags = b.Tags
+	x.xxx_hidden_Primary = b.Primary
+	x.xxx_hidden_Replicas = &b.Replicas
+	x.xxx_hidden_Named = b.Named
+	if b.Token != nil {
+		x.xxx_hidden_Auth = &config_Token{*b.Token}
+	}
+	if b.Issuer != nil {
+		x.xxx_hidden_Auth = &config_Issuer{b.Issuer}
+	}
+	return m0
+}
+
+type case_Config_Auth protoreflect.FieldNumber
+
+func (x case_Config_Auth) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isConfig_Auth interface {
+	isConfig_Auth()
+}
+
+type config_Token struct {
+	Token string `protobuf:"bytes,6,opt,name=token,oneof"`
+}
+
+type config_Issuer struct {
+	Issuer *Config_Endpoint `protobuf:"bytes,7,opt,name=issuer,oneof"`
+}
+
+func (*config_Token) isConfig_Auth() {}
+
+func (*config_Issuer) isConfig_Auth() {}
+
+// Freeze marks x and the messages held by its fields as read-only, after
+// which their setters, clearers and Reset method panic. Getters remain
+// usable. Since Reset panics, proto.Reset and proto.Unmarshal, which reset
+// the message, panic as well, and so do the generated methods replacing its
+// contents. A message is frozen in place, and it cannot be unfrozen; use
+// proto.Clone to obtain a mutable copy.
+//
+// Freeze guards only the generated methods: writes through the exported
+// fields of the open API, through protoreflect.Message or by merging into
+// x are not prevented. Freeze must not be called concurrently with other
+// uses of x.
+func (x *Config) Freeze() {
+	if x == nil {
+		return
+	}
+	x.xxx_frozen = true
+	x.GetPrimary().Freeze()
+	for _, v := range x.GetReplicas() {
+		v.Freeze()
+	}
+	for _, v := range x.GetNamed() {
+		v.Freeze()
+	}
+	x.GetIssuer().Freeze()
+}
+
+type Config_Endpoint struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Address     *string                `protobuf:"bytes,1,opt,name=address"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	xxx_frozen             bool
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Config_Endpoint) Reset() {
+	if x.xxx_frozen {
+		panic("Reset called on frozen message goproto.protoc.methods.freeze.Config.Endpoint")
+	}
+	*x = Config_Endpoint{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Endpoint) ProtoMessage() {}
+
+func (x *Config_Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Config_Endpoint) GetAddress() string {
+	if x != nil {
+		if x.xxx_hidden_Address != nil {
+			return *x.xxx_hidden_Address
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Config_Endpoint) SetAddress(v string) {
+	if x.xxx_frozen {
+		panic("SetAddress called on frozen message goproto.protoc.methods.freeze.Config.Endpoint")
+	}
+	x.xxx_hidden_Address = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *Config_Endpoint) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Config_Endpoint) ClearAddress() {
+	if x.xxx_frozen {
+		panic("ClearAddress called on frozen message goproto.protoc.methods.freeze.Config.Endpoint")
+	}
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Address = nil
+}
+
+type Config_Endpoint_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Address *string
+}
+
+func (b0 Config_Endpoint_builder) Build() *Config_Endpoint {
+	m0 := &Config_Endpoint{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Address != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Address = b.Address
+	}
+	return m0
+}
+
+// Freeze marks x and the messages held by its fields as read-only, after
+// which their setters, clearers and Reset method panic. Getters remain
+// usable. Since Reset panics, proto.Reset and proto.Unmarshal, which reset
+// the message, panic as well, and so do the generated methods replacing its
+// contents. A message is frozen in place, and it cannot be unfrozen; use
+// proto.Clone to obtain a mutable copy.
+//
+// Freeze guards only the generated methods: writes through the exported
+// fields of the open API, through protoreflect.Message or by merging into
+// x are not prevented. Freeze must not be called concurrently with other
+// uses of x.
+func (x *Config_Endpoint) Freeze() {
+	if x == nil {
+		return
+	}
+	x.xxx_frozen = true
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/methods/freeze/freeze.proto\x12\x1dgoproto.protoc.methods.freeze\x1a!google/protobuf/go_features.proto\"\x88\x04\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12H\n" +
+	"\aprimary\x18\x03 \x01(\v2..goproto.protoc.methods.freeze.Config.EndpointR\aprimary\x12J\n" +
+	"\breplicas\x18\x04 \x03(\v2..goproto.protoc.methods.freeze.Config.EndpointR\breplicas\x12F\n" +
+	"\x05named\x18\x05 \x03(\v20.goproto.protoc.methods.freeze.Config.NamedEntryR\x05named\x12\x16\n" +
+	"\x05token\x18\x06 \x01(\tH\x00R\x05token\x12H\n" +
+	"\x06issuer\x18\a \x01(\v2..goproto.protoc.methods.freeze.Config.EndpointH\x00R\x06issuer\x1a$\n" +
+	"\bEndpoint\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x1ah\n" +
+	"\n" +
+	"NamedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..goproto.protoc.methods.freeze.Config.EndpointR\x05value:\x028\x01B\x06\n" +
+	"\x04authBNZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freeze\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_goTypes = []any{
+	(*Config)(nil),          // 0: goproto.protoc.methods.freeze.Config
+	(*Config_Endpoint)(nil), // 1: goproto.protoc.methods.freeze.Config.Endpoint
+	nil,                     // 2: goproto.protoc.methods.freeze.Config.NamedEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.freeze.Config.primary:type_name -> goproto.protoc.methods.freeze.Config.Endpoint
+	1, // 1: goproto.protoc.methods.freeze.Config.replicas:type_name -> goproto.protoc.methods.freeze.Config.Endpoint
+	2, // 2: goproto.protoc.methods.freeze.Config.named:type_name -> goproto.protoc.methods.freeze.Config.NamedEntry
+	1, // 3: goproto.protoc.methods.freeze.Config.issuer:type_name -> goproto.protoc.methods.freeze.Config.Endpoint
+	1, // 4: goproto.protoc.methods.freeze.Config.NamedEntry.value:type_name -> goproto.protoc.methods.freeze.Config.Endpoint
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes[0].OneofWrappers = []any{
+		(*config_Token)(nil),
+		(*config_Issuer)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_freeze_freeze_proto_depIdxs = nil
+}
@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/freeze/replace.proto
+
+//go:build !protoopaque
+
+package freeze
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	url "net/url"
+	os "os"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+	unsafe "unsafe"
+)
+
+// Settings is replaced by the generated methods replacing its contents.
+type Settings struct {
+	state             protoimpl.MessageState `protogen:"hybrid.v1"`
+	Name              *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	Port              *int32                 `protobuf:"varint,2,opt,name=port" json:"port,omitempty" form:"port" uri:"port"`
+	xxx_touched       [1]uint32
+	xxx_frozen        bool
+	xxx_onFieldChange func(field protoreflect.Name, old, new any)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	if x.xxx_frozen {
+		panic("Reset called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	*x = Settings{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Settings) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Settings) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *Settings) SetName(v string) {
+	if x.xxx_frozen {
+		panic("SetName called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	x.xxx_touched[0] |= 1 << 0
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetName()
+		defer func() { h("name", old, x.GetName()) }()
+	}
+	x.Name = &v
+}
+
+func (x *Settings) SetPort(v int32) {
+	if x.xxx_frozen {
+		panic("SetPort called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	x.xxx_touched[0] |= 1 << 1
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetPort()
+		defer func() { h("port", old, x.GetPort()) }()
+	}
+	x.Port = &v
+}
+
+func (x *Settings) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return x.Name != nil
+}
+
+func (x *Settings) HasPort() bool {
+	if x == nil {
+		return false
+	}
+	return x.Port != nil
+}
+
+func (x *Settings) ClearName() {
+	if x.xxx_frozen {
+		panic("ClearName called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	x.Name = nil
+}
+
+func (x *Settings) ClearPort() {
+	if x.xxx_frozen {
+		panic("ClearPort called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	x.Port = nil
+}
+
+type Settings_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name *string
+	Port *int32
+}
+
+func (b0 Settings_builder) Build() *Settings {
+	m0 := &Settings{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Port = b.Port
+	return m0
+}
+
+// ApplyJSONPatch applies the JSON Patch document (RFC 6902) in patch to x.
+// The tokens of a JSON pointer name fields by their JSON or proto name, and
+// elements of repeated fields by their index. Values are decoded as by
+// protojson.Unmarshal.
+//
+// The add, replace and remove operations are supported on singular and
+// repeated fields and on elements of repeated fields, but not on map fields.
+// For fields, add and replace both set the field, and a null value clears
+// it. If an operation fails, an error is reported and x is left unchanged.
+func (x *Settings) ApplyJSONPatch(patch []byte) error {
+	if x.xxx_frozen {
+		panic("ApplyJSONPatch called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	saved_xxx_touched := x.xxx_touched
+	saved_xxx_onFieldChange := x.xxx_onFieldChange
+	err := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatch(x.ProtoReflect(), patch)
+	x.xxx_touched = saved_xxx_touched
+	x.xxx_onFieldChange = saved_xxx_onFieldChange
+	return err
+}
+
+// Freeze marks x and the messages held by its fields as read-only, after
+// which their setters, clearers and Reset method panic. Getters remain
+// usable. Since Reset panics, proto.Reset and proto.Unmarshal, which reset
+// the message, panic as well, and so do the generated methods replacing its
+// contents. A message is frozen in place, and it cannot be unfrozen; use
+// proto.Clone to obtain a mutable copy.
+//
+// Freeze guards only the generated methods: writes through the exported
+// fields of the open API, through protoreflect.Message or by merging into
+// x are not prevented. Freeze must not be called concurrently with other
+// uses of x.
+func (x *Settings) Freeze() {
+	if x == nil {
+		return
+	}
+	x.xxx_frozen = true
+}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Settings) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.HasName() {
+		vs.Set("name", x.GetName())
+	}
+	if x.HasPort() {
+		vs.Set("port", strconv.FormatInt(int64(x.GetPort()), 10))
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are ignored.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Settings) FromURLValues(vs url.Values) error {
+	if x.xxx_frozen {
+		panic("FromURLValues called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	y := proto.CloneOf(x)
+	if s := vs["name"]; len(s) > 0 {
+		y.SetName(s[0])
+	}
+	if s := vs["port"]; len(s) > 0 {
+		n, err := strconv.ParseInt(s[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "port", err)
+		}
+		y.SetPort(int32(n))
+	}
+	saved_xxx_touched := x.xxx_touched
+	saved_xxx_onFieldChange := x.xxx_onFieldChange
+	proto.Reset(x)
+	proto.Merge(x, y)
+	x.xxx_touched = saved_xxx_touched
+	x.xxx_onFieldChange = saved_xxx_onFieldChange
+	return nil
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are ignored. Keys which do
+// not name a field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Settings) FromKV(kv map[string]string) error {
+	if x.xxx_frozen {
+		panic("FromKV called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	for k := range kv {
+		switch k {
+		case "name", "port":
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.freeze.Settings", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["name"]; ok {
+		y.SetName(s)
+	}
+	if s, ok := kv["port"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "port", err)
+		}
+		y.SetPort(int32(n))
+	}
+	saved_xxx_touched := x.xxx_touched
+	saved_xxx_onFieldChange := x.xxx_onFieldChange
+	proto.Reset(x)
+	proto.Merge(x, y)
+	x.xxx_touched = saved_xxx_touched
+	x.xxx_onFieldChange = saved_xxx_onFieldChange
+	return nil
+}
+
+// ApplyEnvOverrides sets each singular scalar field of x from the environment
+// variable named by prefix followed by the proto name of the field in upper
+// snake case, if it is set. Enums are given by name or number, and bytes in
+// standard base64. Fields whose variable is not set are unchanged.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Settings) ApplyEnvOverrides(prefix string) error {
+	if x.xxx_frozen {
+		panic("ApplyEnvOverrides called on frozen message goproto.protoc.methods.freeze.Settings")
+	}
+	y := proto.CloneOf(x)
+	if s, ok := os.LookupEnv(prefix + "NAME"); ok {
+		y.SetName(s)
+	}
+	if s, ok := os.LookupEnv(prefix + "PORT"); ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("environment variable %q: %v", prefix+"PORT", err)
+		}
+		y.SetPort(int32(n))
+	}
+	saved_xxx_touched := x.xxx_touched
+	saved_xxx_onFieldChange := x.xxx_onFieldChange
+	proto.Reset(x)
+	proto.Merge(x, y)
+	x.xxx_touched = saved_xxx_touched
+	x.xxx_onFieldChange = saved_xxx_onFieldChange
+	return nil
+}
+
+// TouchedFields returns the names of the fields of x which were written by
+// a setter since x was created or ClearTouched was last called, in the
+// order in which the fields are declared. Reset forgets the fields, as
+// ClearTouched does, but the generated methods replacing the contents of x,
+// such as FromKV, remember them while not recording the fields they write.
+func (x *Settings) TouchedFields() []protoreflect.Name {
+	if x == nil {
+		return nil
+	}
+	var names []protoreflect.Name
+	if x.xxx_touched[0]&(1<<0) != 0 {
+		names = append(names, "name")
+	}
+	if x.xxx_touched[0]&(1<<1) != 0 {
+		names = append(names, "port")
+	}
+	return names
+}
+
+// ClearTouched forgets which fields of x were written by setters.
+// The values of the fields are unchanged.
+func (x *Settings) ClearTouched() {
+	if x == nil {
+		return
+	}
+	x.xxx_touched = [1]uint32{}
+}
+
+// OnFieldChange registers f to be called by each setter of x after it has
+// written a field, with the name of the field and the values returned by its
+// getter before and after the write. A later call replaces f, and a nil f
+// removes it. Clearers, builders, reflection and unmarshaling do not call f,
+// nor do the setters of the messages held by x. The function is not copied
+// by proto.Clone or proto.Merge, and it is removed by Reset but kept by the
+// generated methods replacing the contents of x, such as FromKV.
+func (x *Settings) OnFieldChange(f func(field protoreflect.Name, old, new any)) {
+	x.xxx_onFieldChange = f
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatch applies the JSON Patch document in patch to m.
+// The operations are applied to a copy of m, which replaces the contents
+// of m if all of them succeed.
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatch(m protoreflect.Message, patch []byte) error {
+	var ops []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return fmt.Errorf("invalid JSON patch: %v", err)
+	}
+	y := proto.Clone(m.Interface())
+	for i, op := range ops {
+		if err := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchOp(y.ProtoReflect(), op.Op, op.Path, op.Value); err != nil {
+			return fmt.Errorf("JSON patch operation %d: %v", i, err)
+		}
+	}
+	proto.Reset(m.Interface())
+	proto.Merge(m.Interface(), y)
+	return nil
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchOp applies the operation op with the given value to the
+// location in m identified by the JSON pointer path.
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchOp(m protoreflect.Message, op, path string, value json.RawMessage) error {
+	switch op {
+	case "add", "replace", "remove":
+	default:
+		return fmt.Errorf("unsupported operation %q", op)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("%s: invalid path %q", op, path)
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	for {
+		fd := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchField(m.Descriptor(), tokens[0])
+		tokens = tokens[1:]
+		switch {
+		case fd == nil || fd.IsMap():
+			return fmt.Errorf("%s: unsupported path %q", op, path)
+		case len(tokens) == 0:
+			if op == "remove" {
+				m.Clear(fd)
+				return nil
+			}
+			v, err := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchValue(m, fd, value, false)
+			if err != nil {
+				return fmt.Errorf("%s %q: %v", op, path, err)
+			}
+			if !v.IsValid() {
+				m.Clear(fd)
+				return nil
+			}
+			m.Set(fd, v)
+			return nil
+		case fd.IsList() && len(tokens) == 1:
+			if err := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchElem(m, fd, op, tokens[0], value); err != nil {
+				return fmt.Errorf("%s %q: %v", op, path, err)
+			}
+			return nil
+		case fd.IsList() && fd.Message() != nil:
+			l := m.Mutable(fd).List()
+			i, ok := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchIndex(tokens[0], l.Len(), false)
+			if !ok {
+				return fmt.Errorf("%s: path %q does not exist", op, path)
+			}
+			m = l.Get(i).Message()
+			tokens = tokens[1:]
+		case fd.Message() != nil && !fd.IsList():
+			if op != "add" && !m.Has(fd) {
+				return fmt.Errorf("%s: path %q does not exist", op, path)
+			}
+			m = m.Mutable(fd).Message()
+		default:
+			return fmt.Errorf("%s: unsupported path %q", op, path)
+		}
+	}
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchElem applies the operation op to the element of the
+// repeated field fd of m with the index given by the token.
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_applyJSONPatchElem(m protoreflect.Message, fd protoreflect.FieldDescriptor, op, token string, value json.RawMessage) error {
+	l := m.Mutable(fd).List()
+	i, ok := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchIndex(token, l.Len(), op == "add")
+	if !ok {
+		return fmt.Errorf("index %q out of range", token)
+	}
+	if op == "remove" {
+		for j := i; j < l.Len()-1; j++ {
+			l.Set(j, l.Get(j+1))
+		}
+		l.Truncate(l.Len() - 1)
+		return nil
+	}
+	v, err := file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchValue(m, fd, value, true)
+	if err != nil {
+		return err
+	}
+	if op == "add" {
+		l.Append(v)
+		for j := l.Len() - 1; j > i; j-- {
+			l.Set(j, l.Get(j-1))
+		}
+	}
+	l.Set(i, v)
+	return nil
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchField returns the field of md with the JSON or proto name
+// given by the token, or nil if there is none.
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchField(md protoreflect.MessageDescriptor, token string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(token); fd != nil {
+		return fd
+	}
+	return md.Fields().ByName(protoreflect.Name(token))
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchIndex returns the index of a list with n elements given by
+// the token and whether it is in range. If add is set, the index may be n,
+// which is also given by the token "-".
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchIndex(token string, n int, add bool) (int, bool) {
+	if token == "-" && add {
+		return n, true
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	i := 0
+	for _, c := range token {
+		if c < '0' || c > '9' || i > n {
+			return 0, false
+		}
+		i = 10*i + int(c-'0')
+	}
+	return i, i < n || (add && i == n)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchValue decodes the JSON value of the field fd of m, or of an
+// element of fd if elem is set. It returns an invalid value if the decoded
+// field is unset, such as for a null value.
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_jsonPatchValue(m protoreflect.Message, fd protoreflect.FieldDescriptor, value json.RawMessage, elem bool) (protoreflect.Value, error) {
+	if value == nil {
+		return protoreflect.Value{}, fmt.Errorf("missing value")
+	}
+	if elem {
+		value = append(append(json.RawMessage("["), value...), ']')
+	}
+	name, _ := json.Marshal(fd.JSONName())
+	b := append(append(append(append([]byte("{"), name...), ':'), value...), '}')
+	tmp := m.New()
+	if err := protojson.Unmarshal(b, tmp.Interface()); err != nil {
+		return protoreflect.Value{}, err
+	}
+	switch {
+	case elem:
+		return tmp.Get(fd).List().Get(0), nil
+	case !tmp.Has(fd):
+		return protoreflect.Value{}, nil
+	}
+	return tmp.Get(fd), nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/methods/freeze/replace.proto\x12\x1dgoproto.protoc.methods.freeze\x1a!google/protobuf/go_features.proto\"2\n" +
+	"\bSettings\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04portBNZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freeze\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_goTypes = []any{
+	(*Settings)(nil), // 0: goproto.protoc.methods.freeze.Settings
+}
+var file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_freeze_replace_proto_depIdxs = nil
+}
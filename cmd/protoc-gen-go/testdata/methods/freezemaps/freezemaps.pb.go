@@ -0,0 +1,327 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/freezemaps/freezemaps.proto
+
+package freezemaps
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Inventory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        map[string]int32       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Shelves       map[uint64]*Shelf      `protobuf:"bytes,2,rep,name=shelves,proto3" json:"shelves,omitempty" form:"shelves" uri:"shelves" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Flags         map[bool]string        `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty" form:"flags" uri:"flags" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Front         *Shelf                 `protobuf:"bytes,4,opt,name=front,proto3" json:"front,omitempty" form:"front" uri:"front"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory) Reset() {
+	*x = Inventory{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory) ProtoMessage() {}
+
+func (x *Inventory) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Inventory) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Inventory) GetShelves() map[uint64]*Shelf {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+func (x *Inventory) GetFlags() map[bool]string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Inventory) GetFront() *Shelf {
+	if x != nil {
+		return x.Front
+	}
+	return nil
+}
+
+// SortedCounts returns the entries of the counts map field sorted by
+// key, or nil if the map is empty, for callers which need to visit the map
+// in a stable order. Message values are the messages held by the map, and
+// are not copied, so their own map fields are sorted separately.
+func (x *Inventory) SortedCounts() []struct {
+	Key   string
+	Value int32
+} {
+	mv := x.GetCounts()
+	if len(mv) == 0 {
+		return nil
+	}
+	entries := make([]struct {
+		Key   string
+		Value int32
+	}, 0, len(mv))
+	for k, v := range mv {
+		entries = append(entries, struct {
+			Key   string
+			Value int32
+		}{k, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// SortedShelves returns the entries of the shelves map field sorted by
+// key, or nil if the map is empty, for callers which need to visit the map
+// in a stable order. Message values are the messages held by the map, and
+// are not copied, so their own map fields are sorted separately.
+func (x *Inventory) SortedShelves() []struct {
+	Key   uint64
+	Value *Shelf
+} {
+	mv := x.GetShelves()
+	if len(mv) == 0 {
+		return nil
+	}
+	entries := make([]struct {
+		Key   uint64
+		Value *Shelf
+	}, 0, len(mv))
+	for k, v := range mv {
+		entries = append(entries, struct {
+			Key   uint64
+			Value *Shelf
+		}{k, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// SortedFlags returns the entries of the flags map field sorted by
+// key, or nil if the map is empty, for callers which need to visit the map
+// in a stable order. Message values are the messages held by the map, and
+// are not copied, so their own map fields are sorted separately.
+func (x *Inventory) SortedFlags() []struct {
+	Key   bool
+	Value string
+} {
+	mv := x.GetFlags()
+	if len(mv) == 0 {
+		return nil
+	}
+	entries := make([]struct {
+		Key   bool
+		Value string
+	}, 0, len(mv))
+	for k, v := range mv {
+		entries = append(entries, struct {
+			Key   bool
+			Value string
+		}{k, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return !entries[i].Key && entries[j].Key
+	})
+	return entries
+}
+
+type Shelf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         map[int32][]byte       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty" form:"slots" uri:"slots" protobuf_key:"zigzag32,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shelf) Reset() {
+	*x = Shelf{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shelf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shelf) ProtoMessage() {}
+
+func (x *Shelf) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shelf.ProtoReflect.Descriptor instead.
+func (*Shelf) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Shelf) GetSlots() map[int32][]byte {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// SortedSlots returns the entries of the slots map field sorted by
+// key, or nil if the map is empty, for callers which need to visit the map
+// in a stable order. Message values are the messages held by the map, and
+// are not copied, so their own map fields are sorted separately.
+func (x *Shelf) SortedSlots() []struct {
+	Key   int32
+	Value []byte
+} {
+	mv := x.GetSlots()
+	if len(mv) == 0 {
+		return nil
+	}
+	entries := make([]struct {
+		Key   int32
+		Value []byte
+	}, 0, len(mv))
+	for k, v := range mv {
+		entries = append(entries, struct {
+			Key   int32
+			Value []byte
+		}{k, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/freezemaps/freezemaps.proto\x12!goproto.protoc.methods.freezemaps\"\x9c\x04\n" +
+	"\tInventory\x12P\n" +
+	"\x06counts\x18\x01 \x03(\v28.goproto.protoc.methods.freezemaps.Inventory.CountsEntryR\x06counts\x12S\n" +
+	"\ashelves\x18\x02 \x03(\v29.goproto.protoc.methods.freezemaps.Inventory.ShelvesEntryR\ashelves\x12M\n" +
+	"\x05flags\x18\x03 \x03(\v27.goproto.protoc.methods.freezemaps.Inventory.FlagsEntryR\x05flags\x12>\n" +
+	"\x05front\x18\x04 \x01(\v2(.goproto.protoc.methods.freezemaps.ShelfR\x05front\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1ad\n" +
+	"\fShelvesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.goproto.protoc.methods.freezemaps.ShelfR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\bR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8c\x01\n" +
+	"\x05Shelf\x12I\n" +
+	"\x05slots\x18\x01 \x03(\v23.goproto.protoc.methods.freezemaps.Shelf.SlotsEntryR\x05slots\x1a8\n" +
+	"\n" +
+	"SlotsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x11R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01BJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/freezemapsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_goTypes = []any{
+	(*Inventory)(nil), // 0: goproto.protoc.methods.freezemaps.Inventory
+	(*Shelf)(nil),     // 1: goproto.protoc.methods.freezemaps.Shelf
+	nil,               // 2: goproto.protoc.methods.freezemaps.Inventory.CountsEntry
+	nil,               // 3: goproto.protoc.methods.freezemaps.Inventory.ShelvesEntry
+	nil,               // 4: goproto.protoc.methods.freezemaps.Inventory.FlagsEntry
+	nil,               // 5: goproto.protoc.methods.freezemaps.Shelf.SlotsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.freezemaps.Inventory.counts:type_name -> goproto.protoc.methods.freezemaps.Inventory.CountsEntry
+	3, // 1: goproto.protoc.methods.freezemaps.Inventory.shelves:type_name -> goproto.protoc.methods.freezemaps.Inventory.ShelvesEntry
+	4, // 2: goproto.protoc.methods.freezemaps.Inventory.flags:type_name -> goproto.protoc.methods.freezemaps.Inventory.FlagsEntry
+	1, // 3: goproto.protoc.methods.freezemaps.Inventory.front:type_name -> goproto.protoc.methods.freezemaps.Shelf
+	5, // 4: goproto.protoc.methods.freezemaps.Shelf.slots:type_name -> goproto.protoc.methods.freezemaps.Shelf.SlotsEntry
+	1, // 5: goproto.protoc.methods.freezemaps.Inventory.ShelvesEntry.value:type_name -> goproto.protoc.methods.freezemaps.Shelf
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_freezemaps_freezemaps_proto_depIdxs = nil
+}
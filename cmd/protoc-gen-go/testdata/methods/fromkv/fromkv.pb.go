@@ -0,0 +1,554 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fromkv/fromkv.proto
+
+package fromkv
+
+import (
+	base64 "encoding/base64"
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config_Mode int32
+
+const (
+	Config_MODE_UNSPECIFIED Config_Mode = 0
+	Config_MODE_FAST        Config_Mode = 1
+	Config_MODE_SAFE        Config_Mode = 2
+)
+
+// Enum value maps for Config_Mode.
+var (
+	Config_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_FAST",
+		2: "MODE_SAFE",
+	}
+	Config_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_FAST":        1,
+		"MODE_SAFE":        2,
+	}
+)
+
+func (x Config_Mode) Enum() *Config_Mode {
+	p := new(Config_Mode)
+	*p = x
+	return p
+}
+
+func (x Config_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Config_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_enumTypes[0].Descriptor()
+}
+
+func (Config_Mode) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_enumTypes[0]
+}
+
+func (x Config_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Config_Mode.Descriptor instead.
+func (Config_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Config struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Workers   int32                  `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty" form:"workers" uri:"workers"`
+	Budget    *uint64                `protobuf:"varint,3,opt,name=budget,proto3,oneof" json:"budget,omitempty" form:"budget" uri:"budget"`
+	Verbose   bool                   `protobuf:"varint,4,opt,name=verbose,proto3" json:"verbose,omitempty" form:"verbose" uri:"verbose"`
+	Ratio     float32                `protobuf:"fixed32,5,opt,name=ratio,proto3" json:"ratio,omitempty" form:"ratio" uri:"ratio"`
+	Threshold float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty" form:"threshold" uri:"threshold"`
+	Mode      Config_Mode            `protobuf:"varint,7,opt,name=mode,proto3,enum=goproto.protoc.methods.fromkv.Config_Mode" json:"mode,omitempty" form:"mode" uri:"mode"`
+	Token     []byte                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty" form:"token" uri:"token"`
+	Offset    int64                  `protobuf:"zigzag64,9,opt,name=offset,proto3" json:"offset,omitempty" form:"offset" uri:"offset"`
+	Limits    *Config_Limits         `protobuf:"bytes,10,opt,name=limits,proto3" json:"limits,omitempty" form:"limits" uri:"limits"`
+	Tags      []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Labels    map[string]string      `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Config_Host
+	//	*Config_Port
+	Target        isConfig_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Config) GetBudget() uint64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *Config) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *Config) GetRatio() float32 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Config) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Config) GetMode() Config_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Config_MODE_UNSPECIFIED
+}
+
+func (x *Config) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Config) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Config) GetLimits() *Config_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Config) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Config) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Config) GetTarget() isConfig_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Config) GetHost() string {
+	if x != nil {
+		if x, ok := x.Target.(*Config_Host); ok {
+			return x.Host
+		}
+	}
+	return ""
+}
+
+func (x *Config) GetPort() uint32 {
+	if x != nil {
+		if x, ok := x.Target.(*Config_Port); ok {
+			return x.Port
+		}
+	}
+	return 0
+}
+
+type isConfig_Target interface {
+	isConfig_Target()
+}
+
+type Config_Host struct {
+	Host string `protobuf:"bytes,13,opt,name=host,proto3,oneof"`
+}
+
+type Config_Port struct {
+	Port uint32 `protobuf:"fixed32,14,opt,name=port,proto3,oneof"`
+}
+
+func (*Config_Host) isConfig_Target() {}
+
+func (*Config_Port) isConfig_Target() {}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are ignored. Keys which do
+// not name a field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Config) FromKV(kv map[string]string) error {
+	for k := range kv {
+		switch k {
+		case "name", "workers", "budget", "verbose", "ratio", "threshold", "mode", "token", "offset", "host", "port", "limits", "tags", "labels":
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.Config", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["name"]; ok {
+		y.Name = s
+	}
+	if s, ok := kv["workers"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "workers", err)
+		}
+		y.Workers = int32(n)
+	}
+	if s, ok := kv["budget"]; ok {
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "budget", err)
+		}
+		y.Budget = &v
+	}
+	if s, ok := kv["verbose"]; ok {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "verbose", err)
+		}
+		y.Verbose = v
+	}
+	if s, ok := kv["ratio"]; ok {
+		n, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "ratio", err)
+		}
+		y.Ratio = float32(n)
+	}
+	if s, ok := kv["threshold"]; ok {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "threshold", err)
+		}
+		y.Threshold = v
+	}
+	if s, ok := kv["mode"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if e, ok := Config_Mode_value[s]; ok {
+			n, err = int64(e), nil
+		}
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "mode", err)
+		}
+		y.Mode = Config_Mode(n)
+	}
+	if s, ok := kv["token"]; ok {
+		v, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "token", err)
+		}
+		y.Token = v
+	}
+	if s, ok := kv["offset"]; ok {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "offset", err)
+		}
+		y.Offset = v
+	}
+	if s, ok := kv["host"]; ok {
+		y.Target = &Config_Host{Host: s}
+	}
+	if s, ok := kv["port"]; ok {
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "port", err)
+		}
+		y.Target = &Config_Port{Port: uint32(n)}
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescGZIP(), []int{1}
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are ignored. Keys which do
+// not name a field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Empty) FromKV(kv map[string]string) error {
+	for k := range kv {
+		return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.Empty", k)
+	}
+	return nil
+}
+
+type Config_Limits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Max           int32                  `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty" form:"max" uri:"max"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Limits) Reset() {
+	*x = Config_Limits{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Limits) ProtoMessage() {}
+
+func (x *Config_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Limits.ProtoReflect.Descriptor instead.
+func (*Config_Limits) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Config_Limits) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are ignored. Keys which do
+// not name a field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Config_Limits) FromKV(kv map[string]string) error {
+	for k := range kv {
+		switch k {
+		case "max":
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.Config.Limits", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["max"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "max", err)
+		}
+		y.Max = int32(n)
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/methods/fromkv/fromkv.proto\x12\x1dgoproto.protoc.methods.fromkv\"\x88\x05\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aworkers\x18\x02 \x01(\x05R\aworkers\x12\x1b\n" +
+	"\x06budget\x18\x03 \x01(\x04H\x01R\x06budget\x88\x01\x01\x12\x18\n" +
+	"\averbose\x18\x04 \x01(\bR\averbose\x12\x14\n" +
+	"\x05ratio\x18\x05 \x01(\x02R\x05ratio\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12>\n" +
+	"\x04mode\x18\a \x01(\x0e2*.goproto.protoc.methods.fromkv.Config.ModeR\x04mode\x12\x14\n" +
+	"\x05token\x18\b \x01(\fR\x05token\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x12R\x06offset\x12D\n" +
+	"\x06limits\x18\n" +
+	" \x01(\v2,.goproto.protoc.methods.fromkv.Config.LimitsR\x06limits\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12I\n" +
+	"\x06labels\x18\f \x03(\v21.goproto.protoc.methods.fromkv.Config.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x04host\x18\r \x01(\tH\x00R\x04host\x12\x14\n" +
+	"\x04port\x18\x0e \x01(\aH\x00R\x04port\x1a\x1a\n" +
+	"\x06Limits\x12\x10\n" +
+	"\x03max\x18\x01 \x01(\x05R\x03max\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\":\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tMODE_FAST\x10\x01\x12\r\n" +
+	"\tMODE_SAFE\x10\x02B\b\n" +
+	"\x06targetB\t\n" +
+	"\a_budget\"\a\n" +
+	"\x05EmptyBFZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkvb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_goTypes = []any{
+	(Config_Mode)(0),      // 0: goproto.protoc.methods.fromkv.Config.Mode
+	(*Config)(nil),        // 1: goproto.protoc.methods.fromkv.Config
+	(*Empty)(nil),         // 2: goproto.protoc.methods.fromkv.Empty
+	(*Config_Limits)(nil), // 3: goproto.protoc.methods.fromkv.Config.Limits
+	nil,                   // 4: goproto.protoc.methods.fromkv.Config.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.fromkv.Config.mode:type_name -> goproto.protoc.methods.fromkv.Config.Mode
+	3, // 1: goproto.protoc.methods.fromkv.Config.limits:type_name -> goproto.protoc.methods.fromkv.Config.Limits
+	4, // 2: goproto.protoc.methods.fromkv.Config.labels:type_name -> goproto.protoc.methods.fromkv.Config.LabelsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes[0].OneofWrappers = []any{
+		(*Config_Host)(nil),
+		(*Config_Port)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_fromkv_proto_depIdxs = nil
+}
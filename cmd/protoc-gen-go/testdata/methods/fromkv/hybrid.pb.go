@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fromkv/hybrid.proto
+
+//go:build !protoopaque
+
+package fromkv
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	strconv "strconv"
+	unsafe "unsafe"
+)
+
+type Job_Priority int32
+
+const (
+	Job_PRIORITY_UNSPECIFIED Job_Priority = 0
+	Job_PRIORITY_HIGH        Job_Priority = 1
+)
+
+// Enum value maps for Job_Priority.
+var (
+	Job_Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_HIGH",
+	}
+	Job_Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_HIGH":        1,
+	}
+)
+
+func (x Job_Priority) Enum() *Job_Priority {
+	p := new(Job_Priority)
+	*p = x
+	return p
+}
+
+func (x Job_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_enumTypes[0].Descriptor()
+}
+
+func (Job_Priority) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_enumTypes[0]
+}
+
+func (x Job_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type Job struct {
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id       *string                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Retries  int32                  `protobuf:"varint,2,opt,name=retries" json:"retries,omitempty" form:"retries" uri:"retries"`
+	Priority *Job_Priority          `protobuf:"varint,3,opt,name=priority,enum=goproto.protoc.methods.fromkv.Job_Priority" json:"priority,omitempty" form:"priority" uri:"priority"`
+	// Types that are valid to be assigned to Schedule:
+	//
+	//	*Job_Delay
+	//	*Job_Cron
+	Schedule      isJob_Schedule `protobuf_oneof:"schedule"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Job) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *Job) GetPriority() Job_Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return Job_PRIORITY_UNSPECIFIED
+}
+
+func (x *Job) GetSchedule() isJob_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Job) GetDelay() int64 {
+	if x != nil {
+		if x, ok := x.Schedule.(*Job_Delay); ok {
+			return x.Delay
+		}
+	}
+	return 0
+}
+
+func (x *Job) GetCron() string {
+	if x != nil {
+		if x, ok := x.Schedule.(*Job_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+func (x *Job) SetId(v string) {
+	x.Id = &v
+}
+
+func (x *Job) SetRetries(v int32) {
+	x.Retries = v
+}
+
+func (x *Job) SetPriority(v Job_Priority) {
+	x.Priority = &v
+}
+
+func (x *Job) SetDelay(v int64) {
+	x.Schedule = &Job_Delay{v}
+}
+
+func (x *Job) SetCron(v string) {
+	x.Schedule = &Job_Cron{v}
+}
+
+func (x *Job) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return x.Id != nil
+}
+
+func (x *Job) HasPriority() bool {
+	if x == nil {
+		return false
+	}
+	return x.Priority != nil
+}
+
+func (x *Job) HasSchedule() bool {
+	if x == nil {
+		return false
+	}
+	return x.Schedule != nil
+}
+
+func (x *Job) HasDelay() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Schedule.(*Job_Delay)
+	return ok
+}
+
+func (x *Job) HasCron() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Schedule.(*Job_Cron)
+	return ok
+}
+
+func (x *Job) ClearId() {
+	x.Id = nil
+}
+
+func (x *Job) ClearPriority() {
+	x.Priority = nil
+}
+
+func (x *Job) ClearSchedule() {
+	x.Schedule = nil
+}
+
+func (x *Job) ClearDelay() {
+	if _, ok := x.Schedule.(*Job_Delay); ok {
+		x.Schedule = nil
+	}
+}
+
+func (x *Job) ClearCron() {
+	if _, ok := x.Schedule.(*Job_Cron); ok {
+		x.Schedule = nil
+	}
+}
+
+const Job_Schedule_not_set_case case_Job_Schedule = 0
+const Job_Delay_case case_Job_Schedule = 4
+const Job_Cron_case case_Job_Schedule = 5
+
+func (x *Job) WhichSchedule() case_Job_Schedule {
+	if x == nil {
+		return Job_Schedule_not_set_case
+	}
+	switch x.Schedule.(type) {
+	case *Job_Delay:
+		return Job_Delay_case
+	case *Job_Cron:
+		return Job_Cron_case
+	default:
+		return Job_Schedule_not_set_case
+	}
+}
+
+type Job_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       *string
+	Retries  int32
+	Priority *Job_Priority
+	// Fields of oneof Schedule:
+	Delay *int64
+	Cron  *string
+	// -- end of Schedule
+}
+
+func (b0 Job_builder) Build() *Job {
+	m0 := &Job{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Retries = b.Retries
+	x.Priority = b.Priority
+	if b.Delay != nil {
+		x.Schedule = &Job_Delay{*b.Delay}
+	}
+	if b.Cron != nil {
+		x.Schedule = &Job_Cron{*b.Cron}
+	}
+	return m0
+}
+
+type case_Job_Schedule protoreflect.FieldNumber
+
+func (x case_Job_Schedule) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isJob_Schedule interface {
+	isJob_Schedule()
+}
+
+type Job_Delay struct {
+	Delay int64 `protobuf:"varint,4,opt,name=delay,oneof"`
+}
+
+type Job_Cron struct {
+	Cron string `protobuf:"bytes,5,opt,name=cron,oneof"`
+}
+
+func (*Job_Delay) isJob_Schedule() {}
+
+func (*Job_Cron) isJob_Schedule() {}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are ignored. Keys which do
+// not name a field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Job) FromKV(kv map[string]string) error {
+	for k := range kv {
+		switch k {
+		case "id", "retries", "priority", "delay", "cron":
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.Job", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["id"]; ok {
+		y.SetId(s)
+	}
+	if s, ok := kv["retries"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "retries", err)
+		}
+		y.SetRetries(int32(n))
+	}
+	if s, ok := kv["priority"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if e, ok := Job_Priority_value[s]; ok {
+			n, err = int64(e), nil
+		}
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "priority", err)
+		}
+		y.SetPriority(Job_Priority(n))
+	}
+	if s, ok := kv["delay"]; ok {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "delay", err)
+		}
+		y.SetDelay(v)
+	}
+	if s, ok := kv["cron"]; ok {
+		y.SetCron(s)
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/methods/fromkv/hybrid.proto\x12\x1dgoproto.protoc.methods.fromkv\x1a!google/protobuf/go_features.proto\"\xf2\x01\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\aretries\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\aretries\x12G\n" +
+	"\bpriority\x18\x03 \x01(\x0e2+.goproto.protoc.methods.fromkv.Job.PriorityR\bpriority\x12\x16\n" +
+	"\x05delay\x18\x04 \x01(\x03H\x00R\x05delay\x12\x14\n" +
+	"\x04cron\x18\x05 \x01(\tH\x00R\x04cron\"7\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x01B\n" +
+	"\n" +
+	"\bscheduleBNZDgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_goTypes = []any{
+	(Job_Priority)(0), // 0: goproto.protoc.methods.fromkv.Job.Priority
+	(*Job)(nil),       // 1: goproto.protoc.methods.fromkv.Job
+}
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.fromkv.Job.priority:type_name -> goproto.protoc.methods.fromkv.Job.Priority
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Job_Delay)(nil),
+		(*Job_Cron)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/fromkv/unsupported/unsupported.proto
+
+package unsupported
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Ports         []int32                `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty" form:"ports" uri:"ports"`
+	Limits        *Config_Limits         `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty" form:"limits" uri:"limits"`
+	Weights       map[string]int32       `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty" form:"weights" uri:"weights" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetPorts() []int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Config) GetLimits() *Config_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Config) GetWeights() map[string]int32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are reported as an error,
+// as are keys which do not name a field of x.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Config) FromKV(kv map[string]string) error {
+	for k := range kv {
+		switch k {
+		case "name":
+		case "ports", "limits", "weights":
+			return fmt.Errorf("key %q does not name a scalar field of message goproto.protoc.methods.fromkv.unsupported.Config", k)
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.unsupported.Config", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["name"]; ok {
+		y.Name = s
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescGZIP(), []int{1}
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are reported as an error,
+// as are keys which do not name a field of x.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Empty) FromKV(kv map[string]string) error {
+	for k := range kv {
+		return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.unsupported.Empty", k)
+	}
+	return nil
+}
+
+type Config_Limits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Max           int32                  `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty" form:"max" uri:"max"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Limits) Reset() {
+	*x = Config_Limits{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Limits) ProtoMessage() {}
+
+func (x *Config_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Limits.ProtoReflect.Descriptor instead.
+func (*Config_Limits) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Config_Limits) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// FromKV sets the singular scalar fields of x from the values in kv, keyed
+// by the proto name of each field. Enums are given by name or number, and
+// bytes in standard base64. Fields without a key in kv are unchanged.
+// Keys naming a message, repeated or map field are reported as an error,
+// as are keys which do not name a field of x.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Config_Limits) FromKV(kv map[string]string) error {
+	for k := range kv {
+		switch k {
+		case "max":
+		default:
+			return fmt.Errorf("unknown key %q for message goproto.protoc.methods.fromkv.unsupported.Config.Limits", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s, ok := kv["max"]; ok {
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("key %q: %v", "max", err)
+		}
+		y.Max = int32(n)
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDesc = "" +
+	"\n" +
+	"Gcmd/protoc-gen-go/testdata/methods/fromkv/unsupported/unsupported.proto\x12)goproto.protoc.methods.fromkv.unsupported\"\xb6\x02\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05ports\x18\x02 \x03(\x05R\x05ports\x12P\n" +
+	"\x06limits\x18\x03 \x01(\v28.goproto.protoc.methods.fromkv.unsupported.Config.LimitsR\x06limits\x12X\n" +
+	"\aweights\x18\x04 \x03(\v2>.goproto.protoc.methods.fromkv.unsupported.Config.WeightsEntryR\aweights\x1a\x1a\n" +
+	"\x06Limits\x12\x10\n" +
+	"\x03max\x18\x01 \x01(\x05R\x03max\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\a\n" +
+	"\x05EmptyBRZPgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/fromkv/unsupportedb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_goTypes = []any{
+	(*Config)(nil),        // 0: goproto.protoc.methods.fromkv.unsupported.Config
+	(*Empty)(nil),         // 1: goproto.protoc.methods.fromkv.unsupported.Empty
+	(*Config_Limits)(nil), // 2: goproto.protoc.methods.fromkv.unsupported.Config.Limits
+	nil,                   // 3: goproto.protoc.methods.fromkv.unsupported.Config.WeightsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.fromkv.unsupported.Config.limits:type_name -> goproto.protoc.methods.fromkv.unsupported.Config.Limits
+	3, // 1: goproto.protoc.methods.fromkv.unsupported.Config.weights:type_name -> goproto.protoc.methods.fromkv.unsupported.Config.WeightsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_fromkv_unsupported_unsupported_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/iszerofast/hybrid.proto
+
+//go:build !protoopaque
+
+package iszerofast
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Entry struct {
+	state  protoimpl.MessageState `protogen:"hybrid.v1"`
+	Key    *string                `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" form:"key" uri:"key"`
+	Parent *Entry                 `protobuf:"bytes,2,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	Values []string               `protobuf:"bytes,3,rep,name=values" json:"values,omitempty" form:"values" uri:"values"`
+	Size   int32                  `protobuf:"varint,4,opt,name=size" json:"size,omitempty" form:"size" uri:"size"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Entry_Number
+	Value         isEntry_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Entry) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *Entry) GetParent() *Entry {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Entry) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Entry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Entry) GetValue() isEntry_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Entry) GetNumber() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*Entry_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *Entry) SetKey(v string) {
+	x.Key = &v
+}
+
+func (x *Entry) SetParent(v *Entry) {
+	x.Parent = v
+}
+
+func (x *Entry) SetValues(v []string) {
+	x.Values = v
+}
+
+func (x *Entry) SetSize(v int32) {
+	x.Size = v
+}
+
+func (x *Entry) SetNumber(v int32) {
+	x.Value = &Entry_Number{v}
+}
+
+func (x *Entry) HasKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.Key != nil
+}
+
+func (x *Entry) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Parent != nil
+}
+
+func (x *Entry) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.Value != nil
+}
+
+func (x *Entry) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Value.(*Entry_Number)
+	return ok
+}
+
+func (x *Entry) ClearKey() {
+	x.Key = nil
+}
+
+func (x *Entry) ClearParent() {
+	x.Parent = nil
+}
+
+func (x *Entry) ClearValue() {
+	x.Value = nil
+}
+
+func (x *Entry) ClearNumber() {
+	if _, ok := x.Value.(*Entry_Number); ok {
+		x.Value = nil
+	}
+}
+
+const Entry_Value_not_set_case case_Entry_Value = 0
+const Entry_Number_case case_Entry_Value = 5
+
+func (x *Entry) WhichValue() case_Entry_Value {
+	if x == nil {
+		return Entry_Value_not_set_case
+	}
+	switch x.Value.(type) {
+	case *Entry_Number:
+		return Entry_Number_case
+	default:
+		return Entry_Value_not_set_case
+	}
+}
+
+type Entry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Key    *string
+	Parent *Entry
+	Values []string
+	Size   int32
+	// Fields of oneof Value:
+	Number *int32
+	// -- end of Value
+}
+
+func (b0 Entry_builder) Build() *Entry {
+	m0 := &Entry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Key = b.Key
+	x.Parent = b.Parent
+	x.Values = b.Values
+	x.Size = b.Size
+	if b.Number != nil {
+		x.Value = &Entry_Number{*b.Number}
+	}
+	return m0
+}
+
+type case_Entry_Value protoreflect.FieldNumber
+
+func (x case_Entry_Value) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isEntry_Value interface {
+	isEntry_Value()
+}
+
+type Entry_Number struct {
+	Number int32 `protobuf:"varint,5,opt,name=number,oneof"`
+}
+
+func (*Entry_Number) isEntry_Value() {}
+
+// IsZeroFast reports whether x has no populated fields, extensions or
+// unknown fields, like proto.Equal(x, &Entry{}) but without reflection.
+// A nil message is zero.
+func (x *Entry) IsZeroFast() bool {
+	if x == nil {
+		return true
+	}
+	if x.Value != nil {
+		return false
+	}
+	if x.HasKey() {
+		return false
+	}
+	if x.HasParent() {
+		return false
+	}
+	if len(x.GetValues()) > 0 {
+		return false
+	}
+	if x.GetSize() != 0 {
+		return false
+	}
+	return len(x.unknownFields) == 0
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/iszerofast/hybrid.proto\x12!goproto.protoc.methods.iszerofast\x1a!google/protobuf/go_features.proto\"\xb1\x01\n" +
+	"\x05Entry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x06parent\x18\x02 \x01(\v2(.goproto.protoc.methods.iszerofast.EntryR\x06parent\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\x12\x19\n" +
+	"\x04size\x18\x04 \x01(\x05B\x05\xaa\x01\x02\b\x02R\x04size\x12\x18\n" +
+	"\x06number\x18\x05 \x01(\x05H\x00R\x06numberB\a\n" +
+	"\x05valueBRZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/iszerofast\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_goTypes = []any{
+	(*Entry)(nil), // 0: goproto.protoc.methods.iszerofast.Entry
+}
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.iszerofast.Entry.parent:type_name -> goproto.protoc.methods.iszerofast.Entry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Entry_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,370 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/iszerofast/iszerofast.proto
+
+package iszerofast
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescGZIP(), []int{0}
+}
+
+type Record struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Data    []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty" form:"data" uri:"data"`
+	Score   float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty" form:"score" uri:"score"`
+	Ratio   float32                `protobuf:"fixed32,5,opt,name=ratio,proto3" json:"ratio,omitempty" form:"ratio" uri:"ratio"`
+	Ok      bool                   `protobuf:"varint,6,opt,name=ok,proto3" json:"ok,omitempty" form:"ok" uri:"ok"`
+	Version *int64                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty" form:"version" uri:"version"`
+	Child   *Record                `protobuf:"bytes,8,opt,name=child,proto3" json:"child,omitempty" form:"child" uri:"child"`
+	Ids     []int32                `protobuf:"varint,9,rep,packed,name=ids,proto3" json:"ids,omitempty" form:"ids" uri:"ids"`
+	Attrs   map[string]string      `protobuf:"bytes,10,rep,name=attrs,proto3" json:"attrs,omitempty" form:"attrs" uri:"attrs" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status  Status                 `protobuf:"varint,11,opt,name=status,proto3,enum=goproto.protoc.methods.iszerofast.Status" json:"status,omitempty" form:"status" uri:"status"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Record_Text
+	//	*Record_Nested
+	Choice        isRecord_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Record) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Record) GetRatio() float32 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Record) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *Record) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *Record) GetChild() *Record {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Record) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Record) GetAttrs() map[string]string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *Record) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Record) GetChoice() isRecord_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Record) GetText() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Record_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Record) GetNested() *Record {
+	if x != nil {
+		if x, ok := x.Choice.(*Record_Nested); ok {
+			return x.Nested
+		}
+	}
+	return nil
+}
+
+type isRecord_Choice interface {
+	isRecord_Choice()
+}
+
+type Record_Text struct {
+	Text string `protobuf:"bytes,12,opt,name=text,proto3,oneof"`
+}
+
+type Record_Nested struct {
+	Nested *Record `protobuf:"bytes,13,opt,name=nested,proto3,oneof"`
+}
+
+func (*Record_Text) isRecord_Choice() {}
+
+func (*Record_Nested) isRecord_Choice() {}
+
+// IsZeroFast reports whether x has no populated fields, extensions or
+// unknown fields, like proto.Equal(x, &Record{}) but without reflection.
+// A nil message is zero.
+func (x *Record) IsZeroFast() bool {
+	if x == nil {
+		return true
+	}
+	if x.Choice != nil {
+		return false
+	}
+	if x.Id != 0 {
+		return false
+	}
+	if x.Name != "" {
+		return false
+	}
+	if len(x.Data) > 0 {
+		return false
+	}
+	if math.Float64bits(x.Score) != 0 {
+		return false
+	}
+	if math.Float32bits(x.Ratio) != 0 {
+		return false
+	}
+	if x.Ok {
+		return false
+	}
+	if x.Version != nil {
+		return false
+	}
+	if x.Child != nil {
+		return false
+	}
+	if len(x.Ids) > 0 {
+		return false
+	}
+	if len(x.Attrs) > 0 {
+		return false
+	}
+	if x.Status != 0 {
+		return false
+	}
+	return len(x.unknownFields) == 0
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/iszerofast/iszerofast.proto\x12!goproto.protoc.methods.iszerofast\"\xa8\x04\n" +
+	"\x06Record\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x14\n" +
+	"\x05ratio\x18\x05 \x01(\x02R\x05ratio\x12\x0e\n" +
+	"\x02ok\x18\x06 \x01(\bR\x02ok\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x03H\x01R\aversion\x88\x01\x01\x12?\n" +
+	"\x05child\x18\b \x01(\v2).goproto.protoc.methods.iszerofast.RecordR\x05child\x12\x10\n" +
+	"\x03ids\x18\t \x03(\x05R\x03ids\x12J\n" +
+	"\x05attrs\x18\n" +
+	" \x03(\v24.goproto.protoc.methods.iszerofast.Record.AttrsEntryR\x05attrs\x12A\n" +
+	"\x06status\x18\v \x01(\x0e2).goproto.protoc.methods.iszerofast.StatusR\x06status\x12\x14\n" +
+	"\x04text\x18\f \x01(\tH\x00R\x04text\x12C\n" +
+	"\x06nested\x18\r \x01(\v2).goproto.protoc.methods.iszerofast.RecordH\x00R\x06nested\x1a8\n" +
+	"\n" +
+	"AttrsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06choiceB\n" +
+	"\n" +
+	"\b_version*3\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ACTIVE\x10\x01BJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/iszerofastb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_goTypes = []any{
+	(Status)(0),    // 0: goproto.protoc.methods.iszerofast.Status
+	(*Record)(nil), // 1: goproto.protoc.methods.iszerofast.Record
+	nil,            // 2: goproto.protoc.methods.iszerofast.Record.AttrsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.iszerofast.Record.child:type_name -> goproto.protoc.methods.iszerofast.Record
+	2, // 1: goproto.protoc.methods.iszerofast.Record.attrs:type_name -> goproto.protoc.methods.iszerofast.Record.AttrsEntry
+	0, // 2: goproto.protoc.methods.iszerofast.Record.status:type_name -> goproto.protoc.methods.iszerofast.Status
+	1, // 3: goproto.protoc.methods.iszerofast.Record.nested:type_name -> goproto.protoc.methods.iszerofast.Record
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_msgTypes[0].OneofWrappers = []any{
+		(*Record_Text)(nil),
+		(*Record_Nested)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_iszerofast_proto_depIdxs = nil
+}
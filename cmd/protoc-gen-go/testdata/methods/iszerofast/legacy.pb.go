@@ -0,0 +1,165 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/iszerofast/legacy.proto
+
+package iszerofast
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Legacy struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Count           *int32                 `protobuf:"varint,1,opt,name=count" json:"count,omitempty" form:"count" uri:"count"`
+	Weight          *float32               `protobuf:"fixed32,2,opt,name=weight" json:"weight,omitempty" form:"weight" uri:"weight"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Legacy) Reset() {
+	*x = Legacy{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Legacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Legacy) ProtoMessage() {}
+
+func (x *Legacy) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Legacy.ProtoReflect.Descriptor instead.
+func (*Legacy) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Legacy) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *Legacy) GetWeight() float32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// IsZeroFast reports whether x has no populated fields, extensions or
+// unknown fields, like proto.Equal(x, &Legacy{}) but without reflection.
+// A nil message is zero.
+func (x *Legacy) IsZeroFast() bool {
+	if x == nil {
+		return true
+	}
+	if x.Count != nil {
+		return false
+	}
+	if x.Weight != nil {
+		return false
+	}
+	if len(x.extensionFields) > 0 {
+		return false
+	}
+	return len(x.unknownFields) == 0
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Legacy)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.iszerofast.note",
+		Tag:           "bytes,100,opt,name=note",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/iszerofast/legacy.proto",
+	},
+}
+
+// Extension fields to Legacy.
+var (
+	// optional string note = 100;
+	E_Note = &file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/iszerofast/legacy.proto\x12!goproto.protoc.methods.iszerofast\"=\n" +
+	"\x06Legacy\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x02R\x06weight*\x05\bd\x10\xc8\x01:=\n" +
+	"\x04note\x12).goproto.protoc.methods.iszerofast.Legacy\x18d \x01(\tR\x04noteBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/iszerofast"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_goTypes = []any{
+	(*Legacy)(nil), // 0: goproto.protoc.methods.iszerofast.Legacy
+}
+var file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.iszerofast.note:extendee -> goproto.protoc.methods.iszerofast.Legacy
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_iszerofast_legacy_proto_depIdxs = nil
+}
@@ -0,0 +1,433 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/jsonpatch/jsonpatch.proto
+
+package jsonpatch
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strings "strings"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Server struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName    string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty" form:"display_name" uri:"display_name"`
+	MaxConnections int64                  `protobuf:"varint,2,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty" form:"max_connections" uri:"max_connections"`
+	Tags           []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Primary        *Server_Endpoint       `protobuf:"bytes,4,opt,name=primary,proto3" json:"primary,omitempty" form:"primary" uri:"primary"`
+	Replicas       []*Server_Endpoint     `protobuf:"bytes,5,rep,name=replicas,proto3" json:"replicas,omitempty" form:"replicas" uri:"replicas"`
+	Labels         map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Enabled        *bool                  `protobuf:"varint,7,opt,name=enabled,proto3,oneof" json:"enabled,omitempty" form:"enabled" uri:"enabled"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Server) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Server) GetMaxConnections() int64 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *Server) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Server) GetPrimary() *Server_Endpoint {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *Server) GetReplicas() []*Server_Endpoint {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Server) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Server) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// ApplyJSONPatch applies the JSON Patch document (RFC 6902) in patch to x.
+// The tokens of a JSON pointer name fields by their JSON or proto name, and
+// elements of repeated fields by their index. Values are decoded as by
+// protojson.Unmarshal.
+//
+// The add, replace and remove operations are supported on singular and
+// repeated fields and on elements of repeated fields, but not on map fields.
+// For fields, add and replace both set the field, and a null value clears
+// it. If an operation fails, an error is reported and x is left unchanged.
+func (x *Server) ApplyJSONPatch(patch []byte) error {
+	return file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatch(x.ProtoReflect(), patch)
+}
+
+type Server_Endpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" form:"host" uri:"host"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty" form:"port" uri:"port"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Endpoint) Reset() {
+	*x = Server_Endpoint{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Endpoint) ProtoMessage() {}
+
+func (x *Server_Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Endpoint.ProtoReflect.Descriptor instead.
+func (*Server_Endpoint) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Server_Endpoint) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Server_Endpoint) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// ApplyJSONPatch applies the JSON Patch document (RFC 6902) in patch to x.
+// The tokens of a JSON pointer name fields by their JSON or proto name, and
+// elements of repeated fields by their index. Values are decoded as by
+// protojson.Unmarshal.
+//
+// The add, replace and remove operations are supported on singular and
+// repeated fields and on elements of repeated fields, but not on map fields.
+// For fields, add and replace both set the field, and a null value clears
+// it. If an operation fails, an error is reported and x is left unchanged.
+func (x *Server_Endpoint) ApplyJSONPatch(patch []byte) error {
+	return file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatch(x.ProtoReflect(), patch)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatch applies the JSON Patch document in patch to m.
+// The operations are applied to a copy of m, which replaces the contents
+// of m if all of them succeed.
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatch(m protoreflect.Message, patch []byte) error {
+	var ops []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return fmt.Errorf("invalid JSON patch: %v", err)
+	}
+	y := proto.Clone(m.Interface())
+	for i, op := range ops {
+		if err := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchOp(y.ProtoReflect(), op.Op, op.Path, op.Value); err != nil {
+			return fmt.Errorf("JSON patch operation %d: %v", i, err)
+		}
+	}
+	proto.Reset(m.Interface())
+	proto.Merge(m.Interface(), y)
+	return nil
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchOp applies the operation op with the given value to the
+// location in m identified by the JSON pointer path.
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchOp(m protoreflect.Message, op, path string, value json.RawMessage) error {
+	switch op {
+	case "add", "replace", "remove":
+	default:
+		return fmt.Errorf("unsupported operation %q", op)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("%s: invalid path %q", op, path)
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	for {
+		fd := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchField(m.Descriptor(), tokens[0])
+		tokens = tokens[1:]
+		switch {
+		case fd == nil || fd.IsMap():
+			return fmt.Errorf("%s: unsupported path %q", op, path)
+		case len(tokens) == 0:
+			if op == "remove" {
+				m.Clear(fd)
+				return nil
+			}
+			v, err := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchValue(m, fd, value, false)
+			if err != nil {
+				return fmt.Errorf("%s %q: %v", op, path, err)
+			}
+			if !v.IsValid() {
+				m.Clear(fd)
+				return nil
+			}
+			m.Set(fd, v)
+			return nil
+		case fd.IsList() && len(tokens) == 1:
+			if err := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchElem(m, fd, op, tokens[0], value); err != nil {
+				return fmt.Errorf("%s %q: %v", op, path, err)
+			}
+			return nil
+		case fd.IsList() && fd.Message() != nil:
+			l := m.Mutable(fd).List()
+			i, ok := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchIndex(tokens[0], l.Len(), false)
+			if !ok {
+				return fmt.Errorf("%s: path %q does not exist", op, path)
+			}
+			m = l.Get(i).Message()
+			tokens = tokens[1:]
+		case fd.Message() != nil && !fd.IsList():
+			if op != "add" && !m.Has(fd) {
+				return fmt.Errorf("%s: path %q does not exist", op, path)
+			}
+			m = m.Mutable(fd).Message()
+		default:
+			return fmt.Errorf("%s: unsupported path %q", op, path)
+		}
+	}
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchElem applies the operation op to the element of the
+// repeated field fd of m with the index given by the token.
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_applyJSONPatchElem(m protoreflect.Message, fd protoreflect.FieldDescriptor, op, token string, value json.RawMessage) error {
+	l := m.Mutable(fd).List()
+	i, ok := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchIndex(token, l.Len(), op == "add")
+	if !ok {
+		return fmt.Errorf("index %q out of range", token)
+	}
+	if op == "remove" {
+		for j := i; j < l.Len()-1; j++ {
+			l.Set(j, l.Get(j+1))
+		}
+		l.Truncate(l.Len() - 1)
+		return nil
+	}
+	v, err := file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchValue(m, fd, value, true)
+	if err != nil {
+		return err
+	}
+	if op == "add" {
+		l.Append(v)
+		for j := l.Len() - 1; j > i; j-- {
+			l.Set(j, l.Get(j-1))
+		}
+	}
+	l.Set(i, v)
+	return nil
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchField returns the field of md with the JSON or proto name
+// given by the token, or nil if there is none.
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchField(md protoreflect.MessageDescriptor, token string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(token); fd != nil {
+		return fd
+	}
+	return md.Fields().ByName(protoreflect.Name(token))
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchIndex returns the index of a list with n elements given by
+// the token and whether it is in range. If add is set, the index may be n,
+// which is also given by the token "-".
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchIndex(token string, n int, add bool) (int, bool) {
+	if token == "-" && add {
+		return n, true
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	i := 0
+	for _, c := range token {
+		if c < '0' || c > '9' || i > n {
+			return 0, false
+		}
+		i = 10*i + int(c-'0')
+	}
+	return i, i < n || (add && i == n)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchValue decodes the JSON value of the field fd of m, or of an
+// element of fd if elem is set. It returns an invalid value if the decoded
+// field is unset, such as for a null value.
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_jsonPatchValue(m protoreflect.Message, fd protoreflect.FieldDescriptor, value json.RawMessage, elem bool) (protoreflect.Value, error) {
+	if value == nil {
+		return protoreflect.Value{}, fmt.Errorf("missing value")
+	}
+	if elem {
+		value = append(append(json.RawMessage("["), value...), ']')
+	}
+	name, _ := json.Marshal(fd.JSONName())
+	b := append(append(append(append([]byte("{"), name...), ':'), value...), '}')
+	tmp := m.New()
+	if err := protojson.Unmarshal(b, tmp.Interface()); err != nil {
+		return protoreflect.Value{}, err
+	}
+	switch {
+	case elem:
+		return tmp.Get(fd).List().Get(0), nil
+	case !tmp.Has(fd):
+		return protoreflect.Value{}, nil
+	}
+	return tmp.Get(fd), nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/jsonpatch/jsonpatch.proto\x12 goproto.protoc.methods.jsonpatch\"\xec\x03\n" +
+	"\x06Server\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12'\n" +
+	"\x0fmax_connections\x18\x02 \x01(\x03R\x0emaxConnections\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12K\n" +
+	"\aprimary\x18\x04 \x01(\v21.goproto.protoc.methods.jsonpatch.Server.EndpointR\aprimary\x12M\n" +
+	"\breplicas\x18\x05 \x03(\v21.goproto.protoc.methods.jsonpatch.Server.EndpointR\breplicas\x12L\n" +
+	"\x06labels\x18\x06 \x03(\v24.goproto.protoc.methods.jsonpatch.Server.LabelsEntryR\x06labels\x12\x1d\n" +
+	"\aenabled\x18\a \x01(\bH\x00R\aenabled\x88\x01\x01\x1a2\n" +
+	"\bEndpoint\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_enabledBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/jsonpatchb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_goTypes = []any{
+	(*Server)(nil),          // 0: goproto.protoc.methods.jsonpatch.Server
+	(*Server_Endpoint)(nil), // 1: goproto.protoc.methods.jsonpatch.Server.Endpoint
+	nil,                     // 2: goproto.protoc.methods.jsonpatch.Server.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.jsonpatch.Server.primary:type_name -> goproto.protoc.methods.jsonpatch.Server.Endpoint
+	1, // 1: goproto.protoc.methods.jsonpatch.Server.replicas:type_name -> goproto.protoc.methods.jsonpatch.Server.Endpoint
+	2, // 2: goproto.protoc.methods.jsonpatch.Server.labels:type_name -> goproto.protoc.methods.jsonpatch.Server.LabelsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_jsonpatch_jsonpatch_proto_depIdxs = nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/kindlookup/kindlookup.proto
+
+package kindlookup
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Account_Tier int32
+
+const (
+	Account_TIER_UNSPECIFIED Account_Tier = 0
+)
+
+// Enum value maps for Account_Tier.
+var (
+	Account_Tier_name = map[int32]string{
+		0: "TIER_UNSPECIFIED",
+	}
+	Account_Tier_value = map[string]int32{
+		"TIER_UNSPECIFIED": 0,
+	}
+)
+
+func (x Account_Tier) Enum() *Account_Tier {
+	p := new(Account_Tier)
+	*p = x
+	return p
+}
+
+func (x Account_Tier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Account_Tier) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_enumTypes[0].Descriptor()
+}
+
+func (Account_Tier) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_enumTypes[0]
+}
+
+func (x Account_Tier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Account_Tier.Descriptor instead.
+func (Account_Tier) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Account struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Balance  int64                  `protobuf:"zigzag64,1,opt,name=balance,proto3" json:"balance,omitempty" form:"balance" uri:"balance"`
+	Aliases  []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty" form:"aliases" uri:"aliases"`
+	Tier     Account_Tier           `protobuf:"varint,3,opt,name=tier,proto3,enum=goproto.protoc.methods.kindlookup.Account_Tier" json:"tier,omitempty" form:"tier" uri:"tier"`
+	Children map[string]*Account    `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Owner:
+	//
+	//	*Account_OwnerId
+	//	*Account_Parent
+	Owner         isAccount_Owner `protobuf_oneof:"owner"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Account) GetTier() Account_Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return Account_TIER_UNSPECIFIED
+}
+
+func (x *Account) GetChildren() map[string]*Account {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Account) GetOwner() isAccount_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Account) GetOwnerId() uint32 {
+	if x != nil {
+		if x, ok := x.Owner.(*Account_OwnerId); ok {
+			return x.OwnerId
+		}
+	}
+	return 0
+}
+
+func (x *Account) GetParent() *Account {
+	if x != nil {
+		if x, ok := x.Owner.(*Account_Parent); ok {
+			return x.Parent
+		}
+	}
+	return nil
+}
+
+type isAccount_Owner interface {
+	isAccount_Owner()
+}
+
+type Account_OwnerId struct {
+	OwnerId uint32 `protobuf:"fixed32,5,opt,name=owner_id,json=ownerId,proto3,oneof"`
+}
+
+type Account_Parent struct {
+	Parent *Account `protobuf:"bytes,6,opt,name=parent,proto3,oneof"`
+}
+
+func (*Account_OwnerId) isAccount_Owner() {}
+
+func (*Account_Parent) isAccount_Owner() {}
+
+var file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_Account_kindsByGoName = map[string]protoreflect.Kind{
+	"Balance":  protoreflect.Sint64Kind,
+	"Aliases":  protoreflect.StringKind,
+	"Tier":     protoreflect.EnumKind,
+	"Children": protoreflect.MessageKind,
+	"OwnerId":  protoreflect.Fixed32Kind,
+	"Parent":   protoreflect.MessageKind,
+}
+
+// KindByGoName returns the kind of the field of Account with the given
+// Go field name, and whether there is such a field. Members of a oneof are
+// identified by the field name within their wrapper type. The kind of a map
+// field is that of its entry message.
+func (*Account) KindByGoName(name string) (protoreflect.Kind, bool) {
+	k, ok := file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_Account_kindsByGoName[name]
+	return k, ok
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/kindlookup/kindlookup.proto\x12!goproto.protoc.methods.kindlookup\"\xcb\x03\n" +
+	"\aAccount\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x12R\abalance\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases\x12C\n" +
+	"\x04tier\x18\x03 \x01(\x0e2/.goproto.protoc.methods.kindlookup.Account.TierR\x04tier\x12T\n" +
+	"\bchildren\x18\x04 \x03(\v28.goproto.protoc.methods.kindlookup.Account.ChildrenEntryR\bchildren\x12\x1b\n" +
+	"\bowner_id\x18\x05 \x01(\aH\x00R\aownerId\x12D\n" +
+	"\x06parent\x18\x06 \x01(\v2*.goproto.protoc.methods.kindlookup.AccountH\x00R\x06parent\x1ag\n" +
+	"\rChildrenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.goproto.protoc.methods.kindlookup.AccountR\x05value:\x028\x01\"\x1c\n" +
+	"\x04Tier\x12\x14\n" +
+	"\x10TIER_UNSPECIFIED\x10\x00B\a\n" +
+	"\x05ownerBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/kindlookupb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_goTypes = []any{
+	(Account_Tier)(0), // 0: goproto.protoc.methods.kindlookup.Account.Tier
+	(*Account)(nil),   // 1: goproto.protoc.methods.kindlookup.Account
+	nil,               // 2: goproto.protoc.methods.kindlookup.Account.ChildrenEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.kindlookup.Account.tier:type_name -> goproto.protoc.methods.kindlookup.Account.Tier
+	2, // 1: goproto.protoc.methods.kindlookup.Account.children:type_name -> goproto.protoc.methods.kindlookup.Account.ChildrenEntry
+	1, // 2: goproto.protoc.methods.kindlookup.Account.parent:type_name -> goproto.protoc.methods.kindlookup.Account
+	1, // 3: goproto.protoc.methods.kindlookup.Account.ChildrenEntry.value:type_name -> goproto.protoc.methods.kindlookup.Account
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_OwnerId)(nil),
+		(*Account_Parent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_kindlookup_kindlookup_proto_depIdxs = nil
+}
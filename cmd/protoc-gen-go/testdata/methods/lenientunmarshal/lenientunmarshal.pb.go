@@ -0,0 +1,224 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/lenientunmarshal/lenientunmarshal.proto
+
+package lenientunmarshal
+
+import (
+	fmt "fmt"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Reading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sensor        string                 `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty" form:"sensor" uri:"sensor"`
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty" form:"value" uri:"value"`
+	Scale         float64                `protobuf:"fixed64,3,opt,name=scale,proto3" json:"scale,omitempty" form:"scale" uri:"scale"`
+	Samples       []int32                `protobuf:"varint,4,rep,packed,name=samples,proto3" json:"samples,omitempty" form:"samples" uri:"samples"`
+	Previous      *Reading               `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reading) Reset() {
+	*x = Reading{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reading) ProtoMessage() {}
+
+func (x *Reading) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reading.ProtoReflect.Descriptor instead.
+func (*Reading) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reading) GetSensor() string {
+	if x != nil {
+		return x.Sensor
+	}
+	return ""
+}
+
+func (x *Reading) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Reading) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *Reading) GetSamples() []int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *Reading) GetPrevious() *Reading {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+// UnmarshalLenient parses the wire-format message in b and places the result
+// in x, like proto.Unmarshal. Fields of x or of its nested messages encoded
+// with an unexpected wire type, which Unmarshal retains as unknown fields,
+// are skipped instead, and each skipped field is described by a warning.
+func (x *Reading) UnmarshalLenient(b []byte) (warnings []string, err error) {
+	b, warnings = file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientFilter(x.ProtoReflect().Descriptor(), b, nil)
+	return warnings, proto.Unmarshal(b, x)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientFilter returns a copy of b without the fields whose wire type
+// does not match the field of md with the same number, recursing into
+// message fields, and appends a warning to warnings for each removed field.
+// Malformed input is retained as is, to be reported by the parser.
+func file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientFilter(md protoreflect.MessageDescriptor, b []byte, warnings []string) ([]byte, []string) {
+	out := make([]byte, 0, len(b))
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return append(out, b...), warnings
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return append(out, b...), warnings
+		}
+		field, value := b[:n+m], b[n:n+m]
+		b = b[n+m:]
+
+		fd := md.Fields().ByNumber(num)
+		if fd == nil {
+			out = append(out, field...) // unknown field or extension
+			continue
+		}
+		want := file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientWireType(fd)
+		packed := fd.IsList() && typ == protowire.BytesType && want != protowire.BytesType && want != protowire.StartGroupType
+		switch {
+		case typ != want && !packed:
+			warnings = append(warnings, fmt.Sprintf("skipped field %v with wire type %d, want %d", fd.FullName(), typ, want))
+		case fd.Kind() == protoreflect.MessageKind:
+			v, _ := protowire.ConsumeBytes(value)
+			var nested []byte
+			nested, warnings = file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientFilter(fd.Message(), v, warnings)
+			out = protowire.AppendTag(out, num, typ)
+			out = protowire.AppendBytes(out, nested)
+		default:
+			out = append(out, field...)
+		}
+	}
+	return out, warnings
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientWireType returns the wire type of the values of fd.
+func file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_lenientWireType(fd protoreflect.FieldDescriptor) protowire.Type {
+	switch fd.Kind() {
+	case protoreflect.BoolKind, protoreflect.EnumKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Uint32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Uint64Kind:
+		return protowire.VarintType
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.BytesType
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDesc = "" +
+	"\n" +
+	"Jcmd/protoc-gen-go/testdata/methods/lenientunmarshal/lenientunmarshal.proto\x12'goproto.protoc.methods.lenientunmarshal\"\xb5\x01\n" +
+	"\aReading\x12\x16\n" +
+	"\x06sensor\x18\x01 \x01(\tR\x06sensor\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x14\n" +
+	"\x05scale\x18\x03 \x01(\x01R\x05scale\x12\x18\n" +
+	"\asamples\x18\x04 \x03(\x05R\asamples\x12L\n" +
+	"\bprevious\x18\x05 \x01(\v20.goproto.protoc.methods.lenientunmarshal.ReadingR\bpreviousBPZNgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/lenientunmarshalb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_goTypes = []any{
+	(*Reading)(nil), // 0: goproto.protoc.methods.lenientunmarshal.Reading
+}
+var file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.lenientunmarshal.Reading.previous:type_name -> goproto.protoc.methods.lenientunmarshal.Reading
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_lenientunmarshal_lenientunmarshal_proto_depIdxs = nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/limit/limit.proto
+
+package limit
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Collections struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Values   []int32                `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty" form:"values" uri:"values"`
+	Counts   map[string]int32       `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Children []*Collections         `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children"`
+	Nested   map[int32]*Collections `protobuf:"bytes,4,rep,name=nested,proto3" json:"nested,omitempty" form:"nested" uri:"nested" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Flags    map[bool]string        `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty" form:"flags" uri:"flags" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Single   *Collections           `protobuf:"bytes,6,opt,name=single,proto3" json:"single,omitempty" form:"single" uri:"single"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Collections_Chosen
+	//	*Collections_Label
+	Choice        isCollections_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collections) Reset() {
+	*x = Collections{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collections) ProtoMessage() {}
+
+func (x *Collections) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collections.ProtoReflect.Descriptor instead.
+func (*Collections) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collections) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Collections) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Collections) GetChildren() []*Collections {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Collections) GetNested() map[int32]*Collections {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Collections) GetFlags() map[bool]string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Collections) GetSingle() *Collections {
+	if x != nil {
+		return x.Single
+	}
+	return nil
+}
+
+func (x *Collections) GetChoice() isCollections_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Collections) GetChosen() *Collections {
+	if x != nil {
+		if x, ok := x.Choice.(*Collections_Chosen); ok {
+			return x.Chosen
+		}
+	}
+	return nil
+}
+
+func (x *Collections) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Collections_Label); ok {
+			return x.Label
+		}
+	}
+	return ""
+}
+
+type isCollections_Choice interface {
+	isCollections_Choice()
+}
+
+type Collections_Chosen struct {
+	Chosen *Collections `protobuf:"bytes,7,opt,name=chosen,proto3,oneof"`
+}
+
+type Collections_Label struct {
+	Label string `protobuf:"bytes,8,opt,name=label,proto3,oneof"`
+}
+
+func (*Collections_Chosen) isCollections_Choice() {}
+
+func (*Collections_Label) isCollections_Choice() {}
+
+// LimitCollections truncates every repeated field of x and trims every map
+// field of x to at most max entries, recursing into message values.
+// Map entries are retained in ascending key order.
+func (x *Collections) LimitCollections(max int) {
+	if x == nil {
+		return
+	}
+	if max < 0 {
+		max = 0
+	}
+	if len(x.Values) > max {
+		x.Values = x.Values[:max]
+	}
+	if len(x.Counts) > max {
+		keys := make([]string, 0, len(x.Counts))
+		for k := range x.Counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys[max:] {
+			delete(x.Counts, k)
+		}
+	}
+	if len(x.Children) > max {
+		x.Children = x.Children[:max]
+	}
+	for _, v := range x.Children {
+		v.LimitCollections(max)
+	}
+	if len(x.Nested) > max {
+		keys := make([]int32, 0, len(x.Nested))
+		for k := range x.Nested {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys[max:] {
+			delete(x.Nested, k)
+		}
+	}
+	for _, v := range x.Nested {
+		v.LimitCollections(max)
+	}
+	if len(x.Flags) > max {
+		keys := make([]bool, 0, len(x.Flags))
+		for k := range x.Flags {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		for _, k := range keys[max:] {
+			delete(x.Flags, k)
+		}
+	}
+	x.Single.LimitCollections(max)
+	x.GetChosen().LimitCollections(max)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_limit_limit_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDesc = "" +
+	"\n" +
+	"4cmd/protoc-gen-go/testdata/methods/limit/limit.proto\x12\x1cgoproto.protoc.methods.limit\"\xdb\x05\n" +
+	"\vCollections\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x05R\x06values\x12M\n" +
+	"\x06counts\x18\x02 \x03(\v25.goproto.protoc.methods.limit.Collections.CountsEntryR\x06counts\x12E\n" +
+	"\bchildren\x18\x03 \x03(\v2).goproto.protoc.methods.limit.CollectionsR\bchildren\x12M\n" +
+	"\x06nested\x18\x04 \x03(\v25.goproto.protoc.methods.limit.Collections.NestedEntryR\x06nested\x12J\n" +
+	"\x05flags\x18\x05 \x03(\v24.goproto.protoc.methods.limit.Collections.FlagsEntryR\x05flags\x12A\n" +
+	"\x06single\x18\x06 \x01(\v2).goproto.protoc.methods.limit.CollectionsR\x06single\x12C\n" +
+	"\x06chosen\x18\a \x01(\v2).goproto.protoc.methods.limit.CollectionsH\x00R\x06chosen\x12\x16\n" +
+	"\x05label\x18\b \x01(\tH\x00R\x05label\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1ad\n" +
+	"\vNestedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).goproto.protoc.methods.limit.CollectionsR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\bR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06choiceBEZCgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/limitb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_goTypes = []any{
+	(*Collections)(nil), // 0: goproto.protoc.methods.limit.Collections
+	nil,                 // 1: goproto.protoc.methods.limit.Collections.CountsEntry
+	nil,                 // 2: goproto.protoc.methods.limit.Collections.NestedEntry
+	nil,                 // 3: goproto.protoc.methods.limit.Collections.FlagsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.limit.Collections.counts:type_name -> goproto.protoc.methods.limit.Collections.CountsEntry
+	0, // 1: goproto.protoc.methods.limit.Collections.children:type_name -> goproto.protoc.methods.limit.Collections
+	2, // 2: goproto.protoc.methods.limit.Collections.nested:type_name -> goproto.protoc.methods.limit.Collections.NestedEntry
+	3, // 3: goproto.protoc.methods.limit.Collections.flags:type_name -> goproto.protoc.methods.limit.Collections.FlagsEntry
+	0, // 4: goproto.protoc.methods.limit.Collections.single:type_name -> goproto.protoc.methods.limit.Collections
+	0, // 5: goproto.protoc.methods.limit.Collections.chosen:type_name -> goproto.protoc.methods.limit.Collections
+	0, // 6: goproto.protoc.methods.limit.Collections.NestedEntry.value:type_name -> goproto.protoc.methods.limit.Collections
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_limit_limit_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_msgTypes[0].OneofWrappers = []any{
+		(*Collections_Chosen)(nil),
+		(*Collections_Label)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_limit_limit_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_limit_limit_proto_depIdxs = nil
+}
@@ -0,0 +1,468 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/logstring/logstring.proto
+
+package logstring
+
+import (
+	fmt "fmt"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strings "strings"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescGZIP(), []int{0}
+}
+
+type Request struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Path    string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" form:"path" uri:"path"`
+	Code    int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty" form:"code" uri:"code"`
+	Retry   bool                   `protobuf:"varint,3,opt,name=retry,proto3" json:"retry,omitempty" form:"retry" uri:"retry"`
+	Status  Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=goproto.protoc.methods.logstring.Status" json:"status,omitempty" form:"status" uri:"status"`
+	Latency *float64               `protobuf:"fixed64,5,opt,name=latency,proto3,oneof" json:"latency,omitempty" form:"latency" uri:"latency"`
+	Token   []byte                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty" form:"token" uri:"token"`
+	Email   string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty" form:"email" uri:"email"`
+	Payload *Request_Payload       `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty" form:"payload" uri:"payload"`
+	Headers []string               `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers,omitempty" form:"headers" uri:"headers"`
+	Labels  map[string]string      `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Client:
+	//
+	//	*Request_UserAgent
+	//	*Request_Raw
+	Client        isRequest_Client `protobuf_oneof:"client"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Request) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Request) GetRetry() bool {
+	if x != nil {
+		return x.Retry
+	}
+	return false
+}
+
+func (x *Request) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Request) GetLatency() float64 {
+	if x != nil && x.Latency != nil {
+		return *x.Latency
+	}
+	return 0
+}
+
+func (x *Request) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Request) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Request) GetPayload() *Request_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Request) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Request) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Request) GetClient() isRequest_Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Request) GetUserAgent() string {
+	if x != nil {
+		if x, ok := x.Client.(*Request_UserAgent); ok {
+			return x.UserAgent
+		}
+	}
+	return ""
+}
+
+func (x *Request) GetRaw() *Request_Payload {
+	if x != nil {
+		if x, ok := x.Client.(*Request_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+type isRequest_Client interface {
+	isRequest_Client()
+}
+
+type Request_UserAgent struct {
+	UserAgent string `protobuf:"bytes,11,opt,name=user_agent,json=userAgent,proto3,oneof"`
+}
+
+type Request_Raw struct {
+	Raw *Request_Payload `protobuf:"bytes,12,opt,name=raw,proto3,oneof"`
+}
+
+func (*Request_UserAgent) isRequest_Client() {}
+
+func (*Request_Raw) isRequest_Client() {}
+
+// LogString returns a single-line rendering of the populated fields of x
+// as space-separated name=value pairs, in the order in which the fields
+// are declared. Message fields are rendered as <MessageName>, repeated and
+// map fields as their number of elements, and fields marked as sensitive
+// as <redacted>.
+func (x *Request) LogString() string {
+	if x == nil {
+		return "<nil>"
+	}
+	var b strings.Builder
+	if x.Path != "" {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "path=%q", x.Path)
+	}
+	if x.Code != 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, "code=", x.Code)
+	}
+	if x.Retry {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, "retry=", x.Retry)
+	}
+	if x.Status != 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, "status=", x.Status)
+	}
+	if x.Latency != nil {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, "latency=", x.GetLatency())
+	}
+	if len(x.Token) > 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("token=<redacted>")
+	}
+	if x.Email != "" {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("email=<redacted>")
+	}
+	if x.Payload != nil {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("payload=<Payload>")
+	}
+	if len(x.Headers) > 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "headers=<%d elements>", len(x.Headers))
+	}
+	if len(x.Labels) > 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "labels=<%d elements>", len(x.Labels))
+	}
+	if v, ok := x.Client.(*Request_UserAgent); ok {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "user_agent=%q", v.UserAgent)
+	}
+	if _, ok := x.Client.(*Request_Raw); ok {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("raw=<Payload>")
+	}
+	return b.String()
+}
+
+type Request_Payload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty" form:"data" uri:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request_Payload) Reset() {
+	*x = Request_Payload{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request_Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request_Payload) ProtoMessage() {}
+
+func (x *Request_Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request_Payload.ProtoReflect.Descriptor instead.
+func (*Request_Payload) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Request_Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// LogString returns a single-line rendering of the populated fields of x
+// as space-separated name=value pairs, in the order in which the fields
+// are declared. Message fields are rendered as <MessageName>, repeated and
+// map fields as their number of elements, and fields marked as sensitive
+// as <redacted>.
+func (x *Request_Payload) LogString() string {
+	if x == nil {
+		return "<nil>"
+	}
+	var b strings.Builder
+	if len(x.Data) > 0 {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "data=%q", x.Data)
+	}
+	return b.String()
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/logstring/logstring.proto\x12 goproto.protoc.methods.logstring\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"\xed\x04\n" +
+	"\aRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05retry\x18\x03 \x01(\bR\x05retry\x12@\n" +
+	"\x06status\x18\x04 \x01(\x0e2(.goproto.protoc.methods.logstring.StatusR\x06status\x12\x1d\n" +
+	"\alatency\x18\x05 \x01(\x01H\x01R\alatency\x88\x01\x01\x12\x1a\n" +
+	"\x05token\x18\x06 \x01(\fB\x04\xd0\xf3\x18\x01R\x05token\x12\x19\n" +
+	"\x05email\x18\a \x01(\tB\x03\x80\x01\x01R\x05email\x12K\n" +
+	"\apayload\x18\b \x01(\v21.goproto.protoc.methods.logstring.Request.PayloadR\apayload\x12\x18\n" +
+	"\aheaders\x18\t \x03(\tR\aheaders\x12M\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v25.goproto.protoc.methods.logstring.Request.LabelsEntryR\x06labels\x12\x1f\n" +
+	"\n" +
+	"user_agent\x18\v \x01(\tH\x00R\tuserAgent\x12E\n" +
+	"\x03raw\x18\f \x01(\v21.goproto.protoc.methods.logstring.Request.PayloadH\x00R\x03raw\x1a\x1d\n" +
+	"\aPayload\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06clientB\n" +
+	"\n" +
+	"\b_latency*3\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ACTIVE\x10\x01BIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/logstringb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_goTypes = []any{
+	(Status)(0),             // 0: goproto.protoc.methods.logstring.Status
+	(*Request)(nil),         // 1: goproto.protoc.methods.logstring.Request
+	(*Request_Payload)(nil), // 2: goproto.protoc.methods.logstring.Request.Payload
+	nil,                     // 3: goproto.protoc.methods.logstring.Request.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.logstring.Request.status:type_name -> goproto.protoc.methods.logstring.Status
+	2, // 1: goproto.protoc.methods.logstring.Request.payload:type_name -> goproto.protoc.methods.logstring.Request.Payload
+	3, // 2: goproto.protoc.methods.logstring.Request.labels:type_name -> goproto.protoc.methods.logstring.Request.LabelsEntry
+	2, // 3: goproto.protoc.methods.logstring.Request.raw:type_name -> goproto.protoc.methods.logstring.Request.Payload
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes[0].OneofWrappers = []any{
+		(*Request_UserAgent)(nil),
+		(*Request_Raw)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_logstring_logstring_proto_depIdxs = nil
+}
@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/maptext/hybrid.proto
+
+//go:build !protoopaque
+
+package maptext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Record struct {
+	state  protoimpl.MessageState `protogen:"hybrid.v1"`
+	Title  *string                `protobuf:"bytes,1,opt,name=title" json:"title,omitempty" form:"title" uri:"title"`
+	Body   string                 `protobuf:"bytes,2,opt,name=body" json:"body,omitempty" form:"body" uri:"body"`
+	Notes  []string               `protobuf:"bytes,3,rep,name=notes" json:"notes,omitempty" form:"notes" uri:"notes"`
+	Lines  map[int32]string       `protobuf:"bytes,4,rep,name=lines" json:"lines,omitempty" form:"lines" uri:"lines" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Parent *Record                `protobuf:"bytes,5,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	// Types that are valid to be assigned to Ref:
+	//
+	//	*Record_Url
+	Ref           isRecord_Ref `protobuf_oneof:"ref"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Record) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Record) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Record) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Record) GetLines() map[int32]string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Record) GetParent() *Record {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Record) GetRef() isRecord_Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *Record) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Ref.(*Record_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *Record) SetTitle(v string) {
+	x.Title = &v
+}
+
+func (x *Record) SetBody(v string) {
+	x.Body = v
+}
+
+func (x *Record) SetNotes(v []string) {
+	x.Notes = v
+}
+
+func (x *Record) SetLines(v map[int32]string) {
+	x.Lines = v
+}
+
+func (x *Record) SetParent(v *Record) {
+	x.Parent = v
+}
+
+func (x *Record) SetUrl(v string) {
+	x.Ref = &Record_Url{v}
+}
+
+func (x *Record) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return x.Title != nil
+}
+
+func (x *Record) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Parent != nil
+}
+
+func (x *Record) HasRef() bool {
+	if x == nil {
+		return false
+	}
+	return x.Ref != nil
+}
+
+func (x *Record) HasUrl() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Ref.(*Record_Url)
+	return ok
+}
+
+func (x *Record) ClearTitle() {
+	x.Title = nil
+}
+
+func (x *Record) ClearParent() {
+	x.Parent = nil
+}
+
+func (x *Record) ClearRef() {
+	x.Ref = nil
+}
+
+func (x *Record) ClearUrl() {
+	if _, ok := x.Ref.(*Record_Url); ok {
+		x.Ref = nil
+	}
+}
+
+const Record_Ref_not_set_case case_Record_Ref = 0
+const Record_Url_case case_Record_Ref = 6
+
+func (x *Record) WhichRef() case_Record_Ref {
+	if x == nil {
+		return Record_Ref_not_set_case
+	}
+	switch x.Ref.(type) {
+	case *Record_Url:
+		return Record_Url_case
+	default:
+		return Record_Ref_not_set_case
+	}
+}
+
+type Record_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Title  *string
+	Body   string
+	Notes  []string
+	Lines  map[int32]string
+	Parent *Record
+	// Fields of oneof Ref:
+	Url *string
+	// -- end of Ref
+}
+
+func (b0 Record_builder) Build() *Record {
+	m0 := &Record{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Title = b.Title
+	x.Body = b.Body
+	x.Notes = b.Notes
+	x.Lines = b.Lines
+	x.Parent = b.Parent
+	if b.Url != nil {
+		x.Ref = &Record_Url{*b.Url}
+	}
+	return m0
+}
+
+type case_Record_Ref protoreflect.FieldNumber
+
+func (x case_Record_Ref) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isRecord_Ref interface {
+	isRecord_Ref()
+}
+
+type Record_Url struct {
+	Url string `protobuf:"bytes,6,opt,name=url,oneof"`
+}
+
+func (*Record_Url) isRecord_Ref() {}
+
+// MapStrings replaces the value of every populated string field of x and of
+// its nested messages with the result of calling f with the name of the field
+// and its value. Each element of a repeated string field and each string
+// value of a map field is replaced likewise. Map keys are left unchanged.
+func (x *Record) MapStrings(f func(fieldName protoreflect.Name, s string) string) {
+	if x == nil {
+		return
+	}
+	if x.HasTitle() {
+		x.SetTitle(f("title", x.GetTitle()))
+	}
+	if x.GetBody() != "" {
+		x.SetBody(f("body", x.GetBody()))
+	}
+	for i, s := range x.GetNotes() {
+		x.GetNotes()[i] = f("notes", s)
+	}
+	for k, s := range x.GetLines() {
+		x.GetLines()[k] = f("lines", s)
+	}
+	x.GetParent().MapStrings(f)
+	if x.HasUrl() {
+		x.SetUrl(f("url", x.GetUrl()))
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/methods/maptext/hybrid.proto\x12\x1egoproto.protoc.methods.maptext\x1a!google/protobuf/go_features.proto\"\xad\x02\n" +
+	"\x06Record\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x19\n" +
+	"\x04body\x18\x02 \x01(\tB\x05\xaa\x01\x02\b\x02R\x04body\x12\x14\n" +
+	"\x05notes\x18\x03 \x03(\tR\x05notes\x12G\n" +
+	"\x05lines\x18\x04 \x03(\v21.goproto.protoc.methods.maptext.Record.LinesEntryR\x05lines\x12>\n" +
+	"\x06parent\x18\x05 \x01(\v2&.goproto.protoc.methods.maptext.RecordR\x06parent\x12\x12\n" +
+	"\x03url\x18\x06 \x01(\tH\x00R\x03url\x1a8\n" +
+	"\n" +
+	"LinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
+	"\x03refBOZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maptext\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_goTypes = []any{
+	(*Record)(nil), // 0: goproto.protoc.methods.maptext.Record
+	nil,            // 1: goproto.protoc.methods.maptext.Record.LinesEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.maptext.Record.lines:type_name -> goproto.protoc.methods.maptext.Record.LinesEntry
+	0, // 1: goproto.protoc.methods.maptext.Record.parent:type_name -> goproto.protoc.methods.maptext.Record
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Record_Url)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_maptext_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,353 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/maptext/maptext.proto
+
+package maptext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Patient struct {
+	state      protoimpl.MessageState      `protogen:"open.v1"`
+	Name       string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Nickname   *string                     `protobuf:"bytes,2,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Age        int32                       `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Aliases    []string                    `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty" form:"aliases" uri:"aliases"`
+	Attributes map[string]string           `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" form:"attributes" uri:"attributes" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Emergency  *Patient_Contact            `protobuf:"bytes,6,opt,name=emergency,proto3" json:"emergency,omitempty" form:"emergency" uri:"emergency"`
+	Relatives  []*Patient_Contact          `protobuf:"bytes,7,rep,name=relatives,proto3" json:"relatives,omitempty" form:"relatives" uri:"relatives"`
+	Doctors    map[string]*Patient_Contact `protobuf:"bytes,8,rep,name=doctors,proto3" json:"doctors,omitempty" form:"doctors" uri:"doctors" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Id:
+	//
+	//	*Patient_Ssn
+	//	*Patient_Number
+	Id            isPatient_Id `protobuf_oneof:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patient) Reset() {
+	*x = Patient{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patient) ProtoMessage() {}
+
+func (x *Patient) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patient.ProtoReflect.Descriptor instead.
+func (*Patient) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Patient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Patient) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Patient) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Patient) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Patient) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Patient) GetEmergency() *Patient_Contact {
+	if x != nil {
+		return x.Emergency
+	}
+	return nil
+}
+
+func (x *Patient) GetRelatives() []*Patient_Contact {
+	if x != nil {
+		return x.Relatives
+	}
+	return nil
+}
+
+func (x *Patient) GetDoctors() map[string]*Patient_Contact {
+	if x != nil {
+		return x.Doctors
+	}
+	return nil
+}
+
+func (x *Patient) GetId() isPatient_Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Patient) GetSsn() string {
+	if x != nil {
+		if x, ok := x.Id.(*Patient_Ssn); ok {
+			return x.Ssn
+		}
+	}
+	return ""
+}
+
+func (x *Patient) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Id.(*Patient_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+type isPatient_Id interface {
+	isPatient_Id()
+}
+
+type Patient_Ssn struct {
+	Ssn string `protobuf:"bytes,9,opt,name=ssn,proto3,oneof"`
+}
+
+type Patient_Number struct {
+	Number int64 `protobuf:"varint,10,opt,name=number,proto3,oneof"`
+}
+
+func (*Patient_Ssn) isPatient_Id() {}
+
+func (*Patient_Number) isPatient_Id() {}
+
+// MapStrings replaces the value of every populated string field of x and of
+// its nested messages with the result of calling f with the name of the field
+// and its value. Each element of a repeated string field and each string
+// value of a map field is replaced likewise. Map keys are left unchanged.
+func (x *Patient) MapStrings(f func(fieldName protoreflect.Name, s string) string) {
+	if x == nil {
+		return
+	}
+	if x.Name != "" {
+		x.Name = f("name", x.Name)
+	}
+	if x.Nickname != nil {
+		*x.Nickname = f("nickname", x.GetNickname())
+	}
+	for i, s := range x.Aliases {
+		x.Aliases[i] = f("aliases", s)
+	}
+	for k, s := range x.Attributes {
+		x.Attributes[k] = f("attributes", s)
+	}
+	x.GetEmergency().MapStrings(f)
+	for _, v := range x.Relatives {
+		v.MapStrings(f)
+	}
+	for _, v := range x.Doctors {
+		v.MapStrings(f)
+	}
+	if v, ok := x.Id.(*Patient_Ssn); ok {
+		v.Ssn = f("ssn", v.Ssn)
+	}
+}
+
+type Patient_Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty" form:"phone" uri:"phone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patient_Contact) Reset() {
+	*x = Patient_Contact{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patient_Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patient_Contact) ProtoMessage() {}
+
+func (x *Patient_Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patient_Contact.ProtoReflect.Descriptor instead.
+func (*Patient_Contact) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Patient_Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Patient_Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// MapStrings replaces the value of every populated string field of x and of
+// its nested messages with the result of calling f with the name of the field
+// and its value. Each element of a repeated string field and each string
+// value of a map field is replaced likewise. Map keys are left unchanged.
+func (x *Patient_Contact) MapStrings(f func(fieldName protoreflect.Name, s string) string) {
+	if x == nil {
+		return
+	}
+	if x.Name != "" {
+		x.Name = f("name", x.Name)
+	}
+	if x.Phone != "" {
+		x.Phone = f("phone", x.Phone)
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/maptext/maptext.proto\x12\x1egoproto.protoc.methods.maptext\"\xd3\x05\n" +
+	"\aPatient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x10\n" +
+	"\x03age\x18\x03 \x01(\x05R\x03age\x12\x18\n" +
+	"\aaliases\x18\x04 \x03(\tR\aaliases\x12W\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v27.goproto.protoc.methods.maptext.Patient.AttributesEntryR\n" +
+	"attributes\x12M\n" +
+	"\temergency\x18\x06 \x01(\v2/.goproto.protoc.methods.maptext.Patient.ContactR\temergency\x12M\n" +
+	"\trelatives\x18\a \x03(\v2/.goproto.protoc.methods.maptext.Patient.ContactR\trelatives\x12N\n" +
+	"\adoctors\x18\b \x03(\v24.goproto.protoc.methods.maptext.Patient.DoctorsEntryR\adoctors\x12\x12\n" +
+	"\x03ssn\x18\t \x01(\tH\x00R\x03ssn\x12\x18\n" +
+	"\x06number\x18\n" +
+	" \x01(\x03H\x00R\x06number\x1a3\n" +
+	"\aContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ak\n" +
+	"\fDoctorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12E\n" +
+	"\x05value\x18\x02 \x01(\v2/.goproto.protoc.methods.maptext.Patient.ContactR\x05value:\x028\x01B\x04\n" +
+	"\x02idB\v\n" +
+	"\t_nicknameBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maptextb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_goTypes = []any{
+	(*Patient)(nil),         // 0: goproto.protoc.methods.maptext.Patient
+	(*Patient_Contact)(nil), // 1: goproto.protoc.methods.maptext.Patient.Contact
+	nil,                     // 2: goproto.protoc.methods.maptext.Patient.AttributesEntry
+	nil,                     // 3: goproto.protoc.methods.maptext.Patient.DoctorsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.maptext.Patient.attributes:type_name -> goproto.protoc.methods.maptext.Patient.AttributesEntry
+	1, // 1: goproto.protoc.methods.maptext.Patient.emergency:type_name -> goproto.protoc.methods.maptext.Patient.Contact
+	1, // 2: goproto.protoc.methods.maptext.Patient.relatives:type_name -> goproto.protoc.methods.maptext.Patient.Contact
+	3, // 3: goproto.protoc.methods.maptext.Patient.doctors:type_name -> goproto.protoc.methods.maptext.Patient.DoctorsEntry
+	1, // 4: goproto.protoc.methods.maptext.Patient.DoctorsEntry.value:type_name -> goproto.protoc.methods.maptext.Patient.Contact
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes[0].OneofWrappers = []any{
+		(*Patient_Ssn)(nil),
+		(*Patient_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_maptext_maptext_proto_depIdxs = nil
+}
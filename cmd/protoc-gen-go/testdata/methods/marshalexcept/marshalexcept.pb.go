@@ -0,0 +1,236 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/marshalexcept/marshalexcept.proto
+
+package marshalexcept
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	OwnerEmail    string                 `protobuf:"bytes,2,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty" form:"owner_email" uri:"owner_email"`
+	Credentials   *Snapshot_Credentials  `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty" form:"credentials" uri:"credentials"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCredentials() *Snapshot_Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *Snapshot) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// MarshalExcept returns the wire-format encoding of x without the fields
+// with the given numbers, which are cleared in a copy of x before it is
+// marshaled. It reports an error if a number is not that of a field of
+// Snapshot.
+func (x *Snapshot) MarshalExcept(except ...protoreflect.FieldNumber) ([]byte, error) {
+	fds := x.ProtoReflect().Descriptor().Fields()
+	for _, num := range except {
+		if fds.ByNumber(num) == nil {
+			return nil, fmt.Errorf("goproto.protoc.methods.marshalexcept.Snapshot has no field number %d", num)
+		}
+	}
+	if x == nil || len(except) == 0 {
+		return proto.Marshal(x)
+	}
+	y := proto.CloneOf(x).ProtoReflect()
+	for _, num := range except {
+		y.Clear(fds.ByNumber(num))
+	}
+	return proto.Marshal(y.Interface())
+}
+
+type Snapshot_Credentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty" form:"token" uri:"token"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot_Credentials) Reset() {
+	*x = Snapshot_Credentials{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot_Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot_Credentials) ProtoMessage() {}
+
+func (x *Snapshot_Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot_Credentials.ProtoReflect.Descriptor instead.
+func (*Snapshot_Credentials) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Snapshot_Credentials) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// MarshalExcept returns the wire-format encoding of x without the fields
+// with the given numbers, which are cleared in a copy of x before it is
+// marshaled. It reports an error if a number is not that of a field of
+// Snapshot_Credentials.
+func (x *Snapshot_Credentials) MarshalExcept(except ...protoreflect.FieldNumber) ([]byte, error) {
+	fds := x.ProtoReflect().Descriptor().Fields()
+	for _, num := range except {
+		if fds.ByNumber(num) == nil {
+			return nil, fmt.Errorf("goproto.protoc.methods.marshalexcept.Snapshot.Credentials has no field number %d", num)
+		}
+	}
+	if x == nil || len(except) == 0 {
+		return proto.Marshal(x)
+	}
+	y := proto.CloneOf(x).ProtoReflect()
+	for _, num := range except {
+		y.Clear(fds.ByNumber(num))
+	}
+	return proto.Marshal(y.Interface())
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDesc = "" +
+	"\n" +
+	"Dcmd/protoc-gen-go/testdata/methods/marshalexcept/marshalexcept.proto\x12$goproto.protoc.methods.marshalexcept\"\xd2\x01\n" +
+	"\bSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vowner_email\x18\x02 \x01(\tR\n" +
+	"ownerEmail\x12\\\n" +
+	"\vcredentials\x18\x03 \x01(\v2:.goproto.protoc.methods.marshalexcept.Snapshot.CredentialsR\vcredentials\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x1a#\n" +
+	"\vCredentials\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05tokenBMZKgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalexceptb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_goTypes = []any{
+	(*Snapshot)(nil),             // 0: goproto.protoc.methods.marshalexcept.Snapshot
+	(*Snapshot_Credentials)(nil), // 1: goproto.protoc.methods.marshalexcept.Snapshot.Credentials
+}
+var file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.marshalexcept.Snapshot.credentials:type_name -> goproto.protoc.methods.marshalexcept.Snapshot.Credentials
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_marshalexcept_marshalexcept_proto_depIdxs = nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/marshalpath/marshalpath.proto
+
+package marshalpath
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	strings "strings"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Customer struct {
+	state    protoimpl.MessageState       `protogen:"open.v1"`
+	Name     string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Address  *Customer_Address            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	Previous []*Customer_Address          `protobuf:"bytes,3,rep,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Named    map[string]*Customer_Address `protobuf:"bytes,4,rep,name=named,proto3" json:"named,omitempty" form:"named" uri:"named" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Customer_Phone
+	//	*Customer_Mailing
+	Contact       isCustomer_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Customer) GetAddress() *Customer_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Customer) GetPrevious() []*Customer_Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Customer) GetNamed() map[string]*Customer_Address {
+	if x != nil {
+		return x.Named
+	}
+	return nil
+}
+
+func (x *Customer) GetContact() isCustomer_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Customer) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Customer_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Customer) GetMailing() *Customer_Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Customer_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+type isCustomer_Contact interface {
+	isCustomer_Contact()
+}
+
+type Customer_Phone struct {
+	Phone string `protobuf:"bytes,5,opt,name=phone,proto3,oneof"`
+}
+
+type Customer_Mailing struct {
+	Mailing *Customer_Address `protobuf:"bytes,6,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Customer_Phone) isCustomer_Contact() {}
+
+func (*Customer_Mailing) isCustomer_Contact() {}
+
+// MarshalPath returns the wire-format encoding of the submessage of x
+// addressed by path, which is a dot-separated list of field names. Every
+// component of the path must name a singular message field. An unset
+// submessage is marshaled as an empty message.
+func (x *Customer) MarshalPath(path string) ([]byte, error) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_marshalPath(x.ProtoReflect(), path)
+}
+
+type Customer_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	Geo           *Customer_Geo          `protobuf:"bytes,2,opt,name=geo,proto3" json:"geo,omitempty" form:"geo" uri:"geo"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer_Address) Reset() {
+	*x = Customer_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer_Address) ProtoMessage() {}
+
+func (x *Customer_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer_Address.ProtoReflect.Descriptor instead.
+func (*Customer_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Customer_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Customer_Address) GetGeo() *Customer_Geo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+// MarshalPath returns the wire-format encoding of the submessage of x
+// addressed by path, which is a dot-separated list of field names. Every
+// component of the path must name a singular message field. An unset
+// submessage is marshaled as an empty message.
+func (x *Customer_Address) MarshalPath(path string) ([]byte, error) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_marshalPath(x.ProtoReflect(), path)
+}
+
+type Customer_Geo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty" form:"lat" uri:"lat"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty" form:"lng" uri:"lng"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer_Geo) Reset() {
+	*x = Customer_Geo{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer_Geo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer_Geo) ProtoMessage() {}
+
+func (x *Customer_Geo) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer_Geo.ProtoReflect.Descriptor instead.
+func (*Customer_Geo) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Customer_Geo) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Customer_Geo) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+// MarshalPath returns the wire-format encoding of the submessage of x
+// addressed by path, which is a dot-separated list of field names. Every
+// component of the path must name a singular message field. An unset
+// submessage is marshaled as an empty message.
+func (x *Customer_Geo) MarshalPath(path string) ([]byte, error) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_marshalPath(x.ProtoReflect(), path)
+}
+
+func file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_marshalPath(m protoreflect.Message, path string) ([]byte, error) {
+	md := m.Descriptor()
+	for _, name := range strings.Split(path, ".") {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		switch {
+		case fd == nil:
+			return nil, fmt.Errorf("invalid path %q for message %v: no field %q in %v", path, md.FullName(), name, m.Descriptor().FullName())
+		case fd.Message() == nil || fd.Cardinality() == protoreflect.Repeated:
+			return nil, fmt.Errorf("invalid path %q for message %v: %v is not a singular message field", path, md.FullName(), fd.FullName())
+		}
+		m = m.Get(fd).Message()
+	}
+	return proto.Marshal(m.Interface())
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/marshalpath/marshalpath.proto\x12\"goproto.protoc.methods.marshalpath\"\x82\x05\n" +
+	"\bCustomer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12N\n" +
+	"\aaddress\x18\x02 \x01(\v24.goproto.protoc.methods.marshalpath.Customer.AddressR\aaddress\x12P\n" +
+	"\bprevious\x18\x03 \x03(\v24.goproto.protoc.methods.marshalpath.Customer.AddressR\bprevious\x12M\n" +
+	"\x05named\x18\x04 \x03(\v27.goproto.protoc.methods.marshalpath.Customer.NamedEntryR\x05named\x12\x16\n" +
+	"\x05phone\x18\x05 \x01(\tH\x00R\x05phone\x12P\n" +
+	"\amailing\x18\x06 \x01(\v24.goproto.protoc.methods.marshalpath.Customer.AddressH\x00R\amailing\x1aa\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12B\n" +
+	"\x03geo\x18\x02 \x01(\v20.goproto.protoc.methods.marshalpath.Customer.GeoR\x03geo\x1a)\n" +
+	"\x03Geo\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\x1an\n" +
+	"\n" +
+	"NamedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12J\n" +
+	"\x05value\x18\x02 \x01(\v24.goproto.protoc.methods.marshalpath.Customer.AddressR\x05value:\x028\x01B\t\n" +
+	"\acontactBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpathb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_goTypes = []any{
+	(*Customer)(nil),         // 0: goproto.protoc.methods.marshalpath.Customer
+	(*Customer_Address)(nil), // 1: goproto.protoc.methods.marshalpath.Customer.Address
+	(*Customer_Geo)(nil),     // 2: goproto.protoc.methods.marshalpath.Customer.Geo
+	nil,                      // 3: goproto.protoc.methods.marshalpath.Customer.NamedEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.marshalpath.Customer.address:type_name -> goproto.protoc.methods.marshalpath.Customer.Address
+	1, // 1: goproto.protoc.methods.marshalpath.Customer.previous:type_name -> goproto.protoc.methods.marshalpath.Customer.Address
+	3, // 2: goproto.protoc.methods.marshalpath.Customer.named:type_name -> goproto.protoc.methods.marshalpath.Customer.NamedEntry
+	1, // 3: goproto.protoc.methods.marshalpath.Customer.mailing:type_name -> goproto.protoc.methods.marshalpath.Customer.Address
+	2, // 4: goproto.protoc.methods.marshalpath.Customer.Address.geo:type_name -> goproto.protoc.methods.marshalpath.Customer.Geo
+	1, // 5: goproto.protoc.methods.marshalpath.Customer.NamedEntry.value:type_name -> goproto.protoc.methods.marshalpath.Customer.Address
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes[0].OneofWrappers = []any{
+		(*Customer_Phone)(nil),
+		(*Customer_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_marshalpath_marshalpath_proto_depIdxs = nil
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/marshalpresent/marshalpresent.proto
+
+package marshalpresent
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type UpdateProfile struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	DisplayName   string                    `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty" form:"display_name" uri:"display_name"`
+	Bio           *string                   `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty" form:"bio" uri:"bio"`
+	Age           int32                     `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Followers     *int32                    `protobuf:"varint,4,opt,name=followers,proto3,oneof" json:"followers,omitempty" form:"followers" uri:"followers"`
+	Rating        float64                   `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty" form:"rating" uri:"rating"`
+	Location      *UpdateProfile_Location   `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty" form:"location" uri:"location"`
+	Visited       []*UpdateProfile_Location `protobuf:"bytes,7,rep,name=visited,proto3" json:"visited,omitempty" form:"visited" uri:"visited"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfile) Reset() {
+	*x = UpdateProfile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfile) ProtoMessage() {}
+
+func (x *UpdateProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfile.ProtoReflect.Descriptor instead.
+func (*UpdateProfile) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfile) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfile) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *UpdateProfile) GetFollowers() int32 {
+	if x != nil && x.Followers != nil {
+		return *x.Followers
+	}
+	return 0
+}
+
+func (x *UpdateProfile) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateProfile) GetLocation() *UpdateProfile_Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateProfile) GetVisited() []*UpdateProfile_Location {
+	if x != nil {
+		return x.Visited
+	}
+	return nil
+}
+
+// MarshalPresentOnly returns the wire-format encoding of a copy of x from
+// which the fields with implicit presence holding the zero value of their
+// type have been cleared, recursively, leaving the fields with explicit
+// presence which were set, even to their zero value.
+//
+// Fields with implicit presence, such as proto3 scalar fields declared
+// without the optional label, cannot tell an explicit zero value from an
+// unset field. Since proto.Marshal already omits most of them when they
+// hold the zero value, the output only differs from that of proto.Marshal
+// for floating-point fields holding negative zero, which are omitted too.
+func (x *UpdateProfile) MarshalPresentOnly() ([]byte, error) {
+	y := proto.CloneOf(x)
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(y.ProtoReflect())
+	return proto.Marshal(y)
+}
+
+type UpdateProfile_Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty" form:"latitude" uri:"latitude"`
+	Longitude     *float64               `protobuf:"fixed64,2,opt,name=longitude,proto3,oneof" json:"longitude,omitempty" form:"longitude" uri:"longitude"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfile_Location) Reset() {
+	*x = UpdateProfile_Location{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfile_Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfile_Location) ProtoMessage() {}
+
+func (x *UpdateProfile_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfile_Location.ProtoReflect.Descriptor instead.
+func (*UpdateProfile_Location) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *UpdateProfile_Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateProfile_Location) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+// MarshalPresentOnly returns the wire-format encoding of a copy of x from
+// which the fields with implicit presence holding the zero value of their
+// type have been cleared, recursively, leaving the fields with explicit
+// presence which were set, even to their zero value.
+//
+// Fields with implicit presence, such as proto3 scalar fields declared
+// without the optional label, cannot tell an explicit zero value from an
+// unset field. Since proto.Marshal already omits most of them when they
+// hold the zero value, the output only differs from that of proto.Marshal
+// for floating-point fields holding negative zero, which are omitted too.
+func (x *UpdateProfile_Location) MarshalPresentOnly() ([]byte, error) {
+	y := proto.CloneOf(x)
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(y.ProtoReflect())
+	return proto.Marshal(y)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero clears the fields of m and of its nested messages
+// which have implicit presence and hold the default value of their type.
+func file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for i, l := 0, v.List(); i < l.Len(); i++ {
+				file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(v.Message())
+				return true
+			})
+		case fd.IsList() || fd.IsMap():
+		case fd.Message() != nil:
+			file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_clearImplicitZero(v.Message())
+		case !fd.HasPresence() && v.Equal(fd.Default()):
+			m.Clear(fd)
+		}
+		return true
+	})
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDesc = "" +
+	"\n" +
+	"Fcmd/protoc-gen-go/testdata/methods/marshalpresent/marshalpresent.proto\x12%goproto.protoc.methods.marshalpresent\"\xb9\x03\n" +
+	"\rUpdateProfile\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x15\n" +
+	"\x03bio\x18\x02 \x01(\tH\x00R\x03bio\x88\x01\x01\x12\x10\n" +
+	"\x03age\x18\x03 \x01(\x05R\x03age\x12!\n" +
+	"\tfollowers\x18\x04 \x01(\x05H\x01R\tfollowers\x88\x01\x01\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x01R\x06rating\x12Y\n" +
+	"\blocation\x18\x06 \x01(\v2=.goproto.protoc.methods.marshalpresent.UpdateProfile.LocationR\blocation\x12W\n" +
+	"\avisited\x18\a \x03(\v2=.goproto.protoc.methods.marshalpresent.UpdateProfile.LocationR\avisited\x1aW\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12!\n" +
+	"\tlongitude\x18\x02 \x01(\x01H\x00R\tlongitude\x88\x01\x01B\f\n" +
+	"\n" +
+	"_longitudeB\x06\n" +
+	"\x04_bioB\f\n" +
+	"\n" +
+	"_followersBNZLgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/marshalpresentb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_goTypes = []any{
+	(*UpdateProfile)(nil),          // 0: goproto.protoc.methods.marshalpresent.UpdateProfile
+	(*UpdateProfile_Location)(nil), // 1: goproto.protoc.methods.marshalpresent.UpdateProfile.Location
+}
+var file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.marshalpresent.UpdateProfile.location:type_name -> goproto.protoc.methods.marshalpresent.UpdateProfile.Location
+	1, // 1: goproto.protoc.methods.marshalpresent.UpdateProfile.visited:type_name -> goproto.protoc.methods.marshalpresent.UpdateProfile.Location
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_marshalpresent_marshalpresent_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/maxsize/maxsize.proto
+
+package maxsize
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" form:"key" uri:"key"`
+	Chunks        []*Record_Chunk        `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks,omitempty" form:"chunks" uri:"chunks"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Record) GetChunks() []*Record_Chunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+// CheckMaxSize reports an error if the wire-format encoding of x, as given
+// by proto.Size, is longer than limit bytes. The size of x and of its
+// nested messages is cached, so it need not be recomputed by a following
+// marshal with proto.MarshalOptions.UseCachedSize.
+func (x *Record) CheckMaxSize(limit int) error {
+	if n := proto.Size(x); n > limit {
+		return fmt.Errorf("goproto.protoc.methods.maxsize.Record: message size %d exceeds the limit of %d bytes", n, limit)
+	}
+	return nil
+}
+
+type Record_Chunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty" form:"data" uri:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record_Chunk) Reset() {
+	*x = Record_Chunk{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record_Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record_Chunk) ProtoMessage() {}
+
+func (x *Record_Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record_Chunk.ProtoReflect.Descriptor instead.
+func (*Record_Chunk) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Record_Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CheckMaxSize reports an error if the wire-format encoding of x, as given
+// by proto.Size, is longer than limit bytes. The size of x and of its
+// nested messages is cached, so it need not be recomputed by a following
+// marshal with proto.MarshalOptions.UseCachedSize.
+func (x *Record_Chunk) CheckMaxSize(limit int) error {
+	if n := proto.Size(x); n > limit {
+		return fmt.Errorf("goproto.protoc.methods.maxsize.Record.Chunk: message size %d exceeds the limit of %d bytes", n, limit)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/maxsize/maxsize.proto\x12\x1egoproto.protoc.methods.maxsize\"}\n" +
+	"\x06Record\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x06chunks\x18\x02 \x03(\v2,.goproto.protoc.methods.maxsize.Record.ChunkR\x06chunks\x1a\x1b\n" +
+	"\x05Chunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04dataBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/maxsizeb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_goTypes = []any{
+	(*Record)(nil),       // 0: goproto.protoc.methods.maxsize.Record
+	(*Record_Chunk)(nil), // 1: goproto.protoc.methods.maxsize.Record.Chunk
+}
+var file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.maxsize.Record.chunks:type_name -> goproto.protoc.methods.maxsize.Record.Chunk
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_maxsize_maxsize_proto_depIdxs = nil
+}
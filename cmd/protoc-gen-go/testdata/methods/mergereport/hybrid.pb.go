@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/mergereport/hybrid.proto
+
+//go:build !protoopaque
+
+package mergereport
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Account struct {
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	Owner   *string                `protobuf:"bytes,1,opt,name=owner" json:"owner,omitempty" form:"owner" uri:"owner"`
+	Balance int64                  `protobuf:"varint,2,opt,name=balance" json:"balance,omitempty" form:"balance" uri:"balance"`
+	Linked  []*Account             `protobuf:"bytes,3,rep,name=linked" json:"linked,omitempty" form:"linked" uri:"linked"`
+	Parent  *Account               `protobuf:"bytes,4,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	// Types that are valid to be assigned to Plan:
+	//
+	//	*Account_Tier
+	//	*Account_Sponsor
+	Plan          isAccount_Plan `protobuf_oneof:"plan"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Account) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetLinked() []*Account {
+	if x != nil {
+		return x.Linked
+	}
+	return nil
+}
+
+func (x *Account) GetParent() *Account {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Account) GetPlan() isAccount_Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *Account) GetTier() string {
+	if x != nil {
+		if x, ok := x.Plan.(*Account_Tier); ok {
+			return x.Tier
+		}
+	}
+	return ""
+}
+
+func (x *Account) GetSponsor() *Account {
+	if x != nil {
+		if x, ok := x.Plan.(*Account_Sponsor); ok {
+			return x.Sponsor
+		}
+	}
+	return nil
+}
+
+func (x *Account) SetOwner(v string) {
+	x.Owner = &v
+}
+
+func (x *Account) SetBalance(v int64) {
+	x.Balance = v
+}
+
+func (x *Account) SetLinked(v []*Account) {
+	x.Linked = v
+}
+
+func (x *Account) SetParent(v *Account) {
+	x.Parent = v
+}
+
+func (x *Account) SetTier(v string) {
+	x.Plan = &Account_Tier{v}
+}
+
+func (x *Account) SetSponsor(v *Account) {
+	if v == nil {
+		x.Plan = nil
+		return
+	}
+	x.Plan = &Account_Sponsor{v}
+}
+
+func (x *Account) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return x.Owner != nil
+}
+
+func (x *Account) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Parent != nil
+}
+
+func (x *Account) HasPlan() bool {
+	if x == nil {
+		return false
+	}
+	return x.Plan != nil
+}
+
+func (x *Account) HasTier() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Plan.(*Account_Tier)
+	return ok
+}
+
+func (x *Account) HasSponsor() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Plan.(*Account_Sponsor)
+	return ok
+}
+
+func (x *Account) ClearOwner() {
+	x.Owner = nil
+}
+
+func (x *Account) ClearParent() {
+	x.Parent = nil
+}
+
+func (x *Account) ClearPlan() {
+	x.Plan = nil
+}
+
+func (x *Account) ClearTier() {
+	if _, ok := x.Plan.(*Account_Tier); ok {
+		x.Plan = nil
+	}
+}
+
+func (x *Account) ClearSponsor() {
+	if _, ok := x.Plan.(*Account_Sponsor); ok {
+		x.Plan = nil
+	}
+}
+
+const Account_Plan_not_set_case case_Account_Plan = 0
+const Account_Tier_case case_Account_Plan = 5
+const Account_Sponsor_case case_Account_Plan = 6
+
+func (x *Account) WhichPlan() case_Account_Plan {
+	if x == nil {
+		return Account_Plan_not_set_case
+	}
+	switch x.Plan.(type) {
+	case *Account_Tier:
+		return Account_Tier_case
+	case *Account_Sponsor:
+		return Account_Sponsor_case
+	default:
+		return Account_Plan_not_set_case
+	}
+}
+
+type Account_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Owner   *string
+	Balance int64
+	Linked  []*Account
+	Parent  *Account
+	// Fields of oneof Plan:
+	Tier    *string
+	Sponsor *Account
+	// -- end of Plan
+}
+
+func (b0 Account_builder) Build() *Account {
+	m0 := &Account{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Owner = b.Owner
+	x.Balance = b.Balance
+	x.Linked = b.Linked
+	x.Parent = b.Parent
+	if b.Tier != nil {
+		x.Plan = &Account_Tier{*b.Tier}
+	}
+	if b.Sponsor != nil {
+		x.Plan = &Account_Sponsor{b.Sponsor}
+	}
+	return m0
+}
+
+type case_Account_Plan protoreflect.FieldNumber
+
+func (x case_Account_Plan) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isAccount_Plan interface {
+	isAccount_Plan()
+}
+
+type Account_Tier struct {
+	Tier string `protobuf:"bytes,5,opt,name=tier,oneof"`
+}
+
+type Account_Sponsor struct {
+	Sponsor *Account `protobuf:"bytes,6,opt,name=sponsor,oneof"`
+}
+
+func (*Account_Tier) isAccount_Plan() {}
+
+func (*Account_Sponsor) isAccount_Plan() {}
+
+// MergeReport merges src into x as by proto.Merge, except that a singular
+// field populated in both x and src with different values keeps the value
+// of x, and its name is reported in conflicts, in the order in which the
+// fields are declared. Setting a different member of a oneof than the one
+// set in x is also a conflict.
+//
+// Singular message fields are merged recursively, and reported if merging
+// them reports a conflict; messages declared in other files are compared
+// as a whole instead. Repeated fields are appended to, and the entries of
+// map fields are copied into x. Unknown fields and extensions are not merged.
+func (x *Account) MergeReport(src *Account) (conflicts []protoreflect.Name, err error) {
+	if src == nil || x == src {
+		return nil, nil
+	}
+	if x == nil {
+		return nil, fmt.Errorf("cannot merge into nil *Account")
+	}
+	if src.HasOwner() {
+		if x.HasOwner() {
+			if x.GetOwner() != src.GetOwner() {
+				conflicts = append(conflicts, "owner")
+			}
+		} else {
+			x.SetOwner(src.GetOwner())
+		}
+	}
+	if src.GetBalance() != 0 {
+		if x.GetBalance() != 0 {
+			if x.GetBalance() != src.GetBalance() {
+				conflicts = append(conflicts, "balance")
+			}
+		} else {
+			x.SetBalance(src.GetBalance())
+		}
+	}
+	if len(src.GetLinked()) > 0 {
+		l := x.GetLinked()
+		for _, e := range src.GetLinked() {
+			l = append(l, proto.CloneOf(e))
+		}
+		x.SetLinked(l)
+	}
+	if src.HasParent() {
+		if x.HasParent() {
+			c, err := x.GetParent().MergeReport(src.GetParent())
+			if err != nil {
+				return conflicts, err
+			}
+			if len(c) > 0 {
+				conflicts = append(conflicts, "parent")
+			}
+		} else {
+			x.SetParent(proto.CloneOf(src.GetParent()))
+		}
+	}
+	if src.HasTier() {
+		if x.HasTier() {
+			if x.GetTier() != src.GetTier() {
+				conflicts = append(conflicts, "tier")
+			}
+		} else if x.HasPlan() {
+			conflicts = append(conflicts, "tier")
+		} else {
+			x.SetTier(src.GetTier())
+		}
+	}
+	if src.HasSponsor() {
+		if x.HasSponsor() {
+			c, err := x.GetSponsor().MergeReport(src.GetSponsor())
+			if err != nil {
+				return conflicts, err
+			}
+			if len(c) > 0 {
+				conflicts = append(conflicts, "sponsor")
+			}
+		} else if x.HasPlan() {
+			conflicts = append(conflicts, "sponsor")
+		} else {
+			x.SetSponsor(proto.CloneOf(src.GetSponsor()))
+		}
+	}
+	return conflicts, nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_rawDesc = "" +
+	"\n" +
+	";cmd/protoc-gen-go/testdata/methods/mergereport/hybrid.proto\x12\"goproto.protoc.methods.mergereport\x1a!google/protobuf/go_features.proto\"\xb1\x02\n" +
+	"\aAccount\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x1f\n" +
+	"\abalance\x18\x02 \x01(\x03B\x05\xaa\x01\x02\b\x02R\abalance\x12C\n" +
+	"\x06linked\x18\x03 \x03(\v2+.goproto.protoc.methods.mergereport.AccountR\x06linked\x12C\n" +
+	"\x06parent\x18\x04 \x01(\v2+.goproto.protoc.methods.mergereport.AccountR\x06parent\x12\x14\n" +
+	"\x04tier\x18\x05 \x01(\tH\x00R\x04tier\x12G\n" +
+	"\asponsor\x18\x06 \x01(\v2+.goproto.protoc.methods.mergereport.AccountH\x00R\asponsorB\x06\n" +
+	"\x04planBSZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/mergereport\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_goTypes = []any{
+	(*Account)(nil), // 0: goproto.protoc.methods.mergereport.Account
+}
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.mergereport.Account.linked:type_name -> goproto.protoc.methods.mergereport.Account
+	0, // 1: goproto.protoc.methods.mergereport.Account.parent:type_name -> goproto.protoc.methods.mergereport.Account
+	0, // 2: goproto.protoc.methods.mergereport.Account.sponsor:type_name -> goproto.protoc.methods.mergereport.Account
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_Tier)(nil),
+		(*Account_Sponsor)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_hybrid_proto_depIdxs = nil
+}
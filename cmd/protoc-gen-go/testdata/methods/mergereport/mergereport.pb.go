@@ -0,0 +1,418 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/mergereport/mergereport.proto
+
+package mergereport
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Profile struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Age     *int32                 `protobuf:"varint,2,opt,name=age,proto3,oneof" json:"age,omitempty" form:"age" uri:"age"`
+	Avatar  []byte                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty" form:"avatar" uri:"avatar"`
+	Tags    []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Labels  map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Address *Profile_Address       `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Profile_Email
+	//	*Profile_Mailing
+	Contact       isProfile_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetAge() int32 {
+	if x != nil && x.Age != nil {
+		return *x.Age
+	}
+	return 0
+}
+
+func (x *Profile) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Profile) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Profile) GetAddress() *Profile_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Profile) GetContact() isProfile_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Profile) GetMailing() *Profile_Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+type isProfile_Contact interface {
+	isProfile_Contact()
+}
+
+type Profile_Email struct {
+	Email string `protobuf:"bytes,7,opt,name=email,proto3,oneof"`
+}
+
+type Profile_Mailing struct {
+	Mailing *Profile_Address `protobuf:"bytes,8,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Profile_Email) isProfile_Contact() {}
+
+func (*Profile_Mailing) isProfile_Contact() {}
+
+// MergeReport merges src into x as by proto.Merge, except that a singular
+// field populated in both x and src with different values keeps the value
+// of x, and its name is reported in conflicts, in the order in which the
+// fields are declared. Setting a different member of a oneof than the one
+// set in x is also a conflict.
+//
+// Singular message fields are merged recursively, and reported if merging
+// them reports a conflict; messages declared in other files are compared
+// as a whole instead. Repeated fields are appended to, and the entries of
+// map fields are copied into x. Unknown fields and extensions are not merged.
+func (x *Profile) MergeReport(src *Profile) (conflicts []protoreflect.Name, err error) {
+	if src == nil || x == src {
+		return nil, nil
+	}
+	if x == nil {
+		return nil, fmt.Errorf("cannot merge into nil *Profile")
+	}
+	if src.Name != "" {
+		if x.Name != "" {
+			if x.GetName() != src.Name {
+				conflicts = append(conflicts, "name")
+			}
+		} else {
+			x.Name = src.Name
+		}
+	}
+	if src.Age != nil {
+		if x.Age != nil {
+			if x.GetAge() != src.GetAge() {
+				conflicts = append(conflicts, "age")
+			}
+		} else {
+			t := src.GetAge()
+			x.Age = &t
+		}
+	}
+	if len(src.Avatar) > 0 {
+		if len(x.Avatar) > 0 {
+			if string(x.GetAvatar()) != string(src.Avatar) {
+				conflicts = append(conflicts, "avatar")
+			}
+		} else {
+			x.Avatar = append([]byte{}, src.Avatar...)
+		}
+	}
+	if len(src.Tags) > 0 {
+		x.Tags = append(x.Tags, src.Tags...)
+	}
+	if len(src.Labels) > 0 {
+		mv := x.Labels
+		if mv == nil {
+			mv = make(map[string]string, len(src.Labels))
+			x.Labels = mv
+		}
+		for k, e := range src.Labels {
+			mv[k] = e
+		}
+	}
+	if src.Address != nil {
+		if x.Address != nil {
+			c, err := x.GetAddress().MergeReport(src.Address)
+			if err != nil {
+				return conflicts, err
+			}
+			if len(c) > 0 {
+				conflicts = append(conflicts, "address")
+			}
+		} else {
+			x.Address = proto.CloneOf(src.Address)
+		}
+	}
+	if v, ok := src.Contact.(*Profile_Email); ok {
+		if _, ok := x.Contact.(*Profile_Email); ok {
+			if x.GetEmail() != v.Email {
+				conflicts = append(conflicts, "email")
+			}
+		} else if x.Contact != nil {
+			conflicts = append(conflicts, "email")
+		} else {
+			x.Contact = &Profile_Email{Email: v.Email}
+		}
+	}
+	if v, ok := src.Contact.(*Profile_Mailing); ok {
+		if _, ok := x.Contact.(*Profile_Mailing); ok {
+			c, err := x.GetMailing().MergeReport(v.Mailing)
+			if err != nil {
+				return conflicts, err
+			}
+			if len(c) > 0 {
+				conflicts = append(conflicts, "mailing")
+			}
+		} else if x.Contact != nil {
+			conflicts = append(conflicts, "mailing")
+		} else {
+			x.Contact = &Profile_Mailing{Mailing: proto.CloneOf(v.Mailing)}
+		}
+	}
+	return conflicts, nil
+}
+
+type Profile_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty" form:"street" uri:"street"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile_Address) Reset() {
+	*x = Profile_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile_Address) ProtoMessage() {}
+
+func (x *Profile_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile_Address.ProtoReflect.Descriptor instead.
+func (*Profile_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Profile_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Profile_Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+// MergeReport merges src into x as by proto.Merge, except that a singular
+// field populated in both x and src with different values keeps the value
+// of x, and its name is reported in conflicts, in the order in which the
+// fields are declared. Setting a different member of a oneof than the one
+// set in x is also a conflict.
+//
+// Singular message fields are merged recursively, and reported if merging
+// them reports a conflict; messages declared in other files are compared
+// as a whole instead. Repeated fields are appended to, and the entries of
+// map fields are copied into x. Unknown fields and extensions are not merged.
+func (x *Profile_Address) MergeReport(src *Profile_Address) (conflicts []protoreflect.Name, err error) {
+	if src == nil || x == src {
+		return nil, nil
+	}
+	if x == nil {
+		return nil, fmt.Errorf("cannot merge into nil *Profile_Address")
+	}
+	if src.City != "" {
+		if x.City != "" {
+			if x.GetCity() != src.City {
+				conflicts = append(conflicts, "city")
+			}
+		} else {
+			x.City = src.City
+		}
+	}
+	if src.Street != "" {
+		if x.Street != "" {
+			if x.GetStreet() != src.Street {
+				conflicts = append(conflicts, "street")
+			}
+		} else {
+			x.Street = src.Street
+		}
+	}
+	return conflicts, nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/mergereport/mergereport.proto\x12\"goproto.protoc.methods.mergereport\"\xee\x03\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x03age\x18\x02 \x01(\x05H\x01R\x03age\x88\x01\x01\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\fR\x06avatar\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12O\n" +
+	"\x06labels\x18\x05 \x03(\v27.goproto.protoc.methods.mergereport.Profile.LabelsEntryR\x06labels\x12M\n" +
+	"\aaddress\x18\x06 \x01(\v23.goproto.protoc.methods.mergereport.Profile.AddressR\aaddress\x12\x16\n" +
+	"\x05email\x18\a \x01(\tH\x00R\x05email\x12O\n" +
+	"\amailing\x18\b \x01(\v23.goproto.protoc.methods.mergereport.Profile.AddressH\x00R\amailing\x1a5\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x16\n" +
+	"\x06street\x18\x02 \x01(\tR\x06street\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\acontactB\x06\n" +
+	"\x04_ageBKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/mergereportb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_goTypes = []any{
+	(*Profile)(nil),         // 0: goproto.protoc.methods.mergereport.Profile
+	(*Profile_Address)(nil), // 1: goproto.protoc.methods.mergereport.Profile.Address
+	nil,                     // 2: goproto.protoc.methods.mergereport.Profile.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.mergereport.Profile.labels:type_name -> goproto.protoc.methods.mergereport.Profile.LabelsEntry
+	1, // 1: goproto.protoc.methods.mergereport.Profile.address:type_name -> goproto.protoc.methods.mergereport.Profile.Address
+	1, // 2: goproto.protoc.methods.mergereport.Profile.mailing:type_name -> goproto.protoc.methods.mergereport.Profile.Address
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_Email)(nil),
+		(*Profile_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_mergereport_mergereport_proto_depIdxs = nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/metriclabels/metriclabels.proto
+
+package metriclabels
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Request struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	HttpMethod string                 `protobuf:"bytes,2,opt,name=http_method,json=httpMethod,proto3" json:"http_method,omitempty" form:"http_method" uri:"http_method"`
+	StatusCode int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty" form:"status_code" uri:"status_code"`
+	Route      *Request_Route         `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty" form:"route" uri:"route"`
+	// Types that are valid to be assigned to Client:
+	//
+	//	*Request_UserAgent
+	//	*Request_ServiceName
+	Client        isRequest_Client `protobuf_oneof:"client"`
+	Tags          []string         `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetHttpMethod() string {
+	if x != nil {
+		return x.HttpMethod
+	}
+	return ""
+}
+
+func (x *Request) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Request) GetRoute() *Request_Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Request) GetClient() isRequest_Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Request) GetUserAgent() string {
+	if x != nil {
+		if x, ok := x.Client.(*Request_UserAgent); ok {
+			return x.UserAgent
+		}
+	}
+	return ""
+}
+
+func (x *Request) GetServiceName() string {
+	if x != nil {
+		if x, ok := x.Client.(*Request_ServiceName); ok {
+			return x.ServiceName
+		}
+	}
+	return ""
+}
+
+func (x *Request) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type isRequest_Client interface {
+	isRequest_Client()
+}
+
+type Request_UserAgent struct {
+	UserAgent string `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3,oneof"`
+}
+
+type Request_ServiceName struct {
+	ServiceName string `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3,oneof"`
+}
+
+func (*Request_UserAgent) isRequest_Client() {}
+
+func (*Request_ServiceName) isRequest_Client() {}
+
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Request_metricFieldLabels = []string{
+	"http_method",
+	"status_code",
+	"route",
+	"user_agent",
+	"service_name",
+	"tags",
+}
+
+// MetricFieldLabels returns the proto names of the fields of Request in the
+// order in which they are declared, for use as the label names of metrics.
+// Fields of nested messages are not included. The returned slice is shared
+// by all calls and must not be modified.
+func (*Request) MetricFieldLabels() []string {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Request_metricFieldLabels
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescGZIP(), []int{1}
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Empty_metricFieldLabels = []string{}
+
+// MetricFieldLabels returns the proto names of the fields of Empty in the
+// order in which they are declared, for use as the label names of metrics.
+// Fields of nested messages are not included. The returned slice is shared
+// by all calls and must not be modified.
+func (*Empty) MetricFieldLabels() []string {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Empty_metricFieldLabels
+}
+
+type Request_Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" form:"path" uri:"path"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request_Route) Reset() {
+	*x = Request_Route{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request_Route) ProtoMessage() {}
+
+func (x *Request_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request_Route.ProtoReflect.Descriptor instead.
+func (*Request_Route) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Request_Route) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Request_Route_metricFieldLabels = []string{
+	"path",
+}
+
+// MetricFieldLabels returns the proto names of the fields of Request_Route in the
+// order in which they are declared, for use as the label names of metrics.
+// Fields of nested messages are not included. The returned slice is shared
+// by all calls and must not be modified.
+func (*Request_Route) MetricFieldLabels() []string {
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_Request_Route_metricFieldLabels
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDesc = "" +
+	"\n" +
+	"Bcmd/protoc-gen-go/testdata/methods/metriclabels/metriclabels.proto\x12#goproto.protoc.methods.metriclabels\"\x96\x02\n" +
+	"\aRequest\x12\x1f\n" +
+	"\vhttp_method\x18\x02 \x01(\tR\n" +
+	"httpMethod\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12H\n" +
+	"\x05route\x18\x03 \x01(\v22.goproto.protoc.methods.metriclabels.Request.RouteR\x05route\x12\x1f\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tH\x00R\tuserAgent\x12#\n" +
+	"\fservice_name\x18\x04 \x01(\tH\x00R\vserviceName\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x1a\x1b\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04pathB\b\n" +
+	"\x06client\"\a\n" +
+	"\x05EmptyBLZJgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/metriclabelsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_goTypes = []any{
+	(*Request)(nil),       // 0: goproto.protoc.methods.metriclabels.Request
+	(*Empty)(nil),         // 1: goproto.protoc.methods.metriclabels.Empty
+	(*Request_Route)(nil), // 2: goproto.protoc.methods.metriclabels.Request.Route
+}
+var file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.metriclabels.Request.route:type_name -> goproto.protoc.methods.metriclabels.Request.Route
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes[0].OneofWrappers = []any{
+		(*Request_UserAgent)(nil),
+		(*Request_ServiceName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_metriclabels_metriclabels_proto_depIdxs = nil
+}
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/oneoftagged/hybrid.proto
+
+//go:build protoopaque
+
+package oneoftagged
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Event struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Target isEvent_Target         `protobuf_oneof:"target"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Event) GetUser() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*event_User); ok {
+			return x.User
+		}
+	}
+	return ""
+}
+
+func (x *Event) GetParent() *Event {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Target.(*event_Parent); ok {
+			return x.Parent
+		}
+	}
+	return nil
+}
+
+func (x *Event) SetUser(v string) {
+	x.xxx_hidden_Target = &event_User{v}
+}
+
+func (x *Event) SetParent(v *Event) {
+	if v == nil {
+		x.xxx_hidden_Target = nil
+		return
+	}
+	x.xxx_hidden_Target = &event_Parent{v}
+}
+
+func (x *Event) HasTarget() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Target != nil
+}
+
+func (x *Event) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*event_User)
+	return ok
+}
+
+func (x *Event) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Target.(*event_Parent)
+	return ok
+}
+
+func (x *Event) ClearTarget() {
+	x.xxx_hidden_Target = nil
+}
+
+func (x *Event) ClearUser() {
+	if _, ok := x.xxx_hidden_Target.(*event_User); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+func (x *Event) ClearParent() {
+	if _, ok := x.xxx_hidden_Target.(*event_Parent); ok {
+		x.xxx_hidden_Target = nil
+	}
+}
+
+const Event_Target_not_set_case case_Event_Target = 0
+const Event_User_case case_Event_Target = 1
+const Event_Parent_case case_Event_Target = 2
+
+func (x *Event) WhichTarget() case_Event_Target {
+	if x == nil {
+		return Event_Target_not_set_case
+	}
+	switch x.xxx_hidden_Target.(type) {
+	case *event_User:
+		return Event_User_case
+	case *event_Parent:
+		return Event_Parent_case
+	default:
+		return Event_Target_not_set_case
+	}
+}
+
+type Event_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof xxx_hidden_Target:
+	User   *string
+	Parent *Event
+	// -- end of xxx_hidden_Target
+}
+
+func (b0 Event_builder) Build() *Event {
+	m0 := &Event{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.User != nil {
+		x.xxx_hidden_Target = &event_User{*b.User}
+	}
+	if b.Parent != nil {
+		x.xxx_hidden_Target = &event_Parent{b.Parent}
+	}
+	return m0
+}
+
+type case_Event_Target protoreflect.FieldNumber
+
+func (x case_Event_Target) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isEvent_Target interface {
+	isEvent_Target()
+}
+
+type event_User struct {
+	User string `protobuf:"bytes,1,opt,name=user,oneof"`
+}
+
+type event_Parent struct {
+	Parent *Event `protobuf:"bytes,2,opt,name=parent,oneof"`
+}
+
+func (*event_User) isEvent_Target() {}
+
+func (*event_Parent) isEvent_Target() {}
+
+// TargetTagged returns the member of the target oneof which is set
+// as a discriminated value, whose Type is the proto name of the member and
+// whose Value is the value of its getter. Both are zero if no member is set.
+func (x *Event) TargetTagged() (t struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}) {
+	if x == nil {
+		return t
+	}
+	switch x.xxx_hidden_Target.(type) {
+	case *event_User:
+		t.Type, t.Value = "user", x.GetUser()
+	case *event_Parent:
+		t.Type, t.Value = "parent", x.GetParent()
+	}
+	return t
+}
+
+// SetTargetTagged sets the target oneof from a discriminated value as
+// returned by TargetTagged. An empty Type clears the oneof. It reports an
+// error if Type does not name a member of the oneof, or if Value does not
+// have the Go type of that member.
+func (x *Event) SetTargetTagged(t struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}) error {
+	switch t.Type {
+	case "":
+		x.ClearTarget()
+	case "user":
+		v, ok := t.Value.(string)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Event.target: value of type %T for member %q, want string", t.Value, t.Type)
+		}
+		x.SetUser(v)
+	case "parent":
+		v, ok := t.Value.(*Event)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Event.target: value of type %T for member %q, want *Event", t.Value, t.Type)
+		}
+		x.SetParent(v)
+	default:
+		return fmt.Errorf("goproto.protoc.methods.oneoftagged.Event.target: unknown member %q", t.Type)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_rawDesc = "" +
+	"\n" +
+	";cmd/protoc-gen-go/testdata/methods/oneoftagged/hybrid.proto\x12\"goproto.protoc.methods.oneoftagged\x1a!google/protobuf/go_features.proto\"l\n" +
+	"\x05Event\x12\x14\n" +
+	"\x04user\x18\x01 \x01(\tH\x00R\x04user\x12C\n" +
+	"\x06parent\x18\x02 \x01(\v2).goproto.protoc.methods.oneoftagged.EventH\x00R\x06parentB\b\n" +
+	"\x06targetBSZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/oneoftagged\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_goTypes = []any{
+	(*Event)(nil), // 0: goproto.protoc.methods.oneoftagged.Event
+}
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.oneoftagged.Event.parent:type_name -> goproto.protoc.methods.oneoftagged.Event
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*event_User)(nil),
+		(*event_Parent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_hybrid_proto_depIdxs = nil
+}
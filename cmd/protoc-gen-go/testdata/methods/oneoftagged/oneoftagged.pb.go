@@ -0,0 +1,437 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/oneoftagged/oneoftagged.proto
+
+package oneoftagged
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescGZIP(), []int{0}
+}
+
+type Shape struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Shape_Circle_
+	//	*Shape_Sides
+	//	*Shape_Label
+	//	*Shape_Color
+	//	*Shape_Raw
+	//	*Shape_Created
+	Kind          isShape_Kind `protobuf_oneof:"kind"`
+	Version       *int32       `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty" form:"version" uri:"version"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shape) Reset() {
+	*x = Shape{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shape) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shape) ProtoMessage() {}
+
+func (x *Shape) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shape.ProtoReflect.Descriptor instead.
+func (*Shape) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shape) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shape) GetKind() isShape_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Shape) GetCircle() *Shape_Circle {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Circle_); ok {
+			return x.Circle
+		}
+	}
+	return nil
+}
+
+func (x *Shape) GetSides() int32 {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Sides); ok {
+			return x.Sides
+		}
+	}
+	return 0
+}
+
+func (x *Shape) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Label); ok {
+			return x.Label
+		}
+	}
+	return ""
+}
+
+func (x *Shape) GetColor() Color {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Color); ok {
+			return x.Color
+		}
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *Shape) GetRaw() []byte {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+func (x *Shape) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Kind.(*Shape_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *Shape) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type isShape_Kind interface {
+	isShape_Kind()
+}
+
+type Shape_Circle_ struct {
+	Circle *Shape_Circle `protobuf:"bytes,2,opt,name=circle,proto3,oneof"`
+}
+
+type Shape_Sides struct {
+	Sides int32 `protobuf:"varint,3,opt,name=sides,proto3,oneof"`
+}
+
+type Shape_Label struct {
+	Label string `protobuf:"bytes,4,opt,name=label,proto3,oneof"`
+}
+
+type Shape_Color struct {
+	Color Color `protobuf:"varint,5,opt,name=color,proto3,enum=goproto.protoc.methods.oneoftagged.Color,oneof"`
+}
+
+type Shape_Raw struct {
+	Raw []byte `protobuf:"bytes,6,opt,name=raw,proto3,oneof"`
+}
+
+type Shape_Created struct {
+	Created *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3,oneof"`
+}
+
+func (*Shape_Circle_) isShape_Kind() {}
+
+func (*Shape_Sides) isShape_Kind() {}
+
+func (*Shape_Label) isShape_Kind() {}
+
+func (*Shape_Color) isShape_Kind() {}
+
+func (*Shape_Raw) isShape_Kind() {}
+
+func (*Shape_Created) isShape_Kind() {}
+
+// KindTagged returns the member of the kind oneof which is set
+// as a discriminated value, whose Type is the proto name of the member and
+// whose Value is the value of its getter. Both are zero if no member is set.
+func (x *Shape) KindTagged() (t struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}) {
+	if x == nil {
+		return t
+	}
+	switch x.Kind.(type) {
+	case *Shape_Circle_:
+		t.Type, t.Value = "circle", x.GetCircle()
+	case *Shape_Sides:
+		t.Type, t.Value = "sides", x.GetSides()
+	case *Shape_Label:
+		t.Type, t.Value = "label", x.GetLabel()
+	case *Shape_Color:
+		t.Type, t.Value = "color", x.GetColor()
+	case *Shape_Raw:
+		t.Type, t.Value = "raw", x.GetRaw()
+	case *Shape_Created:
+		t.Type, t.Value = "created", x.GetCreated()
+	}
+	return t
+}
+
+// SetKindTagged sets the kind oneof from a discriminated value as
+// returned by KindTagged. An empty Type clears the oneof. It reports an
+// error if Type does not name a member of the oneof, or if Value does not
+// have the Go type of that member.
+func (x *Shape) SetKindTagged(t struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}) error {
+	switch t.Type {
+	case "":
+		x.Kind = nil
+	case "circle":
+		v, ok := t.Value.(*Shape_Circle)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want *Shape_Circle", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Circle_{Circle: v}
+	case "sides":
+		v, ok := t.Value.(int32)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want int32", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Sides{Sides: v}
+	case "label":
+		v, ok := t.Value.(string)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want string", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Label{Label: v}
+	case "color":
+		v, ok := t.Value.(Color)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want Color", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Color{Color: v}
+	case "raw":
+		v, ok := t.Value.([]byte)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want []byte", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Raw{Raw: v}
+	case "created":
+		v, ok := t.Value.(*timestamppb.Timestamp)
+		if !ok {
+			return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: value of type %T for member %q, want *timestamppb.Timestamp", t.Value, t.Type)
+		}
+		x.Kind = &Shape_Created{Created: v}
+	default:
+		return fmt.Errorf("goproto.protoc.methods.oneoftagged.Shape.kind: unknown member %q", t.Type)
+	}
+	return nil
+}
+
+type Shape_Circle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Radius        float64                `protobuf:"fixed64,1,opt,name=radius,proto3" json:"radius,omitempty" form:"radius" uri:"radius"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shape_Circle) Reset() {
+	*x = Shape_Circle{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shape_Circle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shape_Circle) ProtoMessage() {}
+
+func (x *Shape_Circle) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shape_Circle.ProtoReflect.Descriptor instead.
+func (*Shape_Circle) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Shape_Circle) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/oneoftagged/oneoftagged.proto\x12\"goproto.protoc.methods.oneoftagged\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x02\n" +
+	"\x05Shape\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
+	"\x06circle\x18\x02 \x01(\v20.goproto.protoc.methods.oneoftagged.Shape.CircleH\x00R\x06circle\x12\x16\n" +
+	"\x05sides\x18\x03 \x01(\x05H\x00R\x05sides\x12\x16\n" +
+	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x12A\n" +
+	"\x05color\x18\x05 \x01(\x0e2).goproto.protoc.methods.oneoftagged.ColorH\x00R\x05color\x12\x12\n" +
+	"\x03raw\x18\x06 \x01(\fH\x00R\x03raw\x126\n" +
+	"\acreated\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\acreated\x12\x1d\n" +
+	"\aversion\x18\b \x01(\x05H\x01R\aversion\x88\x01\x01\x1a \n" +
+	"\x06Circle\x12\x16\n" +
+	"\x06radius\x18\x01 \x01(\x01R\x06radiusB\x06\n" +
+	"\x04kindB\n" +
+	"\n" +
+	"\b_version*-\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/oneoftaggedb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_goTypes = []any{
+	(Color)(0),                    // 0: goproto.protoc.methods.oneoftagged.Color
+	(*Shape)(nil),                 // 1: goproto.protoc.methods.oneoftagged.Shape
+	(*Shape_Circle)(nil),          // 2: goproto.protoc.methods.oneoftagged.Shape.Circle
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.oneoftagged.Shape.circle:type_name -> goproto.protoc.methods.oneoftagged.Shape.Circle
+	0, // 1: goproto.protoc.methods.oneoftagged.Shape.color:type_name -> goproto.protoc.methods.oneoftagged.Color
+	3, // 2: goproto.protoc.methods.oneoftagged.Shape.created:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes[0].OneofWrappers = []any{
+		(*Shape_Circle_)(nil),
+		(*Shape_Sides)(nil),
+		(*Shape_Label)(nil),
+		(*Shape_Color)(nil),
+		(*Shape_Raw)(nil),
+		(*Shape_Created)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_oneoftagged_oneoftagged_proto_depIdxs = nil
+}
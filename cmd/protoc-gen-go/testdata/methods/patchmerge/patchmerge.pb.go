@@ -0,0 +1,363 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/patchmerge/patchmerge.proto
+
+package patchmerge
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Profile struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Age      int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty" form:"age" uri:"age"`
+	Active   *bool                  `protobuf:"varint,3,opt,name=active,proto3,oneof" json:"active,omitempty" form:"active" uri:"active"`
+	Nickname *string                `protobuf:"bytes,4,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Avatar   []byte                 `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty" form:"avatar" uri:"avatar"`
+	Manager  *Profile               `protobuf:"bytes,6,opt,name=manager,proto3" json:"manager,omitempty" form:"manager" uri:"manager"`
+	Tags     []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Reports  []*Profile             `protobuf:"bytes,8,rep,name=reports,proto3" json:"reports,omitempty" form:"reports" uri:"reports"`
+	Labels   map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Peers    map[string]*Profile    `protobuf:"bytes,10,rep,name=peers,proto3" json:"peers,omitempty" form:"peers" uri:"peers" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Updated  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated,proto3" json:"updated,omitempty" form:"updated" uri:"updated"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Profile_Email
+	//	*Profile_Delegate
+	Contact       isProfile_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Profile) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Profile) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Profile) GetManager() *Profile {
+	if x != nil {
+		return x.Manager
+	}
+	return nil
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Profile) GetReports() []*Profile {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *Profile) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Profile) GetPeers() map[string]*Profile {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Profile) GetContact() isProfile_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Profile) GetDelegate() *Profile {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Delegate); ok {
+			return x.Delegate
+		}
+	}
+	return nil
+}
+
+type isProfile_Contact interface {
+	isProfile_Contact()
+}
+
+type Profile_Email struct {
+	Email string `protobuf:"bytes,12,opt,name=email,proto3,oneof"`
+}
+
+type Profile_Delegate struct {
+	Delegate *Profile `protobuf:"bytes,13,opt,name=delegate,proto3,oneof"`
+}
+
+func (*Profile_Email) isProfile_Contact() {}
+
+func (*Profile_Delegate) isProfile_Contact() {}
+
+// PatchFrom merges the fields of src which are populated into x, leaving the
+// other fields of x untouched. Unlike proto.Merge, a field of src which
+// is set to its zero value without explicit presence is not copied.
+//
+// Singular message fields are patched recursively, repeated fields are
+// replaced by a copy of the field of src, and the entries of map fields are
+// copied into the map of x. The values copied from src are deep copies.
+func (x *Profile) PatchFrom(src *Profile) {
+	if src == nil || x == src {
+		return
+	}
+	if src.Name != "" {
+		x.Name = src.Name
+	}
+	if src.Age != 0 {
+		x.Age = src.Age
+	}
+	if src.Active != nil {
+		t := src.GetActive()
+		x.Active = &t
+	}
+	if src.Nickname != nil {
+		t := src.GetNickname()
+		x.Nickname = &t
+	}
+	if len(src.Avatar) > 0 {
+		x.Avatar = append([]byte{}, src.Avatar...)
+	}
+	if src.Manager != nil {
+		if d := x.Manager; d != nil {
+			d.PatchFrom(src.Manager)
+		} else {
+			x.Manager = proto.CloneOf(src.Manager)
+		}
+	}
+	if len(src.Tags) > 0 {
+		l := make([]string, len(src.Tags))
+		copy(l, src.Tags)
+		x.Tags = l
+	}
+	if len(src.Reports) > 0 {
+		l := make([]*Profile, len(src.Reports))
+		for i, e := range src.Reports {
+			l[i] = proto.CloneOf(e)
+		}
+		x.Reports = l
+	}
+	if len(src.Labels) > 0 {
+		mv := x.Labels
+		if mv == nil {
+			mv = make(map[string]string, len(src.Labels))
+			x.Labels = mv
+		}
+		for k, e := range src.Labels {
+			mv[k] = e
+		}
+	}
+	if len(src.Peers) > 0 {
+		mv := x.Peers
+		if mv == nil {
+			mv = make(map[string]*Profile, len(src.Peers))
+			x.Peers = mv
+		}
+		for k, e := range src.Peers {
+			mv[k] = proto.CloneOf(e)
+		}
+	}
+	if src.Updated != nil {
+		if d := x.Updated; d != nil {
+			proto.Merge(d, src.Updated)
+		} else {
+			x.Updated = proto.CloneOf(src.Updated)
+		}
+	}
+	if v, ok := src.Contact.(*Profile_Email); ok {
+		x.Contact = &Profile_Email{Email: v.Email}
+	}
+	if v, ok := src.Contact.(*Profile_Delegate); ok {
+		x.Contact = &Profile_Delegate{Delegate: proto.CloneOf(v.Delegate)}
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDesc = "" +
+	"\n" +
+	">cmd/protoc-gen-go/testdata/methods/patchmerge/patchmerge.proto\x12!goproto.protoc.methods.patchmerge\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x06\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x1b\n" +
+	"\x06active\x18\x03 \x01(\bH\x01R\x06active\x88\x01\x01\x12\x1f\n" +
+	"\bnickname\x18\x04 \x01(\tH\x02R\bnickname\x88\x01\x01\x12\x16\n" +
+	"\x06avatar\x18\x05 \x01(\fR\x06avatar\x12D\n" +
+	"\amanager\x18\x06 \x01(\v2*.goproto.protoc.methods.patchmerge.ProfileR\amanager\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12D\n" +
+	"\areports\x18\b \x03(\v2*.goproto.protoc.methods.patchmerge.ProfileR\areports\x12N\n" +
+	"\x06labels\x18\t \x03(\v26.goproto.protoc.methods.patchmerge.Profile.LabelsEntryR\x06labels\x12K\n" +
+	"\x05peers\x18\n" +
+	" \x03(\v25.goproto.protoc.methods.patchmerge.Profile.PeersEntryR\x05peers\x124\n" +
+	"\aupdated\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\x12\x16\n" +
+	"\x05email\x18\f \x01(\tH\x00R\x05email\x12H\n" +
+	"\bdelegate\x18\r \x01(\v2*.goproto.protoc.methods.patchmerge.ProfileH\x00R\bdelegate\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ad\n" +
+	"\n" +
+	"PeersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.goproto.protoc.methods.patchmerge.ProfileR\x05value:\x028\x01B\t\n" +
+	"\acontactB\t\n" +
+	"\a_activeB\v\n" +
+	"\t_nicknameBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/patchmergeb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_goTypes = []any{
+	(*Profile)(nil),               // 0: goproto.protoc.methods.patchmerge.Profile
+	nil,                           // 1: goproto.protoc.methods.patchmerge.Profile.LabelsEntry
+	nil,                           // 2: goproto.protoc.methods.patchmerge.Profile.PeersEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.patchmerge.Profile.manager:type_name -> goproto.protoc.methods.patchmerge.Profile
+	0, // 1: goproto.protoc.methods.patchmerge.Profile.reports:type_name -> goproto.protoc.methods.patchmerge.Profile
+	1, // 2: goproto.protoc.methods.patchmerge.Profile.labels:type_name -> goproto.protoc.methods.patchmerge.Profile.LabelsEntry
+	2, // 3: goproto.protoc.methods.patchmerge.Profile.peers:type_name -> goproto.protoc.methods.patchmerge.Profile.PeersEntry
+	3, // 4: goproto.protoc.methods.patchmerge.Profile.updated:type_name -> google.protobuf.Timestamp
+	0, // 5: goproto.protoc.methods.patchmerge.Profile.delegate:type_name -> goproto.protoc.methods.patchmerge.Profile
+	0, // 6: goproto.protoc.methods.patchmerge.Profile.PeersEntry.value:type_name -> goproto.protoc.methods.patchmerge.Profile
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_Email)(nil),
+		(*Profile_Delegate)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_patchmerge_patchmerge_proto_depIdxs = nil
+}
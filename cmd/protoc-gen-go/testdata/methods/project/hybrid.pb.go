@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/project/hybrid.proto
+
+//go:build !protoopaque
+
+package project
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Shipment struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Carrier       *string                `protobuf:"bytes,1,opt,name=carrier" json:"carrier,omitempty" form:"carrier" uri:"carrier"`
+	Previous      *Shipment              `protobuf:"bytes,2,opt,name=previous" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Stops         []string               `protobuf:"bytes,3,rep,name=stops" json:"stops,omitempty" form:"stops" uri:"stops"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil && x.Carrier != nil {
+		return *x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetPrevious() *Shipment {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Shipment) GetStops() []string {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Shipment) SetCarrier(v string) {
+	x.Carrier = &v
+}
+
+func (x *Shipment) SetPrevious(v *Shipment) {
+	x.Previous = v
+}
+
+func (x *Shipment) SetStops(v []string) {
+	x.Stops = v
+}
+
+func (x *Shipment) HasCarrier() bool {
+	if x == nil {
+		return false
+	}
+	return x.Carrier != nil
+}
+
+func (x *Shipment) HasPrevious() bool {
+	if x == nil {
+		return false
+	}
+	return x.Previous != nil
+}
+
+func (x *Shipment) ClearCarrier() {
+	x.Carrier = nil
+}
+
+func (x *Shipment) ClearPrevious() {
+	x.Previous = nil
+}
+
+type Shipment_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Carrier  *string
+	Previous *Shipment
+	Stops    []string
+}
+
+func (b0 Shipment_builder) Build() *Shipment {
+	m0 := &Shipment{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Carrier = b.Carrier
+	x.Previous = b.Previous
+	x.Stops = b.Stops
+	return m0
+}
+
+// Project returns a new message holding deep copies of the fields of x with
+// the given numbers, and no other fields. The fields are copied directly,
+// without reflection. Extensions and unknown fields are not copied. It
+// returns nil if x is nil, and panics if a number is not that of a field of
+// Shipment.
+func (x *Shipment) Project(fields ...protoreflect.FieldNumber) *Shipment {
+	if x == nil {
+		return nil
+	}
+	y := new(Shipment)
+	for _, num := range fields {
+		switch num {
+		case 1:
+			if x.HasCarrier() {
+				y.SetCarrier(x.GetCarrier())
+			}
+		case 2:
+			if x.HasPrevious() {
+				y.SetPrevious(proto.CloneOf(x.GetPrevious()))
+			}
+		case 3:
+			if len(x.GetStops()) > 0 {
+				l := make([]string, len(x.GetStops()))
+				copy(l, x.GetStops())
+				y.SetStops(l)
+			}
+		default:
+			panic(fmt.Sprintf("goproto.protoc.methods.project.Shipment has no field number %d", num))
+		}
+	}
+	return y
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"7cmd/protoc-gen-go/testdata/methods/project/hybrid.proto\x12\x1egoproto.protoc.methods.project\x1a!google/protobuf/go_features.proto\"\x80\x01\n" +
+	"\bShipment\x12\x18\n" +
+	"\acarrier\x18\x01 \x01(\tR\acarrier\x12D\n" +
+	"\bprevious\x18\x02 \x01(\v2(.goproto.protoc.methods.project.ShipmentR\bprevious\x12\x14\n" +
+	"\x05stops\x18\x03 \x03(\tR\x05stopsBOZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/project\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_goTypes = []any{
+	(*Shipment)(nil), // 0: goproto.protoc.methods.project.Shipment
+}
+var file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.project.Shipment.previous:type_name -> goproto.protoc.methods.project.Shipment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_project_hybrid_proto_depIdxs = nil
+}
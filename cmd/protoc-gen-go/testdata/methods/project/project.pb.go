@@ -0,0 +1,442 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/project/project.proto
+
+package project
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Order struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Customer    *Customer              `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty" form:"customer" uri:"customer"`
+	Items       []*Item                `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	Attachments map[string][]byte      `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty" form:"attachments" uri:"attachments" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Priority    *int32                 `protobuf:"varint,5,opt,name=priority,proto3,oneof" json:"priority,omitempty" form:"priority" uri:"priority"`
+	Created     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty" form:"created" uri:"created"`
+	// Types that are valid to be assigned to Payment:
+	//
+	//	*Order_Card
+	//	*Order_InvoiceTo
+	Payment       isOrder_Payment `protobuf_oneof:"payment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *Order) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetAttachments() map[string][]byte {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Order) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *Order) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Order) GetPayment() isOrder_Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Order) GetCard() string {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_Card); ok {
+			return x.Card
+		}
+	}
+	return ""
+}
+
+func (x *Order) GetInvoiceTo() *Customer {
+	if x != nil {
+		if x, ok := x.Payment.(*Order_InvoiceTo); ok {
+			return x.InvoiceTo
+		}
+	}
+	return nil
+}
+
+type isOrder_Payment interface {
+	isOrder_Payment()
+}
+
+type Order_Card struct {
+	Card string `protobuf:"bytes,7,opt,name=card,proto3,oneof"`
+}
+
+type Order_InvoiceTo struct {
+	InvoiceTo *Customer `protobuf:"bytes,8,opt,name=invoice_to,json=invoiceTo,proto3,oneof"`
+}
+
+func (*Order_Card) isOrder_Payment() {}
+
+func (*Order_InvoiceTo) isOrder_Payment() {}
+
+// Project returns a new message holding deep copies of the fields of x with
+// the given numbers, and no other fields. The fields are copied directly,
+// without reflection. Extensions and unknown fields are not copied. It
+// returns nil if x is nil, and panics if a number is not that of a field of
+// Order.
+func (x *Order) Project(fields ...protoreflect.FieldNumber) *Order {
+	if x == nil {
+		return nil
+	}
+	y := new(Order)
+	for _, num := range fields {
+		switch num {
+		case 1:
+			if x.Id != "" {
+				y.Id = x.Id
+			}
+		case 2:
+			if x.Customer != nil {
+				y.Customer = proto.CloneOf(x.Customer)
+			}
+		case 3:
+			if len(x.Items) > 0 {
+				l := make([]*Item, len(x.Items))
+				for i, e := range x.Items {
+					l[i] = proto.CloneOf(e)
+				}
+				y.Items = l
+			}
+		case 4:
+			if len(x.Attachments) > 0 {
+				mv := make(map[string][]byte, len(x.Attachments))
+				for k, e := range x.Attachments {
+					mv[k] = append([]byte{}, e...)
+				}
+				y.Attachments = mv
+			}
+		case 5:
+			if x.Priority != nil {
+				t := x.GetPriority()
+				y.Priority = &t
+			}
+		case 6:
+			if x.Created != nil {
+				y.Created = proto.CloneOf(x.Created)
+			}
+		case 7:
+			if v, ok := x.Payment.(*Order_Card); ok {
+				y.Payment = &Order_Card{Card: v.Card}
+			}
+		case 8:
+			if v, ok := x.Payment.(*Order_InvoiceTo); ok {
+				y.Payment = &Order_InvoiceTo{InvoiceTo: proto.CloneOf(v.InvoiceTo)}
+			}
+		default:
+			panic(fmt.Sprintf("goproto.protoc.methods.project.Order has no field number %d", num))
+		}
+	}
+	return y
+}
+
+type Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Project returns a new message holding deep copies of the fields of x with
+// the given numbers, and no other fields. The fields are copied directly,
+// without reflection. Extensions and unknown fields are not copied. It
+// returns nil if x is nil, and panics if a number is not that of a field of
+// Customer.
+func (x *Customer) Project(fields ...protoreflect.FieldNumber) *Customer {
+	if x == nil {
+		return nil
+	}
+	y := new(Customer)
+	for _, num := range fields {
+		switch num {
+		case 1:
+			if x.Name != "" {
+				y.Name = x.Name
+			}
+		default:
+			panic(fmt.Sprintf("goproto.protoc.methods.project.Customer has no field number %d", num))
+		}
+	}
+	return y
+}
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty" form:"sku" uri:"sku"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty" form:"quantity" uri:"quantity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Project returns a new message holding deep copies of the fields of x with
+// the given numbers, and no other fields. The fields are copied directly,
+// without reflection. Extensions and unknown fields are not copied. It
+// returns nil if x is nil, and panics if a number is not that of a field of
+// Item.
+func (x *Item) Project(fields ...protoreflect.FieldNumber) *Item {
+	if x == nil {
+		return nil
+	}
+	y := new(Item)
+	for _, num := range fields {
+		switch num {
+		case 1:
+			if x.Sku != "" {
+				y.Sku = x.Sku
+			}
+		case 2:
+			if x.Quantity != 0 {
+				y.Quantity = x.Quantity
+			}
+		default:
+			panic(fmt.Sprintf("goproto.protoc.methods.project.Item has no field number %d", num))
+		}
+	}
+	return y
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_project_project_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/project/project.proto\x12\x1egoproto.protoc.methods.project\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x04\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\bcustomer\x18\x02 \x01(\v2(.goproto.protoc.methods.project.CustomerR\bcustomer\x12:\n" +
+	"\x05items\x18\x03 \x03(\v2$.goproto.protoc.methods.project.ItemR\x05items\x12X\n" +
+	"\vattachments\x18\x04 \x03(\v26.goproto.protoc.methods.project.Order.AttachmentsEntryR\vattachments\x12\x1f\n" +
+	"\bpriority\x18\x05 \x01(\x05H\x01R\bpriority\x88\x01\x01\x124\n" +
+	"\acreated\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x14\n" +
+	"\x04card\x18\a \x01(\tH\x00R\x04card\x12I\n" +
+	"\n" +
+	"invoice_to\x18\b \x01(\v2(.goproto.protoc.methods.project.CustomerH\x00R\tinvoiceTo\x1a>\n" +
+	"\x10AttachmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01B\t\n" +
+	"\apaymentB\v\n" +
+	"\t_priority\"\x1e\n" +
+	"\bCustomer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x04Item\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantityBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/projectb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_project_project_proto_goTypes = []any{
+	(*Order)(nil),                 // 0: goproto.protoc.methods.project.Order
+	(*Customer)(nil),              // 1: goproto.protoc.methods.project.Customer
+	(*Item)(nil),                  // 2: goproto.protoc.methods.project.Item
+	nil,                           // 3: goproto.protoc.methods.project.Order.AttachmentsEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_project_project_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.project.Order.customer:type_name -> goproto.protoc.methods.project.Customer
+	2, // 1: goproto.protoc.methods.project.Order.items:type_name -> goproto.protoc.methods.project.Item
+	3, // 2: goproto.protoc.methods.project.Order.attachments:type_name -> goproto.protoc.methods.project.Order.AttachmentsEntry
+	4, // 3: goproto.protoc.methods.project.Order.created:type_name -> google.protobuf.Timestamp
+	1, // 4: goproto.protoc.methods.project.Order.invoice_to:type_name -> goproto.protoc.methods.project.Customer
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_project_project_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_project_project_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_project_project_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes[0].OneofWrappers = []any{
+		(*Order_Card)(nil),
+		(*Order_InvoiceTo)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_project_project_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_project_project_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_project_project_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_project_project_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_project_project_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_project_project_proto_depIdxs = nil
+}
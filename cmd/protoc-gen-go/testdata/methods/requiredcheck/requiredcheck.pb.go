@@ -0,0 +1,314 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/requiredcheck/requiredcheck.proto
+
+package requiredcheck
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Order struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	First           *Order_Item            `protobuf:"bytes,2,req,name=first" json:"first,omitempty" form:"first" uri:"first"`
+	Items           []*Order_Item          `protobuf:"bytes,3,rep,name=items" json:"items,omitempty" form:"items" uri:"items"`
+	BySku           map[string]*Order_Item `protobuf:"bytes,4,rep,name=by_sku,json=bySku" json:"by_sku,omitempty" form:"by_sku" uri:"by_sku" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Note            []byte                 `protobuf:"bytes,5,opt,name=note" json:"note,omitempty" form:"note" uri:"note"`
+	Signature       []byte                 `protobuf:"bytes,6,req,name=signature" json:"signature,omitempty" form:"signature" uri:"signature"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetFirst() *Order_Item {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *Order) GetItems() []*Order_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetBySku() map[string]*Order_Item {
+	if x != nil {
+		return x.BySku
+	}
+	return nil
+}
+
+func (x *Order) GetNote() []byte {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *Order) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// UnmarshalRequired parses the wire-format message in b and places the result
+// in x, like proto.Unmarshal. If a required field of x or of a message
+// nested in x is not set, it reports an error naming the first such field.
+func (x *Order) UnmarshalRequired(b []byte) error {
+	if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(b, x); err != nil {
+		return err
+	}
+	return x.checkRequired()
+}
+
+// checkRequired reports an error naming the first required field of x or of
+// a message nested in x which is not set.
+func (x *Order) checkRequired() error {
+	if x == nil {
+		return nil
+	}
+	if x.Id == nil {
+		return fmt.Errorf("required field goproto.protoc.methods.requiredcheck.Order.id not set")
+	}
+	if x.First == nil {
+		return fmt.Errorf("required field goproto.protoc.methods.requiredcheck.Order.first not set")
+	}
+	if x.Signature == nil {
+		return fmt.Errorf("required field goproto.protoc.methods.requiredcheck.Order.signature not set")
+	}
+	if err := x.GetFirst().checkRequired(); err != nil {
+		return err
+	}
+	for _, v := range x.GetItems() {
+		if err := v.checkRequired(); err != nil {
+			return err
+		}
+	}
+	for _, v := range x.GetBySku() {
+		if err := v.checkRequired(); err != nil {
+			return err
+		}
+	}
+	return proto.CheckInitialized(x)
+}
+
+type Order_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *string                `protobuf:"bytes,1,req,name=sku" json:"sku,omitempty" form:"sku" uri:"sku"`
+	Quantity      *int32                 `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty" form:"quantity" uri:"quantity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order_Item) Reset() {
+	*x = Order_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order_Item) ProtoMessage() {}
+
+func (x *Order_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order_Item.ProtoReflect.Descriptor instead.
+func (*Order_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Order_Item) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *Order_Item) GetQuantity() int32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+// UnmarshalRequired parses the wire-format message in b and places the result
+// in x, like proto.Unmarshal. If a required field of x or of a message
+// nested in x is not set, it reports an error naming the first such field.
+func (x *Order_Item) UnmarshalRequired(b []byte) error {
+	if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(b, x); err != nil {
+		return err
+	}
+	return x.checkRequired()
+}
+
+// checkRequired reports an error naming the first required field of x or of
+// a message nested in x which is not set.
+func (x *Order_Item) checkRequired() error {
+	if x == nil {
+		return nil
+	}
+	if x.Sku == nil {
+		return fmt.Errorf("required field goproto.protoc.methods.requiredcheck.Order.Item.sku not set")
+	}
+	return nil
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Order)(nil),
+		ExtensionType: (*Order_Item)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.requiredcheck.extra_item",
+		Tag:           "bytes,100,opt,name=extra_item",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/requiredcheck/requiredcheck.proto",
+	},
+}
+
+// Extension fields to Order.
+var (
+	// optional goproto.protoc.methods.requiredcheck.Order.Item extra_item = 100;
+	E_ExtraItem = &file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDesc = "" +
+	"\n" +
+	"Dcmd/protoc-gen-go/testdata/methods/requiredcheck/requiredcheck.proto\x12$goproto.protoc.methods.requiredcheck\"\xd4\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12F\n" +
+	"\x05first\x18\x02 \x02(\v20.goproto.protoc.methods.requiredcheck.Order.ItemR\x05first\x12F\n" +
+	"\x05items\x18\x03 \x03(\v20.goproto.protoc.methods.requiredcheck.Order.ItemR\x05items\x12M\n" +
+	"\x06by_sku\x18\x04 \x03(\v26.goproto.protoc.methods.requiredcheck.Order.BySkuEntryR\x05bySku\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\fR\x04note\x12\x1c\n" +
+	"\tsignature\x18\x06 \x02(\fR\tsignature\x1a4\n" +
+	"\x04Item\x12\x10\n" +
+	"\x03sku\x18\x01 \x02(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x1aj\n" +
+	"\n" +
+	"BySkuEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12F\n" +
+	"\x05value\x18\x02 \x01(\v20.goproto.protoc.methods.requiredcheck.Order.ItemR\x05value:\x028\x01*\b\bd\x10\x80\x80\x80\x80\x02:|\n" +
+	"\n" +
+	"extra_item\x12+.goproto.protoc.methods.requiredcheck.Order\x18d \x01(\v20.goproto.protoc.methods.requiredcheck.Order.ItemR\textraItemBMZKgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/requiredcheck"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_goTypes = []any{
+	(*Order)(nil),      // 0: goproto.protoc.methods.requiredcheck.Order
+	(*Order_Item)(nil), // 1: goproto.protoc.methods.requiredcheck.Order.Item
+	nil,                // 2: goproto.protoc.methods.requiredcheck.Order.BySkuEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.requiredcheck.Order.first:type_name -> goproto.protoc.methods.requiredcheck.Order.Item
+	1, // 1: goproto.protoc.methods.requiredcheck.Order.items:type_name -> goproto.protoc.methods.requiredcheck.Order.Item
+	2, // 2: goproto.protoc.methods.requiredcheck.Order.by_sku:type_name -> goproto.protoc.methods.requiredcheck.Order.BySkuEntry
+	1, // 3: goproto.protoc.methods.requiredcheck.Order.BySkuEntry.value:type_name -> goproto.protoc.methods.requiredcheck.Order.Item
+	0, // 4: goproto.protoc.methods.requiredcheck.extra_item:extendee -> goproto.protoc.methods.requiredcheck.Order
+	1, // 5: goproto.protoc.methods.requiredcheck.extra_item:type_name -> goproto.protoc.methods.requiredcheck.Order.Item
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	5, // [5:6] is the sub-list for extension type_name
+	4, // [4:5] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_requiredcheck_requiredcheck_proto_depIdxs = nil
+}
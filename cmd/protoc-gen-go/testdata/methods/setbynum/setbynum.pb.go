@@ -0,0 +1,268 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/setbynum/setbynum.proto
+
+package setbynum
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Target struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Count  int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" form:"count" uri:"count"`
+	Name   *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty" form:"name" uri:"name"`
+	Child  *Target                `protobuf:"bytes,3,opt,name=child,proto3" json:"child,omitempty" form:"child" uri:"child"`
+	Tags   []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Totals map[string]int64       `protobuf:"bytes,5,rep,name=totals,proto3" json:"totals,omitempty" form:"totals" uri:"totals" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Target_Flag
+	//	*Target_Other
+	Kind          isTarget_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Target) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Target) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Target) GetChild() *Target {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Target) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Target) GetTotals() map[string]int64 {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *Target) GetKind() isTarget_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Target) GetFlag() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Target_Flag); ok {
+			return x.Flag
+		}
+	}
+	return false
+}
+
+func (x *Target) GetOther() *Target {
+	if x != nil {
+		if x, ok := x.Kind.(*Target_Other); ok {
+			return x.Other
+		}
+	}
+	return nil
+}
+
+type isTarget_Kind interface {
+	isTarget_Kind()
+}
+
+type Target_Flag struct {
+	Flag bool `protobuf:"varint,6,opt,name=flag,proto3,oneof"`
+}
+
+type Target_Other struct {
+	Other *Target `protobuf:"bytes,7,opt,name=other,proto3,oneof"`
+}
+
+func (*Target_Flag) isTarget_Kind() {}
+
+func (*Target_Other) isTarget_Kind() {}
+
+// SetByNumber sets the field of x with the given number to v, which must
+// have the Go type of the field. For fields with explicit presence, v is the
+// value of the field, and not a pointer to it. Setting a oneof member clears
+// the other members of the oneof.
+func (x *Target) SetByNumber(num protoreflect.FieldNumber, v any) error {
+	switch num {
+	case 1:
+		t, ok := v.(int32)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.count", v)
+		}
+		x.Count = t
+	case 2:
+		t, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.name", v)
+		}
+		x.Name = &t
+	case 3:
+		t, ok := v.(*Target)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.child", v)
+		}
+		x.Child = t
+	case 4:
+		t, ok := v.([]string)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.tags", v)
+		}
+		x.Tags = t
+	case 5:
+		t, ok := v.(map[string]int64)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.totals", v)
+		}
+		x.Totals = t
+	case 6:
+		t, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.flag", v)
+		}
+		x.Kind = &Target_Flag{Flag: t}
+	case 7:
+		t, ok := v.(*Target)
+		if !ok {
+			return fmt.Errorf("invalid type %T for field goproto.protoc.methods.setbynum.Target.other", v)
+		}
+		x.Kind = &Target_Other{Other: t}
+	default:
+		return fmt.Errorf("unknown field number %d for message goproto.protoc.methods.setbynum.Target", num)
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/setbynum/setbynum.proto\x12\x1fgoproto.protoc.methods.setbynum\"\xfa\x02\n" +
+	"\x06Target\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12=\n" +
+	"\x05child\x18\x03 \x01(\v2'.goproto.protoc.methods.setbynum.TargetR\x05child\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12K\n" +
+	"\x06totals\x18\x05 \x03(\v23.goproto.protoc.methods.setbynum.Target.TotalsEntryR\x06totals\x12\x14\n" +
+	"\x04flag\x18\x06 \x01(\bH\x00R\x04flag\x12?\n" +
+	"\x05other\x18\a \x01(\v2'.goproto.protoc.methods.setbynum.TargetH\x00R\x05other\x1a9\n" +
+	"\vTotalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x06\n" +
+	"\x04kindB\a\n" +
+	"\x05_nameBHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/setbynumb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_goTypes = []any{
+	(*Target)(nil), // 0: goproto.protoc.methods.setbynum.Target
+	nil,            // 1: goproto.protoc.methods.setbynum.Target.TotalsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.setbynum.Target.child:type_name -> goproto.protoc.methods.setbynum.Target
+	1, // 1: goproto.protoc.methods.setbynum.Target.totals:type_name -> goproto.protoc.methods.setbynum.Target.TotalsEntry
+	0, // 2: goproto.protoc.methods.setbynum.Target.other:type_name -> goproto.protoc.methods.setbynum.Target
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_msgTypes[0].OneofWrappers = []any{
+		(*Target_Flag)(nil),
+		(*Target_Other)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_setbynum_setbynum_proto_depIdxs = nil
+}
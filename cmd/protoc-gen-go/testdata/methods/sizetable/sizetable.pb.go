@@ -0,0 +1,216 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/sizetable/sizetable.proto
+
+package sizetable
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+// Sized has fields on either side of each boundary of the tag size.
+type Sized struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	F1            int32                  `protobuf:"varint,1,opt,name=f1,proto3" json:"f1,omitempty" form:"f1" uri:"f1"`
+	F15           int32                  `protobuf:"varint,15,opt,name=f15,proto3" json:"f15,omitempty" form:"f15" uri:"f15"`
+	F16           int32                  `protobuf:"varint,16,opt,name=f16,proto3" json:"f16,omitempty" form:"f16" uri:"f16"`
+	F2047         int32                  `protobuf:"varint,2047,opt,name=f2047,proto3" json:"f2047,omitempty" form:"f2047" uri:"f2047"`
+	F2048         int32                  `protobuf:"varint,2048,opt,name=f2048,proto3" json:"f2048,omitempty" form:"f2048" uri:"f2048"`
+	F262143       int32                  `protobuf:"varint,262143,opt,name=f262143,proto3" json:"f262143,omitempty" form:"f262143" uri:"f262143"`
+	F262144       int32                  `protobuf:"varint,262144,opt,name=f262144,proto3" json:"f262144,omitempty" form:"f262144" uri:"f262144"`
+	F33554431     int32                  `protobuf:"varint,33554431,opt,name=f33554431,proto3" json:"f33554431,omitempty" form:"f33554431" uri:"f33554431"`
+	F33554432     int32                  `protobuf:"varint,33554432,opt,name=f33554432,proto3" json:"f33554432,omitempty" form:"f33554432" uri:"f33554432"`
+	F536870911    int32                  `protobuf:"varint,536870911,opt,name=f536870911,proto3" json:"f536870911,omitempty" form:"f536870911" uri:"f536870911"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sized) Reset() {
+	*x = Sized{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sized) ProtoMessage() {}
+
+func (x *Sized) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sized.ProtoReflect.Descriptor instead.
+func (*Sized) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Sized) GetF1() int32 {
+	if x != nil {
+		return x.F1
+	}
+	return 0
+}
+
+func (x *Sized) GetF15() int32 {
+	if x != nil {
+		return x.F15
+	}
+	return 0
+}
+
+func (x *Sized) GetF16() int32 {
+	if x != nil {
+		return x.F16
+	}
+	return 0
+}
+
+func (x *Sized) GetF2047() int32 {
+	if x != nil {
+		return x.F2047
+	}
+	return 0
+}
+
+func (x *Sized) GetF2048() int32 {
+	if x != nil {
+		return x.F2048
+	}
+	return 0
+}
+
+func (x *Sized) GetF262143() int32 {
+	if x != nil {
+		return x.F262143
+	}
+	return 0
+}
+
+func (x *Sized) GetF262144() int32 {
+	if x != nil {
+		return x.F262144
+	}
+	return 0
+}
+
+func (x *Sized) GetF33554431() int32 {
+	if x != nil {
+		return x.F33554431
+	}
+	return 0
+}
+
+func (x *Sized) GetF33554432() int32 {
+	if x != nil {
+		return x.F33554432
+	}
+	return 0
+}
+
+func (x *Sized) GetF536870911() int32 {
+	if x != nil {
+		return x.F536870911
+	}
+	return 0
+}
+
+// Sized_fieldTagSizes maps the number of each field of Sized to
+// the size in bytes of its encoded tag, which is the fixed overhead of every
+// occurrence of the field on the wire.
+var Sized_fieldTagSizes = map[protoreflect.FieldNumber]int{
+	1:         1,
+	15:        1,
+	16:        2,
+	2047:      2,
+	2048:      3,
+	262143:    3,
+	262144:    4,
+	33554431:  4,
+	33554432:  5,
+	536870911: 5,
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/sizetable/sizetable.proto\x12 goproto.protoc.methods.sizetable\"\x87\x02\n" +
+	"\x05Sized\x12\x0e\n" +
+	"\x02f1\x18\x01 \x01(\x05R\x02f1\x12\x10\n" +
+	"\x03f15\x18\x0f \x01(\x05R\x03f15\x12\x10\n" +
+	"\x03f16\x18\x10 \x01(\x05R\x03f16\x12\x15\n" +
+	"\x05f2047\x18\xff\x0f \x01(\x05R\x05f2047\x12\x15\n" +
+	"\x05f2048\x18\x80\x10 \x01(\x05R\x05f2048\x12\x1a\n" +
+	"\af262143\x18\xff\xff\x0f \x01(\x05R\af262143\x12\x1a\n" +
+	"\af262144\x18\x80\x80\x10 \x01(\x05R\af262144\x12\x1f\n" +
+	"\tf33554431\x18\xff\xff\xff\x0f \x01(\x05R\tf33554431\x12\x1f\n" +
+	"\tf33554432\x18\x80\x80\x80\x10 \x01(\x05R\tf33554432\x12\"\n" +
+	"\n" +
+	"f536870911\x18\xff\xff\xff\xff\x01 \x01(\x05R\n" +
+	"f536870911BIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/sizetableb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_goTypes = []any{
+	(*Sized)(nil), // 0: goproto.protoc.methods.sizetable.Sized
+}
+var file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_sizetable_sizetable_proto_depIdxs = nil
+}
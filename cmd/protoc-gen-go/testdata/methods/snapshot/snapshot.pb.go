@@ -0,0 +1,220 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/snapshot/snapshot.proto
+
+package snapshot
+
+import (
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Routes struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ByName        map[string]*Routes_Route `protobuf:"bytes,1,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" form:"by_name" uri:"by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Fallback      []*Routes_Route          `protobuf:"bytes,2,rep,name=fallback,proto3" json:"fallback,omitempty" form:"fallback" uri:"fallback"`
+	Version       int64                    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty" form:"version" uri:"version"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Routes) Reset() {
+	*x = Routes{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Routes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Routes) ProtoMessage() {}
+
+func (x *Routes) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Routes.ProtoReflect.Descriptor instead.
+func (*Routes) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Routes) GetByName() map[string]*Routes_Route {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Routes) GetFallback() []*Routes_Route {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+func (x *Routes) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Snapshot returns a deep copy of x, or nil if x is nil, for read-only use.
+// The copy shares no memory with x, so later modifications of x are not
+// visible through it. The copy must not be modified; as long as it is not,
+// it is safe for concurrent use by multiple goroutines.
+func (x *Routes) Snapshot() *Routes {
+	if x == nil {
+		return nil
+	}
+	return proto.CloneOf(x)
+}
+
+type Routes_Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty" form:"target" uri:"target"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty" form:"key" uri:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Routes_Route) Reset() {
+	*x = Routes_Route{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Routes_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Routes_Route) ProtoMessage() {}
+
+func (x *Routes_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Routes_Route.ProtoReflect.Descriptor instead.
+func (*Routes_Route) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Routes_Route) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Routes_Route) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// Snapshot returns a deep copy of x, or nil if x is nil, for read-only use.
+// The copy shares no memory with x, so later modifications of x are not
+// visible through it. The copy must not be modified; as long as it is not,
+// it is safe for concurrent use by multiple goroutines.
+func (x *Routes_Route) Snapshot() *Routes_Route {
+	if x == nil {
+		return nil
+	}
+	return proto.CloneOf(x)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/snapshot/snapshot.proto\x12\x1fgoproto.protoc.methods.snapshot\"\xd8\x02\n" +
+	"\x06Routes\x12L\n" +
+	"\aby_name\x18\x01 \x03(\v23.goproto.protoc.methods.snapshot.Routes.ByNameEntryR\x06byName\x12I\n" +
+	"\bfallback\x18\x02 \x03(\v2-.goproto.protoc.methods.snapshot.Routes.RouteR\bfallback\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x1a1\n" +
+	"\x05Route\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\x1ah\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12C\n" +
+	"\x05value\x18\x02 \x01(\v2-.goproto.protoc.methods.snapshot.Routes.RouteR\x05value:\x028\x01BHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/snapshotb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_goTypes = []any{
+	(*Routes)(nil),       // 0: goproto.protoc.methods.snapshot.Routes
+	(*Routes_Route)(nil), // 1: goproto.protoc.methods.snapshot.Routes.Route
+	nil,                  // 2: goproto.protoc.methods.snapshot.Routes.ByNameEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.snapshot.Routes.by_name:type_name -> goproto.protoc.methods.snapshot.Routes.ByNameEntry
+	1, // 1: goproto.protoc.methods.snapshot.Routes.fallback:type_name -> goproto.protoc.methods.snapshot.Routes.Route
+	1, // 2: goproto.protoc.methods.snapshot.Routes.ByNameEntry.value:type_name -> goproto.protoc.methods.snapshot.Routes.Route
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_snapshot_snapshot_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/streamrepeated/group.proto
+
+package streamrepeated
+
+import (
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Log struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         []*Log_Entry           `protobuf:"group,1,rep,name=Entry,json=entry" json:"entry,omitempty" form:"entry" uri:"entry"`
+	Codes         []int32                `protobuf:"varint,3,rep,name=codes" json:"codes,omitempty" form:"codes" uri:"codes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Log) GetEntry() []*Log_Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *Log) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+// StreamEntry writes the wire-format encoding of the entry field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Log) StreamEntry(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetEntry() {
+		buf = protowire.AppendTag(buf[:0], 1, protowire.StartGroupType)
+		var err error
+		if buf, err = (proto.MarshalOptions{}).MarshalAppend(buf, v); err != nil {
+			return err
+		}
+		buf = protowire.AppendTag(buf, 1, protowire.EndGroupType)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamCodes writes the wire-format encoding of the codes field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Log) StreamCodes(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetCodes() {
+		buf = protowire.AppendTag(buf[:0], 3, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Log_Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          *string                `protobuf:"bytes,2,opt,name=text" json:"text,omitempty" form:"text" uri:"text"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log_Entry) Reset() {
+	*x = Log_Entry{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log_Entry) ProtoMessage() {}
+
+func (x *Log_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log_Entry.ProtoReflect.Descriptor instead.
+func (*Log_Entry) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Log_Entry) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDesc = "" +
+	"\n" +
+	"=cmd/protoc-gen-go/testdata/methods/streamrepeated/group.proto\x12%goproto.protoc.methods.streamrepeated\"\x80\x01\n" +
+	"\x03Log\x12F\n" +
+	"\x05entry\x18\x01 \x03(\n" +
+	"20.goproto.protoc.methods.streamrepeated.Log.EntryR\x05entry\x12\x14\n" +
+	"\x05codes\x18\x03 \x03(\x05R\x05codes\x1a\x1b\n" +
+	"\x05Entry\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04textBNZLgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/streamrepeated"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_goTypes = []any{
+	(*Log)(nil),       // 0: goproto.protoc.methods.streamrepeated.Log
+	(*Log_Entry)(nil), // 1: goproto.protoc.methods.streamrepeated.Log.Entry
+}
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.streamrepeated.Log.entry:type_name -> goproto.protoc.methods.streamrepeated.Log.Entry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_group_proto_depIdxs = nil
+}
@@ -0,0 +1,551 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/streamrepeated/streamrepeated.proto
+
+package streamrepeated
+
+import (
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Batch_Level int32
+
+const (
+	Batch_LEVEL_UNSPECIFIED Batch_Level = 0
+	Batch_LEVEL_HIGH        Batch_Level = 1
+)
+
+// Enum value maps for Batch_Level.
+var (
+	Batch_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_HIGH",
+	}
+	Batch_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_HIGH":        1,
+	}
+)
+
+func (x Batch_Level) Enum() *Batch_Level {
+	p := new(Batch_Level)
+	*p = x
+	return p
+}
+
+func (x Batch_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Batch_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_enumTypes[0].Descriptor()
+}
+
+func (Batch_Level) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_enumTypes[0]
+}
+
+func (x Batch_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Batch_Level.Descriptor instead.
+func (Batch_Level) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Batch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Events        []*Batch_Event         `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty" form:"events" uri:"events"`
+	Counts        []int32                `protobuf:"varint,3,rep,packed,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts"`
+	Deltas        []int64                `protobuf:"zigzag64,4,rep,packed,name=deltas,proto3" json:"deltas,omitempty" form:"deltas" uri:"deltas"`
+	Ids           []uint32               `protobuf:"varint,5,rep,name=ids,proto3" json:"ids,omitempty" form:"ids" uri:"ids"`
+	Flags         []bool                 `protobuf:"varint,6,rep,packed,name=flags,proto3" json:"flags,omitempty" form:"flags" uri:"flags"`
+	Weights       []float32              `protobuf:"fixed32,7,rep,packed,name=weights,proto3" json:"weights,omitempty" form:"weights" uri:"weights"`
+	Scores        []float64              `protobuf:"fixed64,8,rep,packed,name=scores,proto3" json:"scores,omitempty" form:"scores" uri:"scores"`
+	Hashes        []uint32               `protobuf:"fixed32,9,rep,packed,name=hashes,proto3" json:"hashes,omitempty" form:"hashes" uri:"hashes"`
+	Offsets       []int64                `protobuf:"fixed64,10,rep,packed,name=offsets,proto3" json:"offsets,omitempty" form:"offsets" uri:"offsets"`
+	Labels        []string               `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels"`
+	Blobs         [][]byte               `protobuf:"bytes,12,rep,name=blobs,proto3" json:"blobs,omitempty" form:"blobs" uri:"blobs"`
+	Levels        []Batch_Level          `protobuf:"varint,13,rep,packed,name=levels,proto3,enum=goproto.protoc.methods.streamrepeated.Batch_Level" json:"levels,omitempty" form:"levels" uri:"levels"`
+	Totals        map[string]int32       `protobuf:"bytes,14,rep,name=totals,proto3" json:"totals,omitempty" form:"totals" uri:"totals" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Batch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Batch) GetEvents() []*Batch_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Batch) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Batch) GetDeltas() []int64 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+func (x *Batch) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Batch) GetFlags() []bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Batch) GetWeights() []float32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Batch) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Batch) GetHashes() []uint32 {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *Batch) GetOffsets() []int64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *Batch) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Batch) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *Batch) GetLevels() []Batch_Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *Batch) GetTotals() map[string]int32 {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+// StreamEvents writes the wire-format encoding of the events field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamEvents(w io.Writer) error {
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	var buf []byte
+	for _, v := range x.GetEvents() {
+		buf = protowire.AppendTag(buf[:0], 2, protowire.BytesType)
+		buf = protowire.AppendVarint(buf, uint64(proto.Size(v)))
+		var err error
+		if buf, err = opts.MarshalAppend(buf, v); err != nil {
+			return err
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamCounts writes the wire-format encoding of the counts field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamCounts(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetCounts() {
+		buf = protowire.AppendTag(buf[:0], 3, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamDeltas writes the wire-format encoding of the deltas field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamDeltas(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetDeltas() {
+		buf = protowire.AppendTag(buf[:0], 4, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, protowire.EncodeZigZag(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamIds writes the wire-format encoding of the ids field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamIds(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetIds() {
+		buf = protowire.AppendTag(buf[:0], 5, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamFlags writes the wire-format encoding of the flags field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamFlags(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetFlags() {
+		buf = protowire.AppendTag(buf[:0], 6, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, protowire.EncodeBool(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamWeights writes the wire-format encoding of the weights field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamWeights(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetWeights() {
+		buf = protowire.AppendTag(buf[:0], 7, protowire.Fixed32Type)
+		buf = protowire.AppendFixed32(buf, math.Float32bits(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamScores writes the wire-format encoding of the scores field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamScores(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetScores() {
+		buf = protowire.AppendTag(buf[:0], 8, protowire.Fixed64Type)
+		buf = protowire.AppendFixed64(buf, math.Float64bits(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamHashes writes the wire-format encoding of the hashes field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamHashes(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetHashes() {
+		buf = protowire.AppendTag(buf[:0], 9, protowire.Fixed32Type)
+		buf = protowire.AppendFixed32(buf, uint32(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamOffsets writes the wire-format encoding of the offsets field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamOffsets(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetOffsets() {
+		buf = protowire.AppendTag(buf[:0], 10, protowire.Fixed64Type)
+		buf = protowire.AppendFixed64(buf, uint64(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamLabels writes the wire-format encoding of the labels field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamLabels(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetLabels() {
+		buf = protowire.AppendTag(buf[:0], 11, protowire.BytesType)
+		buf = protowire.AppendString(buf, v)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamBlobs writes the wire-format encoding of the blobs field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamBlobs(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetBlobs() {
+		buf = protowire.AppendTag(buf[:0], 12, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, v)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamLevels writes the wire-format encoding of the levels field of x
+// to w, one element at a time, without marshaling the field as a whole.
+// Each element is written with its own tag, so the output is decoded like
+// an encoding of x holding only the field, even if the field is packed.
+func (x *Batch) StreamLevels(w io.Writer) error {
+	var buf []byte
+	for _, v := range x.GetLevels() {
+		buf = protowire.AppendTag(buf[:0], 13, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(v))
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Batch_Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	At            int64                  `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty" form:"at" uri:"at"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Batch_Event) Reset() {
+	*x = Batch_Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch_Event) ProtoMessage() {}
+
+func (x *Batch_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch_Event.ProtoReflect.Descriptor instead.
+func (*Batch_Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Batch_Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Batch_Event) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDesc = "" +
+	"\n" +
+	"Fcmd/protoc-gen-go/testdata/methods/streamrepeated/streamrepeated.proto\x12%goproto.protoc.methods.streamrepeated\"\x87\x05\n" +
+	"\x05Batch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12J\n" +
+	"\x06events\x18\x02 \x03(\v22.goproto.protoc.methods.streamrepeated.Batch.EventR\x06events\x12\x16\n" +
+	"\x06counts\x18\x03 \x03(\x05R\x06counts\x12\x16\n" +
+	"\x06deltas\x18\x04 \x03(\x12R\x06deltas\x12\x14\n" +
+	"\x03ids\x18\x05 \x03(\rB\x02\x10\x00R\x03ids\x12\x14\n" +
+	"\x05flags\x18\x06 \x03(\bR\x05flags\x12\x18\n" +
+	"\aweights\x18\a \x03(\x02R\aweights\x12\x16\n" +
+	"\x06scores\x18\b \x03(\x01R\x06scores\x12\x16\n" +
+	"\x06hashes\x18\t \x03(\aR\x06hashes\x12\x18\n" +
+	"\aoffsets\x18\n" +
+	" \x03(\x10R\aoffsets\x12\x16\n" +
+	"\x06labels\x18\v \x03(\tR\x06labels\x12\x14\n" +
+	"\x05blobs\x18\f \x03(\fR\x05blobs\x12J\n" +
+	"\x06levels\x18\r \x03(\x0e22.goproto.protoc.methods.streamrepeated.Batch.LevelR\x06levels\x12P\n" +
+	"\x06totals\x18\x0e \x03(\v28.goproto.protoc.methods.streamrepeated.Batch.TotalsEntryR\x06totals\x1a'\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\x03R\x02at\x1a9\n" +
+	"\vTotalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\".\n" +
+	"\x05Level\x12\x15\n" +
+	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\x01BNZLgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/streamrepeatedb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_goTypes = []any{
+	(Batch_Level)(0),    // 0: goproto.protoc.methods.streamrepeated.Batch.Level
+	(*Batch)(nil),       // 1: goproto.protoc.methods.streamrepeated.Batch
+	(*Batch_Event)(nil), // 2: goproto.protoc.methods.streamrepeated.Batch.Event
+	nil,                 // 3: goproto.protoc.methods.streamrepeated.Batch.TotalsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.streamrepeated.Batch.events:type_name -> goproto.protoc.methods.streamrepeated.Batch.Event
+	0, // 1: goproto.protoc.methods.streamrepeated.Batch.levels:type_name -> goproto.protoc.methods.streamrepeated.Batch.Level
+	3, // 2: goproto.protoc.methods.streamrepeated.Batch.totals:type_name -> goproto.protoc.methods.streamrepeated.Batch.TotalsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_streamrepeated_streamrepeated_proto_depIdxs = nil
+}
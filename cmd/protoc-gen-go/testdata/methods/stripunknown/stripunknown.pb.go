@@ -0,0 +1,366 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/stripunknown/stripunknown.proto
+
+package stripunknown
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Envelope struct {
+	state    protoimpl.MessageState    `protogen:"open.v1"`
+	Id       *string                   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Item     *Envelope_Item            `protobuf:"bytes,2,opt,name=item" json:"item,omitempty" form:"item" uri:"item"`
+	Items    []*Envelope_Item          `protobuf:"bytes,3,rep,name=items" json:"items,omitempty" form:"items" uri:"items"`
+	ByName   map[string]*Envelope_Item `protobuf:"bytes,4,rep,name=by_name,json=byName" json:"by_name,omitempty" form:"by_name" uri:"by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata *structpb.Struct          `protobuf:"bytes,5,opt,name=metadata" json:"metadata,omitempty" form:"metadata" uri:"metadata"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Envelope_Single
+	//	*Envelope_Text
+	Body            isEnvelope_Body `protobuf_oneof:"body"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Envelope) GetItem() *Envelope_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Envelope) GetItems() []*Envelope_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Envelope) GetByName() map[string]*Envelope_Item {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Envelope) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Envelope) GetBody() isEnvelope_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Envelope) GetSingle() *Envelope_Item {
+	if x != nil {
+		if x, ok := x.Body.(*Envelope_Single); ok {
+			return x.Single
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetText() string {
+	if x != nil {
+		if x, ok := x.Body.(*Envelope_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+type isEnvelope_Body interface {
+	isEnvelope_Body()
+}
+
+type Envelope_Single struct {
+	Single *Envelope_Item `protobuf:"bytes,6,opt,name=single,oneof"`
+}
+
+type Envelope_Text struct {
+	Text string `protobuf:"bytes,7,opt,name=text,oneof"`
+}
+
+func (*Envelope_Single) isEnvelope_Body() {}
+
+func (*Envelope_Text) isEnvelope_Body() {}
+
+// StripUnknownFields discards the unknown fields retained by x and by every
+// message held in its fields, including repeated fields, map values and
+// extensions.
+func (x *Envelope) StripUnknownFields() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Item.StripUnknownFields()
+	for _, v := range x.Items {
+		v.StripUnknownFields()
+	}
+	for _, v := range x.ByName {
+		v.StripUnknownFields()
+	}
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields(x.Metadata.ProtoReflect())
+	x.GetSingle().StripUnknownFields()
+	x.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() {
+			file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFieldsValue(fd, v)
+		}
+		return true
+	})
+}
+
+type Envelope_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	Child         *Envelope_Item         `protobuf:"bytes,2,opt,name=child" json:"child,omitempty" form:"child" uri:"child"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope_Item) Reset() {
+	*x = Envelope_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope_Item) ProtoMessage() {}
+
+func (x *Envelope_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope_Item.ProtoReflect.Descriptor instead.
+func (*Envelope_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Envelope_Item) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Envelope_Item) GetChild() *Envelope_Item {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// StripUnknownFields discards the unknown fields retained by x and by every
+// message held in its fields, including repeated fields, map values and
+// extensions.
+func (x *Envelope_Item) StripUnknownFields() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Child.StripUnknownFields()
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields discards the unknown fields of m and of every
+// message it holds.
+func file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	if m.GetUnknown() != nil {
+		m.SetUnknown(nil)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFieldsValue(fd, v)
+		return true
+	})
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFieldsValue discards the unknown fields of the messages
+// held in the value v of the field fd.
+func file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFieldsValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		l := v.List()
+		for i := 0; i < l.Len(); i++ {
+			file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields(l.Get(i).Message())
+		}
+	case fd.IsMap() && fd.MapValue().Message() != nil:
+		v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields(v.Message())
+			return true
+		})
+	case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+		file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_stripUnknownFields(v.Message())
+	}
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Envelope)(nil),
+		ExtensionType: (*Envelope_Item)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.stripunknown.extra",
+		Tag:           "bytes,100,opt,name=extra",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/stripunknown/stripunknown.proto",
+	},
+}
+
+// Extension fields to Envelope.
+var (
+	// optional goproto.protoc.methods.stripunknown.Envelope.Item extra = 100;
+	E_Extra = &file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDesc = "" +
+	"\n" +
+	"Bcmd/protoc-gen-go/testdata/methods/stripunknown/stripunknown.proto\x12#goproto.protoc.methods.stripunknown\x1a\x1cgoogle/protobuf/struct.proto\"\x80\x05\n" +
+	"\bEnvelope\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x04item\x18\x02 \x01(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemR\x04item\x12H\n" +
+	"\x05items\x18\x03 \x03(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemR\x05items\x12R\n" +
+	"\aby_name\x18\x04 \x03(\v29.goproto.protoc.methods.stripunknown.Envelope.ByNameEntryR\x06byName\x123\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12L\n" +
+	"\x06single\x18\x06 \x01(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemH\x00R\x06single\x12\x14\n" +
+	"\x04text\x18\a \x01(\tH\x00R\x04text\x1ad\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12H\n" +
+	"\x05child\x18\x02 \x01(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemR\x05child\x1am\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\x05value\x18\x02 \x01(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemR\x05value:\x028\x01*\b\bd\x10\x80\x80\x80\x80\x02B\x06\n" +
+	"\x04body:w\n" +
+	"\x05extra\x12-.goproto.protoc.methods.stripunknown.Envelope\x18d \x01(\v22.goproto.protoc.methods.stripunknown.Envelope.ItemR\x05extraBLZJgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/stripunknown"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_goTypes = []any{
+	(*Envelope)(nil),        // 0: goproto.protoc.methods.stripunknown.Envelope
+	(*Envelope_Item)(nil),   // 1: goproto.protoc.methods.stripunknown.Envelope.Item
+	nil,                     // 2: goproto.protoc.methods.stripunknown.Envelope.ByNameEntry
+	(*structpb.Struct)(nil), // 3: google.protobuf.Struct
+}
+var file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.stripunknown.Envelope.item:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	1, // 1: goproto.protoc.methods.stripunknown.Envelope.items:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	2, // 2: goproto.protoc.methods.stripunknown.Envelope.by_name:type_name -> goproto.protoc.methods.stripunknown.Envelope.ByNameEntry
+	3, // 3: goproto.protoc.methods.stripunknown.Envelope.metadata:type_name -> google.protobuf.Struct
+	1, // 4: goproto.protoc.methods.stripunknown.Envelope.single:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	1, // 5: goproto.protoc.methods.stripunknown.Envelope.Item.child:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	1, // 6: goproto.protoc.methods.stripunknown.Envelope.ByNameEntry.value:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	0, // 7: goproto.protoc.methods.stripunknown.extra:extendee -> goproto.protoc.methods.stripunknown.Envelope
+	1, // 8: goproto.protoc.methods.stripunknown.extra:type_name -> goproto.protoc.methods.stripunknown.Envelope.Item
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	8, // [8:9] is the sub-list for extension type_name
+	7, // [7:8] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes[0].OneofWrappers = []any{
+		(*Envelope_Single)(nil),
+		(*Envelope_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_stripunknown_stripunknown_proto_depIdxs = nil
+}
@@ -0,0 +1,448 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/templatemap/templatemap.proto
+
+package templatemap
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescGZIP(), []int{0}
+}
+
+type Profile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserName       string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" form:"user_name" uri:"user_name"`
+	Status         Status                 `protobuf:"varint,2,opt,name=status,proto3,enum=goproto.protoc.methods.templatemap.Status" json:"status,omitempty" form:"status" uri:"status"`
+	Nickname       *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty" form:"nickname" uri:"nickname"`
+	Home           *Profile_Address       `protobuf:"bytes,4,opt,name=home,proto3" json:"home,omitempty" form:"home" uri:"home"`
+	History        []Status               `protobuf:"varint,5,rep,packed,name=history,proto3,enum=goproto.protoc.methods.templatemap.Status" json:"history,omitempty" form:"history" uri:"history"`
+	Previous       []*Profile_Address     `protobuf:"bytes,6,rep,name=previous,proto3" json:"previous,omitempty" form:"previous" uri:"previous"`
+	Roles          map[string]Status      `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty" form:"roles" uri:"roles" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.protoc.methods.templatemap.Status"`
+	Counts         map[string]int32       `protobuf:"bytes,8,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Flags          []Status               `protobuf:"varint,11,rep,packed,name=flags,proto3,enum=goproto.protoc.methods.templatemap.Status" json:"flags,omitempty" form:"flags" uri:"flags"`
+	PreviousStatus *Status                `protobuf:"varint,12,opt,name=previous_status,json=previousStatus,proto3,enum=goproto.protoc.methods.templatemap.Status,oneof" json:"previous_status,omitempty" form:"previous_status" uri:"previous_status"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Profile_Phone
+	//	*Profile_Mailing
+	Contact       isProfile_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Profile) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Profile) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Profile) GetHome() *Profile_Address {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *Profile) GetHistory() []Status {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Profile) GetPrevious() []*Profile_Address {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Profile) GetRoles() map[string]Status {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Profile) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Profile) GetFlags() []Status {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Profile) GetPreviousStatus() Status {
+	if x != nil && x.PreviousStatus != nil {
+		return *x.PreviousStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Profile) GetContact() isProfile_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Profile) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Profile) GetMailing() *Profile_Address {
+	if x != nil {
+		if x, ok := x.Contact.(*Profile_Mailing); ok {
+			return x.Mailing
+		}
+	}
+	return nil
+}
+
+type isProfile_Contact interface {
+	isProfile_Contact()
+}
+
+type Profile_Phone struct {
+	Phone string `protobuf:"bytes,9,opt,name=phone,proto3,oneof"`
+}
+
+type Profile_Mailing struct {
+	Mailing *Profile_Address `protobuf:"bytes,10,opt,name=mailing,proto3,oneof"`
+}
+
+func (*Profile_Phone) isProfile_Contact() {}
+
+func (*Profile_Mailing) isProfile_Contact() {}
+
+// TemplateData returns the fields of x keyed by JSON field name, for use as
+// the data of a text/template or html/template. Unlike ToMap, it includes
+// every field: nested messages are the messages themselves, enums are
+// represented by the name of their value, and fields with presence which
+// are not populated are nil.
+func (x *Profile) TemplateData() map[string]any {
+	if x == nil {
+		return nil
+	}
+	m := make(map[string]any, 12)
+	m["userName"] = x.UserName
+	m["status"] = x.Status.String()
+	if x.Nickname != nil {
+		m["nickname"] = x.GetNickname()
+	} else {
+		m["nickname"] = nil
+	}
+	if x.Home != nil {
+		m["home"] = x.Home
+	} else {
+		m["home"] = nil
+	}
+	{
+		s := make([]string, len(x.History))
+		for i, v := range x.History {
+			s[i] = v.String()
+		}
+		m["history"] = s
+	}
+	m["previous"] = x.Previous
+	{
+		mv := make(map[string]string, len(x.Roles))
+		for k, v := range x.Roles {
+			mv[k] = v.String()
+		}
+		m["roles"] = mv
+	}
+	m["counts"] = x.Counts
+	{
+		s := make([]string, len(x.Flags))
+		for i, v := range x.Flags {
+			s[i] = v.String()
+		}
+		m["flags"] = s
+	}
+	if x.PreviousStatus != nil {
+		m["previousStatus"] = x.GetPreviousStatus().String()
+	} else {
+		m["previousStatus"] = nil
+	}
+	if v, ok := x.Contact.(*Profile_Phone); ok {
+		m["phone"] = v.Phone
+	} else {
+		m["phone"] = nil
+	}
+	if v, ok := x.Contact.(*Profile_Mailing); ok {
+		m["mailing"] = v.Mailing
+	} else {
+		m["mailing"] = nil
+	}
+	return m
+}
+
+type Profile_Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty" form:"city" uri:"city"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile_Address) Reset() {
+	*x = Profile_Address{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile_Address) ProtoMessage() {}
+
+func (x *Profile_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile_Address.ProtoReflect.Descriptor instead.
+func (*Profile_Address) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Profile_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// TemplateData returns the fields of x keyed by JSON field name, for use as
+// the data of a text/template or html/template. Unlike ToMap, it includes
+// every field: nested messages are the messages themselves, enums are
+// represented by the name of their value, and fields with presence which
+// are not populated are nil.
+func (x *Profile_Address) TemplateData() map[string]any {
+	if x == nil {
+		return nil
+	}
+	m := make(map[string]any, 1)
+	m["city"] = x.City
+	return m
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDesc = "" +
+	"\n" +
+	"@cmd/protoc-gen-go/testdata/methods/templatemap/templatemap.proto\x12\"goproto.protoc.methods.templatemap\"\xfb\a\n" +
+	"\aProfile\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12B\n" +
+	"\x06status\x18\x02 \x01(\x0e2*.goproto.protoc.methods.templatemap.StatusR\x06status\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12G\n" +
+	"\x04home\x18\x04 \x01(\v23.goproto.protoc.methods.templatemap.Profile.AddressR\x04home\x12D\n" +
+	"\ahistory\x18\x05 \x03(\x0e2*.goproto.protoc.methods.templatemap.StatusR\ahistory\x12O\n" +
+	"\bprevious\x18\x06 \x03(\v23.goproto.protoc.methods.templatemap.Profile.AddressR\bprevious\x12L\n" +
+	"\x05roles\x18\a \x03(\v26.goproto.protoc.methods.templatemap.Profile.RolesEntryR\x05roles\x12O\n" +
+	"\x06counts\x18\b \x03(\v27.goproto.protoc.methods.templatemap.Profile.CountsEntryR\x06counts\x12@\n" +
+	"\x05flags\x18\v \x03(\x0e2*.goproto.protoc.methods.templatemap.StatusR\x05flags\x12X\n" +
+	"\x0fprevious_status\x18\f \x01(\x0e2*.goproto.protoc.methods.templatemap.StatusH\x02R\x0epreviousStatus\x88\x01\x01\x12\x16\n" +
+	"\x05phone\x18\t \x01(\tH\x00R\x05phone\x12O\n" +
+	"\amailing\x18\n" +
+	" \x01(\v23.goproto.protoc.methods.templatemap.Profile.AddressH\x00R\amailing\x1a\x1d\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x1ad\n" +
+	"\n" +
+	"RolesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\x0e2*.goproto.protoc.methods.templatemap.StatusR\x05value:\x028\x01\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\t\n" +
+	"\acontactB\v\n" +
+	"\t_nicknameB\x12\n" +
+	"\x10_previous_status*3\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ACTIVE\x10\x01BKZIgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/templatemapb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_goTypes = []any{
+	(Status)(0),             // 0: goproto.protoc.methods.templatemap.Status
+	(*Profile)(nil),         // 1: goproto.protoc.methods.templatemap.Profile
+	(*Profile_Address)(nil), // 2: goproto.protoc.methods.templatemap.Profile.Address
+	nil,                     // 3: goproto.protoc.methods.templatemap.Profile.RolesEntry
+	nil,                     // 4: goproto.protoc.methods.templatemap.Profile.CountsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_depIdxs = []int32{
+	0,  // 0: goproto.protoc.methods.templatemap.Profile.status:type_name -> goproto.protoc.methods.templatemap.Status
+	2,  // 1: goproto.protoc.methods.templatemap.Profile.home:type_name -> goproto.protoc.methods.templatemap.Profile.Address
+	0,  // 2: goproto.protoc.methods.templatemap.Profile.history:type_name -> goproto.protoc.methods.templatemap.Status
+	2,  // 3: goproto.protoc.methods.templatemap.Profile.previous:type_name -> goproto.protoc.methods.templatemap.Profile.Address
+	3,  // 4: goproto.protoc.methods.templatemap.Profile.roles:type_name -> goproto.protoc.methods.templatemap.Profile.RolesEntry
+	4,  // 5: goproto.protoc.methods.templatemap.Profile.counts:type_name -> goproto.protoc.methods.templatemap.Profile.CountsEntry
+	0,  // 6: goproto.protoc.methods.templatemap.Profile.flags:type_name -> goproto.protoc.methods.templatemap.Status
+	0,  // 7: goproto.protoc.methods.templatemap.Profile.previous_status:type_name -> goproto.protoc.methods.templatemap.Status
+	2,  // 8: goproto.protoc.methods.templatemap.Profile.mailing:type_name -> goproto.protoc.methods.templatemap.Profile.Address
+	0,  // 9: goproto.protoc.methods.templatemap.Profile.RolesEntry.value:type_name -> goproto.protoc.methods.templatemap.Status
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes[0].OneofWrappers = []any{
+		(*Profile_Phone)(nil),
+		(*Profile_Mailing)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_templatemap_templatemap_proto_depIdxs = nil
+}
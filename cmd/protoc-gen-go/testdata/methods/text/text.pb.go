@@ -0,0 +1,298 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/text/text.proto
+
+package text
+
+import (
+	prototext "google.golang.org/protobuf/encoding/prototext"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Config struct {
+	state    protoimpl.MessageState     `protogen:"open.v1"`
+	Name     string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Backends map[string]*Config_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty" form:"backends" uri:"backends" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Limits   map[string]int64           `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty" form:"limits" uri:"limits" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Types that are valid to be assigned to Mode:
+	//
+	//	*Config_StaticFile
+	//	*Config_Proxy
+	Mode          isConfig_Mode `protobuf_oneof:"mode"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetBackends() map[string]*Config_Backend {
+	if x != nil {
+		return x.Backends
+	}
+	return nil
+}
+
+func (x *Config) GetLimits() map[string]int64 {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Config) GetMode() isConfig_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *Config) GetStaticFile() string {
+	if x != nil {
+		if x, ok := x.Mode.(*Config_StaticFile); ok {
+			return x.StaticFile
+		}
+	}
+	return ""
+}
+
+func (x *Config) GetProxy() *Config_Backend {
+	if x != nil {
+		if x, ok := x.Mode.(*Config_Proxy); ok {
+			return x.Proxy
+		}
+	}
+	return nil
+}
+
+type isConfig_Mode interface {
+	isConfig_Mode()
+}
+
+type Config_StaticFile struct {
+	StaticFile string `protobuf:"bytes,4,opt,name=static_file,json=staticFile,proto3,oneof"`
+}
+
+type Config_Proxy struct {
+	Proxy *Config_Backend `protobuf:"bytes,5,opt,name=proxy,proto3,oneof"`
+}
+
+func (*Config_StaticFile) isConfig_Mode() {}
+
+func (*Config_Proxy) isConfig_Mode() {}
+
+// MarshalText implements encoding.TextMarshaler by marshaling x in the
+// protobuf text format with the options in the package variable
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions.
+// The output is unstable and is not meant to be compared.
+func (x *Config) MarshalText() ([]byte, error) {
+	return File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions.Marshal(x)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by unmarshaling b in the
+// protobuf text format into x with the options in the package variable
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions.
+func (x *Config) UnmarshalText(b []byte) error {
+	return File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions.Unmarshal(b, x)
+}
+
+type Config_Backend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" form:"address" uri:"address"`
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty" form:"weight" uri:"weight"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Backend) Reset() {
+	*x = Config_Backend{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Backend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Backend) ProtoMessage() {}
+
+func (x *Config_Backend) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Backend.ProtoReflect.Descriptor instead.
+func (*Config_Backend) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Config_Backend) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Config_Backend) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// MarshalText implements encoding.TextMarshaler by marshaling x in the
+// protobuf text format with the options in the package variable
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions.
+// The output is unstable and is not meant to be compared.
+func (x *Config_Backend) MarshalText() ([]byte, error) {
+	return File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions.Marshal(x)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by unmarshaling b in the
+// protobuf text format into x with the options in the package variable
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions.
+func (x *Config_Backend) UnmarshalText(b []byte) error {
+	return File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions.Unmarshal(b, x)
+}
+
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions are the options
+// used by the MarshalText methods of the messages in cmd/protoc-gen-go/testdata/methods/text/text.proto.
+// They may be changed, such as to set Multiline and Indent, before any of
+// the methods is called. The zero options are those of prototext.Marshal.
+var File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions = prototext.MarshalOptions{}
+
+// File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions are the options
+// used by the UnmarshalText methods of the messages in cmd/protoc-gen-go/testdata/methods/text/text.proto.
+// They may be changed before any of the methods is called.
+var File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textUnmarshalOptions = prototext.UnmarshalOptions{}
+
+var File_cmd_protoc_gen_go_testdata_methods_text_text_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/methods/text/text.proto\x12\x1bgoproto.protoc.methods.text\"\x86\x04\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12M\n" +
+	"\bbackends\x18\x02 \x03(\v21.goproto.protoc.methods.text.Config.BackendsEntryR\bbackends\x12G\n" +
+	"\x06limits\x18\x03 \x03(\v2/.goproto.protoc.methods.text.Config.LimitsEntryR\x06limits\x12!\n" +
+	"\vstatic_file\x18\x04 \x01(\tH\x00R\n" +
+	"staticFile\x12C\n" +
+	"\x05proxy\x18\x05 \x01(\v2+.goproto.protoc.methods.text.Config.BackendH\x00R\x05proxy\x1a;\n" +
+	"\aBackend\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\x1ah\n" +
+	"\rBackendsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12A\n" +
+	"\x05value\x18\x02 \x01(\v2+.goproto.protoc.methods.text.Config.BackendR\x05value:\x028\x01\x1a9\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x06\n" +
+	"\x04modeBDZBgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/textb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_text_text_proto_goTypes = []any{
+	(*Config)(nil),         // 0: goproto.protoc.methods.text.Config
+	(*Config_Backend)(nil), // 1: goproto.protoc.methods.text.Config.Backend
+	nil,                    // 2: goproto.protoc.methods.text.Config.BackendsEntry
+	nil,                    // 3: goproto.protoc.methods.text.Config.LimitsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_text_text_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.text.Config.backends:type_name -> goproto.protoc.methods.text.Config.BackendsEntry
+	3, // 1: goproto.protoc.methods.text.Config.limits:type_name -> goproto.protoc.methods.text.Config.LimitsEntry
+	1, // 2: goproto.protoc.methods.text.Config.proxy:type_name -> goproto.protoc.methods.text.Config.Backend
+	1, // 3: goproto.protoc.methods.text.Config.BackendsEntry.value:type_name -> goproto.protoc.methods.text.Config.Backend
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_text_text_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_text_text_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_text_text_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes[0].OneofWrappers = []any{
+		(*Config_StaticFile)(nil),
+		(*Config_Proxy)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_text_text_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_text_text_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_text_text_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_text_text_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_text_text_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_text_text_proto_depIdxs = nil
+}
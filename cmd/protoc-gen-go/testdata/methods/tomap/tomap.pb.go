@@ -0,0 +1,497 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/tomap/tomap.proto
+
+package tomap
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_GREEN       Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+		2: "COLOR_GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_GREEN":       2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescGZIP(), []int{0}
+}
+
+type Inner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Color         Color                  `protobuf:"varint,2,opt,name=color,proto3,enum=goproto.protoc.methods.tomap.Color" json:"color,omitempty" form:"color" uri:"color"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inner) Reset() {
+	*x = Inner{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inner) ProtoMessage() {}
+
+func (x *Inner) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inner.ProtoReflect.Descriptor instead.
+func (*Inner) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Inner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Inner) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+// ToMap returns a generic representation of the populated fields of x,
+// keyed by JSON field name. Messages are converted recursively,
+// enums are represented by the name of their value, repeated fields by
+// []any and map fields by a map of the same key type with values of type any.
+func (x *Inner) ToMap() map[string]any {
+	if x == nil {
+		return nil
+	}
+	m := make(map[string]any)
+	if x.Name != "" {
+		m["name"] = x.Name
+	}
+	if x.Color != 0 {
+		m["color"] = x.Color.String()
+	}
+	return m
+}
+
+type Outer struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Count       int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" form:"count" uri:"count"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=label,proto3" json:"display_name,omitempty" form:"display_name" uri:"display_name"`
+	Color       Color                  `protobuf:"varint,3,opt,name=color,proto3,enum=goproto.protoc.methods.tomap.Color" json:"color,omitempty" form:"color" uri:"color"`
+	Inner       *Inner                 `protobuf:"bytes,4,opt,name=inner,proto3" json:"inner,omitempty" form:"inner" uri:"inner"`
+	Tags        []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Items       []*Inner               `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	ByName      map[string]*Inner      `protobuf:"bytes,7,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" form:"by_name" uri:"by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Colors      map[int32]Color        `protobuf:"bytes,8,rep,name=colors,proto3" json:"colors,omitempty" form:"colors" uri:"colors" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=goproto.protoc.methods.tomap.Color"`
+	Enabled     *bool                  `protobuf:"varint,9,opt,name=enabled,proto3,oneof" json:"enabled,omitempty" form:"enabled" uri:"enabled"`
+	Data        []byte                 `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty" form:"data" uri:"data"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Outer_Text
+	//	*Outer_Nested
+	Choice        isOuter_Choice         `protobuf_oneof:"choice"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty" form:"created" uri:"created"`
+	Accent        *Color                 `protobuf:"varint,14,opt,name=accent,proto3,enum=goproto.protoc.methods.tomap.Color,oneof" json:"accent,omitempty" form:"accent" uri:"accent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Outer) Reset() {
+	*x = Outer{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Outer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outer) ProtoMessage() {}
+
+func (x *Outer) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outer.ProtoReflect.Descriptor instead.
+func (*Outer) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Outer) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Outer) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Outer) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *Outer) GetInner() *Inner {
+	if x != nil {
+		return x.Inner
+	}
+	return nil
+}
+
+func (x *Outer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Outer) GetItems() []*Inner {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Outer) GetByName() map[string]*Inner {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Outer) GetColors() map[int32]Color {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *Outer) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *Outer) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Outer) GetChoice() isOuter_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Outer) GetText() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Outer_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Outer) GetNested() *Inner {
+	if x != nil {
+		if x, ok := x.Choice.(*Outer_Nested); ok {
+			return x.Nested
+		}
+	}
+	return nil
+}
+
+func (x *Outer) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Outer) GetAccent() Color {
+	if x != nil && x.Accent != nil {
+		return *x.Accent
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+type isOuter_Choice interface {
+	isOuter_Choice()
+}
+
+type Outer_Text struct {
+	Text string `protobuf:"bytes,11,opt,name=text,proto3,oneof"`
+}
+
+type Outer_Nested struct {
+	Nested *Inner `protobuf:"bytes,12,opt,name=nested,proto3,oneof"`
+}
+
+func (*Outer_Text) isOuter_Choice() {}
+
+func (*Outer_Nested) isOuter_Choice() {}
+
+// ToMap returns a generic representation of the populated fields of x,
+// keyed by JSON field name. Messages are converted recursively,
+// enums are represented by the name of their value, repeated fields by
+// []any and map fields by a map of the same key type with values of type any.
+func (x *Outer) ToMap() map[string]any {
+	if x == nil {
+		return nil
+	}
+	m := make(map[string]any)
+	if x.Count != 0 {
+		m["count"] = x.Count
+	}
+	if x.DisplayName != "" {
+		m["label"] = x.DisplayName
+	}
+	if x.Color != 0 {
+		m["color"] = x.Color.String()
+	}
+	if x.Inner != nil {
+		m["inner"] = x.Inner.ToMap()
+	}
+	if len(x.Tags) > 0 {
+		s := make([]any, len(x.Tags))
+		for i, v := range x.Tags {
+			s[i] = v
+		}
+		m["tags"] = s
+	}
+	if len(x.Items) > 0 {
+		s := make([]any, len(x.Items))
+		for i, v := range x.Items {
+			s[i] = v.ToMap()
+		}
+		m["items"] = s
+	}
+	if len(x.ByName) > 0 {
+		mv := make(map[string]any, len(x.ByName))
+		for k, v := range x.ByName {
+			mv[k] = v.ToMap()
+		}
+		m["byName"] = mv
+	}
+	if len(x.Colors) > 0 {
+		mv := make(map[int32]any, len(x.Colors))
+		for k, v := range x.Colors {
+			mv[k] = v.String()
+		}
+		m["colors"] = mv
+	}
+	if x.Enabled != nil {
+		m["enabled"] = x.GetEnabled()
+	}
+	if len(x.Data) > 0 {
+		m["data"] = x.Data
+	}
+	if v, ok := x.Choice.(*Outer_Text); ok {
+		m["text"] = v.Text
+	}
+	if v, ok := x.Choice.(*Outer_Nested); ok {
+		m["nested"] = v.Nested.ToMap()
+	}
+	if x.Created != nil {
+		if tm, ok := any(x.Created).(interface{ ToMap() map[string]any }); ok {
+			m["created"] = tm.ToMap()
+		} else {
+			m["created"] = x.Created
+		}
+	}
+	if x.Accent != nil {
+		m["accent"] = x.GetAccent().String()
+	}
+	return m
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDesc = "" +
+	"\n" +
+	"4cmd/protoc-gen-go/testdata/methods/tomap/tomap.proto\x12\x1cgoproto.protoc.methods.tomap\x1a\x1fgoogle/protobuf/timestamp.proto\"V\n" +
+	"\x05Inner\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\x05color\x18\x02 \x01(\x0e2#.goproto.protoc.methods.tomap.ColorR\x05color\"\xf3\x06\n" +
+	"\x05Outer\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1b\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\x05label\x129\n" +
+	"\x05color\x18\x03 \x01(\x0e2#.goproto.protoc.methods.tomap.ColorR\x05color\x129\n" +
+	"\x05inner\x18\x04 \x01(\v2#.goproto.protoc.methods.tomap.InnerR\x05inner\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x129\n" +
+	"\x05items\x18\x06 \x03(\v2#.goproto.protoc.methods.tomap.InnerR\x05items\x12H\n" +
+	"\aby_name\x18\a \x03(\v2/.goproto.protoc.methods.tomap.Outer.ByNameEntryR\x06byName\x12G\n" +
+	"\x06colors\x18\b \x03(\v2/.goproto.protoc.methods.tomap.Outer.ColorsEntryR\x06colors\x12\x1d\n" +
+	"\aenabled\x18\t \x01(\bH\x01R\aenabled\x88\x01\x01\x12\x12\n" +
+	"\x04data\x18\n" +
+	" \x01(\fR\x04data\x12\x14\n" +
+	"\x04text\x18\v \x01(\tH\x00R\x04text\x12=\n" +
+	"\x06nested\x18\f \x01(\v2#.goproto.protoc.methods.tomap.InnerH\x00R\x06nested\x124\n" +
+	"\acreated\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12@\n" +
+	"\x06accent\x18\x0e \x01(\x0e2#.goproto.protoc.methods.tomap.ColorH\x02R\x06accent\x88\x01\x01\x1a^\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.goproto.protoc.methods.tomap.InnerR\x05value:\x028\x01\x1a^\n" +
+	"\vColorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\x0e2#.goproto.protoc.methods.tomap.ColorR\x05value:\x028\x01B\b\n" +
+	"\x06choiceB\n" +
+	"\n" +
+	"\b_enabledB\t\n" +
+	"\a_accent*>\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01\x12\x0f\n" +
+	"\vCOLOR_GREEN\x10\x02BEZCgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/tomapb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_goTypes = []any{
+	(Color)(0),                    // 0: goproto.protoc.methods.tomap.Color
+	(*Inner)(nil),                 // 1: goproto.protoc.methods.tomap.Inner
+	(*Outer)(nil),                 // 2: goproto.protoc.methods.tomap.Outer
+	nil,                           // 3: goproto.protoc.methods.tomap.Outer.ByNameEntry
+	nil,                           // 4: goproto.protoc.methods.tomap.Outer.ColorsEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_depIdxs = []int32{
+	0,  // 0: goproto.protoc.methods.tomap.Inner.color:type_name -> goproto.protoc.methods.tomap.Color
+	0,  // 1: goproto.protoc.methods.tomap.Outer.color:type_name -> goproto.protoc.methods.tomap.Color
+	1,  // 2: goproto.protoc.methods.tomap.Outer.inner:type_name -> goproto.protoc.methods.tomap.Inner
+	1,  // 3: goproto.protoc.methods.tomap.Outer.items:type_name -> goproto.protoc.methods.tomap.Inner
+	3,  // 4: goproto.protoc.methods.tomap.Outer.by_name:type_name -> goproto.protoc.methods.tomap.Outer.ByNameEntry
+	4,  // 5: goproto.protoc.methods.tomap.Outer.colors:type_name -> goproto.protoc.methods.tomap.Outer.ColorsEntry
+	1,  // 6: goproto.protoc.methods.tomap.Outer.nested:type_name -> goproto.protoc.methods.tomap.Inner
+	5,  // 7: goproto.protoc.methods.tomap.Outer.created:type_name -> google.protobuf.Timestamp
+	0,  // 8: goproto.protoc.methods.tomap.Outer.accent:type_name -> goproto.protoc.methods.tomap.Color
+	1,  // 9: goproto.protoc.methods.tomap.Outer.ByNameEntry.value:type_name -> goproto.protoc.methods.tomap.Inner
+	0,  // 10: goproto.protoc.methods.tomap.Outer.ColorsEntry.value:type_name -> goproto.protoc.methods.tomap.Color
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes[1].OneofWrappers = []any{
+		(*Outer_Text)(nil),
+		(*Outer_Nested)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_tomap_tomap_proto_depIdxs = nil
+}
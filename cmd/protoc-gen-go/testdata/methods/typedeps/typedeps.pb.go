@@ -0,0 +1,430 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/typedeps/typedeps.proto
+
+package typedeps
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Folder struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Files         []*Folder_File           `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty" form:"files" uri:"files"`
+	Owners        map[string]*Folder_Owner `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty" form:"owners" uri:"owners" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Children      []*Folder                `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty" form:"children" uri:"children"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetFiles() []*Folder_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Folder) GetOwners() map[string]*Folder_Owner {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *Folder) GetChildren() []*Folder {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_messageDependenciesOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_messageDependencies     []protoreflect.FullName
+)
+
+// MessageDependencies returns the full names of the message types which a
+// Folder can contain, in ascending order. These are the types of its message
+// fields, including the elements of repeated fields and the values of map
+// fields, and recursively the types of their fields. The message type itself
+// is included only if it is reachable from its fields. Extensions are not
+// considered. The names are computed once from the descriptor, and a copy
+// of them is returned by each call.
+func (*Folder) MessageDependencies() []protoreflect.FullName {
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_messageDependenciesOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_messageDependencies = file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[0].Descriptor())
+	})
+	return append([]protoreflect.FullName(nil), file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_messageDependencies...)
+}
+
+type Leaf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaf) Reset() {
+	*x = Leaf{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaf) ProtoMessage() {}
+
+func (x *Leaf) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaf.ProtoReflect.Descriptor instead.
+func (*Leaf) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Leaf) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Leaf) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Leaf_messageDependenciesOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Leaf_messageDependencies     []protoreflect.FullName
+)
+
+// MessageDependencies returns the full names of the message types which a
+// Leaf can contain, in ascending order. These are the types of its message
+// fields, including the elements of repeated fields and the values of map
+// fields, and recursively the types of their fields. The message type itself
+// is included only if it is reachable from its fields. Extensions are not
+// considered. The names are computed once from the descriptor, and a copy
+// of them is returned by each call.
+func (*Leaf) MessageDependencies() []protoreflect.FullName {
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Leaf_messageDependenciesOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Leaf_messageDependencies = file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[1].Descriptor())
+	})
+	return append([]protoreflect.FullName(nil), file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Leaf_messageDependencies...)
+}
+
+type Folder_Owner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty" form:"since" uri:"since"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder_Owner) Reset() {
+	*x = Folder_Owner{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder_Owner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder_Owner) ProtoMessage() {}
+
+func (x *Folder_Owner) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder_Owner.ProtoReflect.Descriptor instead.
+func (*Folder_Owner) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Folder_Owner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder_Owner) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_Owner_messageDependenciesOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_Owner_messageDependencies     []protoreflect.FullName
+)
+
+// MessageDependencies returns the full names of the message types which a
+// Folder_Owner can contain, in ascending order. These are the types of its message
+// fields, including the elements of repeated fields and the values of map
+// fields, and recursively the types of their fields. The message type itself
+// is included only if it is reachable from its fields. Extensions are not
+// considered. The names are computed once from the descriptor, and a copy
+// of them is returned by each call.
+func (*Folder_Owner) MessageDependencies() []protoreflect.FullName {
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_Owner_messageDependenciesOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_Owner_messageDependencies = file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[2].Descriptor())
+	})
+	return append([]protoreflect.FullName(nil), file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_Owner_messageDependencies...)
+}
+
+type Folder_File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Owner         *Folder_Owner          `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" form:"owner" uri:"owner"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder_File) Reset() {
+	*x = Folder_File{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder_File) ProtoMessage() {}
+
+func (x *Folder_File) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder_File.ProtoReflect.Descriptor instead.
+func (*Folder_File) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Folder_File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder_File) GetOwner() *Folder_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_File_messageDependenciesOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_File_messageDependencies     []protoreflect.FullName
+)
+
+// MessageDependencies returns the full names of the message types which a
+// Folder_File can contain, in ascending order. These are the types of its message
+// fields, including the elements of repeated fields and the values of map
+// fields, and recursively the types of their fields. The message type itself
+// is included only if it is reachable from its fields. Extensions are not
+// considered. The names are computed once from the descriptor, and a copy
+// of them is returned by each call.
+func (*Folder_File) MessageDependencies() []protoreflect.FullName {
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_File_messageDependenciesOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_File_messageDependencies = file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes[3].Descriptor())
+	})
+	return append([]protoreflect.FullName(nil), file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_Folder_File_messageDependencies...)
+}
+
+// file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies returns the sorted full names of the message
+// types reachable from the fields of md, other than map entries.
+func file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_messageDependencies(md protoreflect.MessageDescriptor) []protoreflect.FullName {
+	seen := make(map[protoreflect.FullName]bool)
+	var names []protoreflect.FullName
+	var walk func(protoreflect.MessageDescriptor)
+	walk = func(md protoreflect.MessageDescriptor) {
+		fds := md.Fields()
+		for i := 0; i < fds.Len(); i++ {
+			d := fds.Get(i).Message()
+			if d == nil || seen[d.FullName()] {
+				continue
+			}
+			seen[d.FullName()] = true
+			if !d.IsMapEntry() {
+				names = append(names, d.FullName())
+			}
+			walk(d)
+		}
+	}
+	walk(md)
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDesc = "" +
+	"\n" +
+	":cmd/protoc-gen-go/testdata/methods/typedeps/typedeps.proto\x12\x1fgoproto.protoc.methods.typedeps\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x04\n" +
+	"\x06Folder\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\x05files\x18\x02 \x03(\v2,.goproto.protoc.methods.typedeps.Folder.FileR\x05files\x12K\n" +
+	"\x06owners\x18\x03 \x03(\v23.goproto.protoc.methods.typedeps.Folder.OwnersEntryR\x06owners\x12C\n" +
+	"\bchildren\x18\x04 \x03(\v2'.goproto.protoc.methods.typedeps.FolderR\bchildren\x1aM\n" +
+	"\x05Owner\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x1a_\n" +
+	"\x04File\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\x05owner\x18\x02 \x01(\v2-.goproto.protoc.methods.typedeps.Folder.OwnerR\x05owner\x1ah\n" +
+	"\vOwnersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12C\n" +
+	"\x05value\x18\x02 \x01(\v2-.goproto.protoc.methods.typedeps.Folder.OwnerR\x05value:\x028\x01\"\xa0\x01\n" +
+	"\x04Leaf\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
+	"\x06labels\x18\x02 \x03(\v21.goproto.protoc.methods.typedeps.Leaf.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BHZFgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/typedepsb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_goTypes = []any{
+	(*Folder)(nil),                // 0: goproto.protoc.methods.typedeps.Folder
+	(*Leaf)(nil),                  // 1: goproto.protoc.methods.typedeps.Leaf
+	(*Folder_Owner)(nil),          // 2: goproto.protoc.methods.typedeps.Folder.Owner
+	(*Folder_File)(nil),           // 3: goproto.protoc.methods.typedeps.Folder.File
+	nil,                           // 4: goproto.protoc.methods.typedeps.Folder.OwnersEntry
+	nil,                           // 5: goproto.protoc.methods.typedeps.Leaf.LabelsEntry
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_depIdxs = []int32{
+	3, // 0: goproto.protoc.methods.typedeps.Folder.files:type_name -> goproto.protoc.methods.typedeps.Folder.File
+	4, // 1: goproto.protoc.methods.typedeps.Folder.owners:type_name -> goproto.protoc.methods.typedeps.Folder.OwnersEntry
+	0, // 2: goproto.protoc.methods.typedeps.Folder.children:type_name -> goproto.protoc.methods.typedeps.Folder
+	5, // 3: goproto.protoc.methods.typedeps.Leaf.labels:type_name -> goproto.protoc.methods.typedeps.Leaf.LabelsEntry
+	6, // 4: goproto.protoc.methods.typedeps.Folder.Owner.since:type_name -> google.protobuf.Timestamp
+	2, // 5: goproto.protoc.methods.typedeps.Folder.File.owner:type_name -> goproto.protoc.methods.typedeps.Folder.Owner
+	2, // 6: goproto.protoc.methods.typedeps.Folder.OwnersEntry.value:type_name -> goproto.protoc.methods.typedeps.Folder.Owner
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_typedeps_typedeps_proto_depIdxs = nil
+}
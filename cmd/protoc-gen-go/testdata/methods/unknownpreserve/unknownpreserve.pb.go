@@ -0,0 +1,213 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/unknownpreserve/unknownpreserve.proto
+
+package unknownpreserve
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Known struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Known) Reset() {
+	*x = Known{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Known) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Known) ProtoMessage() {}
+
+func (x *Known) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Known.ProtoReflect.Descriptor instead.
+func (*Known) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Known) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// HasUnknownFields reports whether x retains any unknown fields.
+func (x *Known) HasUnknownFields() bool {
+	return x != nil && len(x.unknownFields) > 0
+}
+
+// UnknownFieldBytes returns the raw wire encoding of the unknown fields
+// retained by x. The caller must not modify the content of the returned slice.
+func (x *Known) UnknownFieldBytes() []byte {
+	if x == nil {
+		return nil
+	}
+	return x.unknownFields
+}
+
+type Extendable struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Extendable) Reset() {
+	*x = Extendable{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Extendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extendable) ProtoMessage() {}
+
+func (x *Extendable) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extendable.ProtoReflect.Descriptor instead.
+func (*Extendable) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Extendable) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// HasUnknownFields reports whether x retains any unknown fields.
+func (x *Extendable) HasUnknownFields() bool {
+	return x != nil && len(x.unknownFields) > 0
+}
+
+// UnknownFieldBytes returns the raw wire encoding of the unknown fields
+// retained by x. The caller must not modify the content of the returned slice.
+func (x *Extendable) UnknownFieldBytes() []byte {
+	if x == nil {
+		return nil
+	}
+	return x.unknownFields
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "goproto.protoc.methods.unknownpreserve.ext",
+		Tag:           "varint,100,opt,name=ext",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/unknownpreserve/unknownpreserve.proto",
+	},
+}
+
+// Extension fields to Extendable.
+var (
+	// optional int32 ext = 100;
+	E_Ext = &file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_extTypes[0]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDesc = "" +
+	"\n" +
+	"Hcmd/protoc-gen-go/testdata/methods/unknownpreserve/unknownpreserve.proto\x12&goproto.protoc.methods.unknownpreserve\"\x1b\n" +
+	"\x05Known\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"*\n" +
+	"\n" +
+	"Extendable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name*\b\bd\x10\x80\x80\x80\x80\x02:D\n" +
+	"\x03ext\x122.goproto.protoc.methods.unknownpreserve.Extendable\x18d \x01(\x05R\x03extBOZMgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unknownpreserve"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_goTypes = []any{
+	(*Known)(nil),      // 0: goproto.protoc.methods.unknownpreserve.Known
+	(*Extendable)(nil), // 1: goproto.protoc.methods.unknownpreserve.Extendable
+}
+var file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.unknownpreserve.ext:extendee -> goproto.protoc.methods.unknownpreserve.Extendable
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_unknownpreserve_unknownpreserve_proto_depIdxs = nil
+}
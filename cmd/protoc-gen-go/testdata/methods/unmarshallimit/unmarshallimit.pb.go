@@ -0,0 +1,139 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/unmarshallimit/unmarshallimit.proto
+
+package unmarshallimit
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Tree struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty" form:"label" uri:"label"`
+	Child         *Tree                  `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty" form:"child" uri:"child"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tree) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Tree) GetChild() *Tree {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// UnmarshalMax parses the wire-format message in b and places the result
+// in x, like proto.Unmarshal. It reports an error without parsing b
+// if b is longer than maxBytes, and an error if messages are nested more
+// than 8 levels deep.
+func (x *Tree) UnmarshalMax(b []byte, maxBytes int) error {
+	if len(b) > maxBytes {
+		return fmt.Errorf("goproto.protoc.methods.unmarshallimit.Tree: message size %d exceeds the limit of %d bytes", len(b), maxBytes)
+	}
+	return proto.UnmarshalOptions{RecursionLimit: 8}.Unmarshal(b, x)
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDesc = "" +
+	"\n" +
+	"Fcmd/protoc-gen-go/testdata/methods/unmarshallimit/unmarshallimit.proto\x12%goproto.protoc.methods.unmarshallimit\"_\n" +
+	"\x04Tree\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12A\n" +
+	"\x05child\x18\x02 \x01(\v2+.goproto.protoc.methods.unmarshallimit.TreeR\x05childBNZLgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unmarshallimitb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_goTypes = []any{
+	(*Tree)(nil), // 0: goproto.protoc.methods.unmarshallimit.Tree
+}
+var file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.unmarshallimit.Tree.child:type_name -> goproto.protoc.methods.unmarshallimit.Tree
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_unmarshallimit_unmarshallimit_proto_depIdxs = nil
+}
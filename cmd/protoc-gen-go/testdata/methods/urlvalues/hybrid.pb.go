@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/urlvalues/hybrid.proto
+
+//go:build !protoopaque
+
+package urlvalues
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	url "net/url"
+	reflect "reflect"
+	strconv "strconv"
+	unsafe "unsafe"
+)
+
+type Lookup struct {
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	Key     *string                `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" form:"key" uri:"key"`
+	Version int32                  `protobuf:"varint,2,opt,name=version" json:"version,omitempty" form:"version" uri:"version"`
+	Weights []float32              `protobuf:"fixed32,3,rep,packed,name=weights" json:"weights,omitempty" form:"weights" uri:"weights"`
+	// Types that are valid to be assigned to By:
+	//
+	//	*Lookup_Shard
+	//	*Lookup_Parent
+	By            isLookup_By `protobuf_oneof:"by"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lookup) Reset() {
+	*x = Lookup{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lookup) ProtoMessage() {}
+
+func (x *Lookup) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Lookup) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *Lookup) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Lookup) GetWeights() []float32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Lookup) GetBy() isLookup_By {
+	if x != nil {
+		return x.By
+	}
+	return nil
+}
+
+func (x *Lookup) GetShard() uint32 {
+	if x != nil {
+		if x, ok := x.By.(*Lookup_Shard); ok {
+			return x.Shard
+		}
+	}
+	return 0
+}
+
+func (x *Lookup) GetParent() *Lookup {
+	if x != nil {
+		if x, ok := x.By.(*Lookup_Parent); ok {
+			return x.Parent
+		}
+	}
+	return nil
+}
+
+func (x *Lookup) SetKey(v string) {
+	x.Key = &v
+}
+
+func (x *Lookup) SetVersion(v int32) {
+	x.Version = v
+}
+
+func (x *Lookup) SetWeights(v []float32) {
+	x.Weights = v
+}
+
+func (x *Lookup) SetShard(v uint32) {
+	x.By = &Lookup_Shard{v}
+}
+
+func (x *Lookup) SetParent(v *Lookup) {
+	if v == nil {
+		x.By = nil
+		return
+	}
+	x.By = &Lookup_Parent{v}
+}
+
+func (x *Lookup) HasKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.Key != nil
+}
+
+func (x *Lookup) HasBy() bool {
+	if x == nil {
+		return false
+	}
+	return x.By != nil
+}
+
+func (x *Lookup) HasShard() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.By.(*Lookup_Shard)
+	return ok
+}
+
+func (x *Lookup) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.By.(*Lookup_Parent)
+	return ok
+}
+
+func (x *Lookup) ClearKey() {
+	x.Key = nil
+}
+
+func (x *Lookup) ClearBy() {
+	x.By = nil
+}
+
+func (x *Lookup) ClearShard() {
+	if _, ok := x.By.(*Lookup_Shard); ok {
+		x.By = nil
+	}
+}
+
+func (x *Lookup) ClearParent() {
+	if _, ok := x.By.(*Lookup_Parent); ok {
+		x.By = nil
+	}
+}
+
+const Lookup_By_not_set_case case_Lookup_By = 0
+const Lookup_Shard_case case_Lookup_By = 4
+const Lookup_Parent_case case_Lookup_By = 5
+
+func (x *Lookup) WhichBy() case_Lookup_By {
+	if x == nil {
+		return Lookup_By_not_set_case
+	}
+	switch x.By.(type) {
+	case *Lookup_Shard:
+		return Lookup_Shard_case
+	case *Lookup_Parent:
+		return Lookup_Parent_case
+	default:
+		return Lookup_By_not_set_case
+	}
+}
+
+type Lookup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Key     *string
+	Version int32
+	Weights []float32
+	// Fields of oneof By:
+	Shard  *uint32
+	Parent *Lookup
+	// -- end of By
+}
+
+func (b0 Lookup_builder) Build() *Lookup {
+	m0 := &Lookup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Key = b.Key
+	x.Version = b.Version
+	x.Weights = b.Weights
+	if b.Shard != nil {
+		x.By = &Lookup_Shard{*b.Shard}
+	}
+	if b.Parent != nil {
+		x.By = &Lookup_Parent{b.Parent}
+	}
+	return m0
+}
+
+type case_Lookup_By protoreflect.FieldNumber
+
+func (x case_Lookup_By) String() string {
+	md := file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isLookup_By interface {
+	isLookup_By()
+}
+
+type Lookup_Shard struct {
+	Shard uint32 `protobuf:"fixed32,4,opt,name=shard,oneof"`
+}
+
+type Lookup_Parent struct {
+	Parent *Lookup `protobuf:"bytes,5,opt,name=parent,oneof"`
+}
+
+func (*Lookup_Shard) isLookup_By() {}
+
+func (*Lookup_Parent) isLookup_By() {}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Lookup) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.HasKey() {
+		vs.Set("key", x.GetKey())
+	}
+	if x.GetVersion() != 0 {
+		vs.Set("version", strconv.FormatInt(int64(x.GetVersion()), 10))
+	}
+	for _, v := range x.GetWeights() {
+		vs.Add("weights", strconv.FormatFloat(float64(v), 'g', -1, 32))
+	}
+	if x.HasShard() {
+		vs.Set("shard", strconv.FormatUint(uint64(x.GetShard()), 10))
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are ignored.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Lookup) FromURLValues(vs url.Values) error {
+	y := proto.CloneOf(x)
+	if s := vs["key"]; len(s) > 0 {
+		y.SetKey(s[0])
+	}
+	if s := vs["version"]; len(s) > 0 {
+		n, err := strconv.ParseInt(s[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "version", err)
+		}
+		y.SetVersion(int32(n))
+	}
+	if s, ok := vs["weights"]; ok {
+		l := make([]float32, len(s))
+		for i, s := range s {
+			n, err := strconv.ParseFloat(s, 32)
+			if err != nil {
+				return fmt.Errorf("query parameter %q: %v", "weights", err)
+			}
+			l[i] = float32(n)
+		}
+		y.SetWeights(l)
+	}
+	if s := vs["shard"]; len(s) > 0 {
+		n, err := strconv.ParseUint(s[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "shard", err)
+		}
+		y.SetShard(uint32(n))
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_rawDesc = "" +
+	"\n" +
+	"9cmd/protoc-gen-go/testdata/methods/urlvalues/hybrid.proto\x12 goproto.protoc.methods.urlvalues\x1a!google/protobuf/go_features.proto\"\xb7\x01\n" +
+	"\x06Lookup\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\aversion\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\aversion\x12\x18\n" +
+	"\aweights\x18\x03 \x03(\x02R\aweights\x12\x16\n" +
+	"\x05shard\x18\x04 \x01(\aH\x00R\x05shard\x12B\n" +
+	"\x06parent\x18\x05 \x01(\v2(.goproto.protoc.methods.urlvalues.LookupH\x00R\x06parentB\x04\n" +
+	"\x02byBQZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_goTypes = []any{
+	(*Lookup)(nil), // 0: goproto.protoc.methods.urlvalues.Lookup
+}
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.urlvalues.Lookup.parent:type_name -> goproto.protoc.methods.urlvalues.Lookup
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes[0].OneofWrappers = []any{
+		(*Lookup_Shard)(nil),
+		(*Lookup_Parent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_hybrid_proto_depIdxs = nil
+}
@@ -0,0 +1,346 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/urlvalues/unknown/unknown.proto
+
+package unknown
+
+import (
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	url "net/url"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Query struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty" form:"term" uri:"term"`
+	Years         []int32                `protobuf:"varint,2,rep,packed,name=years,proto3" json:"years,omitempty" form:"years" uri:"years"`
+	Page          *Query_Page            `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty" form:"page" uri:"page"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Query) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Query) GetYears() []int32 {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+func (x *Query) GetPage() *Query_Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Query) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.GetTerm() != "" {
+		vs.Set("term", x.GetTerm())
+	}
+	for _, v := range x.GetYears() {
+		vs.Add("years", strconv.FormatInt(int64(v), 10))
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Query) FromURLValues(vs url.Values) error {
+	for k := range vs {
+		switch k {
+		case "term", "years":
+		default:
+			return fmt.Errorf("unknown query parameter %q for message goproto.protoc.methods.urlvalues.unknown.Query", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s := vs["term"]; len(s) > 0 {
+		y.Term = s[0]
+	}
+	if s, ok := vs["years"]; ok {
+		l := make([]int32, len(s))
+		for i, s := range s {
+			n, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return fmt.Errorf("query parameter %q: %v", "years", err)
+			}
+			l[i] = int32(n)
+		}
+		y.Years = l
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescGZIP(), []int{1}
+}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Empty) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Empty) FromURLValues(vs url.Values) error {
+	for k := range vs {
+		return fmt.Errorf("unknown query parameter %q for message goproto.protoc.methods.urlvalues.unknown.Empty", k)
+	}
+	return nil
+}
+
+type Query_Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty" form:"size" uri:"size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Query_Page) Reset() {
+	*x = Query_Page{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Query_Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query_Page) ProtoMessage() {}
+
+func (x *Query_Page) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query_Page.ProtoReflect.Descriptor instead.
+func (*Query_Page) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Query_Page) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Query_Page) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.GetSize() != 0 {
+		vs.Set("size", strconv.FormatInt(int64(x.GetSize()), 10))
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are reported as an error.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Query_Page) FromURLValues(vs url.Values) error {
+	for k := range vs {
+		switch k {
+		case "size":
+		default:
+			return fmt.Errorf("unknown query parameter %q for message goproto.protoc.methods.urlvalues.unknown.Query.Page", k)
+		}
+	}
+	y := proto.CloneOf(x)
+	if s := vs["size"]; len(s) > 0 {
+		n, err := strconv.ParseInt(s[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "size", err)
+		}
+		y.Size = int32(n)
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDesc = "" +
+	"\n" +
+	"Bcmd/protoc-gen-go/testdata/methods/urlvalues/unknown/unknown.proto\x12(goproto.protoc.methods.urlvalues.unknown\"\x97\x01\n" +
+	"\x05Query\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x14\n" +
+	"\x05years\x18\x02 \x03(\x05R\x05years\x12H\n" +
+	"\x04page\x18\x03 \x01(\v24.goproto.protoc.methods.urlvalues.unknown.Query.PageR\x04page\x1a\x1a\n" +
+	"\x04Page\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\"\a\n" +
+	"\x05EmptyBQZOgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues/unknownb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_goTypes = []any{
+	(*Query)(nil),      // 0: goproto.protoc.methods.urlvalues.unknown.Query
+	(*Empty)(nil),      // 1: goproto.protoc.methods.urlvalues.unknown.Empty
+	(*Query_Page)(nil), // 2: goproto.protoc.methods.urlvalues.unknown.Query.Page
+}
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.urlvalues.unknown.Query.page:type_name -> goproto.protoc.methods.urlvalues.unknown.Query.Page
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_unknown_unknown_proto_depIdxs = nil
+}
@@ -0,0 +1,530 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/urlvalues/urlvalues.proto
+
+package urlvalues
+
+import (
+	base64 "encoding/base64"
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	url "net/url"
+	reflect "reflect"
+	strconv "strconv"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Search_Order int32
+
+const (
+	Search_ORDER_UNSPECIFIED Search_Order = 0
+	Search_ORDER_NEWEST      Search_Order = 1
+)
+
+// Enum value maps for Search_Order.
+var (
+	Search_Order_name = map[int32]string{
+		0: "ORDER_UNSPECIFIED",
+		1: "ORDER_NEWEST",
+	}
+	Search_Order_value = map[string]int32{
+		"ORDER_UNSPECIFIED": 0,
+		"ORDER_NEWEST":      1,
+	}
+)
+
+func (x Search_Order) Enum() *Search_Order {
+	p := new(Search_Order)
+	*p = x
+	return p
+}
+
+func (x Search_Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Search_Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_enumTypes[0].Descriptor()
+}
+
+func (Search_Order) Type() protoreflect.EnumType {
+	return &file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_enumTypes[0]
+}
+
+func (x Search_Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Search_Order.Descriptor instead.
+func (Search_Order) EnumDescriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Search struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Query    string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty" form:"query" uri:"query"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty" form:"page" uri:"page"`
+	Limit    *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty" form:"limit" uri:"limit"`
+	Exact    bool                   `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty" form:"exact" uri:"exact"`
+	MinScore float64                `protobuf:"fixed64,5,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty" form:"min_score" uri:"min_score"`
+	Order    Search_Order           `protobuf:"varint,6,opt,name=order,proto3,enum=goproto.protoc.methods.urlvalues.Search_Order" json:"order,omitempty" form:"order" uri:"order"`
+	Cursor   []byte                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty" form:"cursor" uri:"cursor"`
+	Tags     []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Ids      []int64                `protobuf:"zigzag64,9,rep,packed,name=ids,proto3" json:"ids,omitempty" form:"ids" uri:"ids"`
+	Filter   *Search_Filter         `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty" form:"filter" uri:"filter"`
+	Labels   map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Scope:
+	//
+	//	*Search_User
+	//	*Search_Group
+	Scope         isSearch_Scope `protobuf_oneof:"scope"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Search) Reset() {
+	*x = Search{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search) ProtoMessage() {}
+
+func (x *Search) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search.ProtoReflect.Descriptor instead.
+func (*Search) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Search) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Search) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Search) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *Search) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *Search) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *Search) GetOrder() Search_Order {
+	if x != nil {
+		return x.Order
+	}
+	return Search_ORDER_UNSPECIFIED
+}
+
+func (x *Search) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *Search) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Search) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Search) GetFilter() *Search_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Search) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Search) GetScope() isSearch_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *Search) GetUser() string {
+	if x != nil {
+		if x, ok := x.Scope.(*Search_User); ok {
+			return x.User
+		}
+	}
+	return ""
+}
+
+func (x *Search) GetGroup() *Search_Filter {
+	if x != nil {
+		if x, ok := x.Scope.(*Search_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+type isSearch_Scope interface {
+	isSearch_Scope()
+}
+
+type Search_User struct {
+	User string `protobuf:"bytes,12,opt,name=user,proto3,oneof"`
+}
+
+type Search_Group struct {
+	Group *Search_Filter `protobuf:"bytes,13,opt,name=group,proto3,oneof"`
+}
+
+func (*Search_User) isSearch_Scope() {}
+
+func (*Search_Group) isSearch_Scope() {}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Search) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.GetQuery() != "" {
+		vs.Set("query", x.GetQuery())
+	}
+	if x.GetPage() != 0 {
+		vs.Set("page", strconv.FormatInt(int64(x.GetPage()), 10))
+	}
+	if x.Limit != nil {
+		vs.Set("limit", strconv.FormatUint(x.GetLimit(), 10))
+	}
+	if x.GetExact() {
+		vs.Set("exact", strconv.FormatBool(x.GetExact()))
+	}
+	if x.GetMinScore() != 0 {
+		vs.Set("min_score", strconv.FormatFloat(x.GetMinScore(), 'g', -1, 64))
+	}
+	if x.GetOrder() != 0 {
+		vs.Set("order", strconv.FormatInt(int64(x.GetOrder()), 10))
+	}
+	if len(x.GetCursor()) > 0 {
+		vs.Set("cursor", base64.StdEncoding.EncodeToString(x.GetCursor()))
+	}
+	for _, v := range x.GetTags() {
+		vs.Add("tags", v)
+	}
+	for _, v := range x.GetIds() {
+		vs.Add("ids", strconv.FormatInt(v, 10))
+	}
+	if _, ok := x.GetScope().(*Search_User); ok {
+		vs.Set("user", x.GetUser())
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are ignored.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Search) FromURLValues(vs url.Values) error {
+	y := proto.CloneOf(x)
+	if s := vs["query"]; len(s) > 0 {
+		y.Query = s[0]
+	}
+	if s := vs["page"]; len(s) > 0 {
+		n, err := strconv.ParseInt(s[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "page", err)
+		}
+		y.Page = int32(n)
+	}
+	if s := vs["limit"]; len(s) > 0 {
+		v, err := strconv.ParseUint(s[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "limit", err)
+		}
+		y.Limit = &v
+	}
+	if s := vs["exact"]; len(s) > 0 {
+		v, err := strconv.ParseBool(s[0])
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "exact", err)
+		}
+		y.Exact = v
+	}
+	if s := vs["min_score"]; len(s) > 0 {
+		v, err := strconv.ParseFloat(s[0], 64)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "min_score", err)
+		}
+		y.MinScore = v
+	}
+	if s := vs["order"]; len(s) > 0 {
+		n, err := strconv.ParseInt(s[0], 10, 32)
+		if e, ok := Search_Order_value[s[0]]; ok {
+			n, err = int64(e), nil
+		}
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "order", err)
+		}
+		y.Order = Search_Order(n)
+	}
+	if s := vs["cursor"]; len(s) > 0 {
+		v, err := base64.StdEncoding.DecodeString(s[0])
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %v", "cursor", err)
+		}
+		y.Cursor = v
+	}
+	if s, ok := vs["tags"]; ok {
+		l := make([]string, len(s))
+		for i, s := range s {
+			l[i] = s
+		}
+		y.Tags = l
+	}
+	if s, ok := vs["ids"]; ok {
+		l := make([]int64, len(s))
+		for i, s := range s {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return fmt.Errorf("query parameter %q: %v", "ids", err)
+			}
+			l[i] = v
+		}
+		y.Ids = l
+	}
+	if s := vs["user"]; len(s) > 0 {
+		y.Scope = &Search_User{User: s[0]}
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+type Search_Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty" form:"field" uri:"field"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Search_Filter) Reset() {
+	*x = Search_Filter{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search_Filter) ProtoMessage() {}
+
+func (x *Search_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search_Filter.ProtoReflect.Descriptor instead.
+func (*Search_Filter) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Search_Filter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// URLValues returns the populated scalar fields of x as query parameters,
+// keyed by the form tag of each field. A repeated field is given by one value
+// per element. Enums are given by number, and bytes in standard base64.
+// Message and map fields are omitted.
+func (x *Search_Filter) URLValues() url.Values {
+	vs := url.Values{}
+	if x == nil {
+		return vs
+	}
+	if x.GetField() != "" {
+		vs.Set("field", x.GetField())
+	}
+	return vs
+}
+
+// FromURLValues sets the scalar fields of x from the query parameters in vs,
+// keyed by the form tag of each field. A singular field is set to the first
+// value of its key, and a repeated field is replaced by all of them. Enums
+// are given by name or number, and bytes in standard base64. Fields without
+// a key in vs are unchanged.
+// Keys which do not name a scalar field of x are ignored.
+// If a value cannot be parsed, an error is reported and x is left unchanged.
+func (x *Search_Filter) FromURLValues(vs url.Values) error {
+	y := proto.CloneOf(x)
+	if s := vs["field"]; len(s) > 0 {
+		y.Field = s[0]
+	}
+	proto.Reset(x)
+	proto.Merge(x, y)
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/urlvalues/urlvalues.proto\x12 goproto.protoc.methods.urlvalues\"\x9a\x05\n" +
+	"\x06Search\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x04H\x01R\x05limit\x88\x01\x01\x12\x14\n" +
+	"\x05exact\x18\x04 \x01(\bR\x05exact\x12\x1b\n" +
+	"\tmin_score\x18\x05 \x01(\x01R\bminScore\x12D\n" +
+	"\x05order\x18\x06 \x01(\x0e2..goproto.protoc.methods.urlvalues.Search.OrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\fR\x06cursor\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x10\n" +
+	"\x03ids\x18\t \x03(\x12R\x03ids\x12G\n" +
+	"\x06filter\x18\n" +
+	" \x01(\v2/.goproto.protoc.methods.urlvalues.Search.FilterR\x06filter\x12L\n" +
+	"\x06labels\x18\v \x03(\v24.goproto.protoc.methods.urlvalues.Search.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x04user\x18\f \x01(\tH\x00R\x04user\x12G\n" +
+	"\x05group\x18\r \x01(\v2/.goproto.protoc.methods.urlvalues.Search.FilterH\x00R\x05group\x1a\x1e\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x05Order\x12\x15\n" +
+	"\x11ORDER_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fORDER_NEWEST\x10\x01B\a\n" +
+	"\x05scopeB\b\n" +
+	"\x06_limitBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvaluesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_goTypes = []any{
+	(Search_Order)(0),     // 0: goproto.protoc.methods.urlvalues.Search.Order
+	(*Search)(nil),        // 1: goproto.protoc.methods.urlvalues.Search
+	(*Search_Filter)(nil), // 2: goproto.protoc.methods.urlvalues.Search.Filter
+	nil,                   // 3: goproto.protoc.methods.urlvalues.Search.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.urlvalues.Search.order:type_name -> goproto.protoc.methods.urlvalues.Search.Order
+	2, // 1: goproto.protoc.methods.urlvalues.Search.filter:type_name -> goproto.protoc.methods.urlvalues.Search.Filter
+	3, // 2: goproto.protoc.methods.urlvalues.Search.labels:type_name -> goproto.protoc.methods.urlvalues.Search.LabelsEntry
+	2, // 3: goproto.protoc.methods.urlvalues.Search.group:type_name -> goproto.protoc.methods.urlvalues.Search.Filter
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes[0].OneofWrappers = []any{
+		(*Search_User)(nil),
+		(*Search_Group)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_depIdxs,
+		EnumInfos:         file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_enumTypes,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_urlvalues_urlvalues_proto_depIdxs = nil
+}
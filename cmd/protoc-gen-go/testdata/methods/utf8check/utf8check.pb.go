@@ -0,0 +1,395 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/utf8check/utf8check.proto
+
+package utf8check
+
+import (
+	fmt "fmt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+type Document struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" form:"title" uri:"title"`
+	Subtitle   *string                `protobuf:"bytes,2,opt,name=subtitle,proto3,oneof" json:"subtitle,omitempty" form:"subtitle" uri:"subtitle"`
+	Tags       []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Labels     map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sections   map[int32]*Section     `protobuf:"bytes,5,rep,name=sections,proto3" json:"sections,omitempty" form:"sections" uri:"sections" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Summary    *Section               `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty" form:"summary" uri:"summary"`
+	Appendices []*Section             `protobuf:"bytes,7,rep,name=appendices,proto3" json:"appendices,omitempty" form:"appendices" uri:"appendices"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Document_Text
+	//	*Document_Section
+	Body          isDocument_Body  `protobuf_oneof:"body"`
+	Raw           []byte           `protobuf:"bytes,10,opt,name=raw,proto3" json:"raw,omitempty" form:"raw" uri:"raw"`
+	Counts        map[string]int64 `protobuf:"bytes,11,rep,name=counts,proto3" json:"counts,omitempty" form:"counts" uri:"counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Metadata      *structpb.Struct `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty" form:"metadata" uri:"metadata"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Document) GetSubtitle() string {
+	if x != nil && x.Subtitle != nil {
+		return *x.Subtitle
+	}
+	return ""
+}
+
+func (x *Document) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Document) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Document) GetSections() map[int32]*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Document) GetSummary() *Section {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Document) GetAppendices() []*Section {
+	if x != nil {
+		return x.Appendices
+	}
+	return nil
+}
+
+func (x *Document) GetBody() isDocument_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Document) GetText() string {
+	if x != nil {
+		if x, ok := x.Body.(*Document_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Document) GetSection() *Section {
+	if x != nil {
+		if x, ok := x.Body.(*Document_Section); ok {
+			return x.Section
+		}
+	}
+	return nil
+}
+
+func (x *Document) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *Document) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Document) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isDocument_Body interface {
+	isDocument_Body()
+}
+
+type Document_Text struct {
+	Text string `protobuf:"bytes,8,opt,name=text,proto3,oneof"`
+}
+
+type Document_Section struct {
+	Section *Section `protobuf:"bytes,9,opt,name=section,proto3,oneof"`
+}
+
+func (*Document_Text) isDocument_Body() {}
+
+func (*Document_Section) isDocument_Body() {}
+
+// ValidateUTF8 reports an error naming the first string field of x, or of
+// its nested messages, which holds invalid UTF-8. The elements of repeated
+// fields and the keys and values of map fields are checked. Fields are
+// checked regardless of their utf8_validation feature.
+func (x *Document) ValidateUTF8() error {
+	if x == nil {
+		return nil
+	}
+	if !utf8.ValidString(x.GetTitle()) {
+		return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.title contains invalid UTF-8")
+	}
+	if !utf8.ValidString(x.GetSubtitle()) {
+		return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.subtitle contains invalid UTF-8")
+	}
+	for _, v := range x.GetTags() {
+		if !utf8.ValidString(v) {
+			return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.tags contains invalid UTF-8")
+		}
+	}
+	for k, v := range x.GetLabels() {
+		if !utf8.ValidString(k) || !utf8.ValidString(v) {
+			return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.labels contains invalid UTF-8")
+		}
+	}
+	for _, v := range x.GetSections() {
+		if err := v.ValidateUTF8(); err != nil {
+			return err
+		}
+	}
+	if err := x.GetSummary().ValidateUTF8(); err != nil {
+		return err
+	}
+	for _, v := range x.GetAppendices() {
+		if err := v.ValidateUTF8(); err != nil {
+			return err
+		}
+	}
+	if !utf8.ValidString(x.GetText()) {
+		return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.text contains invalid UTF-8")
+	}
+	if err := x.GetSection().ValidateUTF8(); err != nil {
+		return err
+	}
+	for k := range x.GetCounts() {
+		if !utf8.ValidString(k) {
+			return fmt.Errorf("field goproto.protoc.methods.utf8check.Document.counts contains invalid UTF-8")
+		}
+	}
+	if m, ok := any(x.GetMetadata()).(interface{ ValidateUTF8() error }); ok {
+		if err := m.ValidateUTF8(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Heading       string                 `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty" form:"heading" uri:"heading"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Section) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+// ValidateUTF8 reports an error naming the first string field of x, or of
+// its nested messages, which holds invalid UTF-8. The elements of repeated
+// fields and the keys and values of map fields are checked. Fields are
+// checked regardless of their utf8_validation feature.
+func (x *Section) ValidateUTF8() error {
+	if x == nil {
+		return nil
+	}
+	if !utf8.ValidString(x.GetHeading()) {
+		return fmt.Errorf("field goproto.protoc.methods.utf8check.Section.heading contains invalid UTF-8")
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/utf8check/utf8check.proto\x12 goproto.protoc.methods.utf8check\x1a\x1cgoogle/protobuf/struct.proto\"\xf2\x06\n" +
+	"\bDocument\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
+	"\bsubtitle\x18\x02 \x01(\tH\x01R\bsubtitle\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12N\n" +
+	"\x06labels\x18\x04 \x03(\v26.goproto.protoc.methods.utf8check.Document.LabelsEntryR\x06labels\x12T\n" +
+	"\bsections\x18\x05 \x03(\v28.goproto.protoc.methods.utf8check.Document.SectionsEntryR\bsections\x12C\n" +
+	"\asummary\x18\x06 \x01(\v2).goproto.protoc.methods.utf8check.SectionR\asummary\x12I\n" +
+	"\n" +
+	"appendices\x18\a \x03(\v2).goproto.protoc.methods.utf8check.SectionR\n" +
+	"appendices\x12\x14\n" +
+	"\x04text\x18\b \x01(\tH\x00R\x04text\x12E\n" +
+	"\asection\x18\t \x01(\v2).goproto.protoc.methods.utf8check.SectionH\x00R\asection\x12\x10\n" +
+	"\x03raw\x18\n" +
+	" \x01(\fR\x03raw\x12N\n" +
+	"\x06counts\x18\v \x03(\v26.goproto.protoc.methods.utf8check.Document.CountsEntryR\x06counts\x123\n" +
+	"\bmetadata\x18\f \x01(\v2\x17.google.protobuf.StructR\bmetadata\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1af\n" +
+	"\rSectionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).goproto.protoc.methods.utf8check.SectionR\x05value:\x028\x01\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x06\n" +
+	"\x04bodyB\v\n" +
+	"\t_subtitle\"#\n" +
+	"\aSection\x12\x18\n" +
+	"\aheading\x18\x01 \x01(\tR\aheadingBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/utf8checkb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_goTypes = []any{
+	(*Document)(nil),        // 0: goproto.protoc.methods.utf8check.Document
+	(*Section)(nil),         // 1: goproto.protoc.methods.utf8check.Section
+	nil,                     // 2: goproto.protoc.methods.utf8check.Document.LabelsEntry
+	nil,                     // 3: goproto.protoc.methods.utf8check.Document.SectionsEntry
+	nil,                     // 4: goproto.protoc.methods.utf8check.Document.CountsEntry
+	(*structpb.Struct)(nil), // 5: google.protobuf.Struct
+}
+var file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.methods.utf8check.Document.labels:type_name -> goproto.protoc.methods.utf8check.Document.LabelsEntry
+	3, // 1: goproto.protoc.methods.utf8check.Document.sections:type_name -> goproto.protoc.methods.utf8check.Document.SectionsEntry
+	1, // 2: goproto.protoc.methods.utf8check.Document.summary:type_name -> goproto.protoc.methods.utf8check.Section
+	1, // 3: goproto.protoc.methods.utf8check.Document.appendices:type_name -> goproto.protoc.methods.utf8check.Section
+	1, // 4: goproto.protoc.methods.utf8check.Document.section:type_name -> goproto.protoc.methods.utf8check.Section
+	4, // 5: goproto.protoc.methods.utf8check.Document.counts:type_name -> goproto.protoc.methods.utf8check.Document.CountsEntry
+	5, // 6: goproto.protoc.methods.utf8check.Document.metadata:type_name -> google.protobuf.Struct
+	1, // 7: goproto.protoc.methods.utf8check.Document.SectionsEntry.value:type_name -> goproto.protoc.methods.utf8check.Section
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes[0].OneofWrappers = []any{
+		(*Document_Text)(nil),
+		(*Document_Section)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_utf8check_utf8check_proto_depIdxs = nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/wirehex/wirehex.proto
+
+package wirehex
+
+import (
+	hex "encoding/hex"
+	fmt "fmt"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" form:"id" uri:"id"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty" form:"title" uri:"title"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Note          *Ticket_Note           `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty" form:"note" uri:"note"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ticket) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Ticket) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Ticket) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Ticket) GetNote() *Ticket_Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// WireHex returns the hexadecimal encoding of the wire format of x. The
+// message is marshaled deterministically, so equal messages have the same
+// encoding.
+func (x *Ticket) WireHex() (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// TicketFromWireHex returns the message whose wire format has the hexadecimal
+// encoding s, as returned by WireHex.
+func TicketFromWireHex(s string) (*Ticket, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("goproto.protoc.methods.wirehex.Ticket: invalid wire hex: %w", err)
+	}
+	x := new(Ticket)
+	if err := proto.Unmarshal(b, x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ticket_Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          []byte                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty" form:"body" uri:"body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket_Note) Reset() {
+	*x = Ticket_Note{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket_Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket_Note) ProtoMessage() {}
+
+func (x *Ticket_Note) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket_Note.ProtoReflect.Descriptor instead.
+func (*Ticket_Note) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Ticket_Note) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// WireHex returns the hexadecimal encoding of the wire format of x. The
+// message is marshaled deterministically, so equal messages have the same
+// encoding.
+func (x *Ticket_Note) WireHex() (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Ticket_NoteFromWireHex returns the message whose wire format has the hexadecimal
+// encoding s, as returned by WireHex.
+func Ticket_NoteFromWireHex(s string) (*Ticket_Note, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("goproto.protoc.methods.wirehex.Ticket.Note: invalid wire hex: %w", err)
+	}
+	x := new(Ticket_Note)
+	if err := proto.Unmarshal(b, x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+var File_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDesc = "" +
+	"\n" +
+	"8cmd/protoc-gen-go/testdata/methods/wirehex/wirehex.proto\x12\x1egoproto.protoc.methods.wirehex\"\x92\x02\n" +
+	"\x06Ticket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12J\n" +
+	"\x06labels\x18\x03 \x03(\v22.goproto.protoc.methods.wirehex.Ticket.LabelsEntryR\x06labels\x12?\n" +
+	"\x04note\x18\x04 \x01(\v2+.goproto.protoc.methods.wirehex.Ticket.NoteR\x04note\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x1a\n" +
+	"\x04Note\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\fR\x04bodyBGZEgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wirehexb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_goTypes = []any{
+	(*Ticket)(nil),      // 0: goproto.protoc.methods.wirehex.Ticket
+	nil,                 // 1: goproto.protoc.methods.wirehex.Ticket.LabelsEntry
+	(*Ticket_Note)(nil), // 2: goproto.protoc.methods.wirehex.Ticket.Note
+}
+var file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.methods.wirehex.Ticket.labels:type_name -> goproto.protoc.methods.wirehex.Ticket.LabelsEntry
+	2, // 1: goproto.protoc.methods.wirehex.Ticket.note:type_name -> goproto.protoc.methods.wirehex.Ticket.Note
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_wirehex_wirehex_proto_depIdxs = nil
+}
@@ -0,0 +1,312 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/methods/wireorder/wireorder.proto
+
+package wireorder
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Record struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  *string                `protobuf:"bytes,5,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	Id    *int32                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty" form:"id" uri:"id"`
+	Tags  []string               `protobuf:"bytes,12,rep,name=tags" json:"tags,omitempty" form:"tags" uri:"tags"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Record_Text
+	//	*Record_Number
+	Value           isRecord_Value `protobuf_oneof:"value"`
+	Flag            *bool          `protobuf:"varint,2,opt,name=flag" json:"flag,omitempty" form:"flag" uri:"flag"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Record) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Record) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Record) GetValue() isRecord_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Record) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*Record_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Record) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*Record_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *Record) GetFlag() bool {
+	if x != nil && x.Flag != nil {
+		return *x.Flag
+	}
+	return false
+}
+
+type isRecord_Value interface {
+	isRecord_Value()
+}
+
+type Record_Text struct {
+	Text string `protobuf:"bytes,9,opt,name=text,oneof"`
+}
+
+type Record_Number struct {
+	Number int64 `protobuf:"varint,3,opt,name=number,oneof"`
+}
+
+func (*Record_Text) isRecord_Value() {}
+
+func (*Record_Number) isRecord_Value() {}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Record_wireOrderOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Record_wireOrder     []protoreflect.FieldDescriptor
+)
+
+// FieldsInWireOrder returns the descriptors of the fields of Record and of the
+// extensions of Record declared in the same file, sorted by field number.
+// The slice is shared by all callers and must not be modified.
+func (*Record) FieldsInWireOrder() []protoreflect.FieldDescriptor {
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Record_wireOrderOnce.Do(func() {
+		fds := file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[0].Descriptor().Fields()
+		s := make([]protoreflect.FieldDescriptor, 0, fds.Len())
+		for i := 0; i < fds.Len(); i++ {
+			s = append(s, fds.Get(i))
+		}
+		s = append(s, file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes[0].TypeDescriptor())
+		s = append(s, file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes[1].TypeDescriptor())
+		sort.Slice(s, func(i, j int) bool { return s[i].Number() < s[j].Number() })
+		file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Record_wireOrder = s
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Record_wireOrder
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescGZIP(), []int{1}
+}
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Empty_wireOrderOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Empty_wireOrder     []protoreflect.FieldDescriptor
+)
+
+// FieldsInWireOrder returns the descriptors of the fields of Empty and of the
+// extensions of Empty declared in the same file, sorted by field number.
+// The slice is shared by all callers and must not be modified.
+func (*Empty) FieldsInWireOrder() []protoreflect.FieldDescriptor {
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Empty_wireOrderOnce.Do(func() {
+		fds := file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[1].Descriptor().Fields()
+		s := make([]protoreflect.FieldDescriptor, 0, fds.Len())
+		for i := 0; i < fds.Len(); i++ {
+			s = append(s, fds.Get(i))
+		}
+		sort.Slice(s, func(i, j int) bool { return s[i].Number() < s[j].Number() })
+		file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Empty_wireOrder = s
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_Empty_wireOrder
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Record)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         150,
+		Name:          "goproto.protoc.methods.wireorder.note",
+		Tag:           "bytes,150,opt,name=note",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/wireorder/wireorder.proto",
+	},
+	{
+		ExtendedType:  (*Record)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         101,
+		Name:          "goproto.protoc.methods.wireorder.priority",
+		Tag:           "varint,101,opt,name=priority",
+		Filename:      "cmd/protoc-gen-go/testdata/methods/wireorder/wireorder.proto",
+	},
+}
+
+// Extension fields to Record.
+var (
+	// optional string note = 150;
+	E_Note = &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes[0]
+	// optional int32 priority = 101;
+	E_Priority = &file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes[1]
+)
+
+var File_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/methods/wireorder/wireorder.proto\x12 goproto.protoc.methods.wireorder\"\x94\x01\n" +
+	"\x06Record\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x14\n" +
+	"\x04text\x18\t \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x03 \x01(\x03H\x00R\x06number\x12\x12\n" +
+	"\x04flag\x18\x02 \x01(\bR\x04flag*\x05\bd\x10\xc8\x01B\a\n" +
+	"\x05value\"\a\n" +
+	"\x05Empty:=\n" +
+	"\x04note\x12(.goproto.protoc.methods.wireorder.Record\x18\x96\x01 \x01(\tR\x04note:D\n" +
+	"\bpriority\x12(.goproto.protoc.methods.wireorder.Record\x18e \x01(\x05R\bpriorityBIZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wireorder"
+
+var (
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_goTypes = []any{
+	(*Record)(nil), // 0: goproto.protoc.methods.wireorder.Record
+	(*Empty)(nil),  // 1: goproto.protoc.methods.wireorder.Empty
+}
+var file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.methods.wireorder.note:extendee -> goproto.protoc.methods.wireorder.Record
+	0, // 1: goproto.protoc.methods.wireorder.priority:extendee -> goproto.protoc.methods.wireorder.Record
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_init() }
+func file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes[0].OneofWrappers = []any{
+		(*Record_Text)(nil),
+		(*Record_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_msgTypes,
+		ExtensionInfos:    file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_extTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto = out.File
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_methods_wireorder_wireorder_proto_depIdxs = nil
+}
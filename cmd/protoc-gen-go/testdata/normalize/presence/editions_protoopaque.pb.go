@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/normalize/presence/editions.proto
+
+//go:build protoopaque
+
+package presence
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Measurement struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value       float64                `protobuf:"fixed64,1,opt,name=value"`
+	xxx_hidden_Offset      float64                `protobuf:"fixed64,2,opt,name=offset"`
+	xxx_hidden_Raw         []byte                 `protobuf:"bytes,3,opt,name=raw"`
+	xxx_hidden_Previous    *Measurement           `protobuf:"bytes,4,opt,name=previous"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Measurement) Reset() {
+	*x = Measurement{}
+	mi := &file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Measurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measurement) ProtoMessage() {}
+
+func (x *Measurement) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Measurement) GetValue() float64 {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return 0
+}
+
+func (x *Measurement) GetOffset() float64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *Measurement) GetRaw() []byte {
+	if x != nil {
+		return x.xxx_hidden_Raw
+	}
+	return nil
+}
+
+func (x *Measurement) GetPrevious() *Measurement {
+	if x != nil {
+		return x.xxx_hidden_Previous
+	}
+	return nil
+}
+
+func (x *Measurement) SetValue(v float64) {
+	x.xxx_hidden_Value = v
+}
+
+func (x *Measurement) SetOffset(v float64) {
+	x.xxx_hidden_Offset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *Measurement) SetRaw(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Raw = v
+}
+
+func (x *Measurement) SetPrevious(v *Measurement) {
+	x.xxx_hidden_Previous = v
+}
+
+func (x *Measurement) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Measurement) HasPrevious() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Previous != nil
+}
+
+func (x *Measurement) ClearOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Offset = 0
+}
+
+func (x *Measurement) ClearPrevious() {
+	x.xxx_hidden_Previous = nil
+}
+
+type Measurement_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Value    float64
+	Offset   *float64
+	Raw      []byte
+	Previous *Measurement
+}
+
+func (b0 Measurement_builder) Build() *Measurement {
+	m0 := &Measurement{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Value = b.Value
+	if b.Offset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Offset = *b.Offset
+	}
+	x.xxx_hidden_Raw = b.Raw
+	x.xxx_hidden_Previous = b.Previous
+	return m0
+}
+
+// NormalizePresence clears the fields of x and of its nested messages which
+// have implicit presence and equal the default value of their type, so that
+// they are not marshaled. A field has implicit presence if it is a singular
+// scalar field, other than a oneof member, declared without the optional
+// label in proto3, or with features.field_presence = IMPLICIT in editions.
+// Fields of proto2 messages, message fields and repeated and map fields
+// are not affected. Since only negative zero and empty bytes values equal
+// the default of their type without being identical to it, other fields
+// are left unchanged.
+func (x *Measurement) NormalizePresence() {
+	if x == nil {
+		return
+	}
+	if x.GetValue() == 0 { // also true for negative zero
+		x.SetValue(0)
+	}
+	x.GetPrevious().NormalizePresence()
+}
+
+var File_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/normalize/presence/editions.proto\x12!goproto.protoc.normalize.presence\x1a!google/protobuf/go_features.proto\"\xa7\x01\n" +
+	"\vMeasurement\x12\x1b\n" +
+	"\x05value\x18\x01 \x01(\x01B\x05\xaa\x01\x02\b\x02R\x05value\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x01R\x06offset\x12\x17\n" +
+	"\x03raw\x18\x03 \x01(\fB\x05\xaa\x01\x02\b\x02R\x03raw\x12J\n" +
+	"\bprevious\x18\x04 \x01(\v2..goproto.protoc.normalize.presence.MeasurementR\bpreviousBRZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/normalize/presence\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_goTypes = []any{
+	(*Measurement)(nil), // 0: goproto.protoc.normalize.presence.Measurement
+}
+var file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.normalize.presence.Measurement.previous:type_name -> goproto.protoc.normalize.presence.Measurement
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_init() }
+func file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto = out.File
+	file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_normalize_presence_editions_proto_depIdxs = nil
+}
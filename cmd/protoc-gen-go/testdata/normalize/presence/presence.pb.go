@@ -0,0 +1,285 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/normalize/presence/presence.proto
+
+package presence
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Reading struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Sensor      string                 `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty" form:"sensor" uri:"sensor"`
+	Value       float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty" form:"value" uri:"value"`
+	Offset      *float64               `protobuf:"fixed64,3,opt,name=offset,proto3,oneof" json:"offset,omitempty" form:"offset" uri:"offset"`
+	Scale       float32                `protobuf:"fixed32,4,opt,name=scale,proto3" json:"scale,omitempty" form:"scale" uri:"scale"`
+	Raw         []byte                 `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty" form:"raw" uri:"raw"`
+	Checksum    []byte                 `protobuf:"bytes,6,opt,name=checksum,proto3,oneof" json:"checksum,omitempty" form:"checksum" uri:"checksum"`
+	Calibration *Reading               `protobuf:"bytes,7,opt,name=calibration,proto3" json:"calibration,omitempty" form:"calibration" uri:"calibration"`
+	Samples     []*Reading             `protobuf:"bytes,8,rep,name=samples,proto3" json:"samples,omitempty" form:"samples" uri:"samples"`
+	ByUnit      map[string]*Reading    `protobuf:"bytes,9,rep,name=by_unit,json=byUnit,proto3" json:"by_unit,omitempty" form:"by_unit" uri:"by_unit" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Reading_DerivedFrom
+	//	*Reading_Constant
+	Source        isReading_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reading) Reset() {
+	*x = Reading{}
+	mi := &file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reading) ProtoMessage() {}
+
+func (x *Reading) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reading.ProtoReflect.Descriptor instead.
+func (*Reading) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reading) GetSensor() string {
+	if x != nil {
+		return x.Sensor
+	}
+	return ""
+}
+
+func (x *Reading) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Reading) GetOffset() float64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *Reading) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *Reading) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *Reading) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *Reading) GetCalibration() *Reading {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+func (x *Reading) GetSamples() []*Reading {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *Reading) GetByUnit() map[string]*Reading {
+	if x != nil {
+		return x.ByUnit
+	}
+	return nil
+}
+
+func (x *Reading) GetSource() isReading_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Reading) GetDerivedFrom() *Reading {
+	if x != nil {
+		if x, ok := x.Source.(*Reading_DerivedFrom); ok {
+			return x.DerivedFrom
+		}
+	}
+	return nil
+}
+
+func (x *Reading) GetConstant() float64 {
+	if x != nil {
+		if x, ok := x.Source.(*Reading_Constant); ok {
+			return x.Constant
+		}
+	}
+	return 0
+}
+
+type isReading_Source interface {
+	isReading_Source()
+}
+
+type Reading_DerivedFrom struct {
+	DerivedFrom *Reading `protobuf:"bytes,10,opt,name=derived_from,json=derivedFrom,proto3,oneof"`
+}
+
+type Reading_Constant struct {
+	Constant float64 `protobuf:"fixed64,11,opt,name=constant,proto3,oneof"`
+}
+
+func (*Reading_DerivedFrom) isReading_Source() {}
+
+func (*Reading_Constant) isReading_Source() {}
+
+// NormalizePresence clears the fields of x and of its nested messages which
+// have implicit presence and equal the default value of their type, so that
+// they are not marshaled. A field has implicit presence if it is a singular
+// scalar field, other than a oneof member, declared without the optional
+// label in proto3, or with features.field_presence = IMPLICIT in editions.
+// Fields of proto2 messages, message fields and repeated and map fields
+// are not affected. Since only negative zero and empty bytes values equal
+// the default of their type without being identical to it, other fields
+// are left unchanged.
+func (x *Reading) NormalizePresence() {
+	if x == nil {
+		return
+	}
+	if x.Value == 0 { // also true for negative zero
+		x.Value = 0
+	}
+	if x.Scale == 0 { // also true for negative zero
+		x.Scale = 0
+	}
+	if x.Raw != nil && len(x.Raw) == 0 {
+		x.Raw = nil
+	}
+	x.Calibration.NormalizePresence()
+	for _, v := range x.Samples {
+		v.NormalizePresence()
+	}
+	for _, v := range x.ByUnit {
+		v.NormalizePresence()
+	}
+	x.GetDerivedFrom().NormalizePresence()
+}
+
+var File_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDesc = "" +
+	"\n" +
+	"<cmd/protoc-gen-go/testdata/normalize/presence/presence.proto\x12!goproto.protoc.normalize.presence\"\xfa\x04\n" +
+	"\aReading\x12\x16\n" +
+	"\x06sensor\x18\x01 \x01(\tR\x06sensor\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1b\n" +
+	"\x06offset\x18\x03 \x01(\x01H\x01R\x06offset\x88\x01\x01\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\x02R\x05scale\x12\x10\n" +
+	"\x03raw\x18\x05 \x01(\fR\x03raw\x12\x1f\n" +
+	"\bchecksum\x18\x06 \x01(\fH\x02R\bchecksum\x88\x01\x01\x12L\n" +
+	"\vcalibration\x18\a \x01(\v2*.goproto.protoc.normalize.presence.ReadingR\vcalibration\x12D\n" +
+	"\asamples\x18\b \x03(\v2*.goproto.protoc.normalize.presence.ReadingR\asamples\x12O\n" +
+	"\aby_unit\x18\t \x03(\v26.goproto.protoc.normalize.presence.Reading.ByUnitEntryR\x06byUnit\x12O\n" +
+	"\fderived_from\x18\n" +
+	" \x01(\v2*.goproto.protoc.normalize.presence.ReadingH\x00R\vderivedFrom\x12\x1c\n" +
+	"\bconstant\x18\v \x01(\x01H\x00R\bconstant\x1ae\n" +
+	"\vByUnitEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.goproto.protoc.normalize.presence.ReadingR\x05value:\x028\x01B\b\n" +
+	"\x06sourceB\t\n" +
+	"\a_offsetB\v\n" +
+	"\t_checksumBJZHgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/normalize/presenceb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_goTypes = []any{
+	(*Reading)(nil), // 0: goproto.protoc.normalize.presence.Reading
+	nil,             // 1: goproto.protoc.normalize.presence.Reading.ByUnitEntry
+}
+var file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.normalize.presence.Reading.calibration:type_name -> goproto.protoc.normalize.presence.Reading
+	0, // 1: goproto.protoc.normalize.presence.Reading.samples:type_name -> goproto.protoc.normalize.presence.Reading
+	1, // 2: goproto.protoc.normalize.presence.Reading.by_unit:type_name -> goproto.protoc.normalize.presence.Reading.ByUnitEntry
+	0, // 3: goproto.protoc.normalize.presence.Reading.derived_from:type_name -> goproto.protoc.normalize.presence.Reading
+	0, // 4: goproto.protoc.normalize.presence.Reading.ByUnitEntry.value:type_name -> goproto.protoc.normalize.presence.Reading
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_init() }
+func file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_msgTypes[0].OneofWrappers = []any{
+		(*Reading_DerivedFrom)(nil),
+		(*Reading_Constant)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto = out.File
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_normalize_presence_presence_proto_depIdxs = nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/omitstring/omitstring.proto
+
+package omitstring
+
+import (
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Upload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty" form:"payload" uri:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upload) Reset() {
+	*x = Upload{}
+	mi := &file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+// String returns the full name of the message type. The contents of the
+// message are omitted by the omit_string option; use prototext.Format
+// to render them.
+func (x *Upload) String() string {
+	return "goproto.protoc.omitstring.Upload"
+}
+
+func (*Upload) ProtoMessage() {}
+
+func (x *Upload) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upload.ProtoReflect.Descriptor instead.
+func (*Upload) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Upload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Upload) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type Receipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Upload        *Upload                `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty" form:"upload" uri:"upload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Receipt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Receipt) GetUpload() *Upload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+var File_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDesc = "" +
+	"\n" +
+	"6cmd/protoc-gen-go/testdata/omitstring/omitstring.proto\x12\x19goproto.protoc.omitstring\x1a0cmd/protoc-gen-go/testdata/options/options.proto\"<\n" +
+	"\x06Upload\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload:\x04\xe8\xf3\x18\x01\"X\n" +
+	"\aReceipt\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\x06upload\x18\x02 \x01(\v2!.goproto.protoc.omitstring.UploadR\x06uploadBBZ@google.golang.org/protobuf/cmd/protoc-gen-go/testdata/omitstringb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_goTypes = []any{
+	(*Upload)(nil),  // 0: goproto.protoc.omitstring.Upload
+	(*Receipt)(nil), // 1: goproto.protoc.omitstring.Receipt
+}
+var file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.omitstring.Receipt.upload:type_name -> goproto.protoc.omitstring.Upload
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_init() }
+func file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto = out.File
+	file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_omitstring_omitstring_proto_depIdxs = nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/oneofs/value/value.proto
+
+package value
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Payload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*Payload_Text
+	//	*Payload_Number
+	//	*Payload_Nested
+	Content isPayload_Content `protobuf_oneof:"content"`
+	// Types that are valid to be assigned to Other:
+	//
+	//	*Payload_Flag
+	Other         isPayload_Other `protobuf_oneof:"other"`
+	Count         *int32          `protobuf:"varint,5,opt,name=count,proto3,oneof" json:"count,omitempty" form:"count" uri:"count"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payload) GetContent() isPayload_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Payload) GetText() string {
+	if x != nil {
+		if x, ok := x.Content.(*Payload_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Payload) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Content.(*Payload_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *Payload) GetNested() *Payload {
+	if x != nil {
+		if x, ok := x.Content.(*Payload_Nested); ok {
+			return x.Nested
+		}
+	}
+	return nil
+}
+
+func (x *Payload) GetOther() isPayload_Other {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *Payload) GetFlag() bool {
+	if x != nil {
+		if x, ok := x.Other.(*Payload_Flag); ok {
+			return x.Flag
+		}
+	}
+	return false
+}
+
+func (x *Payload) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type isPayload_Content interface {
+	isPayload_Content()
+}
+
+type Payload_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Payload_Number struct {
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3,oneof"`
+}
+
+type Payload_Nested struct {
+	Nested *Payload `protobuf:"bytes,3,opt,name=nested,proto3,oneof"`
+}
+
+func (*Payload_Text) isPayload_Content() {}
+
+func (*Payload_Number) isPayload_Content() {}
+
+func (*Payload_Nested) isPayload_Content() {}
+
+type isPayload_Other interface {
+	isPayload_Other()
+}
+
+type Payload_Flag struct {
+	Flag bool `protobuf:"varint,4,opt,name=flag,proto3,oneof"`
+}
+
+func (*Payload_Flag) isPayload_Other() {}
+
+// GetContentValue returns the value of the member of the content oneof
+// which is set, or nil if none of them is set.
+func (x *Payload) GetContentValue() any {
+	if x == nil {
+		return nil
+	}
+	switch x.Content.(type) {
+	case *Payload_Text:
+		return x.GetText()
+	case *Payload_Number:
+		return x.GetNumber()
+	case *Payload_Nested:
+		return x.GetNested()
+	default:
+		return nil
+	}
+}
+
+// GetOtherValue returns the value of the member of the other oneof
+// which is set, or nil if none of them is set.
+func (x *Payload) GetOtherValue() any {
+	if x == nil {
+		return nil
+	}
+	switch x.Other.(type) {
+	case *Payload_Flag:
+		return x.GetFlag()
+	default:
+		return nil
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_oneofs_value_value_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDesc = "" +
+	"\n" +
+	"3cmd/protoc-gen-go/testdata/oneofs/value/value.proto\x12\x1bgoproto.protoc.oneofs.value\"\xc8\x01\n" +
+	"\aPayload\x12\x14\n" +
+	"\x04text\x18\x01 \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x02 \x01(\x03H\x00R\x06number\x12>\n" +
+	"\x06nested\x18\x03 \x01(\v2$.goproto.protoc.oneofs.value.PayloadH\x00R\x06nested\x12\x14\n" +
+	"\x04flag\x18\x04 \x01(\bH\x01R\x04flag\x12\x19\n" +
+	"\x05count\x18\x05 \x01(\x05H\x02R\x05count\x88\x01\x01B\t\n" +
+	"\acontentB\a\n" +
+	"\x05otherB\b\n" +
+	"\x06_countBDZBgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/oneofs/valueb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_goTypes = []any{
+	(*Payload)(nil), // 0: goproto.protoc.oneofs.value.Payload
+}
+var file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_depIdxs = []int32{
+	0, // 0: goproto.protoc.oneofs.value.Payload.nested:type_name -> goproto.protoc.oneofs.value.Payload
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_init() }
+func file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_oneofs_value_value_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_msgTypes[0].OneofWrappers = []any{
+		(*Payload_Text)(nil),
+		(*Payload_Number)(nil),
+		(*Payload_Nested)(nil),
+		(*Payload_Flag)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_oneofs_value_value_proto = out.File
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_oneofs_value_value_proto_depIdxs = nil
+}
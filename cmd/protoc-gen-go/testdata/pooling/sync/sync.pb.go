@@ -0,0 +1,247 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/pooling/sync/sync.proto
+
+package sync
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Values        []int64                `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty" form:"values" uri:"values"`
+	Attributes    []*Event_Attribute     `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" form:"attributes" uri:"attributes"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" form:"labels" uri:"labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Event) GetAttributes() []*Event_Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Event) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// EventPool is a pool of Event messages, used by GetEvent and PutEvent.
+var EventPool = sync.Pool{New: func() any { return new(Event) }}
+
+// GetEvent returns an empty Event from EventPool.
+func GetEvent() *Event {
+	return EventPool.Get().(*Event)
+}
+
+// PutEvent resets x and returns it to EventPool.
+// The capacity of the repeated and map fields of x is retained.
+// x must not be used, or retained anywhere, after it is returned to the pool.
+func PutEvent(x *Event) {
+	if x == nil {
+		return
+	}
+	clear(x.Values)
+	clear(x.Attributes)
+	clear(x.Labels)
+	*x = Event{Values: x.Values[:0], Attributes: x.Attributes[:0], Labels: x.Labels}
+	EventPool.Put(x)
+}
+
+type Event_Attribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" form:"key" uri:"key"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" form:"value" uri:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Attribute) Reset() {
+	*x = Event_Attribute{}
+	mi := &file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Attribute) ProtoMessage() {}
+
+func (x *Event_Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Attribute.ProtoReflect.Descriptor instead.
+func (*Event_Attribute) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Event_Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Event_Attribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Event_AttributePool is a pool of Event_Attribute messages, used by GetEvent_Attribute and PutEvent_Attribute.
+var Event_AttributePool = sync.Pool{New: func() any { return new(Event_Attribute) }}
+
+// GetEvent_Attribute returns an empty Event_Attribute from Event_AttributePool.
+func GetEvent_Attribute() *Event_Attribute {
+	return Event_AttributePool.Get().(*Event_Attribute)
+}
+
+// PutEvent_Attribute resets x and returns it to Event_AttributePool.
+// x must not be used, or retained anywhere, after it is returned to the pool.
+func PutEvent_Attribute(x *Event_Attribute) {
+	if x == nil {
+		return
+	}
+	x.Reset()
+	Event_AttributePool.Put(x)
+}
+
+var File_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDesc = "" +
+	"\n" +
+	"2cmd/protoc-gen-go/testdata/pooling/sync/sync.proto\x12\x1bgoproto.protoc.pooling.sync\"\xb9\x02\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x03R\x06values\x12L\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2,.goproto.protoc.pooling.sync.Event.AttributeR\n" +
+	"attributes\x12F\n" +
+	"\x06labels\x18\x04 \x03(\v2..goproto.protoc.pooling.sync.Event.LabelsEntryR\x06labels\x1a3\n" +
+	"\tAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BDZBgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/pooling/syncb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_goTypes = []any{
+	(*Event)(nil),           // 0: goproto.protoc.pooling.sync.Event
+	(*Event_Attribute)(nil), // 1: goproto.protoc.pooling.sync.Event.Attribute
+	nil,                     // 2: goproto.protoc.pooling.sync.Event.LabelsEntry
+}
+var file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.pooling.sync.Event.attributes:type_name -> goproto.protoc.pooling.sync.Event.Attribute
+	2, // 1: goproto.protoc.pooling.sync.Event.labels:type_name -> goproto.protoc.pooling.sync.Event.LabelsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_init() }
+func file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto = out.File
+	file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_pooling_sync_sync_proto_depIdxs = nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/reflection/exportmsgtypes/exportmsgtypes.proto
+
+package exportmsgtypes
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+type Inventory struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*Inventory_Item          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" form:"items" uri:"items"`
+	ByName        map[string]*Inventory_Item `protobuf:"bytes,2,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" form:"by_name" uri:"by_name" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory) Reset() {
+	*x = Inventory{}
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory) ProtoMessage() {}
+
+func (x *Inventory) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Inventory) GetItems() []*Inventory_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Inventory) GetByName() map[string]*Inventory_Item {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescGZIP(), []int{1}
+}
+
+type Inventory_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" form:"name" uri:"name"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty" form:"count" uri:"count"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Inventory_Item) Reset() {
+	*x = Inventory_Item{}
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Inventory_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory_Item) ProtoMessage() {}
+
+func (x *Inventory_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory_Item.ProtoReflect.Descriptor instead.
+func (*Inventory_Item) Descriptor() ([]byte, []int) {
+	return file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Inventory_Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Inventory_Item) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_MessageTypes returns the message infos of the messages declared in
+// cmd/protoc-gen-go/testdata/reflection/exportmsgtypes/exportmsgtypes.proto, including nested messages but not map entries.
+// The returned slice is new on each call, but the message infos are shared
+// with the generated code and must not be modified.
+func File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_MessageTypes() []*protoimpl.MessageInfo {
+	return []*protoimpl.MessageInfo{
+		&file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[0], // goproto.protoc.reflection.exportmsgtypes.Inventory
+		&file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[1], // goproto.protoc.reflection.exportmsgtypes.Empty
+		&file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes[2], // goproto.protoc.reflection.exportmsgtypes.Inventory.Item
+	}
+}
+
+var File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDesc = "" +
+	"\n" +
+	"Icmd/protoc-gen-go/testdata/reflection/exportmsgtypes/exportmsgtypes.proto\x12(goproto.protoc.reflection.exportmsgtypes\"\xdc\x02\n" +
+	"\tInventory\x12N\n" +
+	"\x05items\x18\x01 \x03(\v28.goproto.protoc.reflection.exportmsgtypes.Inventory.ItemR\x05items\x12X\n" +
+	"\aby_name\x18\x02 \x03(\v2?.goproto.protoc.reflection.exportmsgtypes.Inventory.ByNameEntryR\x06byName\x1a0\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x1as\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12N\n" +
+	"\x05value\x18\x02 \x01(\v28.goproto.protoc.reflection.exportmsgtypes.Inventory.ItemR\x05value:\x028\x01\"\a\n" +
+	"\x05EmptyBQZOgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/reflection/exportmsgtypesb\x06proto3"
+
+var (
+	file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescOnce sync.Once
+	file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescData []byte
+)
+
+func file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescGZIP() []byte {
+	file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescOnce.Do(func() {
+		file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDesc)))
+	})
+	return file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDescData
+}
+
+var file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_goTypes = []any{
+	(*Inventory)(nil),      // 0: goproto.protoc.reflection.exportmsgtypes.Inventory
+	(*Empty)(nil),          // 1: goproto.protoc.reflection.exportmsgtypes.Empty
+	(*Inventory_Item)(nil), // 2: goproto.protoc.reflection.exportmsgtypes.Inventory.Item
+	nil,                    // 3: goproto.protoc.reflection.exportmsgtypes.Inventory.ByNameEntry
+}
+var file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_depIdxs = []int32{
+	2, // 0: goproto.protoc.reflection.exportmsgtypes.Inventory.items:type_name -> goproto.protoc.reflection.exportmsgtypes.Inventory.Item
+	3, // 1: goproto.protoc.reflection.exportmsgtypes.Inventory.by_name:type_name -> goproto.protoc.reflection.exportmsgtypes.Inventory.ByNameEntry
+	2, // 2: goproto.protoc.reflection.exportmsgtypes.Inventory.ByNameEntry.value:type_name -> goproto.protoc.reflection.exportmsgtypes.Inventory.Item
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_init() }
+func file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto = out.File
+	file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_reflection_exportmsgtypes_exportmsgtypes_proto_depIdxs = nil
+}
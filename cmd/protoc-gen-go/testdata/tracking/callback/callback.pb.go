@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cmd/protoc-gen-go/testdata/tracking/callback/callback.proto
+
+//go:build !protoopaque
+
+package callback
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+type Account struct {
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	Name    *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" form:"name" uri:"name"`
+	Balance *int64                 `protobuf:"varint,2,opt,name=balance" json:"balance,omitempty" form:"balance" uri:"balance"`
+	Tags    []string               `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" form:"tags" uri:"tags"`
+	Limits  map[string]int32       `protobuf:"bytes,4,rep,name=limits" json:"limits,omitempty" form:"limits" uri:"limits" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Parent  *Account               `protobuf:"bytes,5,opt,name=parent" json:"parent,omitempty" form:"parent" uri:"parent"`
+	Avatar  []byte                 `protobuf:"bytes,8,opt,name=avatar" json:"avatar,omitempty" form:"avatar" uri:"avatar"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*Account_Email
+	//	*Account_Phone
+	Contact           isAccount_Contact `protobuf_oneof:"contact"`
+	xxx_onFieldChange func(field protoreflect.Name, old, new any)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Account) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil && x.Balance != nil {
+		return *x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Account) GetLimits() map[string]int32 {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Account) GetParent() *Account {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Account) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Account) GetContact() isAccount_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Account_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Account) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*Account_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *Account) SetName(v string) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetName()
+		defer func() { h("name", old, x.GetName()) }()
+	}
+	x.Name = &v
+}
+
+func (x *Account) SetBalance(v int64) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetBalance()
+		defer func() { h("balance", old, x.GetBalance()) }()
+	}
+	x.Balance = &v
+}
+
+func (x *Account) SetTags(v []string) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetTags()
+		defer func() { h("tags", old, x.GetTags()) }()
+	}
+	x.Tags = v
+}
+
+func (x *Account) SetLimits(v map[string]int32) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetLimits()
+		defer func() { h("limits", old, x.GetLimits()) }()
+	}
+	x.Limits = v
+}
+
+func (x *Account) SetParent(v *Account) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetParent()
+		defer func() { h("parent", old, x.GetParent()) }()
+	}
+	x.Parent = v
+}
+
+func (x *Account) SetAvatar(v []byte) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetAvatar()
+		defer func() { h("avatar", old, x.GetAvatar()) }()
+	}
+	if v == nil {
+		v = []byte{}
+	}
+	x.Avatar = v
+}
+
+func (x *Account) SetEmail(v string) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetEmail()
+		defer func() { h("email", old, x.GetEmail()) }()
+	}
+	x.Contact = &Account_Email{v}
+}
+
+func (x *Account) SetPhone(v string) {
+	if h := x.xxx_onFieldChange; h != nil {
+		old := x.GetPhone()
+		defer func() { h("phone", old, x.GetPhone()) }()
+	}
+	x.Contact = &Account_Phone{v}
+}
+
+func (x *Account) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return x.Name != nil
+}
+
+func (x *Account) HasBalance() bool {
+	if x == nil {
+		return false
+	}
+	return x.Balance != nil
+}
+
+func (x *Account) HasParent() bool {
+	if x == nil {
+		return false
+	}
+	return x.Parent != nil
+}
+
+func (x *Account) HasAvatar() bool {
+	if x == nil {
+		return false
+	}
+	return x.Avatar != nil
+}
+
+func (x *Account) HasContact() bool {
+	if x == nil {
+		return false
+	}
+	return x.Contact != nil
+}
+
+func (x *Account) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Contact.(*Account_Email)
+	return ok
+}
+
+func (x *Account) HasPhone() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Contact.(*Account_Phone)
+	return ok
+}
+
+func (x *Account) ClearName() {
+	x.Name = nil
+}
+
+func (x *Account) ClearBalance() {
+	x.Balance = nil
+}
+
+func (x *Account) ClearParent() {
+	x.Parent = nil
+}
+
+func (x *Account) ClearAvatar() {
+	x.Avatar = nil
+}
+
+func (x *Account) ClearContact() {
+	x.Contact = nil
+}
+
+func (x *Account) ClearEmail() {
+	if _, ok := x.Contact.(*Account_Email); ok {
+		x.Contact = nil
+	}
+}
+
+func (x *Account) ClearPhone() {
+	if _, ok := x.Contact.(*Account_Phone); ok {
+		x.Contact = nil
+	}
+}
+
+const Account_Contact_not_set_case case_Account_Contact = 0
+const Account_Email_case case_Account_Contact = 6
+const Account_Phone_case case_Account_Contact = 7
+
+func (x *Account) WhichContact() case_Account_Contact {
+	if x == nil {
+		return Account_Contact_not_set_case
+	}
+	switch x.Contact.(type) {
+	case *Account_Email:
+		return Account_Email_case
+	case *Account_Phone:
+		return Account_Phone_case
+	default:
+		return Account_Contact_not_set_case
+	}
+}
+
+type Account_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name    *string
+	Balance *int64
+	Tags    []string
+	Limits  map[string]int32
+	Parent  *Account
+	Avatar  []byte
+	// Fields of oneof Contact:
+	Email *string
+	Phone *string
+	// -- end of Contact
+}
+
+func (b0 Account_builder) Build() *Account {
+	m0 := &Account{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Balance = b.Balance
+	x.Tags = b.Tags
+	x.Limits = b.Limits
+	x.Parent = b.Parent
+	x.Avatar = b.Avatar
+	if b.Email != nil {
+		x.Contact = &Account_Email{*b.Email}
+	}
+	if b.Phone != nil {
+		x.Contact = &Account_Phone{*b.Phone}
+	}
+	return m0
+}
+
+type case_Account_Contact protoreflect.FieldNumber
+
+func (x case_Account_Contact) String() string {
+	md := file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isAccount_Contact interface {
+	isAccount_Contact()
+}
+
+type Account_Email struct {
+	Email string `protobuf:"bytes,6,opt,name=email,oneof"`
+}
+
+type Account_Phone struct {
+	Phone string `protobuf:"bytes,7,opt,name=phone,oneof"`
+}
+
+func (*Account_Email) isAccount_Contact() {}
+
+func (*Account_Phone) isAccount_Contact() {}
+
+// OnFieldChange registers f to be called by each setter of x after it has
+// written a field, with the name of the field and the values returned by its
+// getter before and after the write. A later call replaces f, and a nil f
+// removes it. Clearers, builders, reflection and unmarshaling do not call f,
+// nor do the setters of the messages held by x. The function is not copied
+// by proto.Clone or proto.Merge, and it is removed by Reset but kept by the
+// generated methods replacing the contents of x, such as FromKV.
+func (x *Account) OnFieldChange(f func(field protoreflect.Name, old, new any)) {
+	x.xxx_onFieldChange = f
+}
+
+var File_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto protoreflect.FileDescriptor
+
+const file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_rawDesc = "" +
+	"\n" +
+	";cmd/protoc-gen-go/testdata/tracking/callback/callback.proto\x12 goproto.protoc.tracking.callback\x1a!google/protobuf/go_features.proto\"\xeb\x02\n" +
+	"\aAccount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12M\n" +
+	"\x06limits\x18\x04 \x03(\v25.goproto.protoc.tracking.callback.Account.LimitsEntryR\x06limits\x12A\n" +
+	"\x06parent\x18\x05 \x01(\v2).goproto.protoc.tracking.callback.AccountR\x06parent\x12\x16\n" +
+	"\x06avatar\x18\b \x01(\fR\x06avatar\x12\x16\n" +
+	"\x05email\x18\x06 \x01(\tH\x00R\x05email\x12\x16\n" +
+	"\x05phone\x18\a \x01(\tH\x00R\x05phone\x1a9\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\t\n" +
+	"\acontactBQZGgoogle.golang.org/protobuf/cmd/protoc-gen-go/testdata/tracking/callback\x92\x03\x05\xd2>\x02\x10\x02b\beditionsp\xe8\a"
+
+var file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_goTypes = []any{
+	(*Account)(nil), // 0: goproto.protoc.tracking.callback.Account
+	nil,             // 1: goproto.protoc.tracking.callback.Account.LimitsEntry
+}
+var file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_depIdxs = []int32{
+	1, // 0: goproto.protoc.tracking.callback.Account.limits:type_name -> goproto.protoc.tracking.callback.Account.LimitsEntry
+	0, // 1: goproto.protoc.tracking.callback.Account.parent:type_name -> goproto.protoc.tracking.callback.Account
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_init() }
+func file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_init() {
+	if File_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto != nil {
+		return
+	}
+	file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes[0].OneofWrappers = []any{
+		(*Account_Email)(nil),
+		(*Account_Phone)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_rawDesc), len(file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_goTypes,
+		DependencyIndexes: file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_depIdxs,
+		MessageInfos:      file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_msgTypes,
+	}.Build()
+	File_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto = out.File
+	file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_goTypes = nil
+	file_cmd_protoc_gen_go_testdata_tracking_callback_callback_proto_depIdxs = nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	textpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/text"
+)
+
+var (
+	_ encoding.TextMarshaler   = (*textpb.Config)(nil)
+	_ encoding.TextUnmarshaler = (*textpb.Config)(nil)
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	for _, m := range []*textpb.Config{
+		{},
+		{
+			Name:     "name",
+			Backends: map[string]*textpb.Config_Backend{"a": {Address: "a:80", Weight: 2}, "b": {}},
+			Limits:   map[string]int64{"rps": 100},
+			Mode:     &textpb.Config_StaticFile{StaticFile: "index.html"},
+		},
+		{Mode: &textpb.Config_Proxy{Proxy: &textpb.Config_Backend{Address: "proxy"}}},
+	} {
+		b, err := m.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): %v", m, err)
+		}
+		got := new(textpb.Config)
+		if err := prototext.Unmarshal(b, got); err != nil || !proto.Equal(got, m) {
+			t.Errorf("prototext.Unmarshal(MarshalText(%v)) = %v, %v; want %v", m, got, err, m)
+		}
+
+		b, err = prototext.Marshal(m)
+		if err != nil {
+			t.Fatalf("prototext.Marshal(%v): %v", m, err)
+		}
+		got = new(textpb.Config)
+		if err := got.UnmarshalText(b); err != nil || !proto.Equal(got, m) {
+			t.Errorf("UnmarshalText(prototext.Marshal(%v)) = %v, %v; want %v", m, got, err, m)
+		}
+	}
+
+	if err := new(textpb.Config).UnmarshalText([]byte("unknown: 1")); err == nil {
+		t.Errorf("UnmarshalText with an unknown field: got nil error, want error")
+	}
+}
+
+func TestTextMarshalOptions(t *testing.T) {
+	orig := textpb.File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions
+	defer func() { textpb.File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions = orig }()
+	textpb.File_cmd_protoc_gen_go_testdata_methods_text_text_proto_textMarshalOptions = prototext.MarshalOptions{Multiline: true, Indent: "\t"}
+
+	m := &textpb.Config{Mode: &textpb.Config_Proxy{Proxy: &textpb.Config_Backend{Address: "proxy"}}}
+	b, err := m.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "\n\taddress:") {
+		t.Errorf("MarshalText() with Multiline and Indent = %q, want indented fields", b)
+	}
+}
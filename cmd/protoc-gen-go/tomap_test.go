@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+
+	tomappb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/tomap"
+)
+
+func TestToMap(t *testing.T) {
+	m := &tomappb.Outer{
+		Count:       3,
+		DisplayName: "outer",
+		Color:       tomappb.Color_COLOR_GREEN,
+		Inner:       &tomappb.Inner{Name: "inner", Color: tomappb.Color_COLOR_RED},
+		Tags:        []string{"a", "b"},
+		Items:       []*tomappb.Inner{{Name: "first"}, {}},
+		ByName:      map[string]*tomappb.Inner{"x": {Name: "x"}},
+		Colors:      map[int32]tomappb.Color{1: tomappb.Color_COLOR_RED},
+		Enabled:     proto.Bool(false),
+		Accent:      tomappb.Color_COLOR_RED.Enum(),
+		Choice:      &tomappb.Outer_Nested{Nested: &tomappb.Inner{Name: "chosen"}},
+	}
+	want := map[string]any{
+		"count": int32(3),
+		"label": "outer",
+		"color": "COLOR_GREEN",
+		"inner": map[string]any{
+			"name":  "inner",
+			"color": "COLOR_RED",
+		},
+		"tags": []any{"a", "b"},
+		"items": []any{
+			map[string]any{"name": "first"},
+			map[string]any{},
+		},
+		"byName": map[string]any{
+			"x": map[string]any{"name": "x"},
+		},
+		"colors":  map[int32]any{1: "COLOR_RED"},
+		"enabled": false,
+		"accent":  "COLOR_RED",
+		"nested":  map[string]any{"name": "chosen"},
+	}
+	if diff := cmp.Diff(want, m.ToMap()); diff != "" {
+		t.Errorf("ToMap() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := (&tomappb.Outer{}).ToMap(); len(got) != 0 {
+		t.Errorf("ToMap() of empty message = %v, want empty map", got)
+	}
+	if got := (*tomappb.Outer)(nil).ToMap(); got != nil {
+		t.Errorf("ToMap() of nil message = %v, want nil", got)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	touchedpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/tracking/touched"
+)
+
+func TestTouchedFields(t *testing.T) {
+	m := &touchedpb.Account{}
+	if got := m.TouchedFields(); len(got) > 0 {
+		t.Errorf("TouchedFields() of new message = %v, want none", got)
+	}
+
+	m.SetParent(&touchedpb.Account{})
+	m.SetName("")
+	m.SetPhone("555")
+	m.SetLimits(nil)
+	want := []protoreflect.Name{"name", "limits", "parent", "phone"}
+	if diff := cmp.Diff(want, m.TouchedFields()); diff != "" {
+		t.Errorf("TouchedFields() mismatch (-want +got):\n%s", diff)
+	}
+
+	m.ClearTouched()
+	if got := m.TouchedFields(); len(got) > 0 {
+		t.Errorf("TouchedFields() after ClearTouched = %v, want none", got)
+	}
+	if got, want := m.GetPhone(), "555"; got != want {
+		t.Errorf("GetPhone() after ClearTouched = %q, want %q", got, want)
+	}
+
+	m.SetBalance(10)
+	want = []protoreflect.Name{"balance"}
+	if diff := cmp.Diff(want, m.TouchedFields()); diff != "" {
+		t.Errorf("TouchedFields() after ClearTouched and SetBalance mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTouchedFieldsNotSetByUnmarshal(t *testing.T) {
+	b, err := proto.Marshal(touchedpb.Account_builder{Name: proto.String("name"), Balance: proto.Int64(1)}.Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &touchedpb.Account{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.TouchedFields(); len(got) > 0 {
+		t.Errorf("TouchedFields() after Unmarshal = %v, want none", got)
+	}
+	if got := proto.Clone(m).(*touchedpb.Account).GetName(); got != "name" {
+		t.Errorf("Clone().GetName() = %q, want %q", got, "name")
+	}
+}
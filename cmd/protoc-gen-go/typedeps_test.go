@@ -0,0 +1,53 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	typedepspb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/typedeps"
+)
+
+func TestMessageDependencies(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		got  []protoreflect.FullName
+		want []protoreflect.FullName
+	}{{
+		name: "Folder",
+		got:  new(typedepspb.Folder).MessageDependencies(),
+		want: []protoreflect.FullName{
+			"google.protobuf.Timestamp",
+			"goproto.protoc.methods.typedeps.Folder",
+			"goproto.protoc.methods.typedeps.Folder.File",
+			"goproto.protoc.methods.typedeps.Folder.Owner",
+		},
+	}, {
+		name: "Folder.File",
+		got:  new(typedepspb.Folder_File).MessageDependencies(),
+		want: []protoreflect.FullName{
+			"google.protobuf.Timestamp",
+			"goproto.protoc.methods.typedeps.Folder.Owner",
+		},
+	}, {
+		name: "Leaf",
+		got:  new(typedepspb.Leaf).MessageDependencies(),
+		want: nil,
+	}} {
+		if diff := cmp.Diff(test.want, test.got); diff != "" {
+			t.Errorf("%v.MessageDependencies() mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+
+	deps := new(typedepspb.Folder).MessageDependencies()
+	deps[0] = "modified"
+	if got := new(typedepspb.Folder).MessageDependencies(); got[0] == "modified" {
+		t.Errorf("modifying the result of MessageDependencies modified later results")
+	}
+}
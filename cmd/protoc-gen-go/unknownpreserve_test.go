@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	unknownpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unknownpreserve"
+)
+
+type unknownFieldsMessage interface {
+	proto.Message
+	HasUnknownFields() bool
+	UnknownFieldBytes() []byte
+}
+
+func TestUnknownFieldsPreserved(t *testing.T) {
+	// Field 1 is known to both messages, while field 50 is unknown to both
+	// and outside the extension range of Extendable.
+	known := protowire.AppendTag(nil, 1, protowire.BytesType)
+	known = protowire.AppendString(known, "name")
+	unknown := protowire.AppendTag(nil, 50, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 42)
+	unknown = protowire.AppendTag(unknown, 51, protowire.BytesType)
+	unknown = protowire.AppendString(unknown, "payload")
+	payload := append(append([]byte(nil), known...), unknown...)
+
+	for _, m := range []unknownFieldsMessage{
+		&unknownpb.Known{},
+		&unknownpb.Extendable{},
+	} {
+		name := m.ProtoReflect().Descriptor().FullName()
+		if m.HasUnknownFields() {
+			t.Errorf("%v: HasUnknownFields() = true before unmarshal, want false", name)
+		}
+		if err := proto.Unmarshal(payload, m); err != nil {
+			t.Fatalf("%v: proto.Unmarshal: %v", name, err)
+		}
+		if !m.HasUnknownFields() {
+			t.Errorf("%v: HasUnknownFields() = false, want true", name)
+		}
+		if got := m.UnknownFieldBytes(); !bytes.Equal(got, unknown) {
+			t.Errorf("%v: UnknownFieldBytes() = %x, want %x", name, got, unknown)
+		}
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatalf("%v: proto.Marshal: %v", name, err)
+		}
+		if !bytes.Contains(b, unknown) {
+			t.Errorf("%v: proto.Marshal = %x, want it to contain unknown fields %x", name, b, unknown)
+		}
+	}
+}
+
+func TestUnknownFieldsNil(t *testing.T) {
+	var m *unknownpb.Known
+	if m.HasUnknownFields() {
+		t.Errorf("(*Known)(nil).HasUnknownFields() = true, want false")
+	}
+	if got := m.UnknownFieldBytes(); got != nil {
+		t.Errorf("(*Known)(nil).UnknownFieldBytes() = %x, want nil", got)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	unmarshallimitpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/unmarshallimit"
+)
+
+// nestedTree returns a tree of the given depth.
+func nestedTree(depth int) *unmarshallimitpb.Tree {
+	var m *unmarshallimitpb.Tree
+	for i := 0; i < depth; i++ {
+		m = &unmarshallimitpb.Tree{Label: "node", Child: m}
+	}
+	return m
+}
+
+func TestUnmarshalMax(t *testing.T) {
+	want := nestedTree(3)
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &unmarshallimitpb.Tree{Label: "stale"}
+	if err := got.UnmarshalMax(b, len(b)); err != nil {
+		t.Fatalf("UnmarshalMax within the limit: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalMax() = %v, want %v", got, want)
+	}
+
+	err = new(unmarshallimitpb.Tree).UnmarshalMax(b, len(b)-1)
+	if want := "exceeds the limit"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("UnmarshalMax over the size limit: got error %v, want it to contain %q", err, want)
+	}
+}
+
+func TestUnmarshalMaxDepth(t *testing.T) {
+	// The generator was run with unmarshal_max_depth=8.
+	b, err := proto.Marshal(nestedTree(20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := new(unmarshallimitpb.Tree).UnmarshalMax(b, len(b)); err == nil {
+		t.Errorf("UnmarshalMax of a deeply nested message: got nil error, want error")
+	}
+	if err := proto.Unmarshal(b, new(unmarshallimitpb.Tree)); err != nil {
+		t.Errorf("proto.Unmarshal of a deeply nested message: %v", err)
+	}
+}
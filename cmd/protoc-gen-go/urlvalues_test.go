@@ -0,0 +1,197 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/proto"
+
+	urlvaluespb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues"
+	urlvaluesunknownpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/urlvalues/unknown"
+)
+
+func TestURLValues(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		m    interface{ URLValues() url.Values }
+		want url.Values
+	}{{
+		desc: "empty",
+		m:    &urlvaluespb.Search{},
+		want: url.Values{},
+	}, {
+		desc: "nil",
+		m:    (*urlvaluespb.Search)(nil),
+		want: url.Values{},
+	}, {
+		desc: "scalars",
+		m: &urlvaluespb.Search{
+			Query:    "a b",
+			Page:     -2,
+			Limit:    proto.Uint64(0),
+			Exact:    true,
+			MinScore: 0.5,
+			Order:    urlvaluespb.Search_ORDER_NEWEST,
+			Cursor:   []byte{0xff},
+			Scope:    &urlvaluespb.Search_User{User: "me"},
+		},
+		want: url.Values{
+			"query":     {"a b"},
+			"page":      {"-2"},
+			"limit":     {"0"},
+			"exact":     {"true"},
+			"min_score": {"0.5"},
+			"order":     {"1"},
+			"cursor":    {"/w=="},
+			"user":      {"me"},
+		},
+	}, {
+		desc: "repeated",
+		m: &urlvaluespb.Search{
+			Tags: []string{"x", "y", "x"},
+			Ids:  []int64{3, -1},
+		},
+		want: url.Values{
+			"tags": {"x", "y", "x"},
+			"ids":  {"3", "-1"},
+		},
+	}, {
+		desc: "messages and maps omitted",
+		m: &urlvaluespb.Search{
+			Query:  "q",
+			Filter: &urlvaluespb.Search_Filter{Field: "f"},
+			Labels: map[string]string{"k": "v"},
+			Scope:  &urlvaluespb.Search_Group{Group: &urlvaluespb.Search_Filter{Field: "g"}},
+		},
+		want: url.Values{
+			"query": {"q"},
+		},
+	}, {
+		desc: "hybrid",
+		m: urlvaluespb.Lookup_builder{
+			Key:     proto.String(""),
+			Version: 0,
+			Weights: []float32{0.25, 1},
+			Shard:   proto.Uint32(7),
+		}.Build(),
+		want: url.Values{
+			"key":     {""},
+			"weights": {"0.25", "1"},
+			"shard":   {"7"},
+		},
+	}} {
+		if diff := cmp.Diff(test.want, test.m.URLValues()); diff != "" {
+			t.Errorf("%v: URLValues() mismatch (-want +got):\n%s", test.desc, diff)
+		}
+	}
+}
+
+func TestFromURLValues(t *testing.T) {
+	m := &urlvaluespb.Search{Query: "old", Filter: &urlvaluespb.Search_Filter{Field: "f"}}
+	err := m.FromURLValues(url.Values{
+		"page":      {"3", "4"},
+		"limit":     {"0"},
+		"exact":     {"true"},
+		"min_score": {"0.5"},
+		"order":     {"ORDER_NEWEST"},
+		"cursor":    {"/w=="},
+		"tags":      {"x", "y"},
+		"ids":       {"-1", "2"},
+		"user":      {"me"},
+		"unknown":   {"ignored"},
+		"filter":    {"ignored"},
+	})
+	if err != nil {
+		t.Fatalf("FromURLValues: %v", err)
+	}
+	want := &urlvaluespb.Search{
+		Query:    "old",
+		Page:     3,
+		Limit:    proto.Uint64(0),
+		Exact:    true,
+		MinScore: 0.5,
+		Order:    urlvaluespb.Search_ORDER_NEWEST,
+		Cursor:   []byte{0xff},
+		Tags:     []string{"x", "y"},
+		Ids:      []int64{-1, 2},
+		Filter:   &urlvaluespb.Search_Filter{Field: "f"},
+		Scope:    &urlvaluespb.Search_User{User: "me"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("FromURLValues() = %v, want %v", m, want)
+	}
+}
+
+func TestFromURLValuesRoundTrip(t *testing.T) {
+	for _, m := range []interface {
+		proto.Message
+		URLValues() url.Values
+		FromURLValues(url.Values) error
+	}{
+		&urlvaluespb.Search{
+			Query:    "q",
+			Page:     -2,
+			MinScore: 1e-3,
+			Order:    urlvaluespb.Search_ORDER_NEWEST,
+			Tags:     []string{"a", "a"},
+			Ids:      []int64{1},
+		},
+		urlvaluespb.Lookup_builder{
+			Key:     proto.String(""),
+			Version: 4,
+			Weights: []float32{0.25},
+			Shard:   proto.Uint32(7),
+		}.Build(),
+	} {
+		got := m.ProtoReflect().Type().New().Interface().(interface{ FromURLValues(url.Values) error })
+		if err := got.FromURLValues(m.URLValues()); err != nil {
+			t.Errorf("FromURLValues(%v): %v", m.URLValues(), err)
+			continue
+		}
+		if !proto.Equal(got.(proto.Message), m) {
+			t.Errorf("FromURLValues(URLValues()) = %v, want %v", got, m)
+		}
+	}
+}
+
+func TestFromURLValuesErrors(t *testing.T) {
+	for _, vs := range []url.Values{
+		{"page": {"x"}},
+		{"page": {"4294967296"}},
+		{"exact": {"yes"}},
+		{"order": {"ORDER_OLDEST"}},
+		{"cursor": {"%"}},
+		{"ids": {"1", "2.5"}},
+	} {
+		m := &urlvaluespb.Search{Query: "q"}
+		vs["query"], vs["tags"] = []string{"changed"}, []string{"t"}
+		if err := m.FromURLValues(vs); err == nil {
+			t.Errorf("FromURLValues(%v) succeeded, want error", vs)
+		}
+		if want := (&urlvaluespb.Search{Query: "q"}); !proto.Equal(m, want) {
+			t.Errorf("FromURLValues(%v) modified message to %v, want %v", vs, m, want)
+		}
+	}
+}
+
+func TestFromURLValuesUnknownError(t *testing.T) {
+	m := &urlvaluesunknownpb.Query{}
+	if err := m.FromURLValues(url.Values{"term": {"t"}, "years": {"2020"}}); err != nil {
+		t.Errorf("FromURLValues with known keys: %v", err)
+	}
+	for _, key := range []string{"unknown", "page"} {
+		if err := m.FromURLValues(url.Values{key: {"x"}}); err == nil || !strings.Contains(err.Error(), "unknown query parameter") {
+			t.Errorf("FromURLValues with key %q: got error %v, want unknown query parameter", key, err)
+		}
+	}
+	if err := new(urlvaluesunknownpb.Empty).FromURLValues(url.Values{"x": nil}); err == nil {
+		t.Errorf("FromURLValues of message without scalar fields succeeded, want error")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	utf8checkpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/utf8check"
+)
+
+func TestValidateUTF8(t *testing.T) {
+	const invalid = "\xff"
+	valid := &utf8checkpb.Document{
+		Title:      "title ✓",
+		Subtitle:   proto.String("subtitle"),
+		Tags:       []string{"a", "b"},
+		Labels:     map[string]string{"k": "v"},
+		Sections:   map[int32]*utf8checkpb.Section{1: {Heading: "heading"}},
+		Summary:    &utf8checkpb.Section{Heading: "summary"},
+		Appendices: []*utf8checkpb.Section{{Heading: "appendix"}},
+		Body:       &utf8checkpb.Document_Text{Text: "text"},
+		Raw:        []byte(invalid),
+		Counts:     map[string]int64{"count": 1},
+	}
+	if err := valid.ValidateUTF8(); err != nil {
+		t.Errorf("ValidateUTF8() = %v, want nil", err)
+	}
+	var nilDoc *utf8checkpb.Document
+	if err := nilDoc.ValidateUTF8(); err != nil {
+		t.Errorf("nil.ValidateUTF8() = %v, want nil", err)
+	}
+
+	for _, test := range []struct {
+		field  string
+		modify func(*utf8checkpb.Document)
+	}{
+		{"Document.title", func(m *utf8checkpb.Document) { m.Title = invalid }},
+		{"Document.subtitle", func(m *utf8checkpb.Document) { m.Subtitle = proto.String(invalid) }},
+		{"Document.tags", func(m *utf8checkpb.Document) { m.Tags = append(m.Tags, invalid) }},
+		{"Document.labels", func(m *utf8checkpb.Document) { m.Labels[invalid] = "v" }},
+		{"Document.labels", func(m *utf8checkpb.Document) { m.Labels["k"] = invalid }},
+		{"Document.counts", func(m *utf8checkpb.Document) { m.Counts[invalid] = 1 }},
+		{"Section.heading", func(m *utf8checkpb.Document) { m.Sections[1].Heading = invalid }},
+		{"Section.heading", func(m *utf8checkpb.Document) { m.Summary.Heading = invalid }},
+		{"Section.heading", func(m *utf8checkpb.Document) { m.Appendices[0].Heading = invalid }},
+		{"Document.text", func(m *utf8checkpb.Document) { m.Body = &utf8checkpb.Document_Text{Text: invalid} }},
+		{"Section.heading", func(m *utf8checkpb.Document) {
+			m.Body = &utf8checkpb.Document_Section{Section: &utf8checkpb.Section{Heading: invalid}}
+		}},
+	} {
+		m := proto.CloneOf(valid)
+		test.modify(m)
+		err := m.ValidateUTF8()
+		if err == nil || !strings.Contains(err.Error(), "."+test.field+" ") {
+			t.Errorf("ValidateUTF8(%v) = %v, want error naming field %v", m, err, test.field)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	wirehexpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wirehex"
+)
+
+func TestWireHex(t *testing.T) {
+	m := &wirehexpb.Ticket{
+		Id:     150,
+		Title:  "t",
+		Labels: map[string]string{"b": "2", "a": "1"},
+		Note:   &wirehexpb.Ticket_Note{Body: []byte{0xff}},
+	}
+	s, err := m.WireHex()
+	if err != nil {
+		t.Fatalf("WireHex: %v", err)
+	}
+	// The labels are encoded in key order.
+	if want := "089601" + "120174" + "1a060a0161120131" + "1a060a0162120132" + "22030a01ff"; s != want {
+		t.Errorf("WireHex() = %s, want %s", s, want)
+	}
+	again, err := m.WireHex()
+	if err != nil || again != s {
+		t.Errorf("second WireHex() = %s, %v, want %s", again, err, s)
+	}
+	got, err := wirehexpb.TicketFromWireHex(s)
+	if err != nil {
+		t.Fatalf("TicketFromWireHex(%s): %v", s, err)
+	}
+	if !proto.Equal(got, m) {
+		t.Errorf("TicketFromWireHex(WireHex()) = %v, want %v", got, m)
+	}
+
+	note, err := wirehexpb.Ticket_NoteFromWireHex("0a01ff")
+	if err != nil || !proto.Equal(note, m.GetNote()) {
+		t.Errorf("Ticket_NoteFromWireHex(0a01ff) = %v, %v, want %v", note, err, m.GetNote())
+	}
+	if s, err := new(wirehexpb.Ticket).WireHex(); err != nil || s != "" {
+		t.Errorf("empty WireHex() = %q, %v, want empty", s, err)
+	}
+}
+
+func TestWireHexErrors(t *testing.T) {
+	for _, s := range []string{"zz", "089", "0a05ff"} {
+		if m, err := wirehexpb.TicketFromWireHex(s); err == nil {
+			t.Errorf("TicketFromWireHex(%q) = %v, want error", s, m)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	wireorderpb "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/methods/wireorder"
+)
+
+func TestFieldsInWireOrder(t *testing.T) {
+	fds := (*wireorderpb.Record)(nil).FieldsInWireOrder()
+	var got []protoreflect.FieldNumber
+	for _, fd := range fds {
+		got = append(got, fd.Number())
+	}
+	want := []protoreflect.FieldNumber{1, 2, 3, 5, 9, 12, 101, 150}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FieldsInWireOrder() numbers mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := fds[6].FullName(), protoreflect.FullName("goproto.protoc.methods.wireorder.priority"); got != want {
+		t.Errorf("FieldsInWireOrder()[6] = %v, want extension %v", got, want)
+	}
+	if again := new(wireorderpb.Record).FieldsInWireOrder(); &again[0] != &fds[0] {
+		t.Errorf("FieldsInWireOrder() returned a new slice, want the slice built by the first call")
+	}
+	if got := new(wireorderpb.Empty).FieldsInWireOrder(); len(got) != 0 {
+		t.Errorf("Empty.FieldsInWireOrder() = %v, want no fields", got)
+	}
+}
@@ -48,7 +48,12 @@ func init() {
 		// This is reasonable since we fully control the output.
 		detrand.Disable()
 
-		protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		var flags flag.FlagSet
+		gengo.RegisterFlags(&flags)
+		protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+			if err := gengo.ValidateFlags(); err != nil {
+				return err
+			}
 			for _, file := range gen.Files {
 				if file.Generate {
 					gengo.GenerateVersionMarkers = false
@@ -337,6 +342,7 @@ func generateLocalProtos() {
 		path     string
 		pkgPaths map[string]string // mapping of .proto path to Go package path
 		annotate map[string]bool   // .proto files to annotate
+		params   map[string]string // .proto files to additional generator parameters
 		exclude  map[string]bool   // .proto files to exclude from generation
 	}{{
 		path: "cmd/protoc-gen-go/testdata",
@@ -344,6 +350,134 @@ func generateLocalProtos() {
 			"cmd/protoc-gen-go/testdata/nopackage/nopackage.proto": "google.golang.org/protobuf/cmd/protoc-gen-go/testdata/nopackage",
 		},
 		annotate: map[string]bool{"cmd/protoc-gen-go/testdata/annotations/annotations.proto": true},
+		params: map[string]string{
+			"cmd/protoc-gen-go/testdata/assertions/oneof/hybrid.proto":                   "assertions=oneof",
+			"cmd/protoc-gen-go/testdata/assertions/oneof/oneof.proto":                    "assertions=oneof",
+			"cmd/protoc-gen-go/testdata/constants/paths/paths.proto":                     "constants=paths",
+			"cmd/protoc-gen-go/testdata/constants/paths/paths_depth.proto":               "constants=paths,paths_depth=2",
+			"cmd/protoc-gen-go/testdata/constants/syntax/editions.proto":                 "constants=syntax",
+			"cmd/protoc-gen-go/testdata/constants/syntax/proto2.proto":                   "constants=syntax",
+			"cmd/protoc-gen-go/testdata/constants/syntax/proto3.proto":                   "constants=syntax",
+			"cmd/protoc-gen-go/testdata/constructors/oneof/hybrid.proto":                 "constructors=oneof",
+			"cmd/protoc-gen-go/testdata/constructors/oneof/oneof.proto":                  "constructors=oneof",
+			"cmd/protoc-gen-go/testdata/convert/strict/strict.proto":                     "convert_strict",
+			"cmd/protoc-gen-go/testdata/dtoout/dtoout.proto":                             "dto_out",
+			"cmd/protoc-gen-go/testdata/dtoout/shared/shared.proto":                      "dto_out",
+			"cmd/protoc-gen-go/testdata/enums/descriptions/descriptions.proto":           "enums=descriptions",
+			"cmd/protoc-gen-go/testdata/enums/label/label.proto":                         "enums=label",
+			"cmd/protoc-gen-go/testdata/enums/switchstring/switchstring.proto":           "enums=switchstring,switchstring_max=4",
+			"cmd/protoc-gen-go/testdata/fixtures/fixtures.proto":                         "fixtures_out",
+			"cmd/protoc-gen-go/testdata/fixtures/hybrid.proto":                           "fixtures_out",
+			"cmd/protoc-gen-go/testdata/getters/copymap/copymap.proto":                   "getters=copymap",
+			"cmd/protoc-gen-go/testdata/getters/copymap/hybrid.proto":                    "getters=copymap",
+			"cmd/protoc-gen-go/testdata/getters/copymap/mutators.proto":                  "getters=copymap,methods=maptext+mergereport+patchmerge+limit",
+			"cmd/protoc-gen-go/testdata/helpers/at/at.proto":                             "helpers=at",
+			"cmd/protoc-gen-go/testdata/helpers/eachmsg/eachmsg.proto":                   "helpers=eachmsg",
+			"cmd/protoc-gen-go/testdata/helpers/filter/filter.proto":                     "helpers=filter",
+			"cmd/protoc-gen-go/testdata/helpers/filter/hybrid.proto":                     "helpers=filter",
+			"cmd/protoc-gen-go/testdata/helpers/int64string/int64string.proto":           "helpers=int64string",
+			"cmd/protoc-gen-go/testdata/helpers/iter/iter.proto":                         "helpers=iter",
+			"cmd/protoc-gen-go/testdata/helpers/joined/joined.proto":                     "helpers=joined",
+			"cmd/protoc-gen-go/testdata/helpers/mergeunique/mergeunique.proto":           "helpers=mergeunique",
+			"cmd/protoc-gen-go/testdata/indexkey/indexkey_last.proto":                    "index_duplicates=last",
+			"cmd/protoc-gen-go/testdata/json/methods/methods.proto":                      "json=methods",
+			"cmd/protoc-gen-go/testdata/json/numeric/numeric.proto":                      "json=methods",
+			"cmd/protoc-gen-go/testdata/json/rules/rules.proto":                          "json=methods",
+			"cmd/protoc-gen-go/testdata/json/strict/strict.proto":                        "json=methods+strict",
+			"cmd/protoc-gen-go/testdata/layout/pack/pack.proto":                          "layout=pack",
+			"cmd/protoc-gen-go/testdata/maps/jsonnames/jsonnames.proto":                  "maps=jsonnames",
+			"cmd/protoc-gen-go/testdata/methods/applydefaults/applydefaults.proto":       "methods=applydefaults",
+			"cmd/protoc-gen-go/testdata/methods/applydefaults/editions.proto":            "methods=applydefaults",
+			"cmd/protoc-gen-go/testdata/methods/batch/batch.proto":                       "methods=batch",
+			"cmd/protoc-gen-go/testdata/methods/batch/batch_skip.proto":                  "methods=batch,batch_nil=skip",
+			"cmd/protoc-gen-go/testdata/methods/byjsonname/byjsonname.proto":             "methods=byjsonname",
+			"cmd/protoc-gen-go/testdata/methods/byjsonname/hybrid.proto":                 "methods=byjsonname",
+			"cmd/protoc-gen-go/testdata/methods/bytelen/bytelen.proto":                   "methods=bytelen",
+			"cmd/protoc-gen-go/testdata/methods/cachekey/raw/raw.proto":                  "methods=cachekey",
+			"cmd/protoc-gen-go/testdata/methods/cachekey/sha256/sha256.proto":            "methods=cachekey,cachekey=sha256",
+			"cmd/protoc-gen-go/testdata/methods/clearkind/clearkind.proto":               "methods=clearkind",
+			"cmd/protoc-gen-go/testdata/methods/clearpaths/clearpaths.proto":             "methods=clearpaths",
+			"cmd/protoc-gen-go/testdata/methods/coalesce/coalesce.proto":                 "methods=coalesce",
+			"cmd/protoc-gen-go/testdata/methods/coalesce/hybrid.proto":                   "methods=coalesce",
+			"cmd/protoc-gen-go/testdata/methods/cyclecheck/cyclecheck.proto":             "methods=cyclecheck",
+			"cmd/protoc-gen-go/testdata/methods/cyclecheck/hybrid.proto":                 "methods=cyclecheck",
+			"cmd/protoc-gen-go/testdata/methods/defaultjson/defaultjson.proto":           "methods=defaultjson",
+			"cmd/protoc-gen-go/testdata/methods/defaultjson/required.proto":              "methods=defaultjson",
+			"cmd/protoc-gen-go/testdata/methods/depth/depth.proto":                       "methods=depth",
+			"cmd/protoc-gen-go/testdata/methods/descindex/descindex.proto":               "methods=descindex",
+			"cmd/protoc-gen-go/testdata/methods/detectunknown/detectunknown.proto":       "methods=detectunknown",
+			"cmd/protoc-gen-go/testdata/methods/diffcount/diffcount.proto":               "methods=diffcount",
+			"cmd/protoc-gen-go/testdata/methods/eachext/eachext.proto":                   "methods=eachext",
+			"cmd/protoc-gen-go/testdata/methods/enumdefault/enumdefault.proto":           "methods=enumdefault",
+			"cmd/protoc-gen-go/testdata/methods/envoverride/envoverride.proto":           "methods=envoverride",
+			"cmd/protoc-gen-go/testdata/methods/envoverride/hybrid.proto":                "methods=envoverride",
+			"cmd/protoc-gen-go/testdata/methods/equalignore/equalignore.proto":           "methods=equalignore",
+			"cmd/protoc-gen-go/testdata/methods/extnums/extnums.proto":                   "methods=extnums",
+			"cmd/protoc-gen-go/testdata/methods/fastclone/fastclone.proto":               "methods=fastclone",
+			"cmd/protoc-gen-go/testdata/methods/fastclone/hybrid.proto":                  "methods=fastclone",
+			"cmd/protoc-gen-go/testdata/methods/fdlookup/fdlookup.proto":                 "methods=fdlookup",
+			"cmd/protoc-gen-go/testdata/methods/fieldbytes/fieldbytes.proto":             "methods=fieldbytes",
+			"cmd/protoc-gen-go/testdata/methods/fieldbytes/required.proto":               "methods=fieldbytes",
+			"cmd/protoc-gen-go/testdata/methods/fieldsizes/fieldsizes.proto":             "methods=fieldsizes",
+			"cmd/protoc-gen-go/testdata/methods/fingerprint/fingerprint.proto":           "methods=fingerprint",
+			"cmd/protoc-gen-go/testdata/methods/fingerprint/hybrid.proto":                "methods=fingerprint",
+			"cmd/protoc-gen-go/testdata/methods/framewriter/framewriter.proto":           "methods=framewriter",
+			"cmd/protoc-gen-go/testdata/methods/freeze/freeze.proto":                     "methods=freeze",
+			"cmd/protoc-gen-go/testdata/methods/freeze/replace.proto":                    "methods=freeze+fromkv+envoverride+urlvalues+jsonpatch,tracking=touched+callback",
+			"cmd/protoc-gen-go/testdata/methods/freezemaps/freezemaps.proto":             "methods=freezemaps",
+			"cmd/protoc-gen-go/testdata/methods/fromkv/fromkv.proto":                     "methods=fromkv",
+			"cmd/protoc-gen-go/testdata/methods/fromkv/hybrid.proto":                     "methods=fromkv",
+			"cmd/protoc-gen-go/testdata/methods/fromkv/unsupported/unsupported.proto":    "methods=fromkv,fromkv_unsupported=error",
+			"cmd/protoc-gen-go/testdata/methods/iszerofast/hybrid.proto":                 "methods=iszerofast",
+			"cmd/protoc-gen-go/testdata/methods/iszerofast/iszerofast.proto":             "methods=iszerofast",
+			"cmd/protoc-gen-go/testdata/methods/iszerofast/legacy.proto":                 "methods=iszerofast",
+			"cmd/protoc-gen-go/testdata/methods/jsonpatch/jsonpatch.proto":               "methods=jsonpatch",
+			"cmd/protoc-gen-go/testdata/methods/kindlookup/kindlookup.proto":             "methods=kindlookup",
+			"cmd/protoc-gen-go/testdata/methods/lenientunmarshal/lenientunmarshal.proto": "methods=lenientunmarshal",
+			"cmd/protoc-gen-go/testdata/methods/limit/limit.proto":                       "methods=limit",
+			"cmd/protoc-gen-go/testdata/methods/logstring/logstring.proto":               "methods=logstring",
+			"cmd/protoc-gen-go/testdata/methods/maptext/hybrid.proto":                    "methods=maptext",
+			"cmd/protoc-gen-go/testdata/methods/maptext/maptext.proto":                   "methods=maptext",
+			"cmd/protoc-gen-go/testdata/methods/marshalexcept/marshalexcept.proto":       "methods=marshalexcept",
+			"cmd/protoc-gen-go/testdata/methods/marshalpath/marshalpath.proto":           "methods=marshalpath",
+			"cmd/protoc-gen-go/testdata/methods/marshalpresent/marshalpresent.proto":     "methods=marshalpresent",
+			"cmd/protoc-gen-go/testdata/methods/maxsize/maxsize.proto":                   "methods=maxsize",
+			"cmd/protoc-gen-go/testdata/methods/mergereport/hybrid.proto":                "methods=mergereport",
+			"cmd/protoc-gen-go/testdata/methods/mergereport/mergereport.proto":           "methods=mergereport",
+			"cmd/protoc-gen-go/testdata/methods/metriclabels/metriclabels.proto":         "methods=metriclabels",
+			"cmd/protoc-gen-go/testdata/methods/msgcount/msgcount.proto":                 "methods=msgcount",
+			"cmd/protoc-gen-go/testdata/methods/oneoftagged/hybrid.proto":                "methods=oneoftagged",
+			"cmd/protoc-gen-go/testdata/methods/oneoftagged/oneoftagged.proto":           "methods=oneoftagged",
+			"cmd/protoc-gen-go/testdata/methods/patchmerge/patchmerge.proto":             "methods=patchmerge",
+			"cmd/protoc-gen-go/testdata/methods/project/hybrid.proto":                    "methods=project",
+			"cmd/protoc-gen-go/testdata/methods/project/project.proto":                   "methods=project",
+			"cmd/protoc-gen-go/testdata/methods/requiredcheck/requiredcheck.proto":       "methods=requiredcheck",
+			"cmd/protoc-gen-go/testdata/methods/setbynum/setbynum.proto":                 "methods=setbynum",
+			"cmd/protoc-gen-go/testdata/methods/sizetable/sizetable.proto":               "methods=sizetable",
+			"cmd/protoc-gen-go/testdata/methods/snapshot/snapshot.proto":                 "methods=snapshot",
+			"cmd/protoc-gen-go/testdata/methods/streamrepeated/group.proto":              "methods=streamrepeated",
+			"cmd/protoc-gen-go/testdata/methods/streamrepeated/streamrepeated.proto":     "methods=streamrepeated",
+			"cmd/protoc-gen-go/testdata/methods/stripunknown/stripunknown.proto":         "methods=stripunknown",
+			"cmd/protoc-gen-go/testdata/methods/templatemap/templatemap.proto":           "methods=templatemap",
+			"cmd/protoc-gen-go/testdata/methods/text/text.proto":                         "methods=text",
+			"cmd/protoc-gen-go/testdata/methods/tomap/tomap.proto":                       "methods=tomap",
+			"cmd/protoc-gen-go/testdata/methods/typedeps/typedeps.proto":                 "methods=typedeps",
+			"cmd/protoc-gen-go/testdata/methods/unknownpreserve/unknownpreserve.proto":   "methods=unknownpreserve",
+			"cmd/protoc-gen-go/testdata/methods/unmarshallimit/unmarshallimit.proto":     "methods=unmarshallimit,unmarshal_max_depth=8",
+			"cmd/protoc-gen-go/testdata/methods/urlvalues/hybrid.proto":                  "methods=urlvalues",
+			"cmd/protoc-gen-go/testdata/methods/urlvalues/unknown/unknown.proto":         "methods=urlvalues,urlvalues_unknown=error",
+			"cmd/protoc-gen-go/testdata/methods/urlvalues/urlvalues.proto":               "methods=urlvalues",
+			"cmd/protoc-gen-go/testdata/methods/utf8check/utf8check.proto":               "methods=utf8check",
+			"cmd/protoc-gen-go/testdata/methods/wirehex/wirehex.proto":                   "methods=wirehex",
+			"cmd/protoc-gen-go/testdata/methods/wireorder/wireorder.proto":               "methods=wireorder",
+			"cmd/protoc-gen-go/testdata/normalize/presence/editions.proto":               "normalize=presence",
+			"cmd/protoc-gen-go/testdata/normalize/presence/presence.proto":               "normalize=presence",
+			"cmd/protoc-gen-go/testdata/oneofs/value/value.proto":                        "oneofs=value",
+			"cmd/protoc-gen-go/testdata/pooling/sync/sync.proto":                         "pooling=sync",
+			"cmd/protoc-gen-go/testdata/reflection/exportmsgtypes/exportmsgtypes.proto":  "reflection=exportmsgtypes",
+			"cmd/protoc-gen-go/testdata/tracking/callback/callback.proto":                "tracking=callback",
+			"cmd/protoc-gen-go/testdata/tracking/touched/touched.proto":                  "tracking=touched",
+		},
 	}, {
 		path:    "internal/testprotos",
 		exclude: map[string]bool{"internal/testprotos/irregular/irregular.proto": true},
@@ -377,6 +511,9 @@ func generateLocalProtos() {
 			if d.annotate[filepath.ToSlash(relPath)] {
 				opts += ",annotate_code"
 			}
+			if params := d.params[filepath.ToSlash(relPath)]; params != "" {
+				opts += "," + params
+			}
 			if strings.HasPrefix(relPath, "internal/testprotos/test3/") {
 				variant := strings.TrimPrefix(relPath, "internal/testprotos/test3/")
 				if idx := strings.IndexByte(variant, '/'); idx > -1 {